@@ -50,6 +50,11 @@ const OPERATION_TITLES: Record<BulkOperationType, JSX.Element> = {
             <UploadIcon className="icon-inline text-muted" /> Publish changesets
         </>
     ),
+    REFRESH: (
+        <>
+            <SourceBranchIcon className="icon-inline text-muted" /> Refresh changesets
+        </>
+    ),
 }
 
 export interface BulkOperationNodeProps {
@@ -20,6 +20,9 @@ type Services struct {
 	GitHubWebhook             webhooks.Registerer
 	GitLabWebhook             http.Handler
 	BitbucketServerWebhook    http.Handler
+	BitbucketCloudWebhook     http.Handler
+	InsightsExportHandler     http.Handler
+	BatchChangesReportHandler http.Handler
 	NewCodeIntelUploadHandler NewCodeIntelUploadHandler
 	NewExecutorProxyHandler   NewExecutorProxyHandler
 	AuthzResolver             graphqlbackend.AuthzResolver
@@ -48,6 +51,9 @@ func DefaultServices() Services {
 		GitHubWebhook:             registerFunc(func(webhook *webhooks.GitHubWebhook) {}),
 		GitLabWebhook:             makeNotFoundHandler("gitlab webhook"),
 		BitbucketServerWebhook:    makeNotFoundHandler("bitbucket server webhook"),
+		BitbucketCloudWebhook:     makeNotFoundHandler("bitbucket cloud webhook"),
+		InsightsExportHandler:     makeNotFoundHandler("code insights export"),
+		BatchChangesReportHandler: makeNotFoundHandler("batch changes report"),
 		NewCodeIntelUploadHandler: func(_ bool) http.Handler { return makeNotFoundHandler("code intel upload") },
 		NewExecutorProxyHandler:   func() http.Handler { return makeNotFoundHandler("executor proxy") },
 	}
@@ -154,6 +154,7 @@ type CreateBatchChangesCredentialArgs struct {
 	ExternalServiceKind string
 	ExternalServiceURL  string
 	User                *graphql.ID
+	Username            *string
 	Credential          string
 }
 
@@ -212,6 +213,10 @@ type PublishChangesetsArgs struct {
 	Draft bool
 }
 
+type RefreshChangesetsArgs struct {
+	BulkOperationBaseArgs
+}
+
 type ResolveWorkspacesForBatchSpecArgs struct {
 	BatchSpec        string
 	AllowIgnored     bool
@@ -261,6 +266,7 @@ type BatchChangesResolver interface {
 	MergeChangesets(ctx context.Context, args *MergeChangesetsArgs) (BulkOperationResolver, error)
 	CloseChangesets(ctx context.Context, args *CloseChangesetsArgs) (BulkOperationResolver, error)
 	PublishChangesets(ctx context.Context, args *PublishChangesetsArgs) (BulkOperationResolver, error)
+	RefreshChangesets(ctx context.Context, args *RefreshChangesetsArgs) (BulkOperationResolver, error)
 
 	// Queries
 	BatchChange(ctx context.Context, args *BatchChangeArgs) (BatchChangeResolver, error)
@@ -592,6 +598,8 @@ type BatchChangeResolver interface {
 	Changesets(ctx context.Context, args *ListChangesetsArgs) (ChangesetsConnectionResolver, error)
 	ChangesetCountsOverTime(ctx context.Context, args *ChangesetCountsArgs) ([]ChangesetCountsResolver, error)
 	ClosedAt() *DateTime
+	RolloutWave() *int32
+	RolloutPausedReason() *string
 	DiffStat(ctx context.Context) (*DiffStat, error)
 	CurrentSpec(ctx context.Context) (BatchSpecResolver, error)
 	BulkOperations(ctx context.Context, args *ListBatchChangeBulkOperationArgs) (BulkOperationConnectionResolver, error)
@@ -700,6 +708,7 @@ type ExternalChangesetResolver interface {
 
 	Error() *string
 	SyncerError() *string
+	AutoMergeBlockedReason() *string
 	ScheduleEstimateAt(ctx context.Context) (*DateTime, error)
 
 	CurrentSpec(ctx context.Context) (VisibleChangesetSpecResolver, error)
@@ -22,6 +22,7 @@ type CodeIntelResolver interface {
 	CommitGraph(ctx context.Context, id graphql.ID) (CodeIntelligenceCommitGraphResolver, error)
 	QueueAutoIndexJobsForRepo(ctx context.Context, args *QueueAutoIndexJobsForRepoArgs) ([]LSIFIndexResolver, error)
 	GitBlobLSIFData(ctx context.Context, args *GitBlobLSIFDataArgs) (GitBlobLSIFDataResolver, error)
+	GitBlobSearchBasedCodeIntel(ctx context.Context, args *GitBlobLSIFDataArgs) (SearchBasedCodeIntelResolver, error)
 	CodeIntelligenceConfigurationPolicies(ctx context.Context, args *CodeIntelligenceConfigurationPoliciesArgs) (CodeIntelligenceConfigurationPolicyConnectionResolver, error)
 	CreateCodeIntelligenceConfigurationPolicy(ctx context.Context, args *CreateCodeIntelligenceConfigurationPolicyArgs) (CodeIntelligenceConfigurationPolicyResolver, error)
 	UpdateCodeIntelligenceConfigurationPolicy(ctx context.Context, args *UpdateCodeIntelligenceConfigurationPolicyArgs) (*EmptyResponse, error)
@@ -30,6 +31,10 @@ type CodeIntelResolver interface {
 	UpdateRepositoryIndexConfiguration(ctx context.Context, args *UpdateRepositoryIndexConfigurationArgs) (*EmptyResponse, error)
 	PreviewRepositoryFilter(ctx context.Context, args *PreviewRepositoryFilterArgs) (RepositoryFilterPreviewResolver, error)
 	PreviewGitObjectFilter(ctx context.Context, id graphql.ID, args *PreviewGitObjectFilterArgs) ([]GitObjectFilterPreviewResolver, error)
+	PreviewCodeIntelligenceConfigurationPolicy(ctx context.Context, args *PreviewCodeIntelligenceConfigurationPolicyArgs) ([]CodeIntelligenceConfigurationPolicyPreviewResolver, error)
+	CodeIntelPackage(ctx context.Context, args *CodeIntelPackageArgs) (CodeIntelPackageResolver, error)
+	RepositoryCodeIntelDependents(ctx context.Context, id graphql.ID, args *CodeIntelDependencyGraphArgs) (CodeIntelPackageDependentConnectionResolver, error)
+	RepositoryCodeIntelDependencies(ctx context.Context, id graphql.ID, args *CodeIntelDependencyGraphArgs) (CodeIntelPackageConnectionResolver, error)
 	NodeResolvers() map[string]NodeByIDFunc
 	DocumentationSearch(ctx context.Context, args *DocumentationSearchArgs) (DocumentationSearchResultsResolver, error)
 }
@@ -63,6 +68,13 @@ type LSIFUploadResolver interface {
 	PlaceInQueue() *int32
 	AssociatedIndex(ctx context.Context) (LSIFIndexResolver, error)
 	ProjectRoot(ctx context.Context) (*GitTreeEntryResolver, error)
+	ValidationFindings(ctx context.Context) ([]LSIFUploadValidationFindingResolver, error)
+}
+
+type LSIFUploadValidationFindingResolver interface {
+	Kind() string
+	Message() string
+	LineNumbers() []int32
 }
 
 type LSIFUploadConnectionResolver interface {
@@ -153,8 +165,11 @@ type GitBlobLSIFDataResolver interface {
 	Stencil(ctx context.Context) ([]RangeResolver, error)
 	Ranges(ctx context.Context, args *LSIFRangesArgs) (CodeIntelligenceRangeConnectionResolver, error)
 	Definitions(ctx context.Context, args *LSIFQueryPositionArgs) (LocationConnectionResolver, error)
+	TypeDefinitions(ctx context.Context, args *LSIFQueryPositionArgs) (LocationConnectionResolver, error)
 	References(ctx context.Context, args *LSIFPagedQueryPositionArgs) (LocationConnectionResolver, error)
 	Implementations(ctx context.Context, args *LSIFPagedQueryPositionArgs) (LocationConnectionResolver, error)
+	IncomingCalls(ctx context.Context, args *LSIFPagedQueryPositionArgs) (CallHierarchyConnectionResolver, error)
+	OutgoingCalls(ctx context.Context, args *LSIFPagedQueryPositionArgs) (CallHierarchyConnectionResolver, error)
 	Hover(ctx context.Context, args *LSIFQueryPositionArgs) (HoverResolver, error)
 	Documentation(ctx context.Context, args *LSIFQueryPositionArgs) (DocumentationResolver, error)
 }
@@ -215,6 +230,38 @@ type LocationConnectionResolver interface {
 	PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error)
 }
 
+type CallHierarchyConnectionResolver interface {
+	Nodes(ctx context.Context) ([]CallHierarchyItemResolver, error)
+	PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error)
+}
+
+type CallHierarchyItemResolver interface {
+	Name() string
+	Kind() string
+	Location() LocationResolver
+	FromRanges() []RangeResolver
+}
+
+type SearchBasedCodeIntelResolver interface {
+	Definitions(ctx context.Context, args *LSIFQueryPositionArgs) (SearchBasedLocationConnectionResolver, error)
+	References(ctx context.Context, args *SearchBasedReferencesArgs) (SearchBasedLocationConnectionResolver, error)
+}
+
+type SearchBasedReferencesArgs struct {
+	LSIFQueryPositionArgs
+	First *int32
+}
+
+type SearchBasedLocationConnectionResolver interface {
+	Nodes(ctx context.Context) ([]SearchBasedLocationResolver, error)
+	LimitHit() bool
+}
+
+type SearchBasedLocationResolver interface {
+	Location() LocationResolver
+	Confidence() string
+}
+
 type HoverResolver interface {
 	Markdown() Markdown
 	Range() RangeResolver
@@ -300,6 +347,42 @@ type RepositoryFilterPreviewResolver interface {
 	PageInfo() *graphqlutil.PageInfo
 }
 
+type CodeIntelPackageArgs struct {
+	Scheme  string
+	Name    string
+	Version *string
+}
+
+type CodeIntelDependencyGraphArgs struct {
+	graphqlutil.ConnectionArgs
+	After *string
+}
+
+type CodeIntelPackageResolver interface {
+	Scheme() string
+	Name() string
+	Version() *string
+	Dependents(ctx context.Context, args *CodeIntelDependencyGraphArgs) (CodeIntelPackageDependentConnectionResolver, error)
+	Dependencies(ctx context.Context, args *CodeIntelDependencyGraphArgs) (CodeIntelPackageConnectionResolver, error)
+}
+
+type CodeIntelPackageConnectionResolver interface {
+	Nodes() []CodeIntelPackageResolver
+	TotalCount() int32
+	PageInfo() *graphqlutil.PageInfo
+}
+
+type CodeIntelPackageDependentConnectionResolver interface {
+	Nodes() []CodeIntelPackageDependentResolver
+	TotalCount() int32
+	PageInfo() *graphqlutil.PageInfo
+}
+
+type CodeIntelPackageDependentResolver interface {
+	Repository() *RepositoryResolver
+	Package() CodeIntelPackageResolver
+}
+
 type PreviewGitObjectFilterArgs struct {
 	Type    GitObjectType
 	Pattern string
@@ -310,6 +393,36 @@ type GitObjectFilterPreviewResolver interface {
 	Rev() string
 }
 
+type PreviewCodeIntelligenceConfigurationPolicyArgs struct {
+	ID         *graphql.ID
+	Repository *graphql.ID
+	First      *int32
+	CodeIntelConfigurationPolicy
+}
+
+type CodeIntelligenceConfigurationPolicyPreviewResolver interface {
+	Repository() *RepositoryResolver
+	Uploads(ctx context.Context, args *CodeIntelligenceRetentionPreviewArgs) (CodeIntelligenceRetentionPreviewConnectionResolver, error)
+	IndexedCommits(ctx context.Context) ([]GitObjectFilterPreviewResolver, error)
+}
+
+type CodeIntelligenceRetentionPreviewArgs struct {
+	graphqlutil.ConnectionArgs
+	After *string
+}
+
+type CodeIntelligenceRetentionPreviewConnectionResolver interface {
+	Nodes() []CodeIntelligenceRetentionPreviewResolver
+	TotalCount() int32
+	PageInfo() *graphqlutil.PageInfo
+}
+
+type CodeIntelligenceRetentionPreviewResolver interface {
+	Upload() LSIFUploadResolver
+	Retained() bool
+	RetainedByPolicy() bool
+}
+
 type CodeIntelligenceConfigurationPolicyConnectionResolver interface {
 	Nodes(ctx context.Context) ([]CodeIntelligenceConfigurationPolicyResolver, error)
 	TotalCount(ctx context.Context) (*int32, error)
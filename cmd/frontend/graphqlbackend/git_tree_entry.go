@@ -249,6 +249,27 @@ func (r *GitTreeEntryResolver) LSIF(ctx context.Context, args *struct{ ToolName
 	})
 }
 
+func (r *GitTreeEntryResolver) SearchBasedCodeIntel(ctx context.Context) (SearchBasedCodeIntelResolver, error) {
+	if r.stat.IsDir() {
+		// Search-based queries read the contents of the requested file
+		return nil, nil
+	}
+
+	codeIntelRequests.WithLabelValues(trace.RequestOrigin(ctx)).Inc()
+
+	repo, err := r.commit.repoResolver.repo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return EnterpriseResolvers.codeIntelResolver.GitBlobSearchBasedCodeIntel(ctx, &GitBlobLSIFDataArgs{
+		Repo:      repo,
+		Commit:    api.CommitID(r.Commit().OID()),
+		Path:      r.Path(),
+		ExactPath: true,
+	})
+}
+
 type fileInfo struct {
 	path  string
 	size  int64
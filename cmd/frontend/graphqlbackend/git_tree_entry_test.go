@@ -26,6 +26,22 @@ func TestGitTreeEntry_RawZipArchiveURL(t *testing.T) {
 	}
 }
 
+func TestGitTreeEntry_SearchBasedCodeIntelDirectory(t *testing.T) {
+	db := dbmock.NewMockDB()
+	resolver, err := NewGitTreeEntryResolver(db,
+		&GitCommitResolver{
+			repoResolver: NewRepositoryResolver(db, &types.Repo{Name: "my/repo"}),
+		},
+		CreateFileInfo("a/b", true)).
+		SearchBasedCodeIntel(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolver != nil {
+		t.Errorf("expected no search-based code intel resolver for a directory")
+	}
+}
+
 func TestGitTreeEntry_Content(t *testing.T) {
 	wantPath := "foobar.md"
 	wantContent := "foobar"
@@ -35,6 +35,14 @@ type InsightsResolver interface {
 
 	DeleteInsightView(ctx context.Context, args *DeleteInsightViewArgs) (*EmptyResponse, error)
 
+	CreateInsightSeriesAlert(ctx context.Context, args *CreateInsightSeriesAlertArgs) (InsightSeriesAlertResolver, error)
+	DeleteInsightSeriesAlert(ctx context.Context, args *DeleteInsightSeriesAlertArgs) (*EmptyResponse, error)
+
+	CreateInsightAnnotation(ctx context.Context, args *CreateInsightAnnotationArgs) (InsightAnnotationResolver, error)
+	UpdateInsightAnnotation(ctx context.Context, args *UpdateInsightAnnotationArgs) (InsightAnnotationResolver, error)
+	DeleteInsightAnnotation(ctx context.Context, args *DeleteInsightAnnotationArgs) (*EmptyResponse, error)
+	UpdateInsightSeriesGoal(ctx context.Context, args *UpdateInsightSeriesGoalArgs) (InsightViewPayloadResolver, error)
+
 	// Admin Management
 	UpdateInsightSeries(ctx context.Context, args *UpdateInsightSeriesArgs) (InsightSeriesMetadataPayloadResolver, error)
 	InsightSeriesQueryStatus(ctx context.Context) ([]InsightSeriesQueryStatusResolver, error)
@@ -76,12 +84,60 @@ type InsightsPointsArgs struct {
 	ExcludeRepoRegex *string
 }
 
+type InsightsRepositoryBreakdownArgs struct {
+	First            int32
+	From             *DateTime
+	To               *DateTime
+	IncludeRepoRegex *string
+	ExcludeRepoRegex *string
+}
+
+type InsightsDataPointBreakdownResolver interface {
+	DateTime() DateTime
+	Value() float64
+	Repositories() []InsightsRepositoryDataPointResolver
+}
+
+type InsightsRepositoryDataPointResolver interface {
+	RepositoryName() string
+	Value() float64
+	Delta() float64
+}
+
 type InsightSeriesResolver interface {
 	SeriesId() string
 	Label() string
 	Points(ctx context.Context, args *InsightsPointsArgs) ([]InsightsDataPointResolver, error)
+	RepositoryBreakdown(ctx context.Context, args *InsightsRepositoryBreakdownArgs) ([]InsightsDataPointBreakdownResolver, error)
 	Status(ctx context.Context) (InsightStatusResolver, error)
 	DirtyMetadata(ctx context.Context) ([]InsightDirtyQueryResolver, error)
+	Alerts(ctx context.Context) ([]InsightSeriesAlertResolver, error)
+	Annotations(ctx context.Context) ([]InsightAnnotationResolver, error)
+	Goal(ctx context.Context) (InsightSeriesGoalResolver, error)
+}
+
+type InsightAnnotationResolver interface {
+	ID() graphql.ID
+	Time() DateTime
+	Label() string
+}
+
+type InsightSeriesGoalResolver interface {
+	Value() float64
+	TargetDate() *DateTime
+	SlopePerDay() *float64
+	ProjectedCompletionDate() *DateTime
+}
+
+type InsightSeriesAlertResolver interface {
+	ID() graphql.ID
+	SeriesId() string
+	Comparator() string
+	Threshold() float64
+	Email() bool
+	WebhookURL() *string
+	TriggeredAt() *DateTime
+	LastValue() *float64
 }
 
 type InsightResolver interface {
@@ -173,6 +229,7 @@ type InsightViewResolver interface {
 	DataSeries(ctx context.Context) ([]InsightSeriesResolver, error)
 	Presentation(ctx context.Context) (InsightPresentation, error)
 	DataSeriesDefinitions(ctx context.Context) ([]InsightDataSeriesDefinition, error)
+	Annotations(ctx context.Context) ([]InsightAnnotationResolver, error)
 }
 
 type InsightDataSeriesDefinition interface {
@@ -200,6 +257,7 @@ type SearchInsightDataSeriesDefinitionResolver interface {
 	Query(ctx context.Context) (string, error)
 	RepositoryScope(ctx context.Context) (InsightRepositoryScopeResolver, error)
 	TimeScope(ctx context.Context) (InsightTimeScope, error)
+	GeneratedFromCaptureGroups() (bool, error)
 }
 
 type InsightPresentation interface {
@@ -335,11 +393,12 @@ type InsightViewFiltersInput struct {
 }
 
 type LineChartSearchInsightDataSeriesInput struct {
-	SeriesId        *string
-	Query           string
-	TimeScope       TimeScopeInput
-	RepositoryScope RepositoryScopeInput
-	Options         LineChartDataSeriesOptionsInput
+	SeriesId                   *string
+	Query                      string
+	TimeScope                  TimeScopeInput
+	RepositoryScope            RepositoryScopeInput
+	Options                    LineChartDataSeriesOptionsInput
+	GeneratedFromCaptureGroups *bool
 }
 
 type LineChartDataSeriesOptionsInput struct {
@@ -379,6 +438,58 @@ type DeleteInsightViewArgs struct {
 	Id graphql.ID
 }
 
+type CreateInsightSeriesAlertArgs struct {
+	Input CreateInsightSeriesAlertInput
+}
+
+type CreateInsightSeriesAlertInput struct {
+	SeriesId      string
+	InsightViewId *graphql.ID
+	Comparator    string
+	Threshold     float64
+	Email         bool
+	WebhookURL    *string
+}
+
+type DeleteInsightSeriesAlertArgs struct {
+	Id graphql.ID
+}
+
+type CreateInsightAnnotationArgs struct {
+	Input CreateInsightAnnotationInput
+}
+
+type CreateInsightAnnotationInput struct {
+	InsightViewId graphql.ID
+	Time          DateTime
+	Label         string
+}
+
+type UpdateInsightAnnotationArgs struct {
+	Input UpdateInsightAnnotationInput
+}
+
+type UpdateInsightAnnotationInput struct {
+	Id    graphql.ID
+	Time  *DateTime
+	Label *string
+}
+
+type DeleteInsightAnnotationArgs struct {
+	Id graphql.ID
+}
+
+type UpdateInsightSeriesGoalArgs struct {
+	Input UpdateInsightSeriesGoalInput
+}
+
+type UpdateInsightSeriesGoalInput struct {
+	InsightViewId graphql.ID
+	SeriesId      string
+	Value         *float64
+	TargetDate    *DateTime
+}
+
 type SearchInsightLivePreviewSeriesResolver interface {
 	Points(ctx context.Context) ([]InsightsDataPointResolver, error)
 	Label(ctx context.Context) (string, error)
@@ -13,11 +13,13 @@ type LocationResolver interface {
 	Range() *rangeResolver
 	URL(ctx context.Context) (string, error)
 	CanonicalURL() string
+	Approximate() bool
 }
 
 type locationResolver struct {
-	resource *GitTreeEntryResolver
-	lspRange *lsp.Range
+	resource    *GitTreeEntryResolver
+	lspRange    *lsp.Range
+	approximate bool
 }
 
 var _ LocationResolver = &locationResolver{}
@@ -29,6 +31,16 @@ func NewLocationResolver(resource *GitTreeEntryResolver, lspRange *lsp.Range) Lo
 	}
 }
 
+// NewApproximateLocationResolver creates a location resolver whose range was translated from
+// another commit and may only be a best guess, as indicated by the approximate flag.
+func NewApproximateLocationResolver(resource *GitTreeEntryResolver, lspRange *lsp.Range, approximate bool) LocationResolver {
+	return &locationResolver{
+		resource:    resource,
+		lspRange:    lspRange,
+		approximate: approximate,
+	}
+}
+
 func (r *locationResolver) Resource() *GitTreeEntryResolver { return r.resource }
 
 func (r *locationResolver) Range() *rangeResolver {
@@ -51,6 +63,8 @@ func (r *locationResolver) CanonicalURL() string {
 	return r.urlPath(url)
 }
 
+func (r *locationResolver) Approximate() bool { return r.approximate }
+
 func (r *locationResolver) urlPath(prefix string) string {
 	url := prefix
 	if r.lspRange != nil {
@@ -352,6 +352,14 @@ func (r *RepositoryResolver) PreviewGitObjectFilter(ctx context.Context, args *P
 	return EnterpriseResolvers.codeIntelResolver.PreviewGitObjectFilter(ctx, r.ID(), args)
 }
 
+func (r *RepositoryResolver) CodeIntelDependents(ctx context.Context, args *CodeIntelDependencyGraphArgs) (CodeIntelPackageDependentConnectionResolver, error) {
+	return EnterpriseResolvers.codeIntelResolver.RepositoryCodeIntelDependents(ctx, r.ID(), args)
+}
+
+func (r *RepositoryResolver) CodeIntelDependencies(ctx context.Context, args *CodeIntelDependencyGraphArgs) (CodeIntelPackageConnectionResolver, error) {
+	return EnterpriseResolvers.codeIntelResolver.RepositoryCodeIntelDependencies(ctx, r.ID(), args)
+}
+
 type AuthorizedUserArgs struct {
 	RepositoryID graphql.ID
 	Permission   string
@@ -328,6 +328,9 @@ func makeExternalAPI(db database.DB, schema *graphql.Schema, enterprise enterpri
 		enterprise.GitHubWebhook,
 		enterprise.GitLabWebhook,
 		enterprise.BitbucketServerWebhook,
+		enterprise.BitbucketCloudWebhook,
+		enterprise.InsightsExportHandler,
+		enterprise.BatchChangesReportHandler,
 		enterprise.NewCodeIntelUploadHandler,
 		enterprise.NewExecutorProxyHandler,
 		rateLimiter,
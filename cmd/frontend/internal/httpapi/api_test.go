@@ -27,6 +27,9 @@ func newTest() *httptestutil.Client {
 		enterpriseServices.GitHubWebhook,
 		enterpriseServices.GitLabWebhook,
 		enterpriseServices.BitbucketServerWebhook,
+		enterpriseServices.BitbucketCloudWebhook,
+		enterpriseServices.InsightsExportHandler,
+		enterpriseServices.BatchChangesReportHandler,
 		enterpriseServices.NewCodeIntelUploadHandler,
 		rateLimiter,
 	))
@@ -0,0 +1,417 @@
+// Package reports serves status reports of batch changes in formats suitable
+// for sharing outside of Sourcegraph, such as spreadsheets or documents.
+package reports
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gorilla/mux"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/enterprise"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/state"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/csvutil"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+const (
+	FormatCSV      = "csv"
+	FormatMarkdown = "markdown"
+
+	// KindChangesets reports the changesets of a batch change, one per row.
+	KindChangesets = "changesets"
+	// KindBurndown reports the number of changesets in each state per day.
+	// In Markdown reports, both kinds are included.
+	KindBurndown = "burndown"
+)
+
+// batchChangeIDKind is the kind of the relay ID of a batch change, as used by
+// the GraphQL API.
+const batchChangeIDKind = "BatchChange"
+
+type handler struct {
+	store *store.Store
+}
+
+// NewHandler returns an HTTP handler that serves a status report of a batch
+// change. The batch change is identified by the {id} route variable, which may
+// be either the GraphQL ID of the batch change or its database ID. The format
+// is chosen with the "format" query parameter and is either "csv" (the
+// default) or "markdown". CSV reports contain either the changesets or the
+// burndown of the batch change, as chosen with the "report" query parameter.
+func NewHandler(s *store.Store) http.Handler {
+	return &handler{store: s}
+}
+
+// Row is a single changeset of a batch change.
+type Row struct {
+	Repository  string
+	Title       string
+	State       btypes.ChangesetState
+	ReviewState btypes.ChangesetReviewState
+	CheckState  btypes.ChangesetCheckState
+	UpdatedAt   time.Time
+	URL         string
+	Added       int32
+	Changed     int32
+	Deleted     int32
+}
+
+// Report is the status report of a batch change.
+type Report struct {
+	Name        string
+	GeneratedAt time.Time
+	Changesets  []Row
+	Burndown    []*state.ChangesetCounts
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = FormatCSV
+	}
+	if format != FormatCSV && format != FormatMarkdown {
+		http.Error(w, fmt.Sprintf("unsupported report format %q", format), http.StatusBadRequest)
+		return
+	}
+
+	kind := r.URL.Query().Get("report")
+	if kind == "" {
+		kind = KindChangesets
+	}
+	if kind != KindChangesets && kind != KindBurndown {
+		http.Error(w, fmt.Sprintf("unsupported report %q", kind), http.StatusBadRequest)
+		return
+	}
+
+	if err := enterprise.BatchChangesEnabledForUser(ctx, h.store.DatabaseDB()); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	id, err := batchChangeID(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "invalid batch change ID", http.StatusBadRequest)
+		return
+	}
+
+	report, err := h.report(ctx, id)
+	if err != nil {
+		if errors.Is(err, store.ErrNoResults) {
+			http.Error(w, "batch change not found", http.StatusNotFound)
+			return
+		}
+		log15.Error("batches.reports", "error", err)
+		http.Error(w, "failed to build batch change report", http.StatusInternalServerError)
+		return
+	}
+
+	switch format {
+	case FormatCSV:
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", report.Name+"-"+kind+".csv"))
+		if kind == KindBurndown {
+			err = writeBurndownCSV(w, report.Burndown)
+		} else {
+			err = writeChangesetsCSV(w, report.Changesets)
+		}
+	case FormatMarkdown:
+		w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", report.Name+".md"))
+		err = writeMarkdown(w, report)
+	}
+	if err != nil {
+		log15.Error("batches.reports: failed to write response", "error", err)
+	}
+}
+
+// report builds the report of the given batch change.
+func (h *handler) report(ctx context.Context, id int64) (*Report, error) {
+	batchChange, err := h.store.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: id})
+	if err != nil {
+		return nil, err
+	}
+
+	// 🚨 SECURITY: EnforceAuthz only returns the changesets in repositories
+	// the current user has access to.
+	cs, _, err := h.store.ListChangesets(ctx, store.ListChangesetsOpts{
+		BatchChangeID: batchChange.ID,
+		EnforceAuthz:  true,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing changesets")
+	}
+
+	// 🚨 SECURITY: GetReposSetByIDs uses the authzFilter under the hood.
+	repos, err := h.store.Repos().GetReposSetByIDs(ctx, cs.RepoIDs()...)
+	if err != nil {
+		return nil, errors.Wrap(err, "loading repositories")
+	}
+
+	specTitles, err := h.specTitles(ctx, cs)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := changesetRows(cs, repos, specTitles)
+	if err != nil {
+		return nil, err
+	}
+
+	burndown, err := h.burndown(ctx, batchChange)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Report{
+		Name:        batchChange.Name,
+		GeneratedAt: h.store.Clock()().UTC(),
+		Changesets:  rows,
+		Burndown:    burndown,
+	}, nil
+}
+
+// specTitles returns the titles of the changesets that haven't been published
+// yet, which are only stored in their changeset specs.
+func (h *handler) specTitles(ctx context.Context, cs btypes.Changesets) (map[int64]string, error) {
+	var ids []int64
+	for _, c := range cs {
+		if !c.Published() && c.CurrentSpecID != 0 {
+			ids = append(ids, c.CurrentSpecID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	specs, _, err := h.store.ListChangesetSpecs(ctx, store.ListChangesetSpecsOpts{IDs: ids})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing changeset specs")
+	}
+
+	titles := make(map[int64]string, len(specs))
+	for _, spec := range specs {
+		titles[spec.ID] = spec.Spec.Title
+	}
+	return titles, nil
+}
+
+// burndown reconstructs the number of changesets in each state per day since
+// the batch change was created, in the same way as the changeset counts of
+// the GraphQL API.
+func (h *handler) burndown(ctx context.Context, batchChange *btypes.BatchChange) ([]*state.ChangesetCounts, error) {
+	publishedState := btypes.ChangesetPublicationStatePublished
+	cs, _, err := h.store.ListChangesets(ctx, store.ListChangesetsOpts{
+		BatchChangeID: batchChange.ID,
+		// Only load fully-synced changesets, so that the data we use for
+		// computing the changeset counts is complete.
+		PublicationState: &publishedState,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing published changesets")
+	}
+
+	var es []*btypes.ChangesetEvent
+	if ids := cs.IDs(); len(ids) > 0 {
+		es, _, err = h.store.ListChangesetEvents(ctx, store.ListChangesetEventsOpts{ChangesetIDs: ids, Kinds: state.RequiredEventTypesForHistory})
+		if err != nil {
+			return nil, errors.Wrap(err, "listing changeset events")
+		}
+	}
+	// Sort all events once by their timestamps, CalcCounts depends on it.
+	events := state.ChangesetEvents(es)
+	sort.Sort(events)
+
+	start := batchChange.CreatedAt.UTC()
+	if len(events) > 0 && events[0].Timestamp().Before(start) {
+		start = events[0].Timestamp().UTC()
+	}
+
+	return state.CalcCounts(start, h.store.Clock()().UTC(), cs, es...)
+}
+
+// changesetRows returns the rows of the given changesets that are in one of
+// the given repositories, ordered by repository name and title.
+func changesetRows(cs btypes.Changesets, repos map[api.RepoID]*types.Repo, specTitles map[int64]string) ([]Row, error) {
+	rows := make([]Row, 0, len(cs))
+	for _, c := range cs {
+		repo, ok := repos[c.RepoID]
+		if !ok {
+			continue
+		}
+
+		s, err := c.State()
+		if err != nil {
+			return nil, err
+		}
+
+		row := Row{
+			Repository:  string(repo.Name),
+			State:       s,
+			ReviewState: c.ExternalReviewState,
+			CheckState:  c.ExternalCheckState,
+			UpdatedAt:   c.UpdatedAt,
+		}
+
+		if c.Published() {
+			if row.Title, err = c.Title(); err != nil {
+				return nil, err
+			}
+			if row.URL, err = c.URL(); err != nil {
+				return nil, err
+			}
+			if !c.ExternalUpdatedAt.IsZero() {
+				row.UpdatedAt = c.ExternalUpdatedAt
+			}
+		} else {
+			row.Title = specTitles[c.CurrentSpecID]
+		}
+
+		if stat := c.DiffStat(); stat != nil {
+			row.Added, row.Changed, row.Deleted = stat.Added, stat.Changed, stat.Deleted
+		}
+
+		rows = append(rows, row)
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].Repository != rows[j].Repository {
+			return rows[i].Repository < rows[j].Repository
+		}
+		return rows[i].Title < rows[j].Title
+	})
+
+	return rows, nil
+}
+
+var changesetsCSVHeader = []string{"repository", "title", "state", "review_state", "check_state", "updated_at", "url", "added", "changed", "deleted"}
+
+func writeChangesetsCSV(w io.Writer, rows []Row) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(changesetsCSVHeader); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := cw.Write([]string{
+			csvutil.Escape(row.Repository),
+			csvutil.Escape(row.Title),
+			string(row.State),
+			string(row.ReviewState),
+			string(row.CheckState),
+			row.UpdatedAt.UTC().Format(time.RFC3339),
+			csvutil.Escape(row.URL),
+			strconv.Itoa(int(row.Added)),
+			strconv.Itoa(int(row.Changed)),
+			strconv.Itoa(int(row.Deleted)),
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+var burndownCSVHeader = []string{"date", "total", "merged", "closed", "draft", "open", "open_approved", "open_changes_requested", "open_pending"}
+
+func writeBurndownCSV(w io.Writer, counts []*state.ChangesetCounts) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(burndownCSVHeader); err != nil {
+		return err
+	}
+	for _, c := range counts {
+		if err := cw.Write([]string{
+			c.Time.UTC().Format("2006-01-02"),
+			strconv.Itoa(int(c.Total)),
+			strconv.Itoa(int(c.Merged)),
+			strconv.Itoa(int(c.Closed)),
+			strconv.Itoa(int(c.Draft)),
+			strconv.Itoa(int(c.Open)),
+			strconv.Itoa(int(c.OpenApproved)),
+			strconv.Itoa(int(c.OpenChangesRequested)),
+			strconv.Itoa(int(c.OpenPending)),
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeMarkdown(w io.Writer, report *Report) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s\n\n", markdownEscape(report.Name))
+	fmt.Fprintf(&b, "Status of %d changesets as of %s.\n\n", len(report.Changesets), report.GeneratedAt.Format(time.RFC3339))
+
+	b.WriteString("## Changesets\n\n")
+	b.WriteString("| Repository | Title | State | Review state | Check state | Last update | Diff |\n")
+	b.WriteString("| --- | --- | --- | --- | --- | --- | --- |\n")
+	for _, row := range report.Changesets {
+		title := markdownEscape(row.Title)
+		if row.URL != "" {
+			title = fmt.Sprintf("[%s](%s)", title, row.URL)
+		}
+		fmt.Fprintf(&b, "| %s | %s | %s | %s | %s | %s | +%d ~%d -%d |\n",
+			markdownEscape(row.Repository),
+			title,
+			row.State,
+			orDash(string(row.ReviewState)),
+			orDash(string(row.CheckState)),
+			row.UpdatedAt.UTC().Format("2006-01-02"),
+			row.Added, row.Changed, row.Deleted,
+		)
+	}
+
+	b.WriteString("\n## Burndown\n\n")
+	b.WriteString("| Date | Total | Merged | Closed | Draft | Open | Approved | Changes requested | Review pending |\n")
+	b.WriteString("| --- | --- | --- | --- | --- | --- | --- | --- | --- |\n")
+	for _, c := range report.Burndown {
+		fmt.Fprintf(&b, "| %s | %d | %d | %d | %d | %d | %d | %d | %d |\n",
+			c.Time.UTC().Format("2006-01-02"),
+			c.Total, c.Merged, c.Closed, c.Draft, c.Open,
+			c.OpenApproved, c.OpenChangesRequested, c.OpenPending,
+		)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+var markdownEscaper = strings.NewReplacer("|", `\|`, "\n", " ", "[", `\[`, "]", `\]`)
+
+func markdownEscape(s string) string { return markdownEscaper.Replace(s) }
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// batchChangeID returns the database ID of the batch change referenced by the
+// given identifier, which may be either a GraphQL ID or a database ID.
+func batchChangeID(id string) (int64, error) {
+	if relay.UnmarshalKind(graphql.ID(id)) == batchChangeIDKind {
+		var batchChangeID int64
+		if err := relay.UnmarshalSpec(graphql.ID(id), &batchChangeID); err == nil {
+			return batchChangeID, nil
+		}
+	}
+	return strconv.ParseInt(id, 10, 64)
+}
@@ -0,0 +1,230 @@
+package reports
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/state"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestChangesetRows(t *testing.T) {
+	updatedAt := time.Date(2021, 12, 1, 10, 0, 0, 0, time.UTC)
+	externalUpdatedAt := time.Date(2021, 12, 2, 10, 0, 0, 0, time.UTC)
+	added, changed, deleted := int32(3), int32(2), int32(1)
+
+	repos := map[api.RepoID]*types.Repo{
+		1: {ID: 1, Name: "github.com/sourcegraph/zoekt"},
+		2: {ID: 2, Name: "github.com/sourcegraph/sourcegraph"},
+	}
+	cs := btypes.Changesets{
+		{
+			ID:                  1,
+			RepoID:              1,
+			PublicationState:    btypes.ChangesetPublicationStatePublished,
+			ReconcilerState:     btypes.ReconcilerStateCompleted,
+			ExternalState:       btypes.ChangesetExternalStateOpen,
+			ExternalReviewState: btypes.ChangesetReviewStateApproved,
+			ExternalCheckState:  btypes.ChangesetCheckStatePassed,
+			ExternalUpdatedAt:   externalUpdatedAt,
+			UpdatedAt:           updatedAt,
+			Metadata:            &github.PullRequest{Title: "Update dependencies", URL: "https://github.com/sourcegraph/zoekt/pull/1"},
+			DiffStatAdded:       &added,
+			DiffStatChanged:     &changed,
+			DiffStatDeleted:     &deleted,
+		},
+		{
+			ID:               2,
+			RepoID:           2,
+			CurrentSpecID:    5,
+			PublicationState: btypes.ChangesetPublicationStateUnpublished,
+			ReconcilerState:  btypes.ReconcilerStateCompleted,
+			UpdatedAt:        updatedAt,
+		},
+		// Changesets in repositories the user can't access are skipped.
+		{
+			ID:               3,
+			RepoID:           3,
+			PublicationState: btypes.ChangesetPublicationStateUnpublished,
+			ReconcilerState:  btypes.ReconcilerStateCompleted,
+			UpdatedAt:        updatedAt,
+		},
+	}
+
+	have, err := changesetRows(cs, repos, map[int64]string{5: "Update dependencies"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Row{
+		{
+			Repository: "github.com/sourcegraph/sourcegraph",
+			Title:      "Update dependencies",
+			State:      btypes.ChangesetStateUnpublished,
+			UpdatedAt:  updatedAt,
+		},
+		{
+			Repository:  "github.com/sourcegraph/zoekt",
+			Title:       "Update dependencies",
+			State:       btypes.ChangesetStateOpen,
+			ReviewState: btypes.ChangesetReviewStateApproved,
+			CheckState:  btypes.ChangesetCheckStatePassed,
+			UpdatedAt:   externalUpdatedAt,
+			URL:         "https://github.com/sourcegraph/zoekt/pull/1",
+			Added:       3,
+			Changed:     2,
+			Deleted:     1,
+		},
+	}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Errorf("unexpected rows (-want +have):\n%s", diff)
+	}
+}
+
+func TestWriteChangesetsCSV(t *testing.T) {
+	rows := []Row{
+		{
+			Repository:  "github.com/sourcegraph/zoekt",
+			Title:       "Update dependencies, again",
+			State:       btypes.ChangesetStateOpen,
+			ReviewState: btypes.ChangesetReviewStatePending,
+			CheckState:  btypes.ChangesetCheckStateFailed,
+			UpdatedAt:   time.Date(2021, 12, 2, 10, 0, 0, 0, time.UTC),
+			URL:         "https://github.com/sourcegraph/zoekt/pull/1",
+			Added:       3,
+			Changed:     2,
+			Deleted:     1,
+		},
+		{
+			Repository: "github.com/sourcegraph/src-cli",
+			Title:      `=HYPERLINK("https://example.com")`,
+			State:      btypes.ChangesetStateUnpublished,
+			UpdatedAt:  time.Date(2021, 12, 3, 10, 0, 0, 0, time.UTC),
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := writeChangesetsCSV(&buf, rows); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `repository,title,state,review_state,check_state,updated_at,url,added,changed,deleted
+github.com/sourcegraph/zoekt,"Update dependencies, again",OPEN,PENDING,FAILED,2021-12-02T10:00:00Z,https://github.com/sourcegraph/zoekt/pull/1,3,2,1
+github.com/sourcegraph/src-cli,"'=HYPERLINK(""https://example.com"")",UNPUBLISHED,,,2021-12-03T10:00:00Z,,0,0,0
+`
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("unexpected CSV (-want +have):\n%s", diff)
+	}
+}
+
+func TestWriteBurndownCSV(t *testing.T) {
+	counts := []*state.ChangesetCounts{
+		{Time: time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC), Total: 2, Open: 2, OpenPending: 2},
+		{Time: time.Date(2021, 12, 2, 0, 0, 0, 0, time.UTC), Total: 2, Merged: 1, Open: 1, OpenApproved: 1},
+	}
+
+	var buf bytes.Buffer
+	if err := writeBurndownCSV(&buf, counts); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `date,total,merged,closed,draft,open,open_approved,open_changes_requested,open_pending
+2021-12-01,2,0,0,0,2,0,0,2
+2021-12-02,2,1,0,0,1,1,0,0
+`
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("unexpected CSV (-want +have):\n%s", diff)
+	}
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	report := &Report{
+		Name:        "update-deps",
+		GeneratedAt: time.Date(2021, 12, 3, 0, 0, 0, 0, time.UTC),
+		Changesets: []Row{
+			{
+				Repository:  "github.com/sourcegraph/zoekt",
+				Title:       "Update [deps] | all",
+				State:       btypes.ChangesetStateOpen,
+				ReviewState: btypes.ChangesetReviewStateApproved,
+				CheckState:  btypes.ChangesetCheckStatePassed,
+				UpdatedAt:   time.Date(2021, 12, 2, 10, 0, 0, 0, time.UTC),
+				URL:         "https://github.com/sourcegraph/zoekt/pull/1",
+				Added:       3,
+				Changed:     2,
+				Deleted:     1,
+			},
+			{
+				Repository: "github.com/sourcegraph/sourcegraph",
+				Title:      "Update deps",
+				State:      btypes.ChangesetStateUnpublished,
+				UpdatedAt:  time.Date(2021, 12, 1, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		Burndown: []*state.ChangesetCounts{
+			{Time: time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC), Total: 1, Open: 1, OpenApproved: 1},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := writeMarkdown(&buf, report); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `# update-deps
+
+Status of 2 changesets as of 2021-12-03T00:00:00Z.
+
+## Changesets
+
+| Repository | Title | State | Review state | Check state | Last update | Diff |
+| --- | --- | --- | --- | --- | --- | --- |
+| github.com/sourcegraph/zoekt | [Update \[deps\] \| all](https://github.com/sourcegraph/zoekt/pull/1) | OPEN | APPROVED | PASSED | 2021-12-02 | +3 ~2 -1 |
+| github.com/sourcegraph/sourcegraph | Update deps | UNPUBLISHED | - | - | 2021-12-01 | +0 ~0 -0 |
+
+## Burndown
+
+| Date | Total | Merged | Closed | Draft | Open | Approved | Changes requested | Review pending |
+| --- | --- | --- | --- | --- | --- | --- | --- | --- |
+| 2021-12-01 | 1 | 0 | 0 | 0 | 1 | 1 | 0 | 0 |
+`
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("unexpected Markdown (-want +have):\n%s", diff)
+	}
+}
+
+func TestBatchChangeID(t *testing.T) {
+	for name, tc := range map[string]struct {
+		id      string
+		want    int64
+		wantErr bool
+	}{
+		"graphql ID":       {id: string(relay.MarshalID(batchChangeIDKind, int64(42))), want: 42},
+		"database ID":      {id: "42", want: 42},
+		"other graphql ID": {id: string(relay.MarshalID("Repository", int64(42))), wantErr: true},
+		"garbage":          {id: "foo", wantErr: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			have, err := batchChangeID(tc.id)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if have != tc.want {
+				t.Errorf("unexpected ID: want %d, have %d", tc.want, have)
+			}
+		})
+	}
+}
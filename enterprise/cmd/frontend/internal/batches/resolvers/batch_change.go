@@ -147,6 +147,20 @@ func (r *batchChangeResolver) ClosedAt() *graphqlbackend.DateTime {
 	return &graphqlbackend.DateTime{Time: r.batchChange.ClosedAt}
 }
 
+func (r *batchChangeResolver) RolloutWave() *int32 {
+	if r.batchChange.RolloutWave == 0 {
+		return nil
+	}
+	return &r.batchChange.RolloutWave
+}
+
+func (r *batchChangeResolver) RolloutPausedReason() *string {
+	if r.batchChange.RolloutPausedReason == "" {
+		return nil
+	}
+	return &r.batchChange.RolloutPausedReason
+}
+
 func (r *batchChangeResolver) ChangesetsStats(ctx context.Context) (graphqlbackend.ChangesetsStatsResolver, error) {
 	stats, err := r.store.GetChangesetsStats(ctx, r.batchChange.ID)
 	if err != nil {
@@ -121,6 +121,8 @@ func changesetJobTypeToBulkOperationType(t btypes.ChangesetJobType) (string, err
 		return "CLOSE", nil
 	case btypes.ChangesetJobTypePublish:
 		return "PUBLISH", nil
+	case btypes.ChangesetJobTypeRefresh:
+		return "REFRESH", nil
 	default:
 		return "", errors.Errorf("invalid job type %q", t)
 	}
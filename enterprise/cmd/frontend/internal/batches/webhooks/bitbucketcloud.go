@@ -0,0 +1,197 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/inconshreveable/log15"
+
+	fewebhooks "github.com/sourcegraph/sourcegraph/cmd/frontend/webhooks"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+type BitbucketCloudWebhook struct {
+	*Webhook
+}
+
+func NewBitbucketCloudWebhook(store *store.Store) *BitbucketCloudWebhook {
+	return &BitbucketCloudWebhook{
+		Webhook: &Webhook{store, extsvc.TypeBitbucketCloud},
+	}
+}
+
+func (h *BitbucketCloudWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e, extSvc, hErr := h.parseEvent(r)
+	if hErr != nil {
+		respond(w, hErr.code, hErr)
+		return
+	}
+
+	fewebhooks.SetExternalServiceID(r.Context(), extSvc.ID)
+
+	// 🚨 SECURITY: now that the shared secret has been validated, we can use an
+	// internal actor on the context.
+	ctx := actor.WithInternalActor(r.Context())
+
+	externalServiceID, err := extractExternalServiceID(extSvc)
+	if err != nil {
+		respond(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	prs, ev, err := h.convertEvent(ctx, externalServiceID, e)
+	if err != nil {
+		respond(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	m := new(multierror.Error)
+	for _, pr := range prs {
+		if pr == (PR{}) {
+			log15.Warn("Dropping Bitbucket Cloud webhook event", "type", fmt.Sprintf("%T", e))
+			continue
+		}
+
+		err := h.upsertChangesetEvent(ctx, externalServiceID, pr, ev)
+		if err != nil {
+			m = multierror.Append(m, err)
+		}
+	}
+	if m.ErrorOrNil() != nil {
+		respond(w, http.StatusInternalServerError, m)
+	}
+}
+
+func (h *BitbucketCloudWebhook) parseEvent(r *http.Request) (interface{}, *types.ExternalService, *httpError) {
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, nil, &httpError{http.StatusInternalServerError, err}
+	}
+
+	// Bitbucket Cloud doesn't sign webhook payloads, so the shared secret is
+	// passed in the query string of the webhook URL instead.
+	query := r.URL.Query()
+	secret := query.Get("secret")
+	if secret == "" {
+		return nil, nil, &httpError{http.StatusUnauthorized, errors.New("missing webhook secret")}
+	}
+
+	rawID := query.Get(extsvc.IDParam)
+	var externalServiceID int64
+	if rawID != "" {
+		externalServiceID, err = strconv.ParseInt(rawID, 10, 64)
+		if err != nil {
+			return nil, nil, &httpError{http.StatusBadRequest, errors.Wrap(err, "invalid external service id")}
+		}
+	}
+
+	args := database.ExternalServicesListOptions{Kinds: []string{extsvc.KindBitbucketCloud}}
+	if externalServiceID != 0 {
+		args.IDs = append(args.IDs, externalServiceID)
+	}
+	es, err := h.Store.ExternalServices().List(r.Context(), args)
+	if err != nil {
+		return nil, nil, &httpError{http.StatusInternalServerError, err}
+	}
+
+	var extSvc *types.ExternalService
+	for _, e := range es {
+		c, _ := e.Configuration()
+		con, ok := c.(*schema.BitbucketCloudConnection)
+		if !ok || con.WebhookSecret == "" {
+			continue
+		}
+
+		if subtle.ConstantTimeCompare([]byte(secret), []byte(con.WebhookSecret)) == 1 {
+			extSvc = e
+			break
+		}
+	}
+
+	if extSvc == nil {
+		return nil, nil, &httpError{http.StatusUnauthorized, errors.New("invalid webhook secret")}
+	}
+
+	e, err := bitbucketcloud.ParseWebhookEvent(bitbucketcloud.WebhookEventKey(r), payload)
+	if err != nil {
+		return nil, nil, &httpError{http.StatusBadRequest, errors.Wrap(err, "parsing webhook")}
+	}
+	return e, extSvc, nil
+}
+
+func (h *BitbucketCloudWebhook) convertEvent(ctx context.Context, externalServiceID string, theirs interface{}) (prs []PR, ours keyer, err error) {
+	log15.Debug("Bitbucket Cloud webhook received", "type", fmt.Sprintf("%T", theirs))
+
+	switch e := theirs.(type) {
+	case *bitbucketcloud.PullRequestApprovedEvent:
+		return []PR{pullRequestForEvent(&e.PullRequestEvent)}, e, nil
+	case *bitbucketcloud.PullRequestUnapprovedEvent:
+		return []PR{pullRequestForEvent(&e.PullRequestEvent)}, e, nil
+	case *bitbucketcloud.PullRequestChangesRequestCreatedEvent:
+		return []PR{pullRequestForEvent(&e.PullRequestEvent)}, e, nil
+	case *bitbucketcloud.PullRequestChangesRequestRemovedEvent:
+		return []PR{pullRequestForEvent(&e.PullRequestEvent)}, e, nil
+	case *bitbucketcloud.PullRequestCommentCreatedEvent:
+		return []PR{pullRequestForEvent(&e.PullRequestEvent)}, e, nil
+	case *bitbucketcloud.PullRequestFulfilledEvent:
+		return []PR{pullRequestForEvent(&e.PullRequestEvent)}, e, nil
+	case *bitbucketcloud.PullRequestRejectedEvent:
+		return []PR{pullRequestForEvent(&e.PullRequestEvent)}, e, nil
+	case *bitbucketcloud.PullRequestUpdatedEvent:
+		return []PR{pullRequestForEvent(&e.PullRequestEvent)}, e, nil
+	case *bitbucketcloud.RepoCommitStatusEvent:
+		prs, err := h.pullRequestsForCommitStatus(ctx, externalServiceID, e)
+		return prs, &e.CommitStatus, err
+	}
+
+	return
+}
+
+// pullRequestsForCommitStatus returns the pull requests of the changesets
+// whose head commit the commit status was reported on. Commit status events
+// don't reference pull requests, so we have to look them up ourselves.
+func (h *BitbucketCloudWebhook) pullRequestsForCommitStatus(ctx context.Context, externalServiceID string, e *bitbucketcloud.RepoCommitStatusEvent) ([]PR, error) {
+	if e.CommitStatus.Commit.Hash == "" {
+		return nil, nil
+	}
+
+	repo, err := h.getRepoForPR(ctx, h.Store, PR{RepoExternalID: e.Repository.UUID}, externalServiceID)
+	if err != nil {
+		log15.Warn("Webhook event could not be matched to repo", "err", err)
+		return nil, nil
+	}
+
+	cs, _, err := h.Store.ListChangesets(ctx, store.ListChangesetsOpts{
+		RepoID:               repo.ID,
+		BitbucketCloudCommit: e.CommitStatus.Commit.Hash,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	prs := make([]PR, 0, len(cs))
+	for _, c := range cs {
+		id, err := strconv.ParseInt(c.ExternalID, 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "parsing changeset external ID")
+		}
+		prs = append(prs, PR{ID: id, RepoExternalID: e.Repository.UUID})
+	}
+	return prs, nil
+}
+
+func pullRequestForEvent(e *bitbucketcloud.PullRequestEvent) PR {
+	return PR{ID: e.PullRequest.ID, RepoExternalID: e.Repository.UUID}
+}
@@ -0,0 +1,223 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	ct "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/repoupdater/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/timeutil"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// Run from integration_test.go
+func testBitbucketCloudWebhook(db *sql.DB, userID int32) func(*testing.T) {
+	return func(t *testing.T) {
+		now := timeutil.Now()
+		clock := func() time.Time { return now }
+
+		ctx := context.Background()
+
+		ct.TruncateTables(t, db, "changeset_events", "changesets")
+
+		secret := "bitbucket-cloud-secret"
+		esStore := database.ExternalServices(db)
+		extSvc := &types.ExternalService{
+			Kind:        extsvc.KindBitbucketCloud,
+			DisplayName: "Bitbucket Cloud",
+			Config: ct.MarshalJSON(t, &schema.BitbucketCloudConnection{
+				Url:           "https://bitbucket.org",
+				Username:      "sourcegraph-testing",
+				AppPassword:   "app-password",
+				WebhookSecret: secret,
+			}),
+		}
+		if err := esStore.Upsert(ctx, extSvc); err != nil {
+			t.Fatal(err)
+		}
+
+		bbcRepo := &bitbucketcloud.Repo{
+			Slug:     "mux",
+			Name:     "mux",
+			FullName: "sglocal/mux",
+			UUID:     "{e1e75436-05e6-4c38-8543-9c36ec26fad1}",
+		}
+		repo := &types.Repo{
+			Name: "bitbucket.org/sglocal/mux",
+			ExternalRepo: api.ExternalRepoSpec{
+				ID:          bbcRepo.UUID,
+				ServiceType: extsvc.TypeBitbucketCloud,
+				ServiceID:   "https://bitbucket.org/",
+			},
+			Sources: map[string]*types.SourceInfo{
+				extSvc.URN(): {ID: extSvc.URN(), CloneURL: "https://bitbucket.org/sglocal/mux.git"},
+			},
+			Metadata: bbcRepo,
+		}
+		if err := database.Repos(db).Create(ctx, repo); err != nil {
+			t.Fatal(err)
+		}
+
+		s := store.NewWithClock(db, &observation.TestContext, nil, clock)
+
+		spec := &btypes.BatchSpec{
+			NamespaceUserID: userID,
+			UserID:          userID,
+		}
+		if err := s.CreateBatchSpec(ctx, spec); err != nil {
+			t.Fatal(err)
+		}
+
+		batchChange := &btypes.BatchChange{
+			Name:             "Test Bitbucket Cloud batch change",
+			Description:      "Testing THE WEBHOOKS",
+			InitialApplierID: userID,
+			NamespaceUserID:  userID,
+			LastApplierID:    userID,
+			LastAppliedAt:    clock(),
+			BatchSpecID:      spec.ID,
+		}
+		if err := s.CreateBatchChange(ctx, batchChange); err != nil {
+			t.Fatal(err)
+		}
+
+		// Set up mocks to prevent the diffstat computation from trying to
+		// use a real gitserver.
+		state := ct.MockChangesetSyncState(&protocol.RepoInfo{
+			Name: "repo",
+			VCS:  protocol.VCSInfo{URL: "https://example.com/repo/"},
+		})
+		defer state.Unmock()
+
+		// The second pull request has a different head commit, so that the
+		// commit status event must only be associated with the first one.
+		for _, pr := range []*bitbucketcloud.PullRequest{
+			{
+				ID:          2,
+				State:       bitbucketcloud.PullRequestStateOpen,
+				Source:      bitbucketcloud.PullRequestEndpoint{Branch: bitbucketcloud.PullRequestBranch{Name: "query-matcher"}, Commit: &bitbucketcloud.Commit{Hash: "5c9bd1bee5a4"}, Repository: *bbcRepo},
+				Destination: bitbucketcloud.PullRequestEndpoint{Branch: bitbucketcloud.PullRequestBranch{Name: "master"}, Commit: &bitbucketcloud.Commit{Hash: "9d3a5b9b2c4f"}, Repository: *bbcRepo},
+			},
+			{
+				ID:          3,
+				State:       bitbucketcloud.PullRequestStateOpen,
+				Source:      bitbucketcloud.PullRequestEndpoint{Branch: bitbucketcloud.PullRequestBranch{Name: "other-branch"}, Commit: &bitbucketcloud.Commit{Hash: "0a1b2c3d4e5f"}, Repository: *bbcRepo},
+				Destination: bitbucketcloud.PullRequestEndpoint{Branch: bitbucketcloud.PullRequestBranch{Name: "master"}, Commit: &bitbucketcloud.Commit{Hash: "9d3a5b9b2c4f"}, Repository: *bbcRepo},
+			},
+		} {
+			ch := &btypes.Changeset{
+				RepoID:       repo.ID,
+				BatchChanges: []btypes.BatchChangeAssoc{{BatchChangeID: batchChange.ID}},
+			}
+			if err := ch.SetMetadata(pr); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.CreateChangeset(ctx, ch); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		hook := NewBitbucketCloudWebhook(s)
+
+		t.Run("invalid secret", func(t *testing.T) {
+			u := extsvc.WebhookURL(extsvc.TypeBitbucketCloud, extSvc.ID, "https://example.com/") + "&secret=" + url.QueryEscape("not-the-secret")
+
+			req, err := http.NewRequest("POST", u, bytes.NewReader([]byte("{}")))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("X-Event-Key", "pullrequest:approved")
+
+			rec := httptest.NewRecorder()
+			hook.ServeHTTP(rec, req)
+
+			if have, want := rec.Result().StatusCode, http.StatusUnauthorized; have != want {
+				t.Fatalf("unexpected status code: have=%d want=%d", have, want)
+			}
+		})
+
+		fixtureFiles, err := filepath.Glob("testdata/fixtures/webhooks/bitbucketcloud/*.json")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, fixtureFile := range fixtureFiles {
+			_, name := path.Split(fixtureFile)
+			name = strings.TrimSuffix(name, ".json")
+			t.Run(name, func(t *testing.T) {
+				ct.TruncateTables(t, db, "changeset_events")
+
+				tc := loadWebhookTestCase(t, fixtureFile)
+
+				// Send all events twice to ensure we are idempotent
+				for i := 0; i < 2; i++ {
+					for _, event := range tc.Payloads {
+						u := extsvc.WebhookURL(extsvc.TypeBitbucketCloud, extSvc.ID, "https://example.com/") + "&secret=" + url.QueryEscape(secret)
+
+						req, err := http.NewRequest("POST", u, bytes.NewReader(event.Data))
+						if err != nil {
+							t.Fatal(err)
+						}
+						req.Header.Set("X-Event-Key", event.PayloadType)
+
+						rec := httptest.NewRecorder()
+						hook.ServeHTTP(rec, req)
+						resp := rec.Result()
+
+						if resp.StatusCode != http.StatusOK {
+							t.Fatalf("Non 200 code: %v", resp.StatusCode)
+						}
+					}
+				}
+
+				have, _, err := s.ListChangesetEvents(ctx, store.ListChangesetEventsOpts{})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				// Overwrite and format test case
+				if *update {
+					tc.ChangesetEvents = have
+					data, err := json.MarshalIndent(tc, "  ", "  ")
+					if err != nil {
+						t.Fatal(err)
+					}
+					err = os.WriteFile(fixtureFile, data, 0666)
+					if err != nil {
+						t.Fatal(err)
+					}
+				}
+
+				opts := []cmp.Option{
+					cmpopts.IgnoreFields(btypes.ChangesetEvent{}, "CreatedAt"),
+					cmpopts.IgnoreFields(btypes.ChangesetEvent{}, "UpdatedAt"),
+				}
+				if diff := cmp.Diff(tc.ChangesetEvents, have, opts...); diff != "" {
+					t.Error(diff)
+				}
+			})
+		}
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/state"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/syncer"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
@@ -72,6 +73,8 @@ func extractExternalServiceID(extSvc *types.ExternalService) (string, error) {
 		serviceID = c.Url
 	case *schema.BitbucketServerConnection:
 		serviceID = c.Url
+	case *schema.BitbucketCloudConnection:
+		serviceID = c.Url
 	case *schema.GitLabConnection:
 		serviceID = c.Url
 	}
@@ -170,6 +173,13 @@ func (h Webhook) upsertChangesetEvent(
 		ChangesetIDs: []int64{cs.ID},
 	})
 	state.SetDerivedState(ctx, tx.Repos(), cs, events)
+
+	// Reviews and checks reported by webhooks may allow the changeset to be
+	// merged, so we don't wait for the next sync to evaluate auto-merge.
+	if err := syncer.EvaluateAutoMerge(ctx, tx, cs); err != nil {
+		return errors.Wrap(err, "evaluating auto-merge policy")
+	}
+
 	if err := tx.UpdateChangesetCodeHostState(ctx, cs); err != nil {
 		return err
 	}
@@ -14,6 +14,7 @@ type Config struct {
 	UploadStoreConfig                         *uploadstore.Config
 	AutoIndexEnqueuerConfig                   *enqueuer.Config
 	HunkCacheSize                             int
+	ApproximateLocations                      bool
 	DiagnosticsCountMigrationBatchSize        int
 	DiagnosticsCountMigrationBatchInterval    time.Duration
 	DefinitionsCountMigrationBatchSize        int
@@ -42,6 +43,7 @@ func init() {
 	config.AutoIndexEnqueuerConfig = enqueuerConfig
 
 	config.HunkCacheSize = config.GetInt("PRECISE_CODE_INTEL_HUNK_CACHE_SIZE", "1000", "The capacity of the git diff hunk cache.")
+	config.ApproximateLocations = config.GetBool("PRECISE_CODE_INTEL_APPROXIMATE_LOCATIONS", "false", "Move code intelligence locations that have been edited since the indexed commit to the nearest surviving line instead of returning them relative to the indexed commit.")
 	config.DiagnosticsCountMigrationBatchSize = config.GetInt("PRECISE_CODE_INTEL_DIAGNOSTICS_COUNT_MIGRATION_BATCH_SIZE", "1000", "The maximum number of document records to migrate at a time.")
 	config.DiagnosticsCountMigrationBatchInterval = config.GetInterval("PRECISE_CODE_INTEL_DIAGNOSTICS_COUNT_MIGRATION_BATCH_INTERVAL", "1s", "The timeout between processing migration batches.")
 	config.DefinitionsCountMigrationBatchSize = config.GetInt("PRECISE_CODE_INTEL_DEFINITIONS_COUNT_MIGRATION_BATCH_SIZE", "1000", "The maximum number of definition records to migrate at once.")
@@ -25,6 +25,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol/reader"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/upload"
 )
 
@@ -354,6 +355,9 @@ func (h *UploadHandler) markUploadAsFailed(ctx context.Context, tx DBStore, uplo
 // input stream. This method must destructively read the request body, but will re-assign the
 // Body field with a reader that holds the same information as the original request.
 //
+// The tool name of a protobuf-encoded index is read from the metadata message at the start of
+// the index instead.
+//
 // Newer versions of src-cli will do this same check before uploading the file. However, older
 // versions of src-cli will not guarantee that the index name query parameter is sent. Requiring
 // it now will break valid workflows. We only need ot maintain backwards compatibility on single
@@ -370,9 +374,19 @@ func inferIndexer(r *http.Request) (string, error) {
 		return "", err
 	}
 
+	format, indexReader, err := reader.DetectFormat(gzipReader)
+	if err != nil {
+		return "", err
+	}
+
+	readIndexerName := upload.ReadIndexerName
+	if format == reader.FormatProtobuf {
+		readIndexerName = upload.ReadProtobufIndexerName
+	}
+
 	// Read from the stream until we extract a tool name. This method is careful not to
 	// take too much resident memory in the case of a malformed bundle.
-	name, err := upload.ReadIndexerName(gzipReader)
+	name, err := readIndexerName(indexReader)
 	if err != nil {
 		return "", err
 	}
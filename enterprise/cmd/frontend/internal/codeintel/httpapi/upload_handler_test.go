@@ -17,6 +17,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/inconshreveable/log15"
 	"github.com/keegancsmith/sqlf"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
 	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
@@ -27,6 +28,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol/scip"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
 
@@ -189,6 +191,81 @@ func TestHandleEnqueueSinglePayloadNoIndexerName(t *testing.T) {
 	}
 }
 
+func TestHandleEnqueueSinglePayloadNoIndexerNameProtobuf(t *testing.T) {
+	setupRepoMocks(t)
+
+	mockDBStore := NewMockDBStore()
+	mockUploadStore := uploadstoremocks.NewMockStore()
+
+	mockDBStore.TransactFunc.SetDefaultReturn(mockDBStore, nil)
+	mockDBStore.DoneFunc.SetDefaultHook(func(err error) error { return err })
+	mockDBStore.InsertUploadFunc.SetDefaultReturn(42, nil)
+
+	testURL, err := url.Parse("http://test.com/upload")
+	if err != nil {
+		t.Fatalf("unexpected error constructing url: %s", err)
+	}
+	testURL.RawQuery = (url.Values{
+		"commit":     []string{testCommit},
+		"root":       []string{"proj/"},
+		"repository": []string{"github.com/test/test"},
+	}).Encode()
+
+	index := &scip.Index{
+		Metadata: &scip.Metadata{ToolInfo: &scip.ToolInfo{Name: "scip-go"}, ProjectRoot: "file:///test/"},
+	}
+	for i := 0; i < 20000; i++ {
+		index.Documents = append(index.Documents, &scip.Document{RelativePath: "proj/main.go"})
+	}
+
+	encoded, err := proto.Marshal(index)
+	if err != nil {
+		t.Fatalf("unexpected error marshalling index: %s", err)
+	}
+
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	_, _ = io.Copy(gzipWriter, bytes.NewReader(encoded))
+	gzipWriter.Close()
+	expectedContents := buf.Bytes()
+
+	w := httptest.NewRecorder()
+	r, err := http.NewRequest("POST", testURL.String(), bytes.NewReader(expectedContents))
+	if err != nil {
+		t.Fatalf("unexpected error constructing request: %s", err)
+	}
+
+	h := &UploadHandler{
+		dbStore:     mockDBStore,
+		uploadStore: mockUploadStore,
+	}
+	h.handleEnqueue(w, r)
+
+	if w.Code != http.StatusAccepted {
+		t.Errorf("unexpected status code. want=%d have=%d", http.StatusAccepted, w.Code)
+	}
+
+	if len(mockDBStore.InsertUploadFunc.History()) != 1 {
+		t.Errorf("unexpected number of InsertUpload calls. want=%d have=%d", 1, len(mockDBStore.InsertUploadFunc.History()))
+	} else if call := mockDBStore.InsertUploadFunc.History()[0]; call.Arg1.Indexer != "scip-go" {
+		t.Errorf("unexpected indexer name. want=%q have=%q", "scip-go", call.Arg1.Indexer)
+	}
+
+	if len(mockUploadStore.UploadFunc.History()) != 1 {
+		t.Errorf("unexpected number of Upload calls. want=%d have=%d", 1, len(mockUploadStore.UploadFunc.History()))
+	} else {
+		call := mockUploadStore.UploadFunc.History()[0]
+		contents, err := io.ReadAll(call.Arg2)
+		if err != nil {
+			t.Fatalf("unexpected error reading payload: %s", err)
+		}
+
+		if diff := cmp.Diff(expectedContents, contents); diff != "" {
+			t.Errorf("unexpected file contents (-want +got):\n%s", diff)
+		}
+	}
+}
+
 func TestHandleEnqueueMultipartSetup(t *testing.T) {
 	setupRepoMocks(t)
 
@@ -3,18 +3,24 @@ package codeintel
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/cockroachdb/errors"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/enterprise"
 	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/cmd/searcher/protocol"
 	codeintelresolvers "github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers"
 	codeintelgqlresolvers "github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers/graphql"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/policies"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/oobmigration"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/searcher"
+	"github.com/sourcegraph/sourcegraph/internal/symbols"
 )
 
 func Init(ctx context.Context, db database.DB, conf conftypes.UnifiedWatchable, outOfBandMigrationRunner *oobmigration.Runner, enterpriseServices *enterprise.Services, observationContext *observation.Context, services *Services) error {
@@ -44,6 +50,8 @@ func newResolver(ctx context.Context, db database.DB, observationContext *observ
 		false,
 		false,
 	)
+	retentionPolicyMatcher := policies.NewMatcher(services.gitserverClient, policies.RetentionExtractor, true, false)
+	indexingPolicyMatcher := policies.NewMatcher(services.gitserverClient, policies.IndexingExtractor, false, true)
 
 	hunkCache, err := codeintelresolvers.NewHunkCache(config.HunkCacheSize)
 	if err != nil {
@@ -54,15 +62,31 @@ func newResolver(ctx context.Context, db database.DB, observationContext *observ
 		services.dbStore,
 		services.lsifStore,
 		services.gitserverClient,
+		symbols.DefaultClient,
+		&searcherClient{},
 		policyMatcher,
+		retentionPolicyMatcher,
+		indexingPolicyMatcher,
 		services.indexEnqueuer,
 		hunkCache,
+		config.ApproximateLocations,
 		observationContext,
 	)
 
 	return codeintelgqlresolvers.NewResolver(db, innerResolver), nil
 }
 
+// searchBasedFetchTimeout is the maximum time searcher waits to fetch a repository archive when
+// answering search-based code navigation queries.
+const searchBasedFetchTimeout = 5 * time.Second
+
+// searcherClient adapts the searcher package to the SearcherClient interface of the resolvers.
+type searcherClient struct{}
+
+func (c *searcherClient) Search(ctx context.Context, repo api.RepoName, repoID api.RepoID, commit api.CommitID, p *search.TextPatternInfo, onMatches func([]*protocol.FileMatch)) (bool, error) {
+	return searcher.Search(ctx, search.SearcherURLs(), repo, repoID, "", commit, false, p, searchBasedFetchTimeout, nil, onMatches)
+}
+
 func newUploadHandler(ctx context.Context, conf conftypes.SiteConfigQuerier, db database.DB, services *Services) (func(internal bool) http.Handler, error) {
 	internalHandler, err := NewCodeIntelUploadHandler(ctx, conf, db, true, services)
 	if err != nil {
@@ -0,0 +1,63 @@
+package graphql
+
+import (
+	"context"
+	"strings"
+
+	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers"
+)
+
+type CallHierarchyConnectionResolver struct {
+	calls            []resolvers.AdjustedCall
+	cursor           *string
+	locationResolver *CachedLocationResolver
+}
+
+func NewCallHierarchyConnectionResolver(calls []resolvers.AdjustedCall, cursor *string, locationResolver *CachedLocationResolver) gql.CallHierarchyConnectionResolver {
+	return &CallHierarchyConnectionResolver{
+		calls:            calls,
+		cursor:           cursor,
+		locationResolver: locationResolver,
+	}
+}
+
+func (r *CallHierarchyConnectionResolver) Nodes(ctx context.Context) ([]gql.CallHierarchyItemResolver, error) {
+	items := make([]gql.CallHierarchyItemResolver, 0, len(r.calls))
+	for _, call := range r.calls {
+		location, err := resolveLocation(ctx, r.locationResolver, call.Location)
+		if err != nil {
+			return nil, err
+		}
+		if location == nil {
+			continue
+		}
+
+		items = append(items, &CallHierarchyItemResolver{call: call, location: location})
+	}
+
+	return items, nil
+}
+
+func (r *CallHierarchyConnectionResolver) PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error) {
+	return graphqlutil.EncodeCursor(r.cursor), nil
+}
+
+type CallHierarchyItemResolver struct {
+	call     resolvers.AdjustedCall
+	location gql.LocationResolver
+}
+
+func (r *CallHierarchyItemResolver) Name() string                   { return r.call.Name }
+func (r *CallHierarchyItemResolver) Kind() string                   { return strings.ToUpper(r.call.Kind.String()) }
+func (r *CallHierarchyItemResolver) Location() gql.LocationResolver { return r.location }
+
+func (r *CallHierarchyItemResolver) FromRanges() []gql.RangeResolver {
+	resolvers := make([]gql.RangeResolver, 0, len(r.call.FromRanges))
+	for _, rn := range r.call.FromRanges {
+		resolvers = append(resolvers, gql.NewRangeResolver(convertRange(rn)))
+	}
+
+	return resolvers
+}
@@ -0,0 +1,201 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+
+	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers"
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+// DefaultDependencyGraphPageSize is the dependent and dependency page size when no limit is supplied.
+const DefaultDependencyGraphPageSize = 50
+
+// 🚨 SECURITY: dbstore layer handles authz for GetPackageDependents and GetPackageDependencies
+func (r *Resolver) CodeIntelPackage(ctx context.Context, args *gql.CodeIntelPackageArgs) (gql.CodeIntelPackageResolver, error) {
+	pkg := precise.Package{Scheme: args.Scheme, Name: args.Name}
+	if args.Version != nil {
+		pkg.Version = *args.Version
+	}
+
+	return NewCodeIntelPackageResolver(r.db, r.resolver, pkg), nil
+}
+
+// 🚨 SECURITY: dbstore layer handles authz for GetPackageDependents
+func (r *Resolver) RepositoryCodeIntelDependents(ctx context.Context, id graphql.ID, args *gql.CodeIntelDependencyGraphArgs) (gql.CodeIntelPackageDependentConnectionResolver, error) {
+	repositoryID, err := unmarshalRepositoryID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolvePackageDependents(ctx, r.db, r.resolver, store.DependencyGraphOptions{RepositoryID: int(repositoryID)}, args)
+}
+
+// 🚨 SECURITY: dbstore layer handles authz for GetPackageDependencies
+func (r *Resolver) RepositoryCodeIntelDependencies(ctx context.Context, id graphql.ID, args *gql.CodeIntelDependencyGraphArgs) (gql.CodeIntelPackageConnectionResolver, error) {
+	repositoryID, err := unmarshalRepositoryID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolvePackageDependencies(ctx, r.db, r.resolver, store.DependencyGraphOptions{RepositoryID: int(repositoryID)}, args)
+}
+
+type CodeIntelPackageResolver struct {
+	db       database.DB
+	resolver resolvers.Resolver
+	pkg      precise.Package
+}
+
+func NewCodeIntelPackageResolver(db database.DB, resolver resolvers.Resolver, pkg precise.Package) gql.CodeIntelPackageResolver {
+	return &CodeIntelPackageResolver{
+		db:       db,
+		resolver: resolver,
+		pkg:      pkg,
+	}
+}
+
+func (r *CodeIntelPackageResolver) Scheme() string { return r.pkg.Scheme }
+func (r *CodeIntelPackageResolver) Name() string   { return r.pkg.Name }
+func (r *CodeIntelPackageResolver) Version() *string {
+	if r.pkg.Version == "" {
+		return nil
+	}
+
+	return &r.pkg.Version
+}
+
+func (r *CodeIntelPackageResolver) Dependents(ctx context.Context, args *gql.CodeIntelDependencyGraphArgs) (gql.CodeIntelPackageDependentConnectionResolver, error) {
+	return resolvePackageDependents(ctx, r.db, r.resolver, r.options(), args)
+}
+
+func (r *CodeIntelPackageResolver) Dependencies(ctx context.Context, args *gql.CodeIntelDependencyGraphArgs) (gql.CodeIntelPackageConnectionResolver, error) {
+	return resolvePackageDependencies(ctx, r.db, r.resolver, r.options(), args)
+}
+
+func (r *CodeIntelPackageResolver) options() store.DependencyGraphOptions {
+	return store.DependencyGraphOptions{
+		Scheme:  r.pkg.Scheme,
+		Name:    r.pkg.Name,
+		Version: r.pkg.Version,
+	}
+}
+
+func resolvePackageDependents(ctx context.Context, db database.DB, resolver resolvers.Resolver, opts store.DependencyGraphOptions, args *gql.CodeIntelDependencyGraphArgs) (gql.CodeIntelPackageDependentConnectionResolver, error) {
+	if err := applyDependencyGraphPagination(&opts, args); err != nil {
+		return nil, err
+	}
+
+	dependents, totalCount, err := resolver.GetPackageDependents(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]gql.CodeIntelPackageDependentResolver, 0, len(dependents))
+	for _, dependent := range dependents {
+		nodes = append(nodes, &codeIntelPackageDependentResolver{
+			repository: gql.NewRepositoryResolver(db, &types.Repo{
+				ID:   api.RepoID(dependent.RepositoryID),
+				Name: api.RepoName(dependent.RepositoryName),
+			}),
+			pkg: NewCodeIntelPackageResolver(db, resolver, dependent.Package),
+		})
+	}
+
+	return &codeIntelPackageDependentConnectionResolver{
+		nodes:      nodes,
+		totalCount: totalCount,
+		offset:     opts.Offset,
+	}, nil
+}
+
+func resolvePackageDependencies(ctx context.Context, db database.DB, resolver resolvers.Resolver, opts store.DependencyGraphOptions, args *gql.CodeIntelDependencyGraphArgs) (gql.CodeIntelPackageConnectionResolver, error) {
+	if err := applyDependencyGraphPagination(&opts, args); err != nil {
+		return nil, err
+	}
+
+	packages, totalCount, err := resolver.GetPackageDependencies(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]gql.CodeIntelPackageResolver, 0, len(packages))
+	for _, pkg := range packages {
+		nodes = append(nodes, NewCodeIntelPackageResolver(db, resolver, pkg))
+	}
+
+	return &codeIntelPackageConnectionResolver{
+		nodes:      nodes,
+		totalCount: totalCount,
+		offset:     opts.Offset,
+	}, nil
+}
+
+// applyDependencyGraphPagination sets the limit and offset of the given options from the given
+// connection arguments.
+func applyDependencyGraphPagination(opts *store.DependencyGraphOptions, args *gql.CodeIntelDependencyGraphArgs) error {
+	offset, err := graphqlutil.DecodeIntCursor(args.After)
+	if err != nil {
+		return err
+	}
+
+	limit := derefInt32(args.First, DefaultDependencyGraphPageSize)
+	if limit <= 0 {
+		return ErrIllegalLimit
+	}
+
+	opts.Limit = limit
+	opts.Offset = offset
+	return nil
+}
+
+type codeIntelPackageConnectionResolver struct {
+	nodes      []gql.CodeIntelPackageResolver
+	totalCount int
+	offset     int
+}
+
+func (r *codeIntelPackageConnectionResolver) Nodes() []gql.CodeIntelPackageResolver {
+	return r.nodes
+}
+
+func (r *codeIntelPackageConnectionResolver) TotalCount() int32 {
+	return int32(r.totalCount)
+}
+
+func (r *codeIntelPackageConnectionResolver) PageInfo() *graphqlutil.PageInfo {
+	return graphqlutil.EncodeIntCursor(toInt32(graphqlutil.NextOffset(r.offset, len(r.nodes), r.totalCount)))
+}
+
+type codeIntelPackageDependentConnectionResolver struct {
+	nodes      []gql.CodeIntelPackageDependentResolver
+	totalCount int
+	offset     int
+}
+
+func (r *codeIntelPackageDependentConnectionResolver) Nodes() []gql.CodeIntelPackageDependentResolver {
+	return r.nodes
+}
+
+func (r *codeIntelPackageDependentConnectionResolver) TotalCount() int32 {
+	return int32(r.totalCount)
+}
+
+func (r *codeIntelPackageDependentConnectionResolver) PageInfo() *graphqlutil.PageInfo {
+	return graphqlutil.EncodeIntCursor(toInt32(graphqlutil.NextOffset(r.offset, len(r.nodes), r.totalCount)))
+}
+
+type codeIntelPackageDependentResolver struct {
+	repository *gql.RepositoryResolver
+	pkg        gql.CodeIntelPackageResolver
+}
+
+func (r *codeIntelPackageDependentResolver) Repository() *gql.RepositoryResolver   { return r.repository }
+func (r *codeIntelPackageDependentResolver) Package() gql.CodeIntelPackageResolver { return r.pkg }
@@ -0,0 +1,86 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	resolvermocks "github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers/mocks"
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+func TestCodeIntelPackageDependents(t *testing.T) {
+	db := database.NewDB(new(dbtesting.MockDB))
+
+	mockResolver := resolvermocks.NewMockResolver()
+	mockResolver.GetPackageDependentsFunc.SetDefaultReturn([]store.PackageDependent{
+		{RepositoryID: 51, RepositoryName: "github.com/test/app", Package: precise.Package{Scheme: "npm", Name: "leftpad", Version: "1.0.0"}},
+		{RepositoryID: 52, RepositoryName: "github.com/test/cli", Package: precise.Package{Scheme: "npm", Name: "leftpad", Version: "2.0.0"}},
+	}, 5, nil)
+	resolver := NewResolver(db, mockResolver)
+
+	pkg, err := resolver.CodeIntelPackage(context.Background(), &gql.CodeIntelPackageArgs{Scheme: "npm", Name: "leftpad"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if pkg.Version() != nil {
+		t.Errorf("unexpected version. want=%v have=%q", nil, *pkg.Version())
+	}
+
+	first := int32(2)
+	cursor := graphqlutil.EncodeIntCursor(intPtr(1)).EndCursor()
+	dependents, err := pkg.Dependents(context.Background(), &gql.CodeIntelDependencyGraphArgs{
+		ConnectionArgs: graphqlutil.ConnectionArgs{First: &first},
+		After:          cursor,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if history := mockResolver.GetPackageDependentsFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(history))
+	} else {
+		expectedOpts := store.DependencyGraphOptions{Scheme: "npm", Name: "leftpad", Limit: 2, Offset: 1}
+		if diff := cmp.Diff(expectedOpts, history[0].Arg1); diff != "" {
+			t.Errorf("unexpected options (-want +got):\n%s", diff)
+		}
+	}
+
+	if totalCount := dependents.TotalCount(); totalCount != 5 {
+		t.Errorf("unexpected total count. want=%d have=%d", 5, totalCount)
+	}
+
+	var versions []string
+	for _, node := range dependents.Nodes() {
+		versions = append(versions, *node.Package().Version())
+	}
+	if diff := cmp.Diff([]string{"1.0.0", "2.0.0"}, versions); diff != "" {
+		t.Errorf("unexpected versions (-want +got):\n%s", diff)
+	}
+
+	if endCursor := dependents.PageInfo().EndCursor(); endCursor == nil {
+		t.Errorf("expected an end cursor")
+	} else if offset, err := graphqlutil.DecodeIntCursor(endCursor); err != nil || offset != 3 {
+		t.Errorf("unexpected end cursor offset. want=%d have=%d", 3, offset)
+	}
+}
+
+func TestCodeIntelPackageDependenciesIllegalLimit(t *testing.T) {
+	db := database.NewDB(new(dbtesting.MockDB))
+
+	mockResolver := resolvermocks.NewMockResolver()
+	pkg := NewCodeIntelPackageResolver(db, mockResolver, precise.Package{Scheme: "npm", Name: "leftpad", Version: "1.0.0"})
+
+	first := int32(-1)
+	if _, err := pkg.Dependencies(context.Background(), &gql.CodeIntelDependencyGraphArgs{
+		ConnectionArgs: graphqlutil.ConnectionArgs{First: &first},
+	}); err != ErrIllegalLimit {
+		t.Fatalf("unexpected error. want=%q have=%q", ErrIllegalLimit, err)
+	}
+}
@@ -272,5 +272,5 @@ func resolveLocation(ctx context.Context, locationResolver *CachedLocationResolv
 	}
 
 	lspRange := convertRange(location.AdjustedRange)
-	return gql.NewLocationResolver(treeResolver, &lspRange), nil
+	return gql.NewApproximateLocationResolver(treeResolver, &lspRange, location.Approximate), nil
 }
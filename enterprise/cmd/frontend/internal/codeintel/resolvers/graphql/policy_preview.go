@@ -0,0 +1,236 @@
+package graphql
+
+import (
+	"context"
+	"sort"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers"
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+)
+
+const (
+	// DefaultConfigurationPolicyPreviewRepositoryLimit is the number of repositories matching the
+	// repository patterns of a draft configuration policy that are previewed when no limit is supplied.
+	DefaultConfigurationPolicyPreviewRepositoryLimit = 10
+
+	// DefaultRetentionPreviewPageSize is the upload page size of a retention preview when no limit
+	// is supplied.
+	DefaultRetentionPreviewPageSize = 50
+)
+
+// 🚨 SECURITY: Only site admins may preview code intelligence configuration policies
+func (r *Resolver) PreviewCodeIntelligenceConfigurationPolicy(ctx context.Context, args *gql.PreviewCodeIntelligenceConfigurationPolicyArgs) ([]gql.CodeIntelligenceConfigurationPolicyPreviewResolver, error) {
+	if err := backend.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if err := validateConfigurationPolicy(args.CodeIntelConfigurationPolicy); err != nil {
+		return nil, err
+	}
+
+	var policyID int
+	if args.ID != nil {
+		id, err := unmarshalConfigurationPolicyGQLID(*args.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		policyID = int(id)
+	}
+
+	repositoryIDs, err := r.previewedRepositoryIDs(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := store.ConfigurationPolicy{
+		ID:                        policyID,
+		Name:                      args.Name,
+		RepositoryPatterns:        args.RepositoryPatterns,
+		Type:                      store.GitObjectType(args.Type),
+		Pattern:                   args.Pattern,
+		RetentionEnabled:          args.RetentionEnabled,
+		RetentionDuration:         toDuration(args.RetentionDurationHours),
+		RetainIntermediateCommits: args.RetainIntermediateCommits,
+		IndexingEnabled:           args.IndexingEnabled,
+		IndexCommitMaxAge:         toDuration(args.IndexCommitMaxAgeHours),
+		IndexIntermediateCommits:  args.IndexIntermediateCommits,
+	}
+
+	previews := make([]gql.CodeIntelligenceConfigurationPolicyPreviewResolver, 0, len(repositoryIDs))
+	for _, repositoryID := range repositoryIDs {
+		repo, err := backend.NewRepos(r.db.Repos()).Get(ctx, api.RepoID(repositoryID))
+		if err != nil {
+			return nil, err
+		}
+
+		repositoryPolicy := policy
+		repositoryPolicy.RepositoryID = &repositoryID
+
+		previews = append(previews, &configurationPolicyPreviewResolver{
+			db:                 r.db,
+			resolver:           r.resolver,
+			repositoryResolver: gql.NewRepositoryResolver(r.db, repo),
+			repositoryID:       repositoryID,
+			policy:             repositoryPolicy,
+			locationResolver:   r.locationResolver,
+		})
+	}
+
+	return previews, nil
+}
+
+// previewedRepositoryIDs returns the identifiers of the repositories to which the given draft configuration
+// policy applies. A draft policy with neither a repository nor repository patterns applies to all repositories.
+func (r *Resolver) previewedRepositoryIDs(ctx context.Context, args *gql.PreviewCodeIntelligenceConfigurationPolicyArgs) ([]int, error) {
+	if args.Repository != nil {
+		if args.RepositoryPatterns != nil {
+			return nil, errors.Errorf("repository and repositoryPatterns are mutually exclusive")
+		}
+
+		id, err := unmarshalRepositoryID(*args.Repository)
+		if err != nil {
+			return nil, err
+		}
+
+		return []int{int(id)}, nil
+	}
+
+	patterns := []string{"*"}
+	if args.RepositoryPatterns != nil {
+		patterns = *args.RepositoryPatterns
+	}
+
+	limit := DefaultConfigurationPolicyPreviewRepositoryLimit
+	if args.First != nil {
+		if *args.First < 0 {
+			return nil, errors.Errorf("illegal repository limit '%d'", *args.First)
+		}
+
+		limit = int(*args.First)
+	}
+
+	ids, _, _, err := r.resolver.PreviewRepositoryFilter(ctx, patterns, limit, 0)
+	return ids, err
+}
+
+type configurationPolicyPreviewResolver struct {
+	db                 database.DB
+	resolver           resolvers.Resolver
+	repositoryResolver *gql.RepositoryResolver
+	repositoryID       int
+	policy             store.ConfigurationPolicy
+	locationResolver   *CachedLocationResolver
+}
+
+var _ gql.CodeIntelligenceConfigurationPolicyPreviewResolver = &configurationPolicyPreviewResolver{}
+
+func (r *configurationPolicyPreviewResolver) Repository() *gql.RepositoryResolver {
+	return r.repositoryResolver
+}
+
+func (r *configurationPolicyPreviewResolver) Uploads(ctx context.Context, args *gql.CodeIntelligenceRetentionPreviewArgs) (gql.CodeIntelligenceRetentionPreviewConnectionResolver, error) {
+	offset, err := graphqlutil.DecodeIntCursor(args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := DefaultRetentionPreviewPageSize
+	if args.First != nil {
+		pageSize = int(*args.First)
+	}
+
+	retentionPreviews, totalCount, err := r.resolver.PreviewRetention(ctx, r.repositoryID, r.policy, pageSize, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a new prefetcher here as we only want to cache upload and index records in
+	// the same graphQL request, not across different request.
+	prefetcher := NewPrefetcher(r.resolver)
+
+	nodes := make([]gql.CodeIntelligenceRetentionPreviewResolver, 0, len(retentionPreviews))
+	for _, retentionPreview := range retentionPreviews {
+		nodes = append(nodes, &retentionPreviewResolver{
+			uploadResolver:   NewUploadResolver(r.db, r.resolver, retentionPreview.Upload, prefetcher, r.locationResolver),
+			retained:         retentionPreview.Retained,
+			retainedByPolicy: retentionPreview.RetainedByPolicy,
+		})
+	}
+
+	return &retentionPreviewConnectionResolver{
+		nodes:      nodes,
+		totalCount: totalCount,
+		offset:     offset,
+	}, nil
+}
+
+func (r *configurationPolicyPreviewResolver) IndexedCommits(ctx context.Context) ([]gql.GitObjectFilterPreviewResolver, error) {
+	namesByRev, err := r.resolver.PreviewIndexing(ctx, r.repositoryID, r.policy)
+	if err != nil {
+		return nil, err
+	}
+
+	previews := make([]gql.GitObjectFilterPreviewResolver, 0, len(namesByRev))
+	for rev, names := range namesByRev {
+		for _, name := range names {
+			previews = append(previews, &gitObjectFilterPreviewResolver{
+				name: name,
+				rev:  rev,
+			})
+		}
+	}
+
+	sort.Slice(previews, func(i, j int) bool {
+		return previews[i].Name() < previews[j].Name() || (previews[i].Name() == previews[j].Name() && previews[i].Rev() < previews[j].Rev())
+	})
+
+	return previews, nil
+}
+
+type retentionPreviewConnectionResolver struct {
+	nodes      []gql.CodeIntelligenceRetentionPreviewResolver
+	totalCount int
+	offset     int
+}
+
+var _ gql.CodeIntelligenceRetentionPreviewConnectionResolver = &retentionPreviewConnectionResolver{}
+
+func (r *retentionPreviewConnectionResolver) Nodes() []gql.CodeIntelligenceRetentionPreviewResolver {
+	return r.nodes
+}
+
+func (r *retentionPreviewConnectionResolver) TotalCount() int32 {
+	return int32(r.totalCount)
+}
+
+func (r *retentionPreviewConnectionResolver) PageInfo() *graphqlutil.PageInfo {
+	return graphqlutil.EncodeIntCursor(toInt32(graphqlutil.NextOffset(r.offset, len(r.nodes), r.totalCount)))
+}
+
+type retentionPreviewResolver struct {
+	uploadResolver   gql.LSIFUploadResolver
+	retained         bool
+	retainedByPolicy bool
+}
+
+var _ gql.CodeIntelligenceRetentionPreviewResolver = &retentionPreviewResolver{}
+
+func (r *retentionPreviewResolver) Upload() gql.LSIFUploadResolver {
+	return r.uploadResolver
+}
+
+func (r *retentionPreviewResolver) Retained() bool {
+	return r.retained
+}
+
+func (r *retentionPreviewResolver) RetainedByPolicy() bool {
+	return r.retainedByPolicy
+}
@@ -0,0 +1,122 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers"
+	resolvermocks "github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers/mocks"
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestPreviewCodeIntelligenceConfigurationPolicy(t *testing.T) {
+	db := database.NewDB(nil)
+
+	t.Cleanup(func() {
+		database.Mocks.Users.GetByCurrentAuthUser = nil
+		database.Mocks.Repos.Get = nil
+	})
+	database.Mocks.Users.GetByCurrentAuthUser = func(ctx context.Context) (*types.User, error) {
+		return &types.User{SiteAdmin: true}, nil
+	}
+	database.Mocks.Repos.Get = func(ctx context.Context, id api.RepoID) (*types.Repo, error) {
+		return &types.Repo{ID: id}, nil
+	}
+
+	mockResolver := resolvermocks.NewMockResolver()
+	mockResolver.PreviewRepositoryFilterFunc.SetDefaultReturn([]int{50, 51}, 2, nil, nil)
+	mockResolver.PreviewRetentionFunc.SetDefaultReturn([]resolvers.RetentionPreview{
+		{Upload: store.Upload{ID: 1}, Retained: true, RetainedByPolicy: true},
+		{Upload: store.Upload{ID: 2}, Retained: true},
+		{Upload: store.Upload{ID: 3}},
+	}, 3, nil)
+	mockResolver.PreviewIndexingFunc.SetDefaultReturn(map[string][]string{
+		"deadbeef2": {"v1.0.0"},
+		"deadbeef1": {"main", "develop"},
+	}, nil)
+
+	id := marshalConfigurationPolicyGQLID(42)
+	patterns := []string{"github.com/test/*"}
+	hours := int32(24)
+
+	previews, err := NewResolver(db, mockResolver).PreviewCodeIntelligenceConfigurationPolicy(context.Background(), &gql.PreviewCodeIntelligenceConfigurationPolicyArgs{
+		ID: &id,
+		CodeIntelConfigurationPolicy: gql.CodeIntelConfigurationPolicy{
+			Name:                   "releases",
+			RepositoryPatterns:     &patterns,
+			Type:                   gql.GitObjectTypeTag,
+			Pattern:                "v*",
+			RetentionEnabled:       true,
+			RetentionDurationHours: &hours,
+			IndexingEnabled:        true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(previews) != 2 {
+		t.Fatalf("unexpected number of previews. want=%d have=%d", 2, len(previews))
+	}
+
+	if history := mockResolver.PreviewRepositoryFilterFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(history))
+	} else if history[0].Arg2 != DefaultConfigurationPolicyPreviewRepositoryLimit {
+		t.Errorf("unexpected repository limit. want=%d have=%d", DefaultConfigurationPolicyPreviewRepositoryLimit, history[0].Arg2)
+	}
+
+	uploads, err := previews[1].Uploads(context.Background(), &gql.CodeIntelligenceRetentionPreviewArgs{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if history := mockResolver.PreviewRetentionFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(history))
+	} else {
+		if history[0].Arg1 != 51 {
+			t.Errorf("unexpected repository id. want=%d have=%d", 51, history[0].Arg1)
+		}
+		if policy := history[0].Arg2; policy.ID != 42 || policy.RepositoryID == nil || *policy.RepositoryID != 51 || policy.Pattern != "v*" {
+			t.Errorf("unexpected policy: %+v", policy)
+		}
+		if history[0].Arg3 != DefaultRetentionPreviewPageSize {
+			t.Errorf("unexpected page size. want=%d have=%d", DefaultRetentionPreviewPageSize, history[0].Arg3)
+		}
+	}
+
+	var retentions [][2]bool
+	for _, node := range uploads.Nodes() {
+		retentions = append(retentions, [2]bool{node.Retained(), node.RetainedByPolicy()})
+	}
+	if diff := cmp.Diff([][2]bool{{true, true}, {true, false}, {false, false}}, retentions); diff != "" {
+		t.Errorf("unexpected retentions (-want +got):\n%s", diff)
+	}
+
+	indexedCommits, err := previews[1].IndexedCommits(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var names []string
+	for _, indexedCommit := range indexedCommits {
+		names = append(names, indexedCommit.Name()+"@"+indexedCommit.Rev())
+	}
+	if diff := cmp.Diff([]string{"develop@deadbeef1", "main@deadbeef1", "v1.0.0@deadbeef2"}, names); diff != "" {
+		t.Errorf("unexpected indexed commits (-want +got):\n%s", diff)
+	}
+}
+
+func TestPreviewCodeIntelligenceConfigurationPolicyUnauthenticated(t *testing.T) {
+	db := database.NewDB(nil)
+	mockResolver := resolvermocks.NewMockResolver()
+
+	if _, err := NewResolver(db, mockResolver).PreviewCodeIntelligenceConfigurationPolicy(context.Background(), &gql.PreviewCodeIntelligenceConfigurationPolicyArgs{}); err != backend.ErrNotAuthenticated {
+		t.Errorf("unexpected error. want=%q have=%q", backend.ErrNotAuthenticated, err)
+	}
+}
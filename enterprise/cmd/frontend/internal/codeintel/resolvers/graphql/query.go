@@ -16,6 +16,9 @@ const DefaultReferencesPageSize = 100
 // DefaultReferencesPageSize is the implementation result page size when no limit is supplied.
 const DefaultImplementationsPageSize = 100
 
+// DefaultCallsPageSize is the incoming and outgoing call result page size when no limit is supplied.
+const DefaultCallsPageSize = 100
+
 // DefaultDiagnosticsPageSize is the diagnostic result page size when no limit is supplied.
 const DefaultDiagnosticsPageSize = 100
 
@@ -86,6 +89,15 @@ func (r *QueryResolver) Definitions(ctx context.Context, args *gql.LSIFQueryPosi
 	return NewLocationConnectionResolver(locations, nil, r.locationResolver), nil
 }
 
+func (r *QueryResolver) TypeDefinitions(ctx context.Context, args *gql.LSIFQueryPositionArgs) (gql.LocationConnectionResolver, error) {
+	locations, err := r.resolver.TypeDefinitions(ctx, int(args.Line), int(args.Character))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewLocationConnectionResolver(locations, nil, r.locationResolver), nil
+}
+
 func (r *QueryResolver) References(ctx context.Context, args *gql.LSIFPagedQueryPositionArgs) (gql.LocationConnectionResolver, error) {
 	limit := derefInt32(args.First, DefaultReferencesPageSize)
 	if limit <= 0 {
@@ -122,6 +134,42 @@ func (r *QueryResolver) Implementations(ctx context.Context, args *gql.LSIFPaged
 	return NewLocationConnectionResolver(locations, strPtr(cursor), r.locationResolver), nil
 }
 
+func (r *QueryResolver) IncomingCalls(ctx context.Context, args *gql.LSIFPagedQueryPositionArgs) (gql.CallHierarchyConnectionResolver, error) {
+	limit := derefInt32(args.First, DefaultCallsPageSize)
+	if limit <= 0 {
+		return nil, ErrIllegalLimit
+	}
+	cursor, err := graphqlutil.DecodeCursor(args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	calls, cursor, err := r.resolver.IncomingCalls(ctx, int(args.Line), int(args.Character), limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCallHierarchyConnectionResolver(calls, strPtr(cursor), r.locationResolver), nil
+}
+
+func (r *QueryResolver) OutgoingCalls(ctx context.Context, args *gql.LSIFPagedQueryPositionArgs) (gql.CallHierarchyConnectionResolver, error) {
+	limit := derefInt32(args.First, DefaultCallsPageSize)
+	if limit <= 0 {
+		return nil, ErrIllegalLimit
+	}
+	cursor, err := graphqlutil.DecodeCursor(args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	calls, cursor, err := r.resolver.OutgoingCalls(ctx, int(args.Line), int(args.Character), limit, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCallHierarchyConnectionResolver(calls, strPtr(cursor), r.locationResolver), nil
+}
+
 func (r *QueryResolver) Hover(ctx context.Context, args *gql.LSIFQueryPositionArgs) (gql.HoverResolver, error) {
 	text, rx, exists, err := r.resolver.Hover(ctx, int(args.Line), int(args.Character))
 	if err != nil || !exists {
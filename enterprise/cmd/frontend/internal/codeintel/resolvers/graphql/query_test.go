@@ -56,6 +56,28 @@ func TestDefinitions(t *testing.T) {
 	}
 }
 
+func TestTypeDefinitions(t *testing.T) {
+	db := new(dbtesting.MockDB)
+
+	mockResolver := resolvermocks.NewMockQueryResolver()
+	resolver := NewQueryResolver(mockResolver, NewCachedLocationResolver(db))
+
+	args := &gql.LSIFQueryPositionArgs{Line: 10, Character: 15}
+	if _, err := resolver.TypeDefinitions(context.Background(), args); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(mockResolver.TypeDefinitionsFunc.History()) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(mockResolver.TypeDefinitionsFunc.History()))
+	}
+	if val := mockResolver.TypeDefinitionsFunc.History()[0].Arg1; val != 10 {
+		t.Fatalf("unexpected line. want=%d have=%d", 10, val)
+	}
+	if val := mockResolver.TypeDefinitionsFunc.History()[0].Arg2; val != 15 {
+		t.Fatalf("unexpected character. want=%d have=%d", 15, val)
+	}
+}
+
 func TestReferences(t *testing.T) {
 	db := new(dbtesting.MockDB)
 
@@ -141,6 +163,71 @@ func TestReferencesDefaultIllegalLimit(t *testing.T) {
 	}
 }
 
+func TestIncomingCalls(t *testing.T) {
+	db := new(dbtesting.MockDB)
+
+	mockResolver := resolvermocks.NewMockQueryResolver()
+	resolver := NewQueryResolver(mockResolver, NewCachedLocationResolver(db))
+
+	offset := int32(25)
+	cursor := base64.StdEncoding.EncodeToString([]byte("test-cursor"))
+
+	args := &gql.LSIFPagedQueryPositionArgs{
+		LSIFQueryPositionArgs: gql.LSIFQueryPositionArgs{
+			Line:      10,
+			Character: 15,
+		},
+		ConnectionArgs: graphqlutil.ConnectionArgs{First: &offset},
+		After:          &cursor,
+	}
+
+	if _, err := resolver.IncomingCalls(context.Background(), args); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(mockResolver.IncomingCallsFunc.History()) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(mockResolver.IncomingCallsFunc.History()))
+	}
+	if val := mockResolver.IncomingCallsFunc.History()[0].Arg1; val != 10 {
+		t.Fatalf("unexpected line. want=%d have=%d", 10, val)
+	}
+	if val := mockResolver.IncomingCallsFunc.History()[0].Arg2; val != 15 {
+		t.Fatalf("unexpected character. want=%d have=%d", 15, val)
+	}
+	if val := mockResolver.IncomingCallsFunc.History()[0].Arg3; val != 25 {
+		t.Fatalf("unexpected limit. want=%d have=%d", 25, val)
+	}
+	if val := mockResolver.IncomingCallsFunc.History()[0].Arg4; val != "test-cursor" {
+		t.Fatalf("unexpected cursor. want=%s have=%s", "test-cursor", val)
+	}
+}
+
+func TestOutgoingCallsDefaultLimit(t *testing.T) {
+	db := new(dbtesting.MockDB)
+
+	mockResolver := resolvermocks.NewMockQueryResolver()
+	resolver := NewQueryResolver(mockResolver, NewCachedLocationResolver(db))
+
+	args := &gql.LSIFPagedQueryPositionArgs{
+		LSIFQueryPositionArgs: gql.LSIFQueryPositionArgs{
+			Line:      10,
+			Character: 15,
+		},
+		ConnectionArgs: graphqlutil.ConnectionArgs{},
+	}
+
+	if _, err := resolver.OutgoingCalls(context.Background(), args); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(mockResolver.OutgoingCallsFunc.History()) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(mockResolver.OutgoingCallsFunc.History()))
+	}
+	if val := mockResolver.OutgoingCallsFunc.History()[0].Arg3; val != DefaultCallsPageSize {
+		t.Fatalf("unexpected limit. want=%d have=%d", DefaultCallsPageSize, val)
+	}
+}
+
 func TestHover(t *testing.T) {
 	db := new(dbtesting.MockDB)
 
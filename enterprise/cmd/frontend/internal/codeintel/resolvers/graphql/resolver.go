@@ -252,6 +252,10 @@ func (r *Resolver) GitBlobLSIFData(ctx context.Context, args *gql.GitBlobLSIFDat
 	return NewQueryResolver(resolver, r.locationResolver), nil
 }
 
+func (r *Resolver) GitBlobSearchBasedCodeIntel(ctx context.Context, args *gql.GitBlobLSIFDataArgs) (gql.SearchBasedCodeIntelResolver, error) {
+	return NewSearchBasedCodeIntelResolver(r.resolver.SearchBasedQueryResolver(args), args.Repo.ID, string(args.Commit), r.locationResolver), nil
+}
+
 // 🚨 SECURITY: dbstore layer handles authz for GetConfigurationPolicyByID
 func (r *Resolver) ConfigurationPolicyByID(ctx context.Context, id graphql.ID) (gql.CodeIntelligenceConfigurationPolicyResolver, error) {
 	configurationPolicyID, err := unmarshalConfigurationPolicyGQLID(id)
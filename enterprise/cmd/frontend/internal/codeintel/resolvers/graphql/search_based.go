@@ -0,0 +1,104 @@
+package graphql
+
+import (
+	"context"
+
+	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+// DefaultSearchBasedReferencesPageSize is the search-based reference result page size when no limit is supplied.
+const DefaultSearchBasedReferencesPageSize = 100
+
+// SearchBasedCodeIntelResolver is the main interface to search-based code navigation queries of a
+// path-at-revision. All locations are within the repository and commit of the requested path.
+type SearchBasedCodeIntelResolver struct {
+	resolver         resolvers.SearchBasedQueryResolver
+	repositoryID     api.RepoID
+	commit           string
+	locationResolver *CachedLocationResolver
+}
+
+// NewSearchBasedCodeIntelResolver creates a new SearchBasedCodeIntelResolver with the given resolver
+// that defines all search-based code navigation behavior.
+func NewSearchBasedCodeIntelResolver(resolver resolvers.SearchBasedQueryResolver, repositoryID api.RepoID, commit string, locationResolver *CachedLocationResolver) gql.SearchBasedCodeIntelResolver {
+	return &SearchBasedCodeIntelResolver{
+		resolver:         resolver,
+		repositoryID:     repositoryID,
+		commit:           commit,
+		locationResolver: locationResolver,
+	}
+}
+
+func (r *SearchBasedCodeIntelResolver) Definitions(ctx context.Context, args *gql.LSIFQueryPositionArgs) (gql.SearchBasedLocationConnectionResolver, error) {
+	locations, err := r.resolver.Definitions(ctx, int(args.Line), int(args.Character))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.newConnection(locations, len(locations) >= resolvers.SearchBasedDefinitionsLimit), nil
+}
+
+func (r *SearchBasedCodeIntelResolver) References(ctx context.Context, args *gql.SearchBasedReferencesArgs) (gql.SearchBasedLocationConnectionResolver, error) {
+	limit := derefInt32(args.First, DefaultSearchBasedReferencesPageSize)
+	if limit <= 0 {
+		return nil, ErrIllegalLimit
+	}
+
+	locations, limitHit, err := r.resolver.References(ctx, int(args.Line), int(args.Character), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.newConnection(locations, limitHit), nil
+}
+
+func (r *SearchBasedCodeIntelResolver) newConnection(locations []resolvers.SearchBasedLocation, limitHit bool) gql.SearchBasedLocationConnectionResolver {
+	return &SearchBasedLocationConnectionResolver{
+		locations:        locations,
+		limitHit:         limitHit,
+		repositoryID:     r.repositoryID,
+		commit:           r.commit,
+		locationResolver: r.locationResolver,
+	}
+}
+
+type SearchBasedLocationConnectionResolver struct {
+	locations        []resolvers.SearchBasedLocation
+	limitHit         bool
+	repositoryID     api.RepoID
+	commit           string
+	locationResolver *CachedLocationResolver
+}
+
+func (r *SearchBasedLocationConnectionResolver) Nodes(ctx context.Context) ([]gql.SearchBasedLocationResolver, error) {
+	nodes := make([]gql.SearchBasedLocationResolver, 0, len(r.locations))
+	for _, location := range r.locations {
+		treeResolver, err := r.locationResolver.Path(ctx, r.repositoryID, r.commit, location.Path)
+		if err != nil {
+			return nil, err
+		}
+		if treeResolver == nil {
+			continue
+		}
+
+		lspRange := convertRange(location.Range)
+		nodes = append(nodes, &SearchBasedLocationResolver{
+			location:   gql.NewLocationResolver(treeResolver, &lspRange),
+			confidence: location.Confidence,
+		})
+	}
+
+	return nodes, nil
+}
+
+func (r *SearchBasedLocationConnectionResolver) LimitHit() bool { return r.limitHit }
+
+type SearchBasedLocationResolver struct {
+	location   gql.LocationResolver
+	confidence string
+}
+
+func (r *SearchBasedLocationResolver) Location() gql.LocationResolver { return r.location }
+func (r *SearchBasedLocationResolver) Confidence() string             { return r.confidence }
@@ -0,0 +1,55 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	resolvermocks "github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers/mocks"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
+)
+
+func TestSearchBasedReferencesDefaultLimit(t *testing.T) {
+	db := new(dbtesting.MockDB)
+
+	mockResolver := resolvermocks.NewMockSearchBasedQueryResolver()
+	resolver := NewSearchBasedCodeIntelResolver(mockResolver, 42, "deadbeef", NewCachedLocationResolver(db))
+
+	args := &gql.SearchBasedReferencesArgs{
+		LSIFQueryPositionArgs: gql.LSIFQueryPositionArgs{
+			Line:      10,
+			Character: 15,
+		},
+	}
+
+	if _, err := resolver.References(context.Background(), args); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(mockResolver.ReferencesFunc.History()) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(mockResolver.ReferencesFunc.History()))
+	}
+	if val := mockResolver.ReferencesFunc.History()[0].Arg3; val != DefaultSearchBasedReferencesPageSize {
+		t.Fatalf("unexpected limit. want=%d have=%d", DefaultSearchBasedReferencesPageSize, val)
+	}
+}
+
+func TestSearchBasedReferencesIllegalLimit(t *testing.T) {
+	db := new(dbtesting.MockDB)
+
+	mockResolver := resolvermocks.NewMockSearchBasedQueryResolver()
+	resolver := NewSearchBasedCodeIntelResolver(mockResolver, 42, "deadbeef", NewCachedLocationResolver(db))
+
+	first := int32(-1)
+	args := &gql.SearchBasedReferencesArgs{
+		LSIFQueryPositionArgs: gql.LSIFQueryPositionArgs{
+			Line:      10,
+			Character: 15,
+		},
+		First: &first,
+	}
+
+	if _, err := resolver.References(context.Background(), args); err != ErrIllegalLimit {
+		t.Fatalf("unexpected error. want=%q have=%q", ErrIllegalLimit, err)
+	}
+}
@@ -76,3 +76,17 @@ func (r *UploadResolver) AssociatedIndex(ctx context.Context) (gql.LSIFIndexReso
 func (r *UploadResolver) ProjectRoot(ctx context.Context) (*gql.GitTreeEntryResolver, error) {
 	return r.locationResolver.Path(ctx, api.RepoID(r.upload.RepositoryID), r.upload.Commit, r.upload.Root)
 }
+
+func (r *UploadResolver) ValidationFindings(ctx context.Context) ([]gql.LSIFUploadValidationFindingResolver, error) {
+	findings, err := r.resolver.UploadValidationFindings(ctx, r.upload.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]gql.LSIFUploadValidationFindingResolver, 0, len(findings))
+	for _, finding := range findings {
+		resolvers = append(resolvers, NewUploadValidationFindingResolver(finding))
+	}
+
+	return resolvers, nil
+}
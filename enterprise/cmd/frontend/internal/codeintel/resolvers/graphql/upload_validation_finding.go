@@ -0,0 +1,28 @@
+package graphql
+
+import (
+	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+)
+
+type UploadValidationFindingResolver struct {
+	finding store.UploadValidationFinding
+}
+
+func NewUploadValidationFindingResolver(finding store.UploadValidationFinding) gql.LSIFUploadValidationFindingResolver {
+	return &UploadValidationFindingResolver{
+		finding: finding,
+	}
+}
+
+func (r *UploadValidationFindingResolver) Kind() string    { return r.finding.Kind }
+func (r *UploadValidationFindingResolver) Message() string { return r.finding.Message }
+
+func (r *UploadValidationFindingResolver) LineNumbers() []int32 {
+	lineNumbers := make([]int32, 0, len(r.finding.LineNumbers))
+	for _, lineNumber := range r.finding.LineNumbers {
+		lineNumbers = append(lineNumbers, int32(lineNumber))
+	}
+
+	return lineNumbers
+}
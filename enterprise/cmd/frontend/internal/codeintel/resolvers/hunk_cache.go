@@ -1,8 +1,16 @@
 package resolvers
 
-import "github.com/dgraph-io/ristretto"
+import (
+	"strconv"
+	"strings"
 
-// HunkCache is a LRU cache that holds git diff hunks.
+	"github.com/dgraph-io/ristretto"
+
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// HunkCache is a LRU cache that holds git diff hunks of a file between two commits, as well
+// as the commit graph paths between two commits along which hunks are composed.
 type HunkCache interface {
 	// Get returns the value (if any) and a boolean representing whether the value was
 	// found or not.
@@ -21,3 +29,20 @@ func NewHunkCache(size int) (HunkCache, error) {
 		BufferItems: 64,
 	})
 }
+
+// makeHunksKey returns the hunk cache key of the diff hunks of the given path between the
+// given source and target commits.
+func makeHunksKey(repo *types.Repo, sourceCommit, targetCommit, path string) string {
+	return makeKey(strconv.FormatInt(int64(repo.ID), 10), sourceCommit, targetCommit, path)
+}
+
+// makeCommitPathKey returns the hunk cache key of the commit graph path between the given
+// source and target commits. The key is prefixed so that it cannot collide with the key of
+// diff hunks, which begins with a repository identifier.
+func makeCommitPathKey(repo *types.Repo, sourceCommit, targetCommit string) string {
+	return makeKey("path", strconv.FormatInt(int64(repo.ID), 10), sourceCommit, targetCommit)
+}
+
+func makeKey(parts ...string) string {
+	return strings.Join(parts, ":")
+}
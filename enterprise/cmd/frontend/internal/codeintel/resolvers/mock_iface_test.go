@@ -8,13 +8,17 @@ import (
 	"sync"
 	"time"
 
+	protocol1 "github.com/sourcegraph/sourcegraph/cmd/searcher/protocol"
 	enqueuer "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/autoindex/enqueuer"
 	gitserver "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/gitserver"
+	policies "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/policies"
 	dbstore "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
 	lsifstore "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
 	api "github.com/sourcegraph/sourcegraph/internal/api"
 	basestore "github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	protocol "github.com/sourcegraph/sourcegraph/internal/repoupdater/protocol"
+	search "github.com/sourcegraph/sourcegraph/internal/search"
+	result "github.com/sourcegraph/sourcegraph/internal/search/result"
 	config "github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
 	precise "github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
 )
@@ -27,6 +31,9 @@ type MockDBStore struct {
 	// CommitGraphMetadataFunc is an instance of a mock function object
 	// controlling the behavior of the method CommitGraphMetadata.
 	CommitGraphMetadataFunc *DBStoreCommitGraphMetadataFunc
+	// CommitsVisibleToUploadFunc is an instance of a mock function object
+	// controlling the behavior of the method CommitsVisibleToUpload.
+	CommitsVisibleToUploadFunc *DBStoreCommitsVisibleToUploadFunc
 	// CreateConfigurationPolicyFunc is an instance of a mock function
 	// object controlling the behavior of the method
 	// CreateConfigurationPolicy.
@@ -74,9 +81,19 @@ type MockDBStore struct {
 	// GetIndexesByIDsFunc is an instance of a mock function object
 	// controlling the behavior of the method GetIndexesByIDs.
 	GetIndexesByIDsFunc *DBStoreGetIndexesByIDsFunc
+	// GetPackageDependenciesFunc is an instance of a mock function object
+	// controlling the behavior of the method GetPackageDependencies.
+	GetPackageDependenciesFunc *DBStoreGetPackageDependenciesFunc
+	// GetPackageDependentsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetPackageDependents.
+	GetPackageDependentsFunc *DBStoreGetPackageDependentsFunc
 	// GetUploadByIDFunc is an instance of a mock function object
 	// controlling the behavior of the method GetUploadByID.
 	GetUploadByIDFunc *DBStoreGetUploadByIDFunc
+	// GetUploadValidationFindingsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// GetUploadValidationFindings.
+	GetUploadValidationFindingsFunc *DBStoreGetUploadValidationFindingsFunc
 	// GetUploadsFunc is an instance of a mock function object controlling
 	// the behavior of the method GetUploads.
 	GetUploadsFunc *DBStoreGetUploadsFunc
@@ -120,6 +137,11 @@ func NewMockDBStore() *MockDBStore {
 				return false, nil, nil
 			},
 		},
+		CommitsVisibleToUploadFunc: &DBStoreCommitsVisibleToUploadFunc{
+			defaultHook: func(context.Context, int, int, *string) ([]string, *string, error) {
+				return nil, nil, nil
+			},
+		},
 		CreateConfigurationPolicyFunc: &DBStoreCreateConfigurationPolicyFunc{
 			defaultHook: func(context.Context, dbstore.ConfigurationPolicy) (dbstore.ConfigurationPolicy, error) {
 				return dbstore.ConfigurationPolicy{}, nil
@@ -190,11 +212,26 @@ func NewMockDBStore() *MockDBStore {
 				return nil, nil
 			},
 		},
+		GetPackageDependenciesFunc: &DBStoreGetPackageDependenciesFunc{
+			defaultHook: func(context.Context, dbstore.DependencyGraphOptions) ([]precise.Package, int, error) {
+				return nil, 0, nil
+			},
+		},
+		GetPackageDependentsFunc: &DBStoreGetPackageDependentsFunc{
+			defaultHook: func(context.Context, dbstore.DependencyGraphOptions) ([]dbstore.PackageDependent, int, error) {
+				return nil, 0, nil
+			},
+		},
 		GetUploadByIDFunc: &DBStoreGetUploadByIDFunc{
 			defaultHook: func(context.Context, int) (dbstore.Upload, bool, error) {
 				return dbstore.Upload{}, false, nil
 			},
 		},
+		GetUploadValidationFindingsFunc: &DBStoreGetUploadValidationFindingsFunc{
+			defaultHook: func(context.Context, int) ([]dbstore.UploadValidationFinding, error) {
+				return nil, nil
+			},
+		},
 		GetUploadsFunc: &DBStoreGetUploadsFunc{
 			defaultHook: func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
 				return nil, 0, nil
@@ -257,6 +294,11 @@ func NewStrictMockDBStore() *MockDBStore {
 				panic("unexpected invocation of MockDBStore.CommitGraphMetadata")
 			},
 		},
+		CommitsVisibleToUploadFunc: &DBStoreCommitsVisibleToUploadFunc{
+			defaultHook: func(context.Context, int, int, *string) ([]string, *string, error) {
+				panic("unexpected invocation of MockDBStore.CommitsVisibleToUpload")
+			},
+		},
 		CreateConfigurationPolicyFunc: &DBStoreCreateConfigurationPolicyFunc{
 			defaultHook: func(context.Context, dbstore.ConfigurationPolicy) (dbstore.ConfigurationPolicy, error) {
 				panic("unexpected invocation of MockDBStore.CreateConfigurationPolicy")
@@ -327,11 +369,26 @@ func NewStrictMockDBStore() *MockDBStore {
 				panic("unexpected invocation of MockDBStore.GetIndexesByIDs")
 			},
 		},
+		GetPackageDependenciesFunc: &DBStoreGetPackageDependenciesFunc{
+			defaultHook: func(context.Context, dbstore.DependencyGraphOptions) ([]precise.Package, int, error) {
+				panic("unexpected invocation of MockDBStore.GetPackageDependencies")
+			},
+		},
+		GetPackageDependentsFunc: &DBStoreGetPackageDependentsFunc{
+			defaultHook: func(context.Context, dbstore.DependencyGraphOptions) ([]dbstore.PackageDependent, int, error) {
+				panic("unexpected invocation of MockDBStore.GetPackageDependents")
+			},
+		},
 		GetUploadByIDFunc: &DBStoreGetUploadByIDFunc{
 			defaultHook: func(context.Context, int) (dbstore.Upload, bool, error) {
 				panic("unexpected invocation of MockDBStore.GetUploadByID")
 			},
 		},
+		GetUploadValidationFindingsFunc: &DBStoreGetUploadValidationFindingsFunc{
+			defaultHook: func(context.Context, int) ([]dbstore.UploadValidationFinding, error) {
+				panic("unexpected invocation of MockDBStore.GetUploadValidationFindings")
+			},
+		},
 		GetUploadsFunc: &DBStoreGetUploadsFunc{
 			defaultHook: func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
 				panic("unexpected invocation of MockDBStore.GetUploads")
@@ -392,6 +449,9 @@ func NewMockDBStoreFrom(i DBStore) *MockDBStore {
 		CommitGraphMetadataFunc: &DBStoreCommitGraphMetadataFunc{
 			defaultHook: i.CommitGraphMetadata,
 		},
+		CommitsVisibleToUploadFunc: &DBStoreCommitsVisibleToUploadFunc{
+			defaultHook: i.CommitsVisibleToUpload,
+		},
 		CreateConfigurationPolicyFunc: &DBStoreCreateConfigurationPolicyFunc{
 			defaultHook: i.CreateConfigurationPolicy,
 		},
@@ -434,9 +494,18 @@ func NewMockDBStoreFrom(i DBStore) *MockDBStore {
 		GetIndexesByIDsFunc: &DBStoreGetIndexesByIDsFunc{
 			defaultHook: i.GetIndexesByIDs,
 		},
+		GetPackageDependenciesFunc: &DBStoreGetPackageDependenciesFunc{
+			defaultHook: i.GetPackageDependencies,
+		},
+		GetPackageDependentsFunc: &DBStoreGetPackageDependentsFunc{
+			defaultHook: i.GetPackageDependents,
+		},
 		GetUploadByIDFunc: &DBStoreGetUploadByIDFunc{
 			defaultHook: i.GetUploadByID,
 		},
+		GetUploadValidationFindingsFunc: &DBStoreGetUploadValidationFindingsFunc{
+			defaultHook: i.GetUploadValidationFindings,
+		},
 		GetUploadsFunc: &DBStoreGetUploadsFunc{
 			defaultHook: i.GetUploads,
 		},
@@ -582,6 +651,126 @@ func (c DBStoreCommitGraphMetadataFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
+// DBStoreCommitsVisibleToUploadFunc describes the behavior when the
+// CommitsVisibleToUpload method of the parent MockDBStore instance is
+// invoked.
+type DBStoreCommitsVisibleToUploadFunc struct {
+	defaultHook func(context.Context, int, int, *string) ([]string, *string, error)
+	hooks       []func(context.Context, int, int, *string) ([]string, *string, error)
+	history     []DBStoreCommitsVisibleToUploadFuncCall
+	mutex       sync.Mutex
+}
+
+// CommitsVisibleToUpload delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockDBStore) CommitsVisibleToUpload(v0 context.Context, v1 int, v2 int, v3 *string) ([]string, *string, error) {
+	r0, r1, r2 := m.CommitsVisibleToUploadFunc.nextHook()(v0, v1, v2, v3)
+	m.CommitsVisibleToUploadFunc.appendCall(DBStoreCommitsVisibleToUploadFuncCall{v0, v1, v2, v3, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the
+// CommitsVisibleToUpload method of the parent MockDBStore instance is
+// invoked and the hook queue is empty.
+func (f *DBStoreCommitsVisibleToUploadFunc) SetDefaultHook(hook func(context.Context, int, int, *string) ([]string, *string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CommitsVisibleToUpload method of the parent MockDBStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *DBStoreCommitsVisibleToUploadFunc) PushHook(hook func(context.Context, int, int, *string) ([]string, *string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreCommitsVisibleToUploadFunc) SetDefaultReturn(r0 []string, r1 *string, r2 error) {
+	f.SetDefaultHook(func(context.Context, int, int, *string) ([]string, *string, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreCommitsVisibleToUploadFunc) PushReturn(r0 []string, r1 *string, r2 error) {
+	f.PushHook(func(context.Context, int, int, *string) ([]string, *string, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *DBStoreCommitsVisibleToUploadFunc) nextHook() func(context.Context, int, int, *string) ([]string, *string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreCommitsVisibleToUploadFunc) appendCall(r0 DBStoreCommitsVisibleToUploadFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of DBStoreCommitsVisibleToUploadFuncCall
+// objects describing the invocations of this function.
+func (f *DBStoreCommitsVisibleToUploadFunc) History() []DBStoreCommitsVisibleToUploadFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreCommitsVisibleToUploadFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreCommitsVisibleToUploadFuncCall is an object that describes an
+// invocation of method CommitsVisibleToUpload on an instance of
+// MockDBStore.
+type DBStoreCommitsVisibleToUploadFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 *string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 *string
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreCommitsVisibleToUploadFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreCommitsVisibleToUploadFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
 // DBStoreCreateConfigurationPolicyFunc describes the behavior when the
 // CreateConfigurationPolicy method of the parent MockDBStore instance is
 // invoked.
@@ -2175,35 +2364,36 @@ func (c DBStoreGetIndexesByIDsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// DBStoreGetUploadByIDFunc describes the behavior when the GetUploadByID
-// method of the parent MockDBStore instance is invoked.
-type DBStoreGetUploadByIDFunc struct {
-	defaultHook func(context.Context, int) (dbstore.Upload, bool, error)
-	hooks       []func(context.Context, int) (dbstore.Upload, bool, error)
-	history     []DBStoreGetUploadByIDFuncCall
+// DBStoreGetPackageDependenciesFunc describes the behavior when the
+// GetPackageDependencies method of the parent MockDBStore instance is
+// invoked.
+type DBStoreGetPackageDependenciesFunc struct {
+	defaultHook func(context.Context, dbstore.DependencyGraphOptions) ([]precise.Package, int, error)
+	hooks       []func(context.Context, dbstore.DependencyGraphOptions) ([]precise.Package, int, error)
+	history     []DBStoreGetPackageDependenciesFuncCall
 	mutex       sync.Mutex
 }
 
-// GetUploadByID delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockDBStore) GetUploadByID(v0 context.Context, v1 int) (dbstore.Upload, bool, error) {
-	r0, r1, r2 := m.GetUploadByIDFunc.nextHook()(v0, v1)
-	m.GetUploadByIDFunc.appendCall(DBStoreGetUploadByIDFuncCall{v0, v1, r0, r1, r2})
+// GetPackageDependencies delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockDBStore) GetPackageDependencies(v0 context.Context, v1 dbstore.DependencyGraphOptions) ([]precise.Package, int, error) {
+	r0, r1, r2 := m.GetPackageDependenciesFunc.nextHook()(v0, v1)
+	m.GetPackageDependenciesFunc.appendCall(DBStoreGetPackageDependenciesFuncCall{v0, v1, r0, r1, r2})
 	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the GetUploadByID method
-// of the parent MockDBStore instance is invoked and the hook queue is
-// empty.
-func (f *DBStoreGetUploadByIDFunc) SetDefaultHook(hook func(context.Context, int) (dbstore.Upload, bool, error)) {
+// SetDefaultHook sets function that is called when the
+// GetPackageDependencies method of the parent MockDBStore instance is
+// invoked and the hook queue is empty.
+func (f *DBStoreGetPackageDependenciesFunc) SetDefaultHook(hook func(context.Context, dbstore.DependencyGraphOptions) ([]precise.Package, int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetUploadByID method of the parent MockDBStore instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *DBStoreGetUploadByIDFunc) PushHook(hook func(context.Context, int) (dbstore.Upload, bool, error)) {
+// GetPackageDependencies method of the parent MockDBStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *DBStoreGetPackageDependenciesFunc) PushHook(hook func(context.Context, dbstore.DependencyGraphOptions) ([]precise.Package, int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2211,21 +2401,21 @@ func (f *DBStoreGetUploadByIDFunc) PushHook(hook func(context.Context, int) (dbs
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreGetUploadByIDFunc) SetDefaultReturn(r0 dbstore.Upload, r1 bool, r2 error) {
-	f.SetDefaultHook(func(context.Context, int) (dbstore.Upload, bool, error) {
+func (f *DBStoreGetPackageDependenciesFunc) SetDefaultReturn(r0 []precise.Package, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, dbstore.DependencyGraphOptions) ([]precise.Package, int, error) {
 		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreGetUploadByIDFunc) PushReturn(r0 dbstore.Upload, r1 bool, r2 error) {
-	f.PushHook(func(context.Context, int) (dbstore.Upload, bool, error) {
+func (f *DBStoreGetPackageDependenciesFunc) PushReturn(r0 []precise.Package, r1 int, r2 error) {
+	f.PushHook(func(context.Context, dbstore.DependencyGraphOptions) ([]precise.Package, int, error) {
 		return r0, r1, r2
 	})
 }
 
-func (f *DBStoreGetUploadByIDFunc) nextHook() func(context.Context, int) (dbstore.Upload, bool, error) {
+func (f *DBStoreGetPackageDependenciesFunc) nextHook() func(context.Context, dbstore.DependencyGraphOptions) ([]precise.Package, int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2238,38 +2428,39 @@ func (f *DBStoreGetUploadByIDFunc) nextHook() func(context.Context, int) (dbstor
 	return hook
 }
 
-func (f *DBStoreGetUploadByIDFunc) appendCall(r0 DBStoreGetUploadByIDFuncCall) {
+func (f *DBStoreGetPackageDependenciesFunc) appendCall(r0 DBStoreGetPackageDependenciesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreGetUploadByIDFuncCall objects
-// describing the invocations of this function.
-func (f *DBStoreGetUploadByIDFunc) History() []DBStoreGetUploadByIDFuncCall {
+// History returns a sequence of DBStoreGetPackageDependenciesFuncCall
+// objects describing the invocations of this function.
+func (f *DBStoreGetPackageDependenciesFunc) History() []DBStoreGetPackageDependenciesFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreGetUploadByIDFuncCall, len(f.history))
+	history := make([]DBStoreGetPackageDependenciesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreGetUploadByIDFuncCall is an object that describes an invocation of
-// method GetUploadByID on an instance of MockDBStore.
-type DBStoreGetUploadByIDFuncCall struct {
+// DBStoreGetPackageDependenciesFuncCall is an object that describes an
+// invocation of method GetPackageDependencies on an instance of
+// MockDBStore.
+type DBStoreGetPackageDependenciesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int
+	Arg1 dbstore.DependencyGraphOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 dbstore.Upload
+	Result0 []precise.Package
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 bool
+	Result1 int
 	// Result2 is the value of the 3rd result returned from this method
 	// invocation.
 	Result2 error
@@ -2277,44 +2468,46 @@ type DBStoreGetUploadByIDFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c DBStoreGetUploadByIDFuncCall) Args() []interface{} {
+func (c DBStoreGetPackageDependenciesFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreGetUploadByIDFuncCall) Results() []interface{} {
+func (c DBStoreGetPackageDependenciesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// DBStoreGetUploadsFunc describes the behavior when the GetUploads method
-// of the parent MockDBStore instance is invoked.
-type DBStoreGetUploadsFunc struct {
-	defaultHook func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)
-	hooks       []func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)
-	history     []DBStoreGetUploadsFuncCall
+// DBStoreGetPackageDependentsFunc describes the behavior when the
+// GetPackageDependents method of the parent MockDBStore instance is
+// invoked.
+type DBStoreGetPackageDependentsFunc struct {
+	defaultHook func(context.Context, dbstore.DependencyGraphOptions) ([]dbstore.PackageDependent, int, error)
+	hooks       []func(context.Context, dbstore.DependencyGraphOptions) ([]dbstore.PackageDependent, int, error)
+	history     []DBStoreGetPackageDependentsFuncCall
 	mutex       sync.Mutex
 }
 
-// GetUploads delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockDBStore) GetUploads(v0 context.Context, v1 dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
-	r0, r1, r2 := m.GetUploadsFunc.nextHook()(v0, v1)
-	m.GetUploadsFunc.appendCall(DBStoreGetUploadsFuncCall{v0, v1, r0, r1, r2})
+// GetPackageDependents delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockDBStore) GetPackageDependents(v0 context.Context, v1 dbstore.DependencyGraphOptions) ([]dbstore.PackageDependent, int, error) {
+	r0, r1, r2 := m.GetPackageDependentsFunc.nextHook()(v0, v1)
+	m.GetPackageDependentsFunc.appendCall(DBStoreGetPackageDependentsFuncCall{v0, v1, r0, r1, r2})
 	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the GetUploads method of
-// the parent MockDBStore instance is invoked and the hook queue is empty.
-func (f *DBStoreGetUploadsFunc) SetDefaultHook(hook func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)) {
+// SetDefaultHook sets function that is called when the GetPackageDependents
+// method of the parent MockDBStore instance is invoked and the hook queue
+// is empty.
+func (f *DBStoreGetPackageDependentsFunc) SetDefaultHook(hook func(context.Context, dbstore.DependencyGraphOptions) ([]dbstore.PackageDependent, int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetUploads method of the parent MockDBStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *DBStoreGetUploadsFunc) PushHook(hook func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)) {
+// GetPackageDependents method of the parent MockDBStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *DBStoreGetPackageDependentsFunc) PushHook(hook func(context.Context, dbstore.DependencyGraphOptions) ([]dbstore.PackageDependent, int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2322,21 +2515,21 @@ func (f *DBStoreGetUploadsFunc) PushHook(hook func(context.Context, dbstore.GetU
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreGetUploadsFunc) SetDefaultReturn(r0 []dbstore.Upload, r1 int, r2 error) {
-	f.SetDefaultHook(func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
+func (f *DBStoreGetPackageDependentsFunc) SetDefaultReturn(r0 []dbstore.PackageDependent, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, dbstore.DependencyGraphOptions) ([]dbstore.PackageDependent, int, error) {
 		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreGetUploadsFunc) PushReturn(r0 []dbstore.Upload, r1 int, r2 error) {
-	f.PushHook(func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
+func (f *DBStoreGetPackageDependentsFunc) PushReturn(r0 []dbstore.PackageDependent, r1 int, r2 error) {
+	f.PushHook(func(context.Context, dbstore.DependencyGraphOptions) ([]dbstore.PackageDependent, int, error) {
 		return r0, r1, r2
 	})
 }
 
-func (f *DBStoreGetUploadsFunc) nextHook() func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
+func (f *DBStoreGetPackageDependentsFunc) nextHook() func(context.Context, dbstore.DependencyGraphOptions) ([]dbstore.PackageDependent, int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2349,35 +2542,35 @@ func (f *DBStoreGetUploadsFunc) nextHook() func(context.Context, dbstore.GetUplo
 	return hook
 }
 
-func (f *DBStoreGetUploadsFunc) appendCall(r0 DBStoreGetUploadsFuncCall) {
+func (f *DBStoreGetPackageDependentsFunc) appendCall(r0 DBStoreGetPackageDependentsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreGetUploadsFuncCall objects
+// History returns a sequence of DBStoreGetPackageDependentsFuncCall objects
 // describing the invocations of this function.
-func (f *DBStoreGetUploadsFunc) History() []DBStoreGetUploadsFuncCall {
+func (f *DBStoreGetPackageDependentsFunc) History() []DBStoreGetPackageDependentsFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreGetUploadsFuncCall, len(f.history))
+	history := make([]DBStoreGetPackageDependentsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreGetUploadsFuncCall is an object that describes an invocation of
-// method GetUploads on an instance of MockDBStore.
-type DBStoreGetUploadsFuncCall struct {
+// DBStoreGetPackageDependentsFuncCall is an object that describes an
+// invocation of method GetPackageDependents on an instance of MockDBStore.
+type DBStoreGetPackageDependentsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 dbstore.GetUploadsOptions
+	Arg1 dbstore.DependencyGraphOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []dbstore.Upload
+	Result0 []dbstore.PackageDependent
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 int
@@ -2388,45 +2581,45 @@ type DBStoreGetUploadsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c DBStoreGetUploadsFuncCall) Args() []interface{} {
+func (c DBStoreGetPackageDependentsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreGetUploadsFuncCall) Results() []interface{} {
+func (c DBStoreGetPackageDependentsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// DBStoreGetUploadsByIDsFunc describes the behavior when the
-// GetUploadsByIDs method of the parent MockDBStore instance is invoked.
-type DBStoreGetUploadsByIDsFunc struct {
-	defaultHook func(context.Context, ...int) ([]dbstore.Upload, error)
-	hooks       []func(context.Context, ...int) ([]dbstore.Upload, error)
-	history     []DBStoreGetUploadsByIDsFuncCall
+// DBStoreGetUploadByIDFunc describes the behavior when the GetUploadByID
+// method of the parent MockDBStore instance is invoked.
+type DBStoreGetUploadByIDFunc struct {
+	defaultHook func(context.Context, int) (dbstore.Upload, bool, error)
+	hooks       []func(context.Context, int) (dbstore.Upload, bool, error)
+	history     []DBStoreGetUploadByIDFuncCall
 	mutex       sync.Mutex
 }
 
-// GetUploadsByIDs delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockDBStore) GetUploadsByIDs(v0 context.Context, v1 ...int) ([]dbstore.Upload, error) {
-	r0, r1 := m.GetUploadsByIDsFunc.nextHook()(v0, v1...)
-	m.GetUploadsByIDsFunc.appendCall(DBStoreGetUploadsByIDsFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// GetUploadByID delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockDBStore) GetUploadByID(v0 context.Context, v1 int) (dbstore.Upload, bool, error) {
+	r0, r1, r2 := m.GetUploadByIDFunc.nextHook()(v0, v1)
+	m.GetUploadByIDFunc.appendCall(DBStoreGetUploadByIDFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the GetUploadsByIDs
-// method of the parent MockDBStore instance is invoked and the hook queue
-// is empty.
-func (f *DBStoreGetUploadsByIDsFunc) SetDefaultHook(hook func(context.Context, ...int) ([]dbstore.Upload, error)) {
+// SetDefaultHook sets function that is called when the GetUploadByID method
+// of the parent MockDBStore instance is invoked and the hook queue is
+// empty.
+func (f *DBStoreGetUploadByIDFunc) SetDefaultHook(hook func(context.Context, int) (dbstore.Upload, bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetUploadsByIDs method of the parent MockDBStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *DBStoreGetUploadsByIDsFunc) PushHook(hook func(context.Context, ...int) ([]dbstore.Upload, error)) {
+// GetUploadByID method of the parent MockDBStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *DBStoreGetUploadByIDFunc) PushHook(hook func(context.Context, int) (dbstore.Upload, bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2434,21 +2627,21 @@ func (f *DBStoreGetUploadsByIDsFunc) PushHook(hook func(context.Context, ...int)
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreGetUploadsByIDsFunc) SetDefaultReturn(r0 []dbstore.Upload, r1 error) {
-	f.SetDefaultHook(func(context.Context, ...int) ([]dbstore.Upload, error) {
-		return r0, r1
+func (f *DBStoreGetUploadByIDFunc) SetDefaultReturn(r0 dbstore.Upload, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, int) (dbstore.Upload, bool, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreGetUploadsByIDsFunc) PushReturn(r0 []dbstore.Upload, r1 error) {
-	f.PushHook(func(context.Context, ...int) ([]dbstore.Upload, error) {
-		return r0, r1
+func (f *DBStoreGetUploadByIDFunc) PushReturn(r0 dbstore.Upload, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, int) (dbstore.Upload, bool, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *DBStoreGetUploadsByIDsFunc) nextHook() func(context.Context, ...int) ([]dbstore.Upload, error) {
+func (f *DBStoreGetUploadByIDFunc) nextHook() func(context.Context, int) (dbstore.Upload, bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2461,87 +2654,86 @@ func (f *DBStoreGetUploadsByIDsFunc) nextHook() func(context.Context, ...int) ([
 	return hook
 }
 
-func (f *DBStoreGetUploadsByIDsFunc) appendCall(r0 DBStoreGetUploadsByIDsFuncCall) {
+func (f *DBStoreGetUploadByIDFunc) appendCall(r0 DBStoreGetUploadByIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreGetUploadsByIDsFuncCall objects
+// History returns a sequence of DBStoreGetUploadByIDFuncCall objects
 // describing the invocations of this function.
-func (f *DBStoreGetUploadsByIDsFunc) History() []DBStoreGetUploadsByIDsFuncCall {
+func (f *DBStoreGetUploadByIDFunc) History() []DBStoreGetUploadByIDFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreGetUploadsByIDsFuncCall, len(f.history))
+	history := make([]DBStoreGetUploadByIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreGetUploadsByIDsFuncCall is an object that describes an invocation
-// of method GetUploadsByIDs on an instance of MockDBStore.
-type DBStoreGetUploadsByIDsFuncCall struct {
+// DBStoreGetUploadByIDFuncCall is an object that describes an invocation of
+// method GetUploadByID on an instance of MockDBStore.
+type DBStoreGetUploadByIDFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg1 []int
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []dbstore.Upload
+	Result0 dbstore.Upload
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 error
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c DBStoreGetUploadsByIDsFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg1 {
-		trailing = append(trailing, val)
-	}
-
-	return append([]interface{}{c.Arg0}, trailing...)
+// invocation.
+func (c DBStoreGetUploadByIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreGetUploadsByIDsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c DBStoreGetUploadByIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// DBStoreHasCommitFunc describes the behavior when the HasCommit method of
-// the parent MockDBStore instance is invoked.
-type DBStoreHasCommitFunc struct {
-	defaultHook func(context.Context, int, string) (bool, error)
-	hooks       []func(context.Context, int, string) (bool, error)
-	history     []DBStoreHasCommitFuncCall
+// DBStoreGetUploadValidationFindingsFunc describes the behavior when the
+// GetUploadValidationFindings method of the parent MockDBStore instance is
+// invoked.
+type DBStoreGetUploadValidationFindingsFunc struct {
+	defaultHook func(context.Context, int) ([]dbstore.UploadValidationFinding, error)
+	hooks       []func(context.Context, int) ([]dbstore.UploadValidationFinding, error)
+	history     []DBStoreGetUploadValidationFindingsFuncCall
 	mutex       sync.Mutex
 }
 
-// HasCommit delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockDBStore) HasCommit(v0 context.Context, v1 int, v2 string) (bool, error) {
-	r0, r1 := m.HasCommitFunc.nextHook()(v0, v1, v2)
-	m.HasCommitFunc.appendCall(DBStoreHasCommitFuncCall{v0, v1, v2, r0, r1})
+// GetUploadValidationFindings delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockDBStore) GetUploadValidationFindings(v0 context.Context, v1 int) ([]dbstore.UploadValidationFinding, error) {
+	r0, r1 := m.GetUploadValidationFindingsFunc.nextHook()(v0, v1)
+	m.GetUploadValidationFindingsFunc.appendCall(DBStoreGetUploadValidationFindingsFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the HasCommit method of
-// the parent MockDBStore instance is invoked and the hook queue is empty.
-func (f *DBStoreHasCommitFunc) SetDefaultHook(hook func(context.Context, int, string) (bool, error)) {
+// SetDefaultHook sets function that is called when the
+// GetUploadValidationFindings method of the parent MockDBStore instance is
+// invoked and the hook queue is empty.
+func (f *DBStoreGetUploadValidationFindingsFunc) SetDefaultHook(hook func(context.Context, int) ([]dbstore.UploadValidationFinding, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// HasCommit method of the parent MockDBStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *DBStoreHasCommitFunc) PushHook(hook func(context.Context, int, string) (bool, error)) {
+// GetUploadValidationFindings method of the parent MockDBStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *DBStoreGetUploadValidationFindingsFunc) PushHook(hook func(context.Context, int) ([]dbstore.UploadValidationFinding, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2549,21 +2741,21 @@ func (f *DBStoreHasCommitFunc) PushHook(hook func(context.Context, int, string)
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreHasCommitFunc) SetDefaultReturn(r0 bool, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string) (bool, error) {
+func (f *DBStoreGetUploadValidationFindingsFunc) SetDefaultReturn(r0 []dbstore.UploadValidationFinding, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) ([]dbstore.UploadValidationFinding, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreHasCommitFunc) PushReturn(r0 bool, r1 error) {
-	f.PushHook(func(context.Context, int, string) (bool, error) {
+func (f *DBStoreGetUploadValidationFindingsFunc) PushReturn(r0 []dbstore.UploadValidationFinding, r1 error) {
+	f.PushHook(func(context.Context, int) ([]dbstore.UploadValidationFinding, error) {
 		return r0, r1
 	})
 }
 
-func (f *DBStoreHasCommitFunc) nextHook() func(context.Context, int, string) (bool, error) {
+func (f *DBStoreGetUploadValidationFindingsFunc) nextHook() func(context.Context, int) ([]dbstore.UploadValidationFinding, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2576,38 +2768,36 @@ func (f *DBStoreHasCommitFunc) nextHook() func(context.Context, int, string) (bo
 	return hook
 }
 
-func (f *DBStoreHasCommitFunc) appendCall(r0 DBStoreHasCommitFuncCall) {
+func (f *DBStoreGetUploadValidationFindingsFunc) appendCall(r0 DBStoreGetUploadValidationFindingsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreHasCommitFuncCall objects describing
-// the invocations of this function.
-func (f *DBStoreHasCommitFunc) History() []DBStoreHasCommitFuncCall {
+// History returns a sequence of DBStoreGetUploadValidationFindingsFuncCall
+// objects describing the invocations of this function.
+func (f *DBStoreGetUploadValidationFindingsFunc) History() []DBStoreGetUploadValidationFindingsFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreHasCommitFuncCall, len(f.history))
+	history := make([]DBStoreGetUploadValidationFindingsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreHasCommitFuncCall is an object that describes an invocation of
-// method HasCommit on an instance of MockDBStore.
-type DBStoreHasCommitFuncCall struct {
+// DBStoreGetUploadValidationFindingsFuncCall is an object that describes an
+// invocation of method GetUploadValidationFindings on an instance of
+// MockDBStore.
+type DBStoreGetUploadValidationFindingsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 int
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 bool
+	Result0 []dbstore.UploadValidationFinding
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -2615,45 +2805,44 @@ type DBStoreHasCommitFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c DBStoreHasCommitFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c DBStoreGetUploadValidationFindingsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreHasCommitFuncCall) Results() []interface{} {
+func (c DBStoreGetUploadValidationFindingsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// DBStoreHasRepositoryFunc describes the behavior when the HasRepository
-// method of the parent MockDBStore instance is invoked.
-type DBStoreHasRepositoryFunc struct {
-	defaultHook func(context.Context, int) (bool, error)
-	hooks       []func(context.Context, int) (bool, error)
-	history     []DBStoreHasRepositoryFuncCall
+// DBStoreGetUploadsFunc describes the behavior when the GetUploads method
+// of the parent MockDBStore instance is invoked.
+type DBStoreGetUploadsFunc struct {
+	defaultHook func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)
+	hooks       []func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)
+	history     []DBStoreGetUploadsFuncCall
 	mutex       sync.Mutex
 }
 
-// HasRepository delegates to the next hook function in the queue and stores
+// GetUploads delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockDBStore) HasRepository(v0 context.Context, v1 int) (bool, error) {
-	r0, r1 := m.HasRepositoryFunc.nextHook()(v0, v1)
-	m.HasRepositoryFunc.appendCall(DBStoreHasRepositoryFuncCall{v0, v1, r0, r1})
-	return r0, r1
+func (m *MockDBStore) GetUploads(v0 context.Context, v1 dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
+	r0, r1, r2 := m.GetUploadsFunc.nextHook()(v0, v1)
+	m.GetUploadsFunc.appendCall(DBStoreGetUploadsFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the HasRepository method
-// of the parent MockDBStore instance is invoked and the hook queue is
-// empty.
-func (f *DBStoreHasRepositoryFunc) SetDefaultHook(hook func(context.Context, int) (bool, error)) {
+// SetDefaultHook sets function that is called when the GetUploads method of
+// the parent MockDBStore instance is invoked and the hook queue is empty.
+func (f *DBStoreGetUploadsFunc) SetDefaultHook(hook func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// HasRepository method of the parent MockDBStore instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
+// GetUploads method of the parent MockDBStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *DBStoreHasRepositoryFunc) PushHook(hook func(context.Context, int) (bool, error)) {
+func (f *DBStoreGetUploadsFunc) PushHook(hook func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2661,21 +2850,21 @@ func (f *DBStoreHasRepositoryFunc) PushHook(hook func(context.Context, int) (boo
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreHasRepositoryFunc) SetDefaultReturn(r0 bool, r1 error) {
-	f.SetDefaultHook(func(context.Context, int) (bool, error) {
-		return r0, r1
+func (f *DBStoreGetUploadsFunc) SetDefaultReturn(r0 []dbstore.Upload, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreHasRepositoryFunc) PushReturn(r0 bool, r1 error) {
-	f.PushHook(func(context.Context, int) (bool, error) {
-		return r0, r1
+func (f *DBStoreGetUploadsFunc) PushReturn(r0 []dbstore.Upload, r1 int, r2 error) {
+	f.PushHook(func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *DBStoreHasRepositoryFunc) nextHook() func(context.Context, int) (bool, error) {
+func (f *DBStoreGetUploadsFunc) nextHook() func(context.Context, dbstore.GetUploadsOptions) ([]dbstore.Upload, int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2688,82 +2877,84 @@ func (f *DBStoreHasRepositoryFunc) nextHook() func(context.Context, int) (bool,
 	return hook
 }
 
-func (f *DBStoreHasRepositoryFunc) appendCall(r0 DBStoreHasRepositoryFuncCall) {
+func (f *DBStoreGetUploadsFunc) appendCall(r0 DBStoreGetUploadsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreHasRepositoryFuncCall objects
+// History returns a sequence of DBStoreGetUploadsFuncCall objects
 // describing the invocations of this function.
-func (f *DBStoreHasRepositoryFunc) History() []DBStoreHasRepositoryFuncCall {
+func (f *DBStoreGetUploadsFunc) History() []DBStoreGetUploadsFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreHasRepositoryFuncCall, len(f.history))
+	history := make([]DBStoreGetUploadsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreHasRepositoryFuncCall is an object that describes an invocation of
-// method HasRepository on an instance of MockDBStore.
-type DBStoreHasRepositoryFuncCall struct {
+// DBStoreGetUploadsFuncCall is an object that describes an invocation of
+// method GetUploads on an instance of MockDBStore.
+type DBStoreGetUploadsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int
+	Arg1 dbstore.GetUploadsOptions
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 bool
+	Result0 []dbstore.Upload
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 error
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c DBStoreHasRepositoryFuncCall) Args() []interface{} {
+func (c DBStoreGetUploadsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreHasRepositoryFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c DBStoreGetUploadsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// DBStoreMarkRepositoryAsDirtyFunc describes the behavior when the
-// MarkRepositoryAsDirty method of the parent MockDBStore instance is
-// invoked.
-type DBStoreMarkRepositoryAsDirtyFunc struct {
-	defaultHook func(context.Context, int) error
-	hooks       []func(context.Context, int) error
-	history     []DBStoreMarkRepositoryAsDirtyFuncCall
+// DBStoreGetUploadsByIDsFunc describes the behavior when the
+// GetUploadsByIDs method of the parent MockDBStore instance is invoked.
+type DBStoreGetUploadsByIDsFunc struct {
+	defaultHook func(context.Context, ...int) ([]dbstore.Upload, error)
+	hooks       []func(context.Context, ...int) ([]dbstore.Upload, error)
+	history     []DBStoreGetUploadsByIDsFuncCall
 	mutex       sync.Mutex
 }
 
-// MarkRepositoryAsDirty delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockDBStore) MarkRepositoryAsDirty(v0 context.Context, v1 int) error {
-	r0 := m.MarkRepositoryAsDirtyFunc.nextHook()(v0, v1)
-	m.MarkRepositoryAsDirtyFunc.appendCall(DBStoreMarkRepositoryAsDirtyFuncCall{v0, v1, r0})
-	return r0
+// GetUploadsByIDs delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockDBStore) GetUploadsByIDs(v0 context.Context, v1 ...int) ([]dbstore.Upload, error) {
+	r0, r1 := m.GetUploadsByIDsFunc.nextHook()(v0, v1...)
+	m.GetUploadsByIDsFunc.appendCall(DBStoreGetUploadsByIDsFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// MarkRepositoryAsDirty method of the parent MockDBStore instance is
-// invoked and the hook queue is empty.
-func (f *DBStoreMarkRepositoryAsDirtyFunc) SetDefaultHook(hook func(context.Context, int) error) {
+// SetDefaultHook sets function that is called when the GetUploadsByIDs
+// method of the parent MockDBStore instance is invoked and the hook queue
+// is empty.
+func (f *DBStoreGetUploadsByIDsFunc) SetDefaultHook(hook func(context.Context, ...int) ([]dbstore.Upload, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// MarkRepositoryAsDirty method of the parent MockDBStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *DBStoreMarkRepositoryAsDirtyFunc) PushHook(hook func(context.Context, int) error) {
+// GetUploadsByIDs method of the parent MockDBStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *DBStoreGetUploadsByIDsFunc) PushHook(hook func(context.Context, ...int) ([]dbstore.Upload, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2771,21 +2962,21 @@ func (f *DBStoreMarkRepositoryAsDirtyFunc) PushHook(hook func(context.Context, i
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreMarkRepositoryAsDirtyFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int) error {
-		return r0
+func (f *DBStoreGetUploadsByIDsFunc) SetDefaultReturn(r0 []dbstore.Upload, r1 error) {
+	f.SetDefaultHook(func(context.Context, ...int) ([]dbstore.Upload, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreMarkRepositoryAsDirtyFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int) error {
-		return r0
+func (f *DBStoreGetUploadsByIDsFunc) PushReturn(r0 []dbstore.Upload, r1 error) {
+	f.PushHook(func(context.Context, ...int) ([]dbstore.Upload, error) {
+		return r0, r1
 	})
 }
 
-func (f *DBStoreMarkRepositoryAsDirtyFunc) nextHook() func(context.Context, int) error {
+func (f *DBStoreGetUploadsByIDsFunc) nextHook() func(context.Context, ...int) ([]dbstore.Upload, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2798,79 +2989,87 @@ func (f *DBStoreMarkRepositoryAsDirtyFunc) nextHook() func(context.Context, int)
 	return hook
 }
 
-func (f *DBStoreMarkRepositoryAsDirtyFunc) appendCall(r0 DBStoreMarkRepositoryAsDirtyFuncCall) {
+func (f *DBStoreGetUploadsByIDsFunc) appendCall(r0 DBStoreGetUploadsByIDsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreMarkRepositoryAsDirtyFuncCall
-// objects describing the invocations of this function.
-func (f *DBStoreMarkRepositoryAsDirtyFunc) History() []DBStoreMarkRepositoryAsDirtyFuncCall {
+// History returns a sequence of DBStoreGetUploadsByIDsFuncCall objects
+// describing the invocations of this function.
+func (f *DBStoreGetUploadsByIDsFunc) History() []DBStoreGetUploadsByIDsFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreMarkRepositoryAsDirtyFuncCall, len(f.history))
+	history := make([]DBStoreGetUploadsByIDsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreMarkRepositoryAsDirtyFuncCall is an object that describes an
-// invocation of method MarkRepositoryAsDirty on an instance of MockDBStore.
-type DBStoreMarkRepositoryAsDirtyFuncCall struct {
+// DBStoreGetUploadsByIDsFuncCall is an object that describes an invocation
+// of method GetUploadsByIDs on an instance of MockDBStore.
+type DBStoreGetUploadsByIDsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 int
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 []dbstore.Upload
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c DBStoreGetUploadsByIDsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreMarkRepositoryAsDirtyFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
-}
-
-// Results returns an interface slice containing the results of this
-// invocation.
-func (c DBStoreMarkRepositoryAsDirtyFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c DBStoreGetUploadsByIDsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// DBStoreReferenceIDsAndFiltersFunc describes the behavior when the
-// ReferenceIDsAndFilters method of the parent MockDBStore instance is
-// invoked.
-type DBStoreReferenceIDsAndFiltersFunc struct {
-	defaultHook func(context.Context, int, string, []precise.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error)
-	hooks       []func(context.Context, int, string, []precise.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error)
-	history     []DBStoreReferenceIDsAndFiltersFuncCall
+// DBStoreHasCommitFunc describes the behavior when the HasCommit method of
+// the parent MockDBStore instance is invoked.
+type DBStoreHasCommitFunc struct {
+	defaultHook func(context.Context, int, string) (bool, error)
+	hooks       []func(context.Context, int, string) (bool, error)
+	history     []DBStoreHasCommitFuncCall
 	mutex       sync.Mutex
 }
 
-// ReferenceIDsAndFilters delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockDBStore) ReferenceIDsAndFilters(v0 context.Context, v1 int, v2 string, v3 []precise.QualifiedMonikerData, v4 int, v5 int) (dbstore.PackageReferenceScanner, int, error) {
-	r0, r1, r2 := m.ReferenceIDsAndFiltersFunc.nextHook()(v0, v1, v2, v3, v4, v5)
-	m.ReferenceIDsAndFiltersFunc.appendCall(DBStoreReferenceIDsAndFiltersFuncCall{v0, v1, v2, v3, v4, v5, r0, r1, r2})
-	return r0, r1, r2
+// HasCommit delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockDBStore) HasCommit(v0 context.Context, v1 int, v2 string) (bool, error) {
+	r0, r1 := m.HasCommitFunc.nextHook()(v0, v1, v2)
+	m.HasCommitFunc.appendCall(DBStoreHasCommitFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// ReferenceIDsAndFilters method of the parent MockDBStore instance is
-// invoked and the hook queue is empty.
-func (f *DBStoreReferenceIDsAndFiltersFunc) SetDefaultHook(hook func(context.Context, int, string, []precise.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error)) {
+// SetDefaultHook sets function that is called when the HasCommit method of
+// the parent MockDBStore instance is invoked and the hook queue is empty.
+func (f *DBStoreHasCommitFunc) SetDefaultHook(hook func(context.Context, int, string) (bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ReferenceIDsAndFilters method of the parent MockDBStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *DBStoreReferenceIDsAndFiltersFunc) PushHook(hook func(context.Context, int, string, []precise.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error)) {
+// HasCommit method of the parent MockDBStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *DBStoreHasCommitFunc) PushHook(hook func(context.Context, int, string) (bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2878,21 +3077,21 @@ func (f *DBStoreReferenceIDsAndFiltersFunc) PushHook(hook func(context.Context,
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreReferenceIDsAndFiltersFunc) SetDefaultReturn(r0 dbstore.PackageReferenceScanner, r1 int, r2 error) {
-	f.SetDefaultHook(func(context.Context, int, string, []precise.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error) {
-		return r0, r1, r2
+func (f *DBStoreHasCommitFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string) (bool, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreReferenceIDsAndFiltersFunc) PushReturn(r0 dbstore.PackageReferenceScanner, r1 int, r2 error) {
-	f.PushHook(func(context.Context, int, string, []precise.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error) {
-		return r0, r1, r2
+func (f *DBStoreHasCommitFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, int, string) (bool, error) {
+		return r0, r1
 	})
 }
 
-func (f *DBStoreReferenceIDsAndFiltersFunc) nextHook() func(context.Context, int, string, []precise.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error) {
+func (f *DBStoreHasCommitFunc) nextHook() func(context.Context, int, string) (bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2905,27 +3104,26 @@ func (f *DBStoreReferenceIDsAndFiltersFunc) nextHook() func(context.Context, int
 	return hook
 }
 
-func (f *DBStoreReferenceIDsAndFiltersFunc) appendCall(r0 DBStoreReferenceIDsAndFiltersFuncCall) {
+func (f *DBStoreHasCommitFunc) appendCall(r0 DBStoreHasCommitFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreReferenceIDsAndFiltersFuncCall
-// objects describing the invocations of this function.
-func (f *DBStoreReferenceIDsAndFiltersFunc) History() []DBStoreReferenceIDsAndFiltersFuncCall {
+// History returns a sequence of DBStoreHasCommitFuncCall objects describing
+// the invocations of this function.
+func (f *DBStoreHasCommitFunc) History() []DBStoreHasCommitFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreReferenceIDsAndFiltersFuncCall, len(f.history))
+	history := make([]DBStoreHasCommitFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreReferenceIDsAndFiltersFuncCall is an object that describes an
-// invocation of method ReferenceIDsAndFilters on an instance of
-// MockDBStore.
-type DBStoreReferenceIDsAndFiltersFuncCall struct {
+// DBStoreHasCommitFuncCall is an object that describes an invocation of
+// method HasCommit on an instance of MockDBStore.
+type DBStoreHasCommitFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -2935,68 +3133,55 @@ type DBStoreReferenceIDsAndFiltersFuncCall struct {
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
 	Arg2 string
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 []precise.QualifiedMonikerData
-	// Arg4 is the value of the 5th argument passed to this method
-	// invocation.
-	Arg4 int
-	// Arg5 is the value of the 6th argument passed to this method
-	// invocation.
-	Arg5 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 dbstore.PackageReferenceScanner
+	Result0 bool
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 int
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c DBStoreReferenceIDsAndFiltersFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
+func (c DBStoreHasCommitFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreReferenceIDsAndFiltersFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+func (c DBStoreHasCommitFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// DBStoreRepoIDsByGlobPatternsFunc describes the behavior when the
-// RepoIDsByGlobPatterns method of the parent MockDBStore instance is
-// invoked.
-type DBStoreRepoIDsByGlobPatternsFunc struct {
-	defaultHook func(context.Context, []string, int, int) ([]int, int, error)
-	hooks       []func(context.Context, []string, int, int) ([]int, int, error)
-	history     []DBStoreRepoIDsByGlobPatternsFuncCall
+// DBStoreHasRepositoryFunc describes the behavior when the HasRepository
+// method of the parent MockDBStore instance is invoked.
+type DBStoreHasRepositoryFunc struct {
+	defaultHook func(context.Context, int) (bool, error)
+	hooks       []func(context.Context, int) (bool, error)
+	history     []DBStoreHasRepositoryFuncCall
 	mutex       sync.Mutex
 }
 
-// RepoIDsByGlobPatterns delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockDBStore) RepoIDsByGlobPatterns(v0 context.Context, v1 []string, v2 int, v3 int) ([]int, int, error) {
-	r0, r1, r2 := m.RepoIDsByGlobPatternsFunc.nextHook()(v0, v1, v2, v3)
-	m.RepoIDsByGlobPatternsFunc.appendCall(DBStoreRepoIDsByGlobPatternsFuncCall{v0, v1, v2, v3, r0, r1, r2})
-	return r0, r1, r2
+// HasRepository delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockDBStore) HasRepository(v0 context.Context, v1 int) (bool, error) {
+	r0, r1 := m.HasRepositoryFunc.nextHook()(v0, v1)
+	m.HasRepositoryFunc.appendCall(DBStoreHasRepositoryFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// RepoIDsByGlobPatterns method of the parent MockDBStore instance is
-// invoked and the hook queue is empty.
-func (f *DBStoreRepoIDsByGlobPatternsFunc) SetDefaultHook(hook func(context.Context, []string, int, int) ([]int, int, error)) {
+// SetDefaultHook sets function that is called when the HasRepository method
+// of the parent MockDBStore instance is invoked and the hook queue is
+// empty.
+func (f *DBStoreHasRepositoryFunc) SetDefaultHook(hook func(context.Context, int) (bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// RepoIDsByGlobPatterns method of the parent MockDBStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *DBStoreRepoIDsByGlobPatternsFunc) PushHook(hook func(context.Context, []string, int, int) ([]int, int, error)) {
+// HasRepository method of the parent MockDBStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *DBStoreHasRepositoryFunc) PushHook(hook func(context.Context, int) (bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3004,21 +3189,21 @@ func (f *DBStoreRepoIDsByGlobPatternsFunc) PushHook(hook func(context.Context, [
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreRepoIDsByGlobPatternsFunc) SetDefaultReturn(r0 []int, r1 int, r2 error) {
-	f.SetDefaultHook(func(context.Context, []string, int, int) ([]int, int, error) {
-		return r0, r1, r2
+func (f *DBStoreHasRepositoryFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) (bool, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreRepoIDsByGlobPatternsFunc) PushReturn(r0 []int, r1 int, r2 error) {
-	f.PushHook(func(context.Context, []string, int, int) ([]int, int, error) {
-		return r0, r1, r2
+func (f *DBStoreHasRepositoryFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, int) (bool, error) {
+		return r0, r1
 	})
 }
 
-func (f *DBStoreRepoIDsByGlobPatternsFunc) nextHook() func(context.Context, []string, int, int) ([]int, int, error) {
+func (f *DBStoreHasRepositoryFunc) nextHook() func(context.Context, int) (bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3031,89 +3216,82 @@ func (f *DBStoreRepoIDsByGlobPatternsFunc) nextHook() func(context.Context, []st
 	return hook
 }
 
-func (f *DBStoreRepoIDsByGlobPatternsFunc) appendCall(r0 DBStoreRepoIDsByGlobPatternsFuncCall) {
+func (f *DBStoreHasRepositoryFunc) appendCall(r0 DBStoreHasRepositoryFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreRepoIDsByGlobPatternsFuncCall
-// objects describing the invocations of this function.
-func (f *DBStoreRepoIDsByGlobPatternsFunc) History() []DBStoreRepoIDsByGlobPatternsFuncCall {
+// History returns a sequence of DBStoreHasRepositoryFuncCall objects
+// describing the invocations of this function.
+func (f *DBStoreHasRepositoryFunc) History() []DBStoreHasRepositoryFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreRepoIDsByGlobPatternsFuncCall, len(f.history))
+	history := make([]DBStoreHasRepositoryFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreRepoIDsByGlobPatternsFuncCall is an object that describes an
-// invocation of method RepoIDsByGlobPatterns on an instance of MockDBStore.
-type DBStoreRepoIDsByGlobPatternsFuncCall struct {
+// DBStoreHasRepositoryFuncCall is an object that describes an invocation of
+// method HasRepository on an instance of MockDBStore.
+type DBStoreHasRepositoryFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 []string
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 int
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 int
+	Arg1 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []int
+	Result0 bool
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 int
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c DBStoreRepoIDsByGlobPatternsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c DBStoreHasRepositoryFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreRepoIDsByGlobPatternsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+func (c DBStoreHasRepositoryFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// DBStoreRepoNameFunc describes the behavior when the RepoName method of
-// the parent MockDBStore instance is invoked.
-type DBStoreRepoNameFunc struct {
-	defaultHook func(context.Context, int) (string, error)
-	hooks       []func(context.Context, int) (string, error)
-	history     []DBStoreRepoNameFuncCall
+// DBStoreMarkRepositoryAsDirtyFunc describes the behavior when the
+// MarkRepositoryAsDirty method of the parent MockDBStore instance is
+// invoked.
+type DBStoreMarkRepositoryAsDirtyFunc struct {
+	defaultHook func(context.Context, int) error
+	hooks       []func(context.Context, int) error
+	history     []DBStoreMarkRepositoryAsDirtyFuncCall
 	mutex       sync.Mutex
 }
 
-// RepoName delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockDBStore) RepoName(v0 context.Context, v1 int) (string, error) {
-	r0, r1 := m.RepoNameFunc.nextHook()(v0, v1)
-	m.RepoNameFunc.appendCall(DBStoreRepoNameFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// MarkRepositoryAsDirty delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockDBStore) MarkRepositoryAsDirty(v0 context.Context, v1 int) error {
+	r0 := m.MarkRepositoryAsDirtyFunc.nextHook()(v0, v1)
+	m.MarkRepositoryAsDirtyFunc.appendCall(DBStoreMarkRepositoryAsDirtyFuncCall{v0, v1, r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the RepoName method of
-// the parent MockDBStore instance is invoked and the hook queue is empty.
-func (f *DBStoreRepoNameFunc) SetDefaultHook(hook func(context.Context, int) (string, error)) {
+// SetDefaultHook sets function that is called when the
+// MarkRepositoryAsDirty method of the parent MockDBStore instance is
+// invoked and the hook queue is empty.
+func (f *DBStoreMarkRepositoryAsDirtyFunc) SetDefaultHook(hook func(context.Context, int) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// RepoName method of the parent MockDBStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *DBStoreRepoNameFunc) PushHook(hook func(context.Context, int) (string, error)) {
+// MarkRepositoryAsDirty method of the parent MockDBStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *DBStoreMarkRepositoryAsDirtyFunc) PushHook(hook func(context.Context, int) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3121,21 +3299,21 @@ func (f *DBStoreRepoNameFunc) PushHook(hook func(context.Context, int) (string,
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreRepoNameFunc) SetDefaultReturn(r0 string, r1 error) {
-	f.SetDefaultHook(func(context.Context, int) (string, error) {
-		return r0, r1
+func (f *DBStoreMarkRepositoryAsDirtyFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreRepoNameFunc) PushReturn(r0 string, r1 error) {
-	f.PushHook(func(context.Context, int) (string, error) {
-		return r0, r1
-	})
+func (f *DBStoreMarkRepositoryAsDirtyFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int) error {
+		return r0
+	})
 }
 
-func (f *DBStoreRepoNameFunc) nextHook() func(context.Context, int) (string, error) {
+func (f *DBStoreMarkRepositoryAsDirtyFunc) nextHook() func(context.Context, int) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3148,26 +3326,26 @@ func (f *DBStoreRepoNameFunc) nextHook() func(context.Context, int) (string, err
 	return hook
 }
 
-func (f *DBStoreRepoNameFunc) appendCall(r0 DBStoreRepoNameFuncCall) {
+func (f *DBStoreMarkRepositoryAsDirtyFunc) appendCall(r0 DBStoreMarkRepositoryAsDirtyFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreRepoNameFuncCall objects describing
-// the invocations of this function.
-func (f *DBStoreRepoNameFunc) History() []DBStoreRepoNameFuncCall {
+// History returns a sequence of DBStoreMarkRepositoryAsDirtyFuncCall
+// objects describing the invocations of this function.
+func (f *DBStoreMarkRepositoryAsDirtyFunc) History() []DBStoreMarkRepositoryAsDirtyFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreRepoNameFuncCall, len(f.history))
+	history := make([]DBStoreMarkRepositoryAsDirtyFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreRepoNameFuncCall is an object that describes an invocation of
-// method RepoName on an instance of MockDBStore.
-type DBStoreRepoNameFuncCall struct {
+// DBStoreMarkRepositoryAsDirtyFuncCall is an object that describes an
+// invocation of method MarkRepositoryAsDirty on an instance of MockDBStore.
+type DBStoreMarkRepositoryAsDirtyFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -3176,55 +3354,51 @@ type DBStoreRepoNameFuncCall struct {
 	Arg1 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 string
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c DBStoreRepoNameFuncCall) Args() []interface{} {
+func (c DBStoreMarkRepositoryAsDirtyFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreRepoNameFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c DBStoreMarkRepositoryAsDirtyFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// DBStoreUpdateConfigurationPolicyFunc describes the behavior when the
-// UpdateConfigurationPolicy method of the parent MockDBStore instance is
+// DBStoreReferenceIDsAndFiltersFunc describes the behavior when the
+// ReferenceIDsAndFilters method of the parent MockDBStore instance is
 // invoked.
-type DBStoreUpdateConfigurationPolicyFunc struct {
-	defaultHook func(context.Context, dbstore.ConfigurationPolicy) error
-	hooks       []func(context.Context, dbstore.ConfigurationPolicy) error
-	history     []DBStoreUpdateConfigurationPolicyFuncCall
+type DBStoreReferenceIDsAndFiltersFunc struct {
+	defaultHook func(context.Context, int, string, []precise.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error)
+	hooks       []func(context.Context, int, string, []precise.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error)
+	history     []DBStoreReferenceIDsAndFiltersFuncCall
 	mutex       sync.Mutex
 }
 
-// UpdateConfigurationPolicy delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockDBStore) UpdateConfigurationPolicy(v0 context.Context, v1 dbstore.ConfigurationPolicy) error {
-	r0 := m.UpdateConfigurationPolicyFunc.nextHook()(v0, v1)
-	m.UpdateConfigurationPolicyFunc.appendCall(DBStoreUpdateConfigurationPolicyFuncCall{v0, v1, r0})
-	return r0
+// ReferenceIDsAndFilters delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockDBStore) ReferenceIDsAndFilters(v0 context.Context, v1 int, v2 string, v3 []precise.QualifiedMonikerData, v4 int, v5 int) (dbstore.PackageReferenceScanner, int, error) {
+	r0, r1, r2 := m.ReferenceIDsAndFiltersFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.ReferenceIDsAndFiltersFunc.appendCall(DBStoreReferenceIDsAndFiltersFuncCall{v0, v1, v2, v3, v4, v5, r0, r1, r2})
+	return r0, r1, r2
 }
 
 // SetDefaultHook sets function that is called when the
-// UpdateConfigurationPolicy method of the parent MockDBStore instance is
+// ReferenceIDsAndFilters method of the parent MockDBStore instance is
 // invoked and the hook queue is empty.
-func (f *DBStoreUpdateConfigurationPolicyFunc) SetDefaultHook(hook func(context.Context, dbstore.ConfigurationPolicy) error) {
+func (f *DBStoreReferenceIDsAndFiltersFunc) SetDefaultHook(hook func(context.Context, int, string, []precise.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// UpdateConfigurationPolicy method of the parent MockDBStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *DBStoreUpdateConfigurationPolicyFunc) PushHook(hook func(context.Context, dbstore.ConfigurationPolicy) error) {
+// ReferenceIDsAndFilters method of the parent MockDBStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *DBStoreReferenceIDsAndFiltersFunc) PushHook(hook func(context.Context, int, string, []precise.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3232,21 +3406,21 @@ func (f *DBStoreUpdateConfigurationPolicyFunc) PushHook(hook func(context.Contex
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreUpdateConfigurationPolicyFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, dbstore.ConfigurationPolicy) error {
-		return r0
+func (f *DBStoreReferenceIDsAndFiltersFunc) SetDefaultReturn(r0 dbstore.PackageReferenceScanner, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, int, string, []precise.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreUpdateConfigurationPolicyFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, dbstore.ConfigurationPolicy) error {
-		return r0
+func (f *DBStoreReferenceIDsAndFiltersFunc) PushReturn(r0 dbstore.PackageReferenceScanner, r1 int, r2 error) {
+	f.PushHook(func(context.Context, int, string, []precise.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *DBStoreUpdateConfigurationPolicyFunc) nextHook() func(context.Context, dbstore.ConfigurationPolicy) error {
+func (f *DBStoreReferenceIDsAndFiltersFunc) nextHook() func(context.Context, int, string, []precise.QualifiedMonikerData, int, int) (dbstore.PackageReferenceScanner, int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3259,82 +3433,98 @@ func (f *DBStoreUpdateConfigurationPolicyFunc) nextHook() func(context.Context,
 	return hook
 }
 
-func (f *DBStoreUpdateConfigurationPolicyFunc) appendCall(r0 DBStoreUpdateConfigurationPolicyFuncCall) {
+func (f *DBStoreReferenceIDsAndFiltersFunc) appendCall(r0 DBStoreReferenceIDsAndFiltersFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of DBStoreUpdateConfigurationPolicyFuncCall
+// History returns a sequence of DBStoreReferenceIDsAndFiltersFuncCall
 // objects describing the invocations of this function.
-func (f *DBStoreUpdateConfigurationPolicyFunc) History() []DBStoreUpdateConfigurationPolicyFuncCall {
+func (f *DBStoreReferenceIDsAndFiltersFunc) History() []DBStoreReferenceIDsAndFiltersFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreUpdateConfigurationPolicyFuncCall, len(f.history))
+	history := make([]DBStoreReferenceIDsAndFiltersFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreUpdateConfigurationPolicyFuncCall is an object that describes an
-// invocation of method UpdateConfigurationPolicy on an instance of
+// DBStoreReferenceIDsAndFiltersFuncCall is an object that describes an
+// invocation of method ReferenceIDsAndFilters on an instance of
 // MockDBStore.
-type DBStoreUpdateConfigurationPolicyFuncCall struct {
+type DBStoreReferenceIDsAndFiltersFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 dbstore.ConfigurationPolicy
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 []precise.QualifiedMonikerData
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 dbstore.PackageReferenceScanner
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c DBStoreUpdateConfigurationPolicyFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c DBStoreReferenceIDsAndFiltersFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreUpdateConfigurationPolicyFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c DBStoreReferenceIDsAndFiltersFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// DBStoreUpdateIndexConfigurationByRepositoryIDFunc describes the behavior
-// when the UpdateIndexConfigurationByRepositoryID method of the parent
-// MockDBStore instance is invoked.
-type DBStoreUpdateIndexConfigurationByRepositoryIDFunc struct {
-	defaultHook func(context.Context, int, []byte) error
-	hooks       []func(context.Context, int, []byte) error
-	history     []DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall
+// DBStoreRepoIDsByGlobPatternsFunc describes the behavior when the
+// RepoIDsByGlobPatterns method of the parent MockDBStore instance is
+// invoked.
+type DBStoreRepoIDsByGlobPatternsFunc struct {
+	defaultHook func(context.Context, []string, int, int) ([]int, int, error)
+	hooks       []func(context.Context, []string, int, int) ([]int, int, error)
+	history     []DBStoreRepoIDsByGlobPatternsFuncCall
 	mutex       sync.Mutex
 }
 
-// UpdateIndexConfigurationByRepositoryID delegates to the next hook
-// function in the queue and stores the parameter and result values of this
-// invocation.
-func (m *MockDBStore) UpdateIndexConfigurationByRepositoryID(v0 context.Context, v1 int, v2 []byte) error {
-	r0 := m.UpdateIndexConfigurationByRepositoryIDFunc.nextHook()(v0, v1, v2)
-	m.UpdateIndexConfigurationByRepositoryIDFunc.appendCall(DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall{v0, v1, v2, r0})
-	return r0
+// RepoIDsByGlobPatterns delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockDBStore) RepoIDsByGlobPatterns(v0 context.Context, v1 []string, v2 int, v3 int) ([]int, int, error) {
+	r0, r1, r2 := m.RepoIDsByGlobPatternsFunc.nextHook()(v0, v1, v2, v3)
+	m.RepoIDsByGlobPatternsFunc.appendCall(DBStoreRepoIDsByGlobPatternsFuncCall{v0, v1, v2, v3, r0, r1, r2})
+	return r0, r1, r2
 }
 
 // SetDefaultHook sets function that is called when the
-// UpdateIndexConfigurationByRepositoryID method of the parent MockDBStore
-// instance is invoked and the hook queue is empty.
-func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) SetDefaultHook(hook func(context.Context, int, []byte) error) {
+// RepoIDsByGlobPatterns method of the parent MockDBStore instance is
+// invoked and the hook queue is empty.
+func (f *DBStoreRepoIDsByGlobPatternsFunc) SetDefaultHook(hook func(context.Context, []string, int, int) ([]int, int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// UpdateIndexConfigurationByRepositoryID method of the parent MockDBStore
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) PushHook(hook func(context.Context, int, []byte) error) {
+// RepoIDsByGlobPatterns method of the parent MockDBStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *DBStoreRepoIDsByGlobPatternsFunc) PushHook(hook func(context.Context, []string, int, int) ([]int, int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3342,21 +3532,21 @@ func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) PushHook(hook func(c
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int, []byte) error {
-		return r0
+func (f *DBStoreRepoIDsByGlobPatternsFunc) SetDefaultReturn(r0 []int, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, []string, int, int) ([]int, int, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int, []byte) error {
-		return r0
+func (f *DBStoreRepoIDsByGlobPatternsFunc) PushReturn(r0 []int, r1 int, r2 error) {
+	f.PushHook(func(context.Context, []string, int, int) ([]int, int, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) nextHook() func(context.Context, int, []byte) error {
+func (f *DBStoreRepoIDsByGlobPatternsFunc) nextHook() func(context.Context, []string, int, int) ([]int, int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3369,244 +3559,89 @@ func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) nextHook() func(cont
 	return hook
 }
 
-func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) appendCall(r0 DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall) {
+func (f *DBStoreRepoIDsByGlobPatternsFunc) appendCall(r0 DBStoreRepoIDsByGlobPatternsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall objects describing
-// the invocations of this function.
-func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) History() []DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall {
+// History returns a sequence of DBStoreRepoIDsByGlobPatternsFuncCall
+// objects describing the invocations of this function.
+func (f *DBStoreRepoIDsByGlobPatternsFunc) History() []DBStoreRepoIDsByGlobPatternsFuncCall {
 	f.mutex.Lock()
-	history := make([]DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall, len(f.history))
+	history := make([]DBStoreRepoIDsByGlobPatternsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall is an object that
-// describes an invocation of method UpdateIndexConfigurationByRepositoryID
-// on an instance of MockDBStore.
-type DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall struct {
+// DBStoreRepoIDsByGlobPatternsFuncCall is an object that describes an
+// invocation of method RepoIDsByGlobPatterns on an instance of MockDBStore.
+type DBStoreRepoIDsByGlobPatternsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int
+	Arg1 []string
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 []byte
+	Arg2 int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 []int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c DBStoreRepoIDsByGlobPatternsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c DBStoreRepoIDsByGlobPatternsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// MockEnqueuerDBStore is a mock implementation of the EnqueuerDBStore
-// interface (from the package
-// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
-// used for unit testing.
-type MockEnqueuerDBStore struct {
-	// DirtyRepositoriesFunc is an instance of a mock function object
-	// controlling the behavior of the method DirtyRepositories.
-	DirtyRepositoriesFunc *EnqueuerDBStoreDirtyRepositoriesFunc
-	// DoneFunc is an instance of a mock function object controlling the
-	// behavior of the method Done.
-	DoneFunc *EnqueuerDBStoreDoneFunc
-	// GetIndexConfigurationByRepositoryIDFunc is an instance of a mock
-	// function object controlling the behavior of the method
-	// GetIndexConfigurationByRepositoryID.
-	GetIndexConfigurationByRepositoryIDFunc *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc
-	// GetIndexesByIDsFunc is an instance of a mock function object
-	// controlling the behavior of the method GetIndexesByIDs.
-	GetIndexesByIDsFunc *EnqueuerDBStoreGetIndexesByIDsFunc
-	// HandleFunc is an instance of a mock function object controlling the
-	// behavior of the method Handle.
-	HandleFunc *EnqueuerDBStoreHandleFunc
-	// InsertIndexesFunc is an instance of a mock function object
-	// controlling the behavior of the method InsertIndexes.
-	InsertIndexesFunc *EnqueuerDBStoreInsertIndexesFunc
-	// IsQueuedFunc is an instance of a mock function object controlling the
-	// behavior of the method IsQueued.
-	IsQueuedFunc *EnqueuerDBStoreIsQueuedFunc
-	// TransactFunc is an instance of a mock function object controlling the
-	// behavior of the method Transact.
-	TransactFunc *EnqueuerDBStoreTransactFunc
-}
-
-// NewMockEnqueuerDBStore creates a new mock of the EnqueuerDBStore
-// interface. All methods return zero values for all results, unless
-// overwritten.
-func NewMockEnqueuerDBStore() *MockEnqueuerDBStore {
-	return &MockEnqueuerDBStore{
-		DirtyRepositoriesFunc: &EnqueuerDBStoreDirtyRepositoriesFunc{
-			defaultHook: func(context.Context) (map[int]int, error) {
-				return nil, nil
-			},
-		},
-		DoneFunc: &EnqueuerDBStoreDoneFunc{
-			defaultHook: func(error) error {
-				return nil
-			},
-		},
-		GetIndexConfigurationByRepositoryIDFunc: &EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc{
-			defaultHook: func(context.Context, int) (dbstore.IndexConfiguration, bool, error) {
-				return dbstore.IndexConfiguration{}, false, nil
-			},
-		},
-		GetIndexesByIDsFunc: &EnqueuerDBStoreGetIndexesByIDsFunc{
-			defaultHook: func(context.Context, ...int) ([]dbstore.Index, error) {
-				return nil, nil
-			},
-		},
-		HandleFunc: &EnqueuerDBStoreHandleFunc{
-			defaultHook: func() *basestore.TransactableHandle {
-				return nil
-			},
-		},
-		InsertIndexesFunc: &EnqueuerDBStoreInsertIndexesFunc{
-			defaultHook: func(context.Context, []dbstore.Index) ([]dbstore.Index, error) {
-				return nil, nil
-			},
-		},
-		IsQueuedFunc: &EnqueuerDBStoreIsQueuedFunc{
-			defaultHook: func(context.Context, int, string) (bool, error) {
-				return false, nil
-			},
-		},
-		TransactFunc: &EnqueuerDBStoreTransactFunc{
-			defaultHook: func(context.Context) (enqueuer.DBStore, error) {
-				return nil, nil
-			},
-		},
-	}
-}
-
-// NewStrictMockEnqueuerDBStore creates a new mock of the EnqueuerDBStore
-// interface. All methods panic on invocation, unless overwritten.
-func NewStrictMockEnqueuerDBStore() *MockEnqueuerDBStore {
-	return &MockEnqueuerDBStore{
-		DirtyRepositoriesFunc: &EnqueuerDBStoreDirtyRepositoriesFunc{
-			defaultHook: func(context.Context) (map[int]int, error) {
-				panic("unexpected invocation of MockEnqueuerDBStore.DirtyRepositories")
-			},
-		},
-		DoneFunc: &EnqueuerDBStoreDoneFunc{
-			defaultHook: func(error) error {
-				panic("unexpected invocation of MockEnqueuerDBStore.Done")
-			},
-		},
-		GetIndexConfigurationByRepositoryIDFunc: &EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc{
-			defaultHook: func(context.Context, int) (dbstore.IndexConfiguration, bool, error) {
-				panic("unexpected invocation of MockEnqueuerDBStore.GetIndexConfigurationByRepositoryID")
-			},
-		},
-		GetIndexesByIDsFunc: &EnqueuerDBStoreGetIndexesByIDsFunc{
-			defaultHook: func(context.Context, ...int) ([]dbstore.Index, error) {
-				panic("unexpected invocation of MockEnqueuerDBStore.GetIndexesByIDs")
-			},
-		},
-		HandleFunc: &EnqueuerDBStoreHandleFunc{
-			defaultHook: func() *basestore.TransactableHandle {
-				panic("unexpected invocation of MockEnqueuerDBStore.Handle")
-			},
-		},
-		InsertIndexesFunc: &EnqueuerDBStoreInsertIndexesFunc{
-			defaultHook: func(context.Context, []dbstore.Index) ([]dbstore.Index, error) {
-				panic("unexpected invocation of MockEnqueuerDBStore.InsertIndexes")
-			},
-		},
-		IsQueuedFunc: &EnqueuerDBStoreIsQueuedFunc{
-			defaultHook: func(context.Context, int, string) (bool, error) {
-				panic("unexpected invocation of MockEnqueuerDBStore.IsQueued")
-			},
-		},
-		TransactFunc: &EnqueuerDBStoreTransactFunc{
-			defaultHook: func(context.Context) (enqueuer.DBStore, error) {
-				panic("unexpected invocation of MockEnqueuerDBStore.Transact")
-			},
-		},
-	}
-}
-
-// NewMockEnqueuerDBStoreFrom creates a new mock of the MockEnqueuerDBStore
-// interface. All methods delegate to the given implementation, unless
-// overwritten.
-func NewMockEnqueuerDBStoreFrom(i EnqueuerDBStore) *MockEnqueuerDBStore {
-	return &MockEnqueuerDBStore{
-		DirtyRepositoriesFunc: &EnqueuerDBStoreDirtyRepositoriesFunc{
-			defaultHook: i.DirtyRepositories,
-		},
-		DoneFunc: &EnqueuerDBStoreDoneFunc{
-			defaultHook: i.Done,
-		},
-		GetIndexConfigurationByRepositoryIDFunc: &EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc{
-			defaultHook: i.GetIndexConfigurationByRepositoryID,
-		},
-		GetIndexesByIDsFunc: &EnqueuerDBStoreGetIndexesByIDsFunc{
-			defaultHook: i.GetIndexesByIDs,
-		},
-		HandleFunc: &EnqueuerDBStoreHandleFunc{
-			defaultHook: i.Handle,
-		},
-		InsertIndexesFunc: &EnqueuerDBStoreInsertIndexesFunc{
-			defaultHook: i.InsertIndexes,
-		},
-		IsQueuedFunc: &EnqueuerDBStoreIsQueuedFunc{
-			defaultHook: i.IsQueued,
-		},
-		TransactFunc: &EnqueuerDBStoreTransactFunc{
-			defaultHook: i.Transact,
-		},
-	}
-}
-
-// EnqueuerDBStoreDirtyRepositoriesFunc describes the behavior when the
-// DirtyRepositories method of the parent MockEnqueuerDBStore instance is
-// invoked.
-type EnqueuerDBStoreDirtyRepositoriesFunc struct {
-	defaultHook func(context.Context) (map[int]int, error)
-	hooks       []func(context.Context) (map[int]int, error)
-	history     []EnqueuerDBStoreDirtyRepositoriesFuncCall
+// DBStoreRepoNameFunc describes the behavior when the RepoName method of
+// the parent MockDBStore instance is invoked.
+type DBStoreRepoNameFunc struct {
+	defaultHook func(context.Context, int) (string, error)
+	hooks       []func(context.Context, int) (string, error)
+	history     []DBStoreRepoNameFuncCall
 	mutex       sync.Mutex
 }
 
-// DirtyRepositories delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockEnqueuerDBStore) DirtyRepositories(v0 context.Context) (map[int]int, error) {
-	r0, r1 := m.DirtyRepositoriesFunc.nextHook()(v0)
-	m.DirtyRepositoriesFunc.appendCall(EnqueuerDBStoreDirtyRepositoriesFuncCall{v0, r0, r1})
+// RepoName delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockDBStore) RepoName(v0 context.Context, v1 int) (string, error) {
+	r0, r1 := m.RepoNameFunc.nextHook()(v0, v1)
+	m.RepoNameFunc.appendCall(DBStoreRepoNameFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the DirtyRepositories
-// method of the parent MockEnqueuerDBStore instance is invoked and the hook
-// queue is empty.
-func (f *EnqueuerDBStoreDirtyRepositoriesFunc) SetDefaultHook(hook func(context.Context) (map[int]int, error)) {
+// SetDefaultHook sets function that is called when the RepoName method of
+// the parent MockDBStore instance is invoked and the hook queue is empty.
+func (f *DBStoreRepoNameFunc) SetDefaultHook(hook func(context.Context, int) (string, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// DirtyRepositories method of the parent MockEnqueuerDBStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *EnqueuerDBStoreDirtyRepositoriesFunc) PushHook(hook func(context.Context) (map[int]int, error)) {
+// RepoName method of the parent MockDBStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *DBStoreRepoNameFunc) PushHook(hook func(context.Context, int) (string, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3614,21 +3649,21 @@ func (f *EnqueuerDBStoreDirtyRepositoriesFunc) PushHook(hook func(context.Contex
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerDBStoreDirtyRepositoriesFunc) SetDefaultReturn(r0 map[int]int, r1 error) {
-	f.SetDefaultHook(func(context.Context) (map[int]int, error) {
+func (f *DBStoreRepoNameFunc) SetDefaultReturn(r0 string, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) (string, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerDBStoreDirtyRepositoriesFunc) PushReturn(r0 map[int]int, r1 error) {
-	f.PushHook(func(context.Context) (map[int]int, error) {
+func (f *DBStoreRepoNameFunc) PushReturn(r0 string, r1 error) {
+	f.PushHook(func(context.Context, int) (string, error) {
 		return r0, r1
 	})
 }
 
-func (f *EnqueuerDBStoreDirtyRepositoriesFunc) nextHook() func(context.Context) (map[int]int, error) {
+func (f *DBStoreRepoNameFunc) nextHook() func(context.Context, int) (string, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3641,33 +3676,35 @@ func (f *EnqueuerDBStoreDirtyRepositoriesFunc) nextHook() func(context.Context)
 	return hook
 }
 
-func (f *EnqueuerDBStoreDirtyRepositoriesFunc) appendCall(r0 EnqueuerDBStoreDirtyRepositoriesFuncCall) {
+func (f *DBStoreRepoNameFunc) appendCall(r0 DBStoreRepoNameFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnqueuerDBStoreDirtyRepositoriesFuncCall
-// objects describing the invocations of this function.
-func (f *EnqueuerDBStoreDirtyRepositoriesFunc) History() []EnqueuerDBStoreDirtyRepositoriesFuncCall {
+// History returns a sequence of DBStoreRepoNameFuncCall objects describing
+// the invocations of this function.
+func (f *DBStoreRepoNameFunc) History() []DBStoreRepoNameFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerDBStoreDirtyRepositoriesFuncCall, len(f.history))
+	history := make([]DBStoreRepoNameFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerDBStoreDirtyRepositoriesFuncCall is an object that describes an
-// invocation of method DirtyRepositories on an instance of
-// MockEnqueuerDBStore.
-type EnqueuerDBStoreDirtyRepositoriesFuncCall struct {
+// DBStoreRepoNameFuncCall is an object that describes an invocation of
+// method RepoName on an instance of MockDBStore.
+type DBStoreRepoNameFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 map[int]int
+	Result0 string
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -3675,45 +3712,47 @@ type EnqueuerDBStoreDirtyRepositoriesFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerDBStoreDirtyRepositoriesFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c DBStoreRepoNameFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerDBStoreDirtyRepositoriesFuncCall) Results() []interface{} {
+func (c DBStoreRepoNameFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// EnqueuerDBStoreDoneFunc describes the behavior when the Done method of
-// the parent MockEnqueuerDBStore instance is invoked.
-type EnqueuerDBStoreDoneFunc struct {
-	defaultHook func(error) error
-	hooks       []func(error) error
-	history     []EnqueuerDBStoreDoneFuncCall
+// DBStoreUpdateConfigurationPolicyFunc describes the behavior when the
+// UpdateConfigurationPolicy method of the parent MockDBStore instance is
+// invoked.
+type DBStoreUpdateConfigurationPolicyFunc struct {
+	defaultHook func(context.Context, dbstore.ConfigurationPolicy) error
+	hooks       []func(context.Context, dbstore.ConfigurationPolicy) error
+	history     []DBStoreUpdateConfigurationPolicyFuncCall
 	mutex       sync.Mutex
 }
 
-// Done delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockEnqueuerDBStore) Done(v0 error) error {
-	r0 := m.DoneFunc.nextHook()(v0)
-	m.DoneFunc.appendCall(EnqueuerDBStoreDoneFuncCall{v0, r0})
+// UpdateConfigurationPolicy delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockDBStore) UpdateConfigurationPolicy(v0 context.Context, v1 dbstore.ConfigurationPolicy) error {
+	r0 := m.UpdateConfigurationPolicyFunc.nextHook()(v0, v1)
+	m.UpdateConfigurationPolicyFunc.appendCall(DBStoreUpdateConfigurationPolicyFuncCall{v0, v1, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Done method of the
-// parent MockEnqueuerDBStore instance is invoked and the hook queue is
-// empty.
-func (f *EnqueuerDBStoreDoneFunc) SetDefaultHook(hook func(error) error) {
+// SetDefaultHook sets function that is called when the
+// UpdateConfigurationPolicy method of the parent MockDBStore instance is
+// invoked and the hook queue is empty.
+func (f *DBStoreUpdateConfigurationPolicyFunc) SetDefaultHook(hook func(context.Context, dbstore.ConfigurationPolicy) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Done method of the parent MockEnqueuerDBStore instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *EnqueuerDBStoreDoneFunc) PushHook(hook func(error) error) {
+// UpdateConfigurationPolicy method of the parent MockDBStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *DBStoreUpdateConfigurationPolicyFunc) PushHook(hook func(context.Context, dbstore.ConfigurationPolicy) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3721,21 +3760,21 @@ func (f *EnqueuerDBStoreDoneFunc) PushHook(hook func(error) error) {
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerDBStoreDoneFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(error) error {
+func (f *DBStoreUpdateConfigurationPolicyFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, dbstore.ConfigurationPolicy) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerDBStoreDoneFunc) PushReturn(r0 error) {
-	f.PushHook(func(error) error {
+func (f *DBStoreUpdateConfigurationPolicyFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, dbstore.ConfigurationPolicy) error {
 		return r0
 	})
 }
 
-func (f *EnqueuerDBStoreDoneFunc) nextHook() func(error) error {
+func (f *DBStoreUpdateConfigurationPolicyFunc) nextHook() func(context.Context, dbstore.ConfigurationPolicy) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3748,29 +3787,33 @@ func (f *EnqueuerDBStoreDoneFunc) nextHook() func(error) error {
 	return hook
 }
 
-func (f *EnqueuerDBStoreDoneFunc) appendCall(r0 EnqueuerDBStoreDoneFuncCall) {
+func (f *DBStoreUpdateConfigurationPolicyFunc) appendCall(r0 DBStoreUpdateConfigurationPolicyFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnqueuerDBStoreDoneFuncCall objects
-// describing the invocations of this function.
-func (f *EnqueuerDBStoreDoneFunc) History() []EnqueuerDBStoreDoneFuncCall {
+// History returns a sequence of DBStoreUpdateConfigurationPolicyFuncCall
+// objects describing the invocations of this function.
+func (f *DBStoreUpdateConfigurationPolicyFunc) History() []DBStoreUpdateConfigurationPolicyFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerDBStoreDoneFuncCall, len(f.history))
+	history := make([]DBStoreUpdateConfigurationPolicyFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerDBStoreDoneFuncCall is an object that describes an invocation of
-// method Done on an instance of MockEnqueuerDBStore.
-type EnqueuerDBStoreDoneFuncCall struct {
+// DBStoreUpdateConfigurationPolicyFuncCall is an object that describes an
+// invocation of method UpdateConfigurationPolicy on an instance of
+// MockDBStore.
+type DBStoreUpdateConfigurationPolicyFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 error
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 dbstore.ConfigurationPolicy
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -3778,48 +3821,48 @@ type EnqueuerDBStoreDoneFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerDBStoreDoneFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c DBStoreUpdateConfigurationPolicyFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerDBStoreDoneFuncCall) Results() []interface{} {
+func (c DBStoreUpdateConfigurationPolicyFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc describes the
-// behavior when the GetIndexConfigurationByRepositoryID method of the
-// parent MockEnqueuerDBStore instance is invoked.
-type EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc struct {
-	defaultHook func(context.Context, int) (dbstore.IndexConfiguration, bool, error)
-	hooks       []func(context.Context, int) (dbstore.IndexConfiguration, bool, error)
-	history     []EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall
+// DBStoreUpdateIndexConfigurationByRepositoryIDFunc describes the behavior
+// when the UpdateIndexConfigurationByRepositoryID method of the parent
+// MockDBStore instance is invoked.
+type DBStoreUpdateIndexConfigurationByRepositoryIDFunc struct {
+	defaultHook func(context.Context, int, []byte) error
+	hooks       []func(context.Context, int, []byte) error
+	history     []DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall
 	mutex       sync.Mutex
 }
 
-// GetIndexConfigurationByRepositoryID delegates to the next hook function
-// in the queue and stores the parameter and result values of this
+// UpdateIndexConfigurationByRepositoryID delegates to the next hook
+// function in the queue and stores the parameter and result values of this
 // invocation.
-func (m *MockEnqueuerDBStore) GetIndexConfigurationByRepositoryID(v0 context.Context, v1 int) (dbstore.IndexConfiguration, bool, error) {
-	r0, r1, r2 := m.GetIndexConfigurationByRepositoryIDFunc.nextHook()(v0, v1)
-	m.GetIndexConfigurationByRepositoryIDFunc.appendCall(EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall{v0, v1, r0, r1, r2})
-	return r0, r1, r2
+func (m *MockDBStore) UpdateIndexConfigurationByRepositoryID(v0 context.Context, v1 int, v2 []byte) error {
+	r0 := m.UpdateIndexConfigurationByRepositoryIDFunc.nextHook()(v0, v1, v2)
+	m.UpdateIndexConfigurationByRepositoryIDFunc.appendCall(DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall{v0, v1, v2, r0})
+	return r0
 }
 
 // SetDefaultHook sets function that is called when the
-// GetIndexConfigurationByRepositoryID method of the parent
-// MockEnqueuerDBStore instance is invoked and the hook queue is empty.
-func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) SetDefaultHook(hook func(context.Context, int) (dbstore.IndexConfiguration, bool, error)) {
+// UpdateIndexConfigurationByRepositoryID method of the parent MockDBStore
+// instance is invoked and the hook queue is empty.
+func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) SetDefaultHook(hook func(context.Context, int, []byte) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetIndexConfigurationByRepositoryID method of the parent
-// MockEnqueuerDBStore instance invokes the hook at the front of the queue
-// and discards it. After the queue is empty, the default hook function is
-// invoked for any future action.
-func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) PushHook(hook func(context.Context, int) (dbstore.IndexConfiguration, bool, error)) {
+// UpdateIndexConfigurationByRepositoryID method of the parent MockDBStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) PushHook(hook func(context.Context, int, []byte) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3827,21 +3870,21 @@ func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) PushHook(hook f
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) SetDefaultReturn(r0 dbstore.IndexConfiguration, r1 bool, r2 error) {
-	f.SetDefaultHook(func(context.Context, int) (dbstore.IndexConfiguration, bool, error) {
-		return r0, r1, r2
+func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int, []byte) error {
+		return r0
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) PushReturn(r0 dbstore.IndexConfiguration, r1 bool, r2 error) {
-	f.PushHook(func(context.Context, int) (dbstore.IndexConfiguration, bool, error) {
-		return r0, r1, r2
+func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int, []byte) error {
+		return r0
 	})
 }
 
-func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) nextHook() func(context.Context, int) (dbstore.IndexConfiguration, bool, error) {
+func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) nextHook() func(context.Context, int, []byte) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3854,205 +3897,351 @@ func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) nextHook() func
 	return hook
 }
 
-func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) appendCall(r0 EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall) {
+func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) appendCall(r0 DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall objects
-// describing the invocations of this function.
-func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) History() []EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall {
+// DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall objects describing
+// the invocations of this function.
+func (f *DBStoreUpdateIndexConfigurationByRepositoryIDFunc) History() []DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall, len(f.history))
+	history := make([]DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall is an object
-// that describes an invocation of method
-// GetIndexConfigurationByRepositoryID on an instance of
-// MockEnqueuerDBStore.
-type EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall struct {
+// DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall is an object that
+// describes an invocation of method UpdateIndexConfigurationByRepositoryID
+// on an instance of MockDBStore.
+type DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 int
-	// Result0 is the value of the 1st result returned from this method
-	// invocation.
-	Result0 dbstore.IndexConfiguration
-	// Result1 is the value of the 2nd result returned from this method
+	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Result1 bool
-	// Result2 is the value of the 3rd result returned from this method
+	Arg2 []byte
+	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result2 error
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
-}
-
-// EnqueuerDBStoreGetIndexesByIDsFunc describes the behavior when the
-// GetIndexesByIDs method of the parent MockEnqueuerDBStore instance is
-// invoked.
-type EnqueuerDBStoreGetIndexesByIDsFunc struct {
-	defaultHook func(context.Context, ...int) ([]dbstore.Index, error)
-	hooks       []func(context.Context, ...int) ([]dbstore.Index, error)
-	history     []EnqueuerDBStoreGetIndexesByIDsFuncCall
-	mutex       sync.Mutex
-}
-
-// GetIndexesByIDs delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockEnqueuerDBStore) GetIndexesByIDs(v0 context.Context, v1 ...int) ([]dbstore.Index, error) {
-	r0, r1 := m.GetIndexesByIDsFunc.nextHook()(v0, v1...)
-	m.GetIndexesByIDsFunc.appendCall(EnqueuerDBStoreGetIndexesByIDsFuncCall{v0, v1, r0, r1})
-	return r0, r1
-}
-
-// SetDefaultHook sets function that is called when the GetIndexesByIDs
-// method of the parent MockEnqueuerDBStore instance is invoked and the hook
-// queue is empty.
-func (f *EnqueuerDBStoreGetIndexesByIDsFunc) SetDefaultHook(hook func(context.Context, ...int) ([]dbstore.Index, error)) {
-	f.defaultHook = hook
-}
-
-// PushHook adds a function to the end of hook queue. Each invocation of the
-// GetIndexesByIDs method of the parent MockEnqueuerDBStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *EnqueuerDBStoreGetIndexesByIDsFunc) PushHook(hook func(context.Context, ...int) ([]dbstore.Index, error)) {
-	f.mutex.Lock()
-	f.hooks = append(f.hooks, hook)
-	f.mutex.Unlock()
-}
-
-// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
-// the given values.
-func (f *EnqueuerDBStoreGetIndexesByIDsFunc) SetDefaultReturn(r0 []dbstore.Index, r1 error) {
-	f.SetDefaultHook(func(context.Context, ...int) ([]dbstore.Index, error) {
-		return r0, r1
-	})
+func (c DBStoreUpdateIndexConfigurationByRepositoryIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// PushReturn calls PushDefaultHook with a function that returns the given
-// values.
-func (f *EnqueuerDBStoreGetIndexesByIDsFunc) PushReturn(r0 []dbstore.Index, r1 error) {
-	f.PushHook(func(context.Context, ...int) ([]dbstore.Index, error) {
-		return r0, r1
-	})
+// MockEnqueuerDBStore is a mock implementation of the EnqueuerDBStore
+// interface (from the package
+// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
+// used for unit testing.
+type MockEnqueuerDBStore struct {
+	// DirtyRepositoriesFunc is an instance of a mock function object
+	// controlling the behavior of the method DirtyRepositories.
+	DirtyRepositoriesFunc *EnqueuerDBStoreDirtyRepositoriesFunc
+	// DoneFunc is an instance of a mock function object controlling the
+	// behavior of the method Done.
+	DoneFunc *EnqueuerDBStoreDoneFunc
+	// GetIndexConfigurationByRepositoryIDFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// GetIndexConfigurationByRepositoryID.
+	GetIndexConfigurationByRepositoryIDFunc *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc
+	// GetIndexesByIDsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetIndexesByIDs.
+	GetIndexesByIDsFunc *EnqueuerDBStoreGetIndexesByIDsFunc
+	// HandleFunc is an instance of a mock function object controlling the
+	// behavior of the method Handle.
+	HandleFunc *EnqueuerDBStoreHandleFunc
+	// InsertIndexesFunc is an instance of a mock function object
+	// controlling the behavior of the method InsertIndexes.
+	InsertIndexesFunc *EnqueuerDBStoreInsertIndexesFunc
+	// IsQueuedFunc is an instance of a mock function object controlling the
+	// behavior of the method IsQueued.
+	IsQueuedFunc *EnqueuerDBStoreIsQueuedFunc
+	// TransactFunc is an instance of a mock function object controlling the
+	// behavior of the method Transact.
+	TransactFunc *EnqueuerDBStoreTransactFunc
 }
 
-func (f *EnqueuerDBStoreGetIndexesByIDsFunc) nextHook() func(context.Context, ...int) ([]dbstore.Index, error) {
-	f.mutex.Lock()
-	defer f.mutex.Unlock()
-
-	if len(f.hooks) == 0 {
-		return f.defaultHook
+// NewMockEnqueuerDBStore creates a new mock of the EnqueuerDBStore
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockEnqueuerDBStore() *MockEnqueuerDBStore {
+	return &MockEnqueuerDBStore{
+		DirtyRepositoriesFunc: &EnqueuerDBStoreDirtyRepositoriesFunc{
+			defaultHook: func(context.Context) (map[int]int, error) {
+				return nil, nil
+			},
+		},
+		DoneFunc: &EnqueuerDBStoreDoneFunc{
+			defaultHook: func(error) error {
+				return nil
+			},
+		},
+		GetIndexConfigurationByRepositoryIDFunc: &EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc{
+			defaultHook: func(context.Context, int) (dbstore.IndexConfiguration, bool, error) {
+				return dbstore.IndexConfiguration{}, false, nil
+			},
+		},
+		GetIndexesByIDsFunc: &EnqueuerDBStoreGetIndexesByIDsFunc{
+			defaultHook: func(context.Context, ...int) ([]dbstore.Index, error) {
+				return nil, nil
+			},
+		},
+		HandleFunc: &EnqueuerDBStoreHandleFunc{
+			defaultHook: func() *basestore.TransactableHandle {
+				return nil
+			},
+		},
+		InsertIndexesFunc: &EnqueuerDBStoreInsertIndexesFunc{
+			defaultHook: func(context.Context, []dbstore.Index) ([]dbstore.Index, error) {
+				return nil, nil
+			},
+		},
+		IsQueuedFunc: &EnqueuerDBStoreIsQueuedFunc{
+			defaultHook: func(context.Context, int, string) (bool, error) {
+				return false, nil
+			},
+		},
+		TransactFunc: &EnqueuerDBStoreTransactFunc{
+			defaultHook: func(context.Context) (enqueuer.DBStore, error) {
+				return nil, nil
+			},
+		},
 	}
-
-	hook := f.hooks[0]
-	f.hooks = f.hooks[1:]
-	return hook
 }
 
-func (f *EnqueuerDBStoreGetIndexesByIDsFunc) appendCall(r0 EnqueuerDBStoreGetIndexesByIDsFuncCall) {
+// NewStrictMockEnqueuerDBStore creates a new mock of the EnqueuerDBStore
+// interface. All methods panic on invocation, unless overwritten.
+func NewStrictMockEnqueuerDBStore() *MockEnqueuerDBStore {
+	return &MockEnqueuerDBStore{
+		DirtyRepositoriesFunc: &EnqueuerDBStoreDirtyRepositoriesFunc{
+			defaultHook: func(context.Context) (map[int]int, error) {
+				panic("unexpected invocation of MockEnqueuerDBStore.DirtyRepositories")
+			},
+		},
+		DoneFunc: &EnqueuerDBStoreDoneFunc{
+			defaultHook: func(error) error {
+				panic("unexpected invocation of MockEnqueuerDBStore.Done")
+			},
+		},
+		GetIndexConfigurationByRepositoryIDFunc: &EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc{
+			defaultHook: func(context.Context, int) (dbstore.IndexConfiguration, bool, error) {
+				panic("unexpected invocation of MockEnqueuerDBStore.GetIndexConfigurationByRepositoryID")
+			},
+		},
+		GetIndexesByIDsFunc: &EnqueuerDBStoreGetIndexesByIDsFunc{
+			defaultHook: func(context.Context, ...int) ([]dbstore.Index, error) {
+				panic("unexpected invocation of MockEnqueuerDBStore.GetIndexesByIDs")
+			},
+		},
+		HandleFunc: &EnqueuerDBStoreHandleFunc{
+			defaultHook: func() *basestore.TransactableHandle {
+				panic("unexpected invocation of MockEnqueuerDBStore.Handle")
+			},
+		},
+		InsertIndexesFunc: &EnqueuerDBStoreInsertIndexesFunc{
+			defaultHook: func(context.Context, []dbstore.Index) ([]dbstore.Index, error) {
+				panic("unexpected invocation of MockEnqueuerDBStore.InsertIndexes")
+			},
+		},
+		IsQueuedFunc: &EnqueuerDBStoreIsQueuedFunc{
+			defaultHook: func(context.Context, int, string) (bool, error) {
+				panic("unexpected invocation of MockEnqueuerDBStore.IsQueued")
+			},
+		},
+		TransactFunc: &EnqueuerDBStoreTransactFunc{
+			defaultHook: func(context.Context) (enqueuer.DBStore, error) {
+				panic("unexpected invocation of MockEnqueuerDBStore.Transact")
+			},
+		},
+	}
+}
+
+// NewMockEnqueuerDBStoreFrom creates a new mock of the MockEnqueuerDBStore
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockEnqueuerDBStoreFrom(i EnqueuerDBStore) *MockEnqueuerDBStore {
+	return &MockEnqueuerDBStore{
+		DirtyRepositoriesFunc: &EnqueuerDBStoreDirtyRepositoriesFunc{
+			defaultHook: i.DirtyRepositories,
+		},
+		DoneFunc: &EnqueuerDBStoreDoneFunc{
+			defaultHook: i.Done,
+		},
+		GetIndexConfigurationByRepositoryIDFunc: &EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc{
+			defaultHook: i.GetIndexConfigurationByRepositoryID,
+		},
+		GetIndexesByIDsFunc: &EnqueuerDBStoreGetIndexesByIDsFunc{
+			defaultHook: i.GetIndexesByIDs,
+		},
+		HandleFunc: &EnqueuerDBStoreHandleFunc{
+			defaultHook: i.Handle,
+		},
+		InsertIndexesFunc: &EnqueuerDBStoreInsertIndexesFunc{
+			defaultHook: i.InsertIndexes,
+		},
+		IsQueuedFunc: &EnqueuerDBStoreIsQueuedFunc{
+			defaultHook: i.IsQueued,
+		},
+		TransactFunc: &EnqueuerDBStoreTransactFunc{
+			defaultHook: i.Transact,
+		},
+	}
+}
+
+// EnqueuerDBStoreDirtyRepositoriesFunc describes the behavior when the
+// DirtyRepositories method of the parent MockEnqueuerDBStore instance is
+// invoked.
+type EnqueuerDBStoreDirtyRepositoriesFunc struct {
+	defaultHook func(context.Context) (map[int]int, error)
+	hooks       []func(context.Context) (map[int]int, error)
+	history     []EnqueuerDBStoreDirtyRepositoriesFuncCall
+	mutex       sync.Mutex
+}
+
+// DirtyRepositories delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockEnqueuerDBStore) DirtyRepositories(v0 context.Context) (map[int]int, error) {
+	r0, r1 := m.DirtyRepositoriesFunc.nextHook()(v0)
+	m.DirtyRepositoriesFunc.appendCall(EnqueuerDBStoreDirtyRepositoriesFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the DirtyRepositories
+// method of the parent MockEnqueuerDBStore instance is invoked and the hook
+// queue is empty.
+func (f *EnqueuerDBStoreDirtyRepositoriesFunc) SetDefaultHook(hook func(context.Context) (map[int]int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DirtyRepositories method of the parent MockEnqueuerDBStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *EnqueuerDBStoreDirtyRepositoriesFunc) PushHook(hook func(context.Context) (map[int]int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *EnqueuerDBStoreDirtyRepositoriesFunc) SetDefaultReturn(r0 map[int]int, r1 error) {
+	f.SetDefaultHook(func(context.Context) (map[int]int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *EnqueuerDBStoreDirtyRepositoriesFunc) PushReturn(r0 map[int]int, r1 error) {
+	f.PushHook(func(context.Context) (map[int]int, error) {
+		return r0, r1
+	})
+}
+
+func (f *EnqueuerDBStoreDirtyRepositoriesFunc) nextHook() func(context.Context) (map[int]int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnqueuerDBStoreDirtyRepositoriesFunc) appendCall(r0 EnqueuerDBStoreDirtyRepositoriesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnqueuerDBStoreGetIndexesByIDsFuncCall
+// History returns a sequence of EnqueuerDBStoreDirtyRepositoriesFuncCall
 // objects describing the invocations of this function.
-func (f *EnqueuerDBStoreGetIndexesByIDsFunc) History() []EnqueuerDBStoreGetIndexesByIDsFuncCall {
+func (f *EnqueuerDBStoreDirtyRepositoriesFunc) History() []EnqueuerDBStoreDirtyRepositoriesFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerDBStoreGetIndexesByIDsFuncCall, len(f.history))
+	history := make([]EnqueuerDBStoreDirtyRepositoriesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerDBStoreGetIndexesByIDsFuncCall is an object that describes an
-// invocation of method GetIndexesByIDs on an instance of
+// EnqueuerDBStoreDirtyRepositoriesFuncCall is an object that describes an
+// invocation of method DirtyRepositories on an instance of
 // MockEnqueuerDBStore.
-type EnqueuerDBStoreGetIndexesByIDsFuncCall struct {
+type EnqueuerDBStoreDirtyRepositoriesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg1 []int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []dbstore.Index
+	Result0 map[int]int
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c EnqueuerDBStoreGetIndexesByIDsFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg1 {
-		trailing = append(trailing, val)
-	}
-
-	return append([]interface{}{c.Arg0}, trailing...)
+// invocation.
+func (c EnqueuerDBStoreDirtyRepositoriesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerDBStoreGetIndexesByIDsFuncCall) Results() []interface{} {
+func (c EnqueuerDBStoreDirtyRepositoriesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// EnqueuerDBStoreHandleFunc describes the behavior when the Handle method
-// of the parent MockEnqueuerDBStore instance is invoked.
-type EnqueuerDBStoreHandleFunc struct {
-	defaultHook func() *basestore.TransactableHandle
-	hooks       []func() *basestore.TransactableHandle
-	history     []EnqueuerDBStoreHandleFuncCall
+// EnqueuerDBStoreDoneFunc describes the behavior when the Done method of
+// the parent MockEnqueuerDBStore instance is invoked.
+type EnqueuerDBStoreDoneFunc struct {
+	defaultHook func(error) error
+	hooks       []func(error) error
+	history     []EnqueuerDBStoreDoneFuncCall
 	mutex       sync.Mutex
 }
 
-// Handle delegates to the next hook function in the queue and stores the
+// Done delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockEnqueuerDBStore) Handle() *basestore.TransactableHandle {
-	r0 := m.HandleFunc.nextHook()()
-	m.HandleFunc.appendCall(EnqueuerDBStoreHandleFuncCall{r0})
+func (m *MockEnqueuerDBStore) Done(v0 error) error {
+	r0 := m.DoneFunc.nextHook()(v0)
+	m.DoneFunc.appendCall(EnqueuerDBStoreDoneFuncCall{v0, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Handle method of the
+// SetDefaultHook sets function that is called when the Done method of the
 // parent MockEnqueuerDBStore instance is invoked and the hook queue is
 // empty.
-func (f *EnqueuerDBStoreHandleFunc) SetDefaultHook(hook func() *basestore.TransactableHandle) {
+func (f *EnqueuerDBStoreDoneFunc) SetDefaultHook(hook func(error) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Handle method of the parent MockEnqueuerDBStore instance invokes the hook
+// Done method of the parent MockEnqueuerDBStore instance invokes the hook
 // at the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *EnqueuerDBStoreHandleFunc) PushHook(hook func() *basestore.TransactableHandle) {
+func (f *EnqueuerDBStoreDoneFunc) PushHook(hook func(error) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4060,21 +4249,21 @@ func (f *EnqueuerDBStoreHandleFunc) PushHook(hook func() *basestore.Transactable
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerDBStoreHandleFunc) SetDefaultReturn(r0 *basestore.TransactableHandle) {
-	f.SetDefaultHook(func() *basestore.TransactableHandle {
+func (f *EnqueuerDBStoreDoneFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(error) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerDBStoreHandleFunc) PushReturn(r0 *basestore.TransactableHandle) {
-	f.PushHook(func() *basestore.TransactableHandle {
+func (f *EnqueuerDBStoreDoneFunc) PushReturn(r0 error) {
+	f.PushHook(func(error) error {
 		return r0
 	})
 }
 
-func (f *EnqueuerDBStoreHandleFunc) nextHook() func() *basestore.TransactableHandle {
+func (f *EnqueuerDBStoreDoneFunc) nextHook() func(error) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4087,73 +4276,78 @@ func (f *EnqueuerDBStoreHandleFunc) nextHook() func() *basestore.TransactableHan
 	return hook
 }
 
-func (f *EnqueuerDBStoreHandleFunc) appendCall(r0 EnqueuerDBStoreHandleFuncCall) {
+func (f *EnqueuerDBStoreDoneFunc) appendCall(r0 EnqueuerDBStoreDoneFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnqueuerDBStoreHandleFuncCall objects
+// History returns a sequence of EnqueuerDBStoreDoneFuncCall objects
 // describing the invocations of this function.
-func (f *EnqueuerDBStoreHandleFunc) History() []EnqueuerDBStoreHandleFuncCall {
+func (f *EnqueuerDBStoreDoneFunc) History() []EnqueuerDBStoreDoneFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerDBStoreHandleFuncCall, len(f.history))
+	history := make([]EnqueuerDBStoreDoneFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerDBStoreHandleFuncCall is an object that describes an invocation
-// of method Handle on an instance of MockEnqueuerDBStore.
-type EnqueuerDBStoreHandleFuncCall struct {
+// EnqueuerDBStoreDoneFuncCall is an object that describes an invocation of
+// method Done on an instance of MockEnqueuerDBStore.
+type EnqueuerDBStoreDoneFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 error
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *basestore.TransactableHandle
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerDBStoreHandleFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c EnqueuerDBStoreDoneFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerDBStoreHandleFuncCall) Results() []interface{} {
+func (c EnqueuerDBStoreDoneFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnqueuerDBStoreInsertIndexesFunc describes the behavior when the
-// InsertIndexes method of the parent MockEnqueuerDBStore instance is
-// invoked.
-type EnqueuerDBStoreInsertIndexesFunc struct {
-	defaultHook func(context.Context, []dbstore.Index) ([]dbstore.Index, error)
-	hooks       []func(context.Context, []dbstore.Index) ([]dbstore.Index, error)
-	history     []EnqueuerDBStoreInsertIndexesFuncCall
+// EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc describes the
+// behavior when the GetIndexConfigurationByRepositoryID method of the
+// parent MockEnqueuerDBStore instance is invoked.
+type EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc struct {
+	defaultHook func(context.Context, int) (dbstore.IndexConfiguration, bool, error)
+	hooks       []func(context.Context, int) (dbstore.IndexConfiguration, bool, error)
+	history     []EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall
 	mutex       sync.Mutex
 }
 
-// InsertIndexes delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockEnqueuerDBStore) InsertIndexes(v0 context.Context, v1 []dbstore.Index) ([]dbstore.Index, error) {
-	r0, r1 := m.InsertIndexesFunc.nextHook()(v0, v1)
-	m.InsertIndexesFunc.appendCall(EnqueuerDBStoreInsertIndexesFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// GetIndexConfigurationByRepositoryID delegates to the next hook function
+// in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockEnqueuerDBStore) GetIndexConfigurationByRepositoryID(v0 context.Context, v1 int) (dbstore.IndexConfiguration, bool, error) {
+	r0, r1, r2 := m.GetIndexConfigurationByRepositoryIDFunc.nextHook()(v0, v1)
+	m.GetIndexConfigurationByRepositoryIDFunc.appendCall(EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the InsertIndexes method
-// of the parent MockEnqueuerDBStore instance is invoked and the hook queue
-// is empty.
-func (f *EnqueuerDBStoreInsertIndexesFunc) SetDefaultHook(hook func(context.Context, []dbstore.Index) ([]dbstore.Index, error)) {
+// SetDefaultHook sets function that is called when the
+// GetIndexConfigurationByRepositoryID method of the parent
+// MockEnqueuerDBStore instance is invoked and the hook queue is empty.
+func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) SetDefaultHook(hook func(context.Context, int) (dbstore.IndexConfiguration, bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// InsertIndexes method of the parent MockEnqueuerDBStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *EnqueuerDBStoreInsertIndexesFunc) PushHook(hook func(context.Context, []dbstore.Index) ([]dbstore.Index, error)) {
+// GetIndexConfigurationByRepositoryID method of the parent
+// MockEnqueuerDBStore instance invokes the hook at the front of the queue
+// and discards it. After the queue is empty, the default hook function is
+// invoked for any future action.
+func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) PushHook(hook func(context.Context, int) (dbstore.IndexConfiguration, bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4161,21 +4355,21 @@ func (f *EnqueuerDBStoreInsertIndexesFunc) PushHook(hook func(context.Context, [
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerDBStoreInsertIndexesFunc) SetDefaultReturn(r0 []dbstore.Index, r1 error) {
-	f.SetDefaultHook(func(context.Context, []dbstore.Index) ([]dbstore.Index, error) {
-		return r0, r1
+func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) SetDefaultReturn(r0 dbstore.IndexConfiguration, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, int) (dbstore.IndexConfiguration, bool, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerDBStoreInsertIndexesFunc) PushReturn(r0 []dbstore.Index, r1 error) {
-	f.PushHook(func(context.Context, []dbstore.Index) ([]dbstore.Index, error) {
-		return r0, r1
+func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) PushReturn(r0 dbstore.IndexConfiguration, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, int) (dbstore.IndexConfiguration, bool, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *EnqueuerDBStoreInsertIndexesFunc) nextHook() func(context.Context, []dbstore.Index) ([]dbstore.Index, error) {
+func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) nextHook() func(context.Context, int) (dbstore.IndexConfiguration, bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4188,81 +4382,88 @@ func (f *EnqueuerDBStoreInsertIndexesFunc) nextHook() func(context.Context, []db
 	return hook
 }
 
-func (f *EnqueuerDBStoreInsertIndexesFunc) appendCall(r0 EnqueuerDBStoreInsertIndexesFuncCall) {
+func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) appendCall(r0 EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnqueuerDBStoreInsertIndexesFuncCall
-// objects describing the invocations of this function.
-func (f *EnqueuerDBStoreInsertIndexesFunc) History() []EnqueuerDBStoreInsertIndexesFuncCall {
+// History returns a sequence of
+// EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall objects
+// describing the invocations of this function.
+func (f *EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFunc) History() []EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerDBStoreInsertIndexesFuncCall, len(f.history))
+	history := make([]EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerDBStoreInsertIndexesFuncCall is an object that describes an
-// invocation of method InsertIndexes on an instance of MockEnqueuerDBStore.
-type EnqueuerDBStoreInsertIndexesFuncCall struct {
+// EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall is an object
+// that describes an invocation of method
+// GetIndexConfigurationByRepositoryID on an instance of
+// MockEnqueuerDBStore.
+type EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 []dbstore.Index
+	Arg1 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []dbstore.Index
+	Result0 dbstore.IndexConfiguration
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 error
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerDBStoreInsertIndexesFuncCall) Args() []interface{} {
+func (c EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerDBStoreInsertIndexesFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c EnqueuerDBStoreGetIndexConfigurationByRepositoryIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// EnqueuerDBStoreIsQueuedFunc describes the behavior when the IsQueued
-// method of the parent MockEnqueuerDBStore instance is invoked.
-type EnqueuerDBStoreIsQueuedFunc struct {
-	defaultHook func(context.Context, int, string) (bool, error)
-	hooks       []func(context.Context, int, string) (bool, error)
-	history     []EnqueuerDBStoreIsQueuedFuncCall
+// EnqueuerDBStoreGetIndexesByIDsFunc describes the behavior when the
+// GetIndexesByIDs method of the parent MockEnqueuerDBStore instance is
+// invoked.
+type EnqueuerDBStoreGetIndexesByIDsFunc struct {
+	defaultHook func(context.Context, ...int) ([]dbstore.Index, error)
+	hooks       []func(context.Context, ...int) ([]dbstore.Index, error)
+	history     []EnqueuerDBStoreGetIndexesByIDsFuncCall
 	mutex       sync.Mutex
 }
 
-// IsQueued delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockEnqueuerDBStore) IsQueued(v0 context.Context, v1 int, v2 string) (bool, error) {
-	r0, r1 := m.IsQueuedFunc.nextHook()(v0, v1, v2)
-	m.IsQueuedFunc.appendCall(EnqueuerDBStoreIsQueuedFuncCall{v0, v1, v2, r0, r1})
+// GetIndexesByIDs delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockEnqueuerDBStore) GetIndexesByIDs(v0 context.Context, v1 ...int) ([]dbstore.Index, error) {
+	r0, r1 := m.GetIndexesByIDsFunc.nextHook()(v0, v1...)
+	m.GetIndexesByIDsFunc.appendCall(EnqueuerDBStoreGetIndexesByIDsFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the IsQueued method of
-// the parent MockEnqueuerDBStore instance is invoked and the hook queue is
-// empty.
-func (f *EnqueuerDBStoreIsQueuedFunc) SetDefaultHook(hook func(context.Context, int, string) (bool, error)) {
+// SetDefaultHook sets function that is called when the GetIndexesByIDs
+// method of the parent MockEnqueuerDBStore instance is invoked and the hook
+// queue is empty.
+func (f *EnqueuerDBStoreGetIndexesByIDsFunc) SetDefaultHook(hook func(context.Context, ...int) ([]dbstore.Index, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// IsQueued method of the parent MockEnqueuerDBStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *EnqueuerDBStoreIsQueuedFunc) PushHook(hook func(context.Context, int, string) (bool, error)) {
+// GetIndexesByIDs method of the parent MockEnqueuerDBStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *EnqueuerDBStoreGetIndexesByIDsFunc) PushHook(hook func(context.Context, ...int) ([]dbstore.Index, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4270,21 +4471,21 @@ func (f *EnqueuerDBStoreIsQueuedFunc) PushHook(hook func(context.Context, int, s
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerDBStoreIsQueuedFunc) SetDefaultReturn(r0 bool, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string) (bool, error) {
+func (f *EnqueuerDBStoreGetIndexesByIDsFunc) SetDefaultReturn(r0 []dbstore.Index, r1 error) {
+	f.SetDefaultHook(func(context.Context, ...int) ([]dbstore.Index, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerDBStoreIsQueuedFunc) PushReturn(r0 bool, r1 error) {
-	f.PushHook(func(context.Context, int, string) (bool, error) {
+func (f *EnqueuerDBStoreGetIndexesByIDsFunc) PushReturn(r0 []dbstore.Index, r1 error) {
+	f.PushHook(func(context.Context, ...int) ([]dbstore.Index, error) {
 		return r0, r1
 	})
 }
 
-func (f *EnqueuerDBStoreIsQueuedFunc) nextHook() func(context.Context, int, string) (bool, error) {
+func (f *EnqueuerDBStoreGetIndexesByIDsFunc) nextHook() func(context.Context, ...int) ([]dbstore.Index, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4297,84 +4498,89 @@ func (f *EnqueuerDBStoreIsQueuedFunc) nextHook() func(context.Context, int, stri
 	return hook
 }
 
-func (f *EnqueuerDBStoreIsQueuedFunc) appendCall(r0 EnqueuerDBStoreIsQueuedFuncCall) {
+func (f *EnqueuerDBStoreGetIndexesByIDsFunc) appendCall(r0 EnqueuerDBStoreGetIndexesByIDsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnqueuerDBStoreIsQueuedFuncCall objects
-// describing the invocations of this function.
-func (f *EnqueuerDBStoreIsQueuedFunc) History() []EnqueuerDBStoreIsQueuedFuncCall {
+// History returns a sequence of EnqueuerDBStoreGetIndexesByIDsFuncCall
+// objects describing the invocations of this function.
+func (f *EnqueuerDBStoreGetIndexesByIDsFunc) History() []EnqueuerDBStoreGetIndexesByIDsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerDBStoreIsQueuedFuncCall, len(f.history))
+	history := make([]EnqueuerDBStoreGetIndexesByIDsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerDBStoreIsQueuedFuncCall is an object that describes an invocation
-// of method IsQueued on an instance of MockEnqueuerDBStore.
-type EnqueuerDBStoreIsQueuedFuncCall struct {
+// EnqueuerDBStoreGetIndexesByIDsFuncCall is an object that describes an
+// invocation of method GetIndexesByIDs on an instance of
+// MockEnqueuerDBStore.
+type EnqueuerDBStoreGetIndexesByIDsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 int
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 string
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 bool
+	Result0 []dbstore.Index
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation.
-func (c EnqueuerDBStoreIsQueuedFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c EnqueuerDBStoreGetIndexesByIDsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerDBStoreIsQueuedFuncCall) Results() []interface{} {
+func (c EnqueuerDBStoreGetIndexesByIDsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// EnqueuerDBStoreTransactFunc describes the behavior when the Transact
-// method of the parent MockEnqueuerDBStore instance is invoked.
-type EnqueuerDBStoreTransactFunc struct {
-	defaultHook func(context.Context) (enqueuer.DBStore, error)
-	hooks       []func(context.Context) (enqueuer.DBStore, error)
-	history     []EnqueuerDBStoreTransactFuncCall
+// EnqueuerDBStoreHandleFunc describes the behavior when the Handle method
+// of the parent MockEnqueuerDBStore instance is invoked.
+type EnqueuerDBStoreHandleFunc struct {
+	defaultHook func() *basestore.TransactableHandle
+	hooks       []func() *basestore.TransactableHandle
+	history     []EnqueuerDBStoreHandleFuncCall
 	mutex       sync.Mutex
 }
 
-// Transact delegates to the next hook function in the queue and stores the
+// Handle delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockEnqueuerDBStore) Transact(v0 context.Context) (enqueuer.DBStore, error) {
-	r0, r1 := m.TransactFunc.nextHook()(v0)
-	m.TransactFunc.appendCall(EnqueuerDBStoreTransactFuncCall{v0, r0, r1})
-	return r0, r1
+func (m *MockEnqueuerDBStore) Handle() *basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(EnqueuerDBStoreHandleFuncCall{r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the Transact method of
-// the parent MockEnqueuerDBStore instance is invoked and the hook queue is
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockEnqueuerDBStore instance is invoked and the hook queue is
 // empty.
-func (f *EnqueuerDBStoreTransactFunc) SetDefaultHook(hook func(context.Context) (enqueuer.DBStore, error)) {
+func (f *EnqueuerDBStoreHandleFunc) SetDefaultHook(hook func() *basestore.TransactableHandle) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Transact method of the parent MockEnqueuerDBStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *EnqueuerDBStoreTransactFunc) PushHook(hook func(context.Context) (enqueuer.DBStore, error)) {
+// Handle method of the parent MockEnqueuerDBStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *EnqueuerDBStoreHandleFunc) PushHook(hook func() *basestore.TransactableHandle) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4382,21 +4588,21 @@ func (f *EnqueuerDBStoreTransactFunc) PushHook(hook func(context.Context) (enque
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerDBStoreTransactFunc) SetDefaultReturn(r0 enqueuer.DBStore, r1 error) {
-	f.SetDefaultHook(func(context.Context) (enqueuer.DBStore, error) {
-		return r0, r1
+func (f *EnqueuerDBStoreHandleFunc) SetDefaultReturn(r0 *basestore.TransactableHandle) {
+	f.SetDefaultHook(func() *basestore.TransactableHandle {
+		return r0
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerDBStoreTransactFunc) PushReturn(r0 enqueuer.DBStore, r1 error) {
-	f.PushHook(func(context.Context) (enqueuer.DBStore, error) {
-		return r0, r1
+func (f *EnqueuerDBStoreHandleFunc) PushReturn(r0 *basestore.TransactableHandle) {
+	f.PushHook(func() *basestore.TransactableHandle {
+		return r0
 	})
 }
 
-func (f *EnqueuerDBStoreTransactFunc) nextHook() func(context.Context) (enqueuer.DBStore, error) {
+func (f *EnqueuerDBStoreHandleFunc) nextHook() func() *basestore.TransactableHandle {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4409,191 +4615,73 @@ func (f *EnqueuerDBStoreTransactFunc) nextHook() func(context.Context) (enqueuer
 	return hook
 }
 
-func (f *EnqueuerDBStoreTransactFunc) appendCall(r0 EnqueuerDBStoreTransactFuncCall) {
+func (f *EnqueuerDBStoreHandleFunc) appendCall(r0 EnqueuerDBStoreHandleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnqueuerDBStoreTransactFuncCall objects
+// History returns a sequence of EnqueuerDBStoreHandleFuncCall objects
 // describing the invocations of this function.
-func (f *EnqueuerDBStoreTransactFunc) History() []EnqueuerDBStoreTransactFuncCall {
+func (f *EnqueuerDBStoreHandleFunc) History() []EnqueuerDBStoreHandleFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerDBStoreTransactFuncCall, len(f.history))
+	history := make([]EnqueuerDBStoreHandleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerDBStoreTransactFuncCall is an object that describes an invocation
-// of method Transact on an instance of MockEnqueuerDBStore.
-type EnqueuerDBStoreTransactFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 context.Context
+// EnqueuerDBStoreHandleFuncCall is an object that describes an invocation
+// of method Handle on an instance of MockEnqueuerDBStore.
+type EnqueuerDBStoreHandleFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 enqueuer.DBStore
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 *basestore.TransactableHandle
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerDBStoreTransactFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c EnqueuerDBStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerDBStoreTransactFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c EnqueuerDBStoreHandleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// MockEnqueuerGitserverClient is a mock implementation of the
-// EnqueuerGitserverClient interface (from the package
-// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
-// used for unit testing.
-type MockEnqueuerGitserverClient struct {
-	// FileExistsFunc is an instance of a mock function object controlling
-	// the behavior of the method FileExists.
-	FileExistsFunc *EnqueuerGitserverClientFileExistsFunc
-	// HeadFunc is an instance of a mock function object controlling the
-	// behavior of the method Head.
-	HeadFunc *EnqueuerGitserverClientHeadFunc
-	// ListFilesFunc is an instance of a mock function object controlling
-	// the behavior of the method ListFiles.
-	ListFilesFunc *EnqueuerGitserverClientListFilesFunc
-	// RawContentsFunc is an instance of a mock function object controlling
-	// the behavior of the method RawContents.
-	RawContentsFunc *EnqueuerGitserverClientRawContentsFunc
-	// ResolveRevisionFunc is an instance of a mock function object
-	// controlling the behavior of the method ResolveRevision.
-	ResolveRevisionFunc *EnqueuerGitserverClientResolveRevisionFunc
-}
-
-// NewMockEnqueuerGitserverClient creates a new mock of the
-// EnqueuerGitserverClient interface. All methods return zero values for all
-// results, unless overwritten.
-func NewMockEnqueuerGitserverClient() *MockEnqueuerGitserverClient {
-	return &MockEnqueuerGitserverClient{
-		FileExistsFunc: &EnqueuerGitserverClientFileExistsFunc{
-			defaultHook: func(context.Context, int, string, string) (bool, error) {
-				return false, nil
-			},
-		},
-		HeadFunc: &EnqueuerGitserverClientHeadFunc{
-			defaultHook: func(context.Context, int) (string, bool, error) {
-				return "", false, nil
-			},
-		},
-		ListFilesFunc: &EnqueuerGitserverClientListFilesFunc{
-			defaultHook: func(context.Context, int, string, *regexp.Regexp) ([]string, error) {
-				return nil, nil
-			},
-		},
-		RawContentsFunc: &EnqueuerGitserverClientRawContentsFunc{
-			defaultHook: func(context.Context, int, string, string) ([]byte, error) {
-				return nil, nil
-			},
-		},
-		ResolveRevisionFunc: &EnqueuerGitserverClientResolveRevisionFunc{
-			defaultHook: func(context.Context, int, string) (api.CommitID, error) {
-				return "", nil
-			},
-		},
-	}
-}
-
-// NewStrictMockEnqueuerGitserverClient creates a new mock of the
-// EnqueuerGitserverClient interface. All methods panic on invocation,
-// unless overwritten.
-func NewStrictMockEnqueuerGitserverClient() *MockEnqueuerGitserverClient {
-	return &MockEnqueuerGitserverClient{
-		FileExistsFunc: &EnqueuerGitserverClientFileExistsFunc{
-			defaultHook: func(context.Context, int, string, string) (bool, error) {
-				panic("unexpected invocation of MockEnqueuerGitserverClient.FileExists")
-			},
-		},
-		HeadFunc: &EnqueuerGitserverClientHeadFunc{
-			defaultHook: func(context.Context, int) (string, bool, error) {
-				panic("unexpected invocation of MockEnqueuerGitserverClient.Head")
-			},
-		},
-		ListFilesFunc: &EnqueuerGitserverClientListFilesFunc{
-			defaultHook: func(context.Context, int, string, *regexp.Regexp) ([]string, error) {
-				panic("unexpected invocation of MockEnqueuerGitserverClient.ListFiles")
-			},
-		},
-		RawContentsFunc: &EnqueuerGitserverClientRawContentsFunc{
-			defaultHook: func(context.Context, int, string, string) ([]byte, error) {
-				panic("unexpected invocation of MockEnqueuerGitserverClient.RawContents")
-			},
-		},
-		ResolveRevisionFunc: &EnqueuerGitserverClientResolveRevisionFunc{
-			defaultHook: func(context.Context, int, string) (api.CommitID, error) {
-				panic("unexpected invocation of MockEnqueuerGitserverClient.ResolveRevision")
-			},
-		},
-	}
-}
-
-// NewMockEnqueuerGitserverClientFrom creates a new mock of the
-// MockEnqueuerGitserverClient interface. All methods delegate to the given
-// implementation, unless overwritten.
-func NewMockEnqueuerGitserverClientFrom(i EnqueuerGitserverClient) *MockEnqueuerGitserverClient {
-	return &MockEnqueuerGitserverClient{
-		FileExistsFunc: &EnqueuerGitserverClientFileExistsFunc{
-			defaultHook: i.FileExists,
-		},
-		HeadFunc: &EnqueuerGitserverClientHeadFunc{
-			defaultHook: i.Head,
-		},
-		ListFilesFunc: &EnqueuerGitserverClientListFilesFunc{
-			defaultHook: i.ListFiles,
-		},
-		RawContentsFunc: &EnqueuerGitserverClientRawContentsFunc{
-			defaultHook: i.RawContents,
-		},
-		ResolveRevisionFunc: &EnqueuerGitserverClientResolveRevisionFunc{
-			defaultHook: i.ResolveRevision,
-		},
-	}
-}
-
-// EnqueuerGitserverClientFileExistsFunc describes the behavior when the
-// FileExists method of the parent MockEnqueuerGitserverClient instance is
+// EnqueuerDBStoreInsertIndexesFunc describes the behavior when the
+// InsertIndexes method of the parent MockEnqueuerDBStore instance is
 // invoked.
-type EnqueuerGitserverClientFileExistsFunc struct {
-	defaultHook func(context.Context, int, string, string) (bool, error)
-	hooks       []func(context.Context, int, string, string) (bool, error)
-	history     []EnqueuerGitserverClientFileExistsFuncCall
+type EnqueuerDBStoreInsertIndexesFunc struct {
+	defaultHook func(context.Context, []dbstore.Index) ([]dbstore.Index, error)
+	hooks       []func(context.Context, []dbstore.Index) ([]dbstore.Index, error)
+	history     []EnqueuerDBStoreInsertIndexesFuncCall
 	mutex       sync.Mutex
 }
 
-// FileExists delegates to the next hook function in the queue and stores
+// InsertIndexes delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockEnqueuerGitserverClient) FileExists(v0 context.Context, v1 int, v2 string, v3 string) (bool, error) {
-	r0, r1 := m.FileExistsFunc.nextHook()(v0, v1, v2, v3)
-	m.FileExistsFunc.appendCall(EnqueuerGitserverClientFileExistsFuncCall{v0, v1, v2, v3, r0, r1})
+func (m *MockEnqueuerDBStore) InsertIndexes(v0 context.Context, v1 []dbstore.Index) ([]dbstore.Index, error) {
+	r0, r1 := m.InsertIndexesFunc.nextHook()(v0, v1)
+	m.InsertIndexesFunc.appendCall(EnqueuerDBStoreInsertIndexesFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the FileExists method of
-// the parent MockEnqueuerGitserverClient instance is invoked and the hook
-// queue is empty.
-func (f *EnqueuerGitserverClientFileExistsFunc) SetDefaultHook(hook func(context.Context, int, string, string) (bool, error)) {
+// SetDefaultHook sets function that is called when the InsertIndexes method
+// of the parent MockEnqueuerDBStore instance is invoked and the hook queue
+// is empty.
+func (f *EnqueuerDBStoreInsertIndexesFunc) SetDefaultHook(hook func(context.Context, []dbstore.Index) ([]dbstore.Index, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// FileExists method of the parent MockEnqueuerGitserverClient instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *EnqueuerGitserverClientFileExistsFunc) PushHook(hook func(context.Context, int, string, string) (bool, error)) {
+// InsertIndexes method of the parent MockEnqueuerDBStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *EnqueuerDBStoreInsertIndexesFunc) PushHook(hook func(context.Context, []dbstore.Index) ([]dbstore.Index, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4601,21 +4689,21 @@ func (f *EnqueuerGitserverClientFileExistsFunc) PushHook(hook func(context.Conte
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerGitserverClientFileExistsFunc) SetDefaultReturn(r0 bool, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string, string) (bool, error) {
+func (f *EnqueuerDBStoreInsertIndexesFunc) SetDefaultReturn(r0 []dbstore.Index, r1 error) {
+	f.SetDefaultHook(func(context.Context, []dbstore.Index) ([]dbstore.Index, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerGitserverClientFileExistsFunc) PushReturn(r0 bool, r1 error) {
-	f.PushHook(func(context.Context, int, string, string) (bool, error) {
+func (f *EnqueuerDBStoreInsertIndexesFunc) PushReturn(r0 []dbstore.Index, r1 error) {
+	f.PushHook(func(context.Context, []dbstore.Index) ([]dbstore.Index, error) {
 		return r0, r1
 	})
 }
 
-func (f *EnqueuerGitserverClientFileExistsFunc) nextHook() func(context.Context, int, string, string) (bool, error) {
+func (f *EnqueuerDBStoreInsertIndexesFunc) nextHook() func(context.Context, []dbstore.Index) ([]dbstore.Index, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4628,42 +4716,35 @@ func (f *EnqueuerGitserverClientFileExistsFunc) nextHook() func(context.Context,
 	return hook
 }
 
-func (f *EnqueuerGitserverClientFileExistsFunc) appendCall(r0 EnqueuerGitserverClientFileExistsFuncCall) {
+func (f *EnqueuerDBStoreInsertIndexesFunc) appendCall(r0 EnqueuerDBStoreInsertIndexesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnqueuerGitserverClientFileExistsFuncCall
+// History returns a sequence of EnqueuerDBStoreInsertIndexesFuncCall
 // objects describing the invocations of this function.
-func (f *EnqueuerGitserverClientFileExistsFunc) History() []EnqueuerGitserverClientFileExistsFuncCall {
+func (f *EnqueuerDBStoreInsertIndexesFunc) History() []EnqueuerDBStoreInsertIndexesFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerGitserverClientFileExistsFuncCall, len(f.history))
+	history := make([]EnqueuerDBStoreInsertIndexesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerGitserverClientFileExistsFuncCall is an object that describes an
-// invocation of method FileExists on an instance of
-// MockEnqueuerGitserverClient.
-type EnqueuerGitserverClientFileExistsFuncCall struct {
+// EnqueuerDBStoreInsertIndexesFuncCall is an object that describes an
+// invocation of method InsertIndexes on an instance of MockEnqueuerDBStore.
+type EnqueuerDBStoreInsertIndexesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 string
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 string
+	Arg1 []dbstore.Index
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 bool
+	Result0 []dbstore.Index
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -4671,45 +4752,45 @@ type EnqueuerGitserverClientFileExistsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerGitserverClientFileExistsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c EnqueuerDBStoreInsertIndexesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerGitserverClientFileExistsFuncCall) Results() []interface{} {
+func (c EnqueuerDBStoreInsertIndexesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// EnqueuerGitserverClientHeadFunc describes the behavior when the Head
-// method of the parent MockEnqueuerGitserverClient instance is invoked.
-type EnqueuerGitserverClientHeadFunc struct {
-	defaultHook func(context.Context, int) (string, bool, error)
-	hooks       []func(context.Context, int) (string, bool, error)
-	history     []EnqueuerGitserverClientHeadFuncCall
+// EnqueuerDBStoreIsQueuedFunc describes the behavior when the IsQueued
+// method of the parent MockEnqueuerDBStore instance is invoked.
+type EnqueuerDBStoreIsQueuedFunc struct {
+	defaultHook func(context.Context, int, string) (bool, error)
+	hooks       []func(context.Context, int, string) (bool, error)
+	history     []EnqueuerDBStoreIsQueuedFuncCall
 	mutex       sync.Mutex
 }
 
-// Head delegates to the next hook function in the queue and stores the
+// IsQueued delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockEnqueuerGitserverClient) Head(v0 context.Context, v1 int) (string, bool, error) {
-	r0, r1, r2 := m.HeadFunc.nextHook()(v0, v1)
-	m.HeadFunc.appendCall(EnqueuerGitserverClientHeadFuncCall{v0, v1, r0, r1, r2})
-	return r0, r1, r2
+func (m *MockEnqueuerDBStore) IsQueued(v0 context.Context, v1 int, v2 string) (bool, error) {
+	r0, r1 := m.IsQueuedFunc.nextHook()(v0, v1, v2)
+	m.IsQueuedFunc.appendCall(EnqueuerDBStoreIsQueuedFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Head method of the
-// parent MockEnqueuerGitserverClient instance is invoked and the hook queue
-// is empty.
-func (f *EnqueuerGitserverClientHeadFunc) SetDefaultHook(hook func(context.Context, int) (string, bool, error)) {
+// SetDefaultHook sets function that is called when the IsQueued method of
+// the parent MockEnqueuerDBStore instance is invoked and the hook queue is
+// empty.
+func (f *EnqueuerDBStoreIsQueuedFunc) SetDefaultHook(hook func(context.Context, int, string) (bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Head method of the parent MockEnqueuerGitserverClient instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *EnqueuerGitserverClientHeadFunc) PushHook(hook func(context.Context, int) (string, bool, error)) {
+// IsQueued method of the parent MockEnqueuerDBStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *EnqueuerDBStoreIsQueuedFunc) PushHook(hook func(context.Context, int, string) (bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4717,21 +4798,21 @@ func (f *EnqueuerGitserverClientHeadFunc) PushHook(hook func(context.Context, in
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerGitserverClientHeadFunc) SetDefaultReturn(r0 string, r1 bool, r2 error) {
-	f.SetDefaultHook(func(context.Context, int) (string, bool, error) {
-		return r0, r1, r2
+func (f *EnqueuerDBStoreIsQueuedFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string) (bool, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerGitserverClientHeadFunc) PushReturn(r0 string, r1 bool, r2 error) {
-	f.PushHook(func(context.Context, int) (string, bool, error) {
-		return r0, r1, r2
+func (f *EnqueuerDBStoreIsQueuedFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, int, string) (bool, error) {
+		return r0, r1
 	})
 }
 
-func (f *EnqueuerGitserverClientHeadFunc) nextHook() func(context.Context, int) (string, bool, error) {
+func (f *EnqueuerDBStoreIsQueuedFunc) nextHook() func(context.Context, int, string) (bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4744,86 +4825,84 @@ func (f *EnqueuerGitserverClientHeadFunc) nextHook() func(context.Context, int)
 	return hook
 }
 
-func (f *EnqueuerGitserverClientHeadFunc) appendCall(r0 EnqueuerGitserverClientHeadFuncCall) {
+func (f *EnqueuerDBStoreIsQueuedFunc) appendCall(r0 EnqueuerDBStoreIsQueuedFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnqueuerGitserverClientHeadFuncCall objects
+// History returns a sequence of EnqueuerDBStoreIsQueuedFuncCall objects
 // describing the invocations of this function.
-func (f *EnqueuerGitserverClientHeadFunc) History() []EnqueuerGitserverClientHeadFuncCall {
+func (f *EnqueuerDBStoreIsQueuedFunc) History() []EnqueuerDBStoreIsQueuedFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerGitserverClientHeadFuncCall, len(f.history))
+	history := make([]EnqueuerDBStoreIsQueuedFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerGitserverClientHeadFuncCall is an object that describes an
-// invocation of method Head on an instance of MockEnqueuerGitserverClient.
-type EnqueuerGitserverClientHeadFuncCall struct {
+// EnqueuerDBStoreIsQueuedFuncCall is an object that describes an invocation
+// of method IsQueued on an instance of MockEnqueuerDBStore.
+type EnqueuerDBStoreIsQueuedFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 string
+	Result0 bool
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 bool
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerGitserverClientHeadFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c EnqueuerDBStoreIsQueuedFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerGitserverClientHeadFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+func (c EnqueuerDBStoreIsQueuedFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// EnqueuerGitserverClientListFilesFunc describes the behavior when the
-// ListFiles method of the parent MockEnqueuerGitserverClient instance is
-// invoked.
-type EnqueuerGitserverClientListFilesFunc struct {
-	defaultHook func(context.Context, int, string, *regexp.Regexp) ([]string, error)
-	hooks       []func(context.Context, int, string, *regexp.Regexp) ([]string, error)
-	history     []EnqueuerGitserverClientListFilesFuncCall
+// EnqueuerDBStoreTransactFunc describes the behavior when the Transact
+// method of the parent MockEnqueuerDBStore instance is invoked.
+type EnqueuerDBStoreTransactFunc struct {
+	defaultHook func(context.Context) (enqueuer.DBStore, error)
+	hooks       []func(context.Context) (enqueuer.DBStore, error)
+	history     []EnqueuerDBStoreTransactFuncCall
 	mutex       sync.Mutex
 }
 
-// ListFiles delegates to the next hook function in the queue and stores the
+// Transact delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockEnqueuerGitserverClient) ListFiles(v0 context.Context, v1 int, v2 string, v3 *regexp.Regexp) ([]string, error) {
-	r0, r1 := m.ListFilesFunc.nextHook()(v0, v1, v2, v3)
-	m.ListFilesFunc.appendCall(EnqueuerGitserverClientListFilesFuncCall{v0, v1, v2, v3, r0, r1})
+func (m *MockEnqueuerDBStore) Transact(v0 context.Context) (enqueuer.DBStore, error) {
+	r0, r1 := m.TransactFunc.nextHook()(v0)
+	m.TransactFunc.appendCall(EnqueuerDBStoreTransactFuncCall{v0, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the ListFiles method of
-// the parent MockEnqueuerGitserverClient instance is invoked and the hook
-// queue is empty.
-func (f *EnqueuerGitserverClientListFilesFunc) SetDefaultHook(hook func(context.Context, int, string, *regexp.Regexp) ([]string, error)) {
+// SetDefaultHook sets function that is called when the Transact method of
+// the parent MockEnqueuerDBStore instance is invoked and the hook queue is
+// empty.
+func (f *EnqueuerDBStoreTransactFunc) SetDefaultHook(hook func(context.Context) (enqueuer.DBStore, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListFiles method of the parent MockEnqueuerGitserverClient instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *EnqueuerGitserverClientListFilesFunc) PushHook(hook func(context.Context, int, string, *regexp.Regexp) ([]string, error)) {
+// Transact method of the parent MockEnqueuerDBStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *EnqueuerDBStoreTransactFunc) PushHook(hook func(context.Context) (enqueuer.DBStore, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4831,21 +4910,21 @@ func (f *EnqueuerGitserverClientListFilesFunc) PushHook(hook func(context.Contex
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerGitserverClientListFilesFunc) SetDefaultReturn(r0 []string, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string, *regexp.Regexp) ([]string, error) {
+func (f *EnqueuerDBStoreTransactFunc) SetDefaultReturn(r0 enqueuer.DBStore, r1 error) {
+	f.SetDefaultHook(func(context.Context) (enqueuer.DBStore, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerGitserverClientListFilesFunc) PushReturn(r0 []string, r1 error) {
-	f.PushHook(func(context.Context, int, string, *regexp.Regexp) ([]string, error) {
+func (f *EnqueuerDBStoreTransactFunc) PushReturn(r0 enqueuer.DBStore, r1 error) {
+	f.PushHook(func(context.Context) (enqueuer.DBStore, error) {
 		return r0, r1
 	})
 }
 
-func (f *EnqueuerGitserverClientListFilesFunc) nextHook() func(context.Context, int, string, *regexp.Regexp) ([]string, error) {
+func (f *EnqueuerDBStoreTransactFunc) nextHook() func(context.Context) (enqueuer.DBStore, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4858,42 +4937,32 @@ func (f *EnqueuerGitserverClientListFilesFunc) nextHook() func(context.Context,
 	return hook
 }
 
-func (f *EnqueuerGitserverClientListFilesFunc) appendCall(r0 EnqueuerGitserverClientListFilesFuncCall) {
+func (f *EnqueuerDBStoreTransactFunc) appendCall(r0 EnqueuerDBStoreTransactFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnqueuerGitserverClientListFilesFuncCall
-// objects describing the invocations of this function.
-func (f *EnqueuerGitserverClientListFilesFunc) History() []EnqueuerGitserverClientListFilesFuncCall {
+// History returns a sequence of EnqueuerDBStoreTransactFuncCall objects
+// describing the invocations of this function.
+func (f *EnqueuerDBStoreTransactFunc) History() []EnqueuerDBStoreTransactFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerGitserverClientListFilesFuncCall, len(f.history))
+	history := make([]EnqueuerDBStoreTransactFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerGitserverClientListFilesFuncCall is an object that describes an
-// invocation of method ListFiles on an instance of
-// MockEnqueuerGitserverClient.
-type EnqueuerGitserverClientListFilesFuncCall struct {
+// EnqueuerDBStoreTransactFuncCall is an object that describes an invocation
+// of method Transact on an instance of MockEnqueuerDBStore.
+type EnqueuerDBStoreTransactFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 int
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 string
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 *regexp.Regexp
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []string
+	Result0 enqueuer.DBStore
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -4901,47 +4970,158 @@ type EnqueuerGitserverClientListFilesFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerGitserverClientListFilesFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c EnqueuerDBStoreTransactFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerGitserverClientListFilesFuncCall) Results() []interface{} {
+func (c EnqueuerDBStoreTransactFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// EnqueuerGitserverClientRawContentsFunc describes the behavior when the
-// RawContents method of the parent MockEnqueuerGitserverClient instance is
+// MockEnqueuerGitserverClient is a mock implementation of the
+// EnqueuerGitserverClient interface (from the package
+// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
+// used for unit testing.
+type MockEnqueuerGitserverClient struct {
+	// FileExistsFunc is an instance of a mock function object controlling
+	// the behavior of the method FileExists.
+	FileExistsFunc *EnqueuerGitserverClientFileExistsFunc
+	// HeadFunc is an instance of a mock function object controlling the
+	// behavior of the method Head.
+	HeadFunc *EnqueuerGitserverClientHeadFunc
+	// ListFilesFunc is an instance of a mock function object controlling
+	// the behavior of the method ListFiles.
+	ListFilesFunc *EnqueuerGitserverClientListFilesFunc
+	// RawContentsFunc is an instance of a mock function object controlling
+	// the behavior of the method RawContents.
+	RawContentsFunc *EnqueuerGitserverClientRawContentsFunc
+	// ResolveRevisionFunc is an instance of a mock function object
+	// controlling the behavior of the method ResolveRevision.
+	ResolveRevisionFunc *EnqueuerGitserverClientResolveRevisionFunc
+}
+
+// NewMockEnqueuerGitserverClient creates a new mock of the
+// EnqueuerGitserverClient interface. All methods return zero values for all
+// results, unless overwritten.
+func NewMockEnqueuerGitserverClient() *MockEnqueuerGitserverClient {
+	return &MockEnqueuerGitserverClient{
+		FileExistsFunc: &EnqueuerGitserverClientFileExistsFunc{
+			defaultHook: func(context.Context, int, string, string) (bool, error) {
+				return false, nil
+			},
+		},
+		HeadFunc: &EnqueuerGitserverClientHeadFunc{
+			defaultHook: func(context.Context, int) (string, bool, error) {
+				return "", false, nil
+			},
+		},
+		ListFilesFunc: &EnqueuerGitserverClientListFilesFunc{
+			defaultHook: func(context.Context, int, string, *regexp.Regexp) ([]string, error) {
+				return nil, nil
+			},
+		},
+		RawContentsFunc: &EnqueuerGitserverClientRawContentsFunc{
+			defaultHook: func(context.Context, int, string, string) ([]byte, error) {
+				return nil, nil
+			},
+		},
+		ResolveRevisionFunc: &EnqueuerGitserverClientResolveRevisionFunc{
+			defaultHook: func(context.Context, int, string) (api.CommitID, error) {
+				return "", nil
+			},
+		},
+	}
+}
+
+// NewStrictMockEnqueuerGitserverClient creates a new mock of the
+// EnqueuerGitserverClient interface. All methods panic on invocation,
+// unless overwritten.
+func NewStrictMockEnqueuerGitserverClient() *MockEnqueuerGitserverClient {
+	return &MockEnqueuerGitserverClient{
+		FileExistsFunc: &EnqueuerGitserverClientFileExistsFunc{
+			defaultHook: func(context.Context, int, string, string) (bool, error) {
+				panic("unexpected invocation of MockEnqueuerGitserverClient.FileExists")
+			},
+		},
+		HeadFunc: &EnqueuerGitserverClientHeadFunc{
+			defaultHook: func(context.Context, int) (string, bool, error) {
+				panic("unexpected invocation of MockEnqueuerGitserverClient.Head")
+			},
+		},
+		ListFilesFunc: &EnqueuerGitserverClientListFilesFunc{
+			defaultHook: func(context.Context, int, string, *regexp.Regexp) ([]string, error) {
+				panic("unexpected invocation of MockEnqueuerGitserverClient.ListFiles")
+			},
+		},
+		RawContentsFunc: &EnqueuerGitserverClientRawContentsFunc{
+			defaultHook: func(context.Context, int, string, string) ([]byte, error) {
+				panic("unexpected invocation of MockEnqueuerGitserverClient.RawContents")
+			},
+		},
+		ResolveRevisionFunc: &EnqueuerGitserverClientResolveRevisionFunc{
+			defaultHook: func(context.Context, int, string) (api.CommitID, error) {
+				panic("unexpected invocation of MockEnqueuerGitserverClient.ResolveRevision")
+			},
+		},
+	}
+}
+
+// NewMockEnqueuerGitserverClientFrom creates a new mock of the
+// MockEnqueuerGitserverClient interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockEnqueuerGitserverClientFrom(i EnqueuerGitserverClient) *MockEnqueuerGitserverClient {
+	return &MockEnqueuerGitserverClient{
+		FileExistsFunc: &EnqueuerGitserverClientFileExistsFunc{
+			defaultHook: i.FileExists,
+		},
+		HeadFunc: &EnqueuerGitserverClientHeadFunc{
+			defaultHook: i.Head,
+		},
+		ListFilesFunc: &EnqueuerGitserverClientListFilesFunc{
+			defaultHook: i.ListFiles,
+		},
+		RawContentsFunc: &EnqueuerGitserverClientRawContentsFunc{
+			defaultHook: i.RawContents,
+		},
+		ResolveRevisionFunc: &EnqueuerGitserverClientResolveRevisionFunc{
+			defaultHook: i.ResolveRevision,
+		},
+	}
+}
+
+// EnqueuerGitserverClientFileExistsFunc describes the behavior when the
+// FileExists method of the parent MockEnqueuerGitserverClient instance is
 // invoked.
-type EnqueuerGitserverClientRawContentsFunc struct {
-	defaultHook func(context.Context, int, string, string) ([]byte, error)
-	hooks       []func(context.Context, int, string, string) ([]byte, error)
-	history     []EnqueuerGitserverClientRawContentsFuncCall
+type EnqueuerGitserverClientFileExistsFunc struct {
+	defaultHook func(context.Context, int, string, string) (bool, error)
+	hooks       []func(context.Context, int, string, string) (bool, error)
+	history     []EnqueuerGitserverClientFileExistsFuncCall
 	mutex       sync.Mutex
 }
 
-// RawContents delegates to the next hook function in the queue and stores
+// FileExists delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockEnqueuerGitserverClient) RawContents(v0 context.Context, v1 int, v2 string, v3 string) ([]byte, error) {
-	r0, r1 := m.RawContentsFunc.nextHook()(v0, v1, v2, v3)
-	m.RawContentsFunc.appendCall(EnqueuerGitserverClientRawContentsFuncCall{v0, v1, v2, v3, r0, r1})
+func (m *MockEnqueuerGitserverClient) FileExists(v0 context.Context, v1 int, v2 string, v3 string) (bool, error) {
+	r0, r1 := m.FileExistsFunc.nextHook()(v0, v1, v2, v3)
+	m.FileExistsFunc.appendCall(EnqueuerGitserverClientFileExistsFuncCall{v0, v1, v2, v3, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the RawContents method
-// of the parent MockEnqueuerGitserverClient instance is invoked and the
-// hook queue is empty.
-func (f *EnqueuerGitserverClientRawContentsFunc) SetDefaultHook(hook func(context.Context, int, string, string) ([]byte, error)) {
+// SetDefaultHook sets function that is called when the FileExists method of
+// the parent MockEnqueuerGitserverClient instance is invoked and the hook
+// queue is empty.
+func (f *EnqueuerGitserverClientFileExistsFunc) SetDefaultHook(hook func(context.Context, int, string, string) (bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// RawContents method of the parent MockEnqueuerGitserverClient instance
+// FileExists method of the parent MockEnqueuerGitserverClient instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *EnqueuerGitserverClientRawContentsFunc) PushHook(hook func(context.Context, int, string, string) ([]byte, error)) {
+func (f *EnqueuerGitserverClientFileExistsFunc) PushHook(hook func(context.Context, int, string, string) (bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4949,21 +5129,21 @@ func (f *EnqueuerGitserverClientRawContentsFunc) PushHook(hook func(context.Cont
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerGitserverClientRawContentsFunc) SetDefaultReturn(r0 []byte, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string, string) ([]byte, error) {
+func (f *EnqueuerGitserverClientFileExistsFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, string) (bool, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerGitserverClientRawContentsFunc) PushReturn(r0 []byte, r1 error) {
-	f.PushHook(func(context.Context, int, string, string) ([]byte, error) {
+func (f *EnqueuerGitserverClientFileExistsFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, int, string, string) (bool, error) {
 		return r0, r1
 	})
 }
 
-func (f *EnqueuerGitserverClientRawContentsFunc) nextHook() func(context.Context, int, string, string) ([]byte, error) {
+func (f *EnqueuerGitserverClientFileExistsFunc) nextHook() func(context.Context, int, string, string) (bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4976,27 +5156,27 @@ func (f *EnqueuerGitserverClientRawContentsFunc) nextHook() func(context.Context
 	return hook
 }
 
-func (f *EnqueuerGitserverClientRawContentsFunc) appendCall(r0 EnqueuerGitserverClientRawContentsFuncCall) {
+func (f *EnqueuerGitserverClientFileExistsFunc) appendCall(r0 EnqueuerGitserverClientFileExistsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnqueuerGitserverClientRawContentsFuncCall
+// History returns a sequence of EnqueuerGitserverClientFileExistsFuncCall
 // objects describing the invocations of this function.
-func (f *EnqueuerGitserverClientRawContentsFunc) History() []EnqueuerGitserverClientRawContentsFuncCall {
+func (f *EnqueuerGitserverClientFileExistsFunc) History() []EnqueuerGitserverClientFileExistsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerGitserverClientRawContentsFuncCall, len(f.history))
+	history := make([]EnqueuerGitserverClientFileExistsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerGitserverClientRawContentsFuncCall is an object that describes an
-// invocation of method RawContents on an instance of
+// EnqueuerGitserverClientFileExistsFuncCall is an object that describes an
+// invocation of method FileExists on an instance of
 // MockEnqueuerGitserverClient.
-type EnqueuerGitserverClientRawContentsFuncCall struct {
+type EnqueuerGitserverClientFileExistsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -5011,7 +5191,7 @@ type EnqueuerGitserverClientRawContentsFuncCall struct {
 	Arg3 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []byte
+	Result0 bool
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -5019,47 +5199,45 @@ type EnqueuerGitserverClientRawContentsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerGitserverClientRawContentsFuncCall) Args() []interface{} {
+func (c EnqueuerGitserverClientFileExistsFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerGitserverClientRawContentsFuncCall) Results() []interface{} {
+func (c EnqueuerGitserverClientFileExistsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// EnqueuerGitserverClientResolveRevisionFunc describes the behavior when
-// the ResolveRevision method of the parent MockEnqueuerGitserverClient
-// instance is invoked.
-type EnqueuerGitserverClientResolveRevisionFunc struct {
-	defaultHook func(context.Context, int, string) (api.CommitID, error)
-	hooks       []func(context.Context, int, string) (api.CommitID, error)
-	history     []EnqueuerGitserverClientResolveRevisionFuncCall
+// EnqueuerGitserverClientHeadFunc describes the behavior when the Head
+// method of the parent MockEnqueuerGitserverClient instance is invoked.
+type EnqueuerGitserverClientHeadFunc struct {
+	defaultHook func(context.Context, int) (string, bool, error)
+	hooks       []func(context.Context, int) (string, bool, error)
+	history     []EnqueuerGitserverClientHeadFuncCall
 	mutex       sync.Mutex
 }
 
-// ResolveRevision delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockEnqueuerGitserverClient) ResolveRevision(v0 context.Context, v1 int, v2 string) (api.CommitID, error) {
-	r0, r1 := m.ResolveRevisionFunc.nextHook()(v0, v1, v2)
-	m.ResolveRevisionFunc.appendCall(EnqueuerGitserverClientResolveRevisionFuncCall{v0, v1, v2, r0, r1})
-	return r0, r1
+// Head delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockEnqueuerGitserverClient) Head(v0 context.Context, v1 int) (string, bool, error) {
+	r0, r1, r2 := m.HeadFunc.nextHook()(v0, v1)
+	m.HeadFunc.appendCall(EnqueuerGitserverClientHeadFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the ResolveRevision
-// method of the parent MockEnqueuerGitserverClient instance is invoked and
-// the hook queue is empty.
-func (f *EnqueuerGitserverClientResolveRevisionFunc) SetDefaultHook(hook func(context.Context, int, string) (api.CommitID, error)) {
+// SetDefaultHook sets function that is called when the Head method of the
+// parent MockEnqueuerGitserverClient instance is invoked and the hook queue
+// is empty.
+func (f *EnqueuerGitserverClientHeadFunc) SetDefaultHook(hook func(context.Context, int) (string, bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ResolveRevision method of the parent MockEnqueuerGitserverClient instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *EnqueuerGitserverClientResolveRevisionFunc) PushHook(hook func(context.Context, int, string) (api.CommitID, error)) {
+// Head method of the parent MockEnqueuerGitserverClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *EnqueuerGitserverClientHeadFunc) PushHook(hook func(context.Context, int) (string, bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -5067,21 +5245,21 @@ func (f *EnqueuerGitserverClientResolveRevisionFunc) PushHook(hook func(context.
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *EnqueuerGitserverClientResolveRevisionFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string) (api.CommitID, error) {
-		return r0, r1
+func (f *EnqueuerGitserverClientHeadFunc) SetDefaultReturn(r0 string, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, int) (string, bool, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *EnqueuerGitserverClientResolveRevisionFunc) PushReturn(r0 api.CommitID, r1 error) {
-	f.PushHook(func(context.Context, int, string) (api.CommitID, error) {
-		return r0, r1
+func (f *EnqueuerGitserverClientHeadFunc) PushReturn(r0 string, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, int) (string, bool, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *EnqueuerGitserverClientResolveRevisionFunc) nextHook() func(context.Context, int, string) (api.CommitID, error) {
+func (f *EnqueuerGitserverClientHeadFunc) nextHook() func(context.Context, int) (string, bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -5094,149 +5272,86 @@ func (f *EnqueuerGitserverClientResolveRevisionFunc) nextHook() func(context.Con
 	return hook
 }
 
-func (f *EnqueuerGitserverClientResolveRevisionFunc) appendCall(r0 EnqueuerGitserverClientResolveRevisionFuncCall) {
+func (f *EnqueuerGitserverClientHeadFunc) appendCall(r0 EnqueuerGitserverClientHeadFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// EnqueuerGitserverClientResolveRevisionFuncCall objects describing the
-// invocations of this function.
-func (f *EnqueuerGitserverClientResolveRevisionFunc) History() []EnqueuerGitserverClientResolveRevisionFuncCall {
+// History returns a sequence of EnqueuerGitserverClientHeadFuncCall objects
+// describing the invocations of this function.
+func (f *EnqueuerGitserverClientHeadFunc) History() []EnqueuerGitserverClientHeadFuncCall {
 	f.mutex.Lock()
-	history := make([]EnqueuerGitserverClientResolveRevisionFuncCall, len(f.history))
+	history := make([]EnqueuerGitserverClientHeadFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnqueuerGitserverClientResolveRevisionFuncCall is an object that
-// describes an invocation of method ResolveRevision on an instance of
-// MockEnqueuerGitserverClient.
-type EnqueuerGitserverClientResolveRevisionFuncCall struct {
+// EnqueuerGitserverClientHeadFuncCall is an object that describes an
+// invocation of method Head on an instance of MockEnqueuerGitserverClient.
+type EnqueuerGitserverClientHeadFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 int
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 api.CommitID
+	Result0 string
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 error
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnqueuerGitserverClientResolveRevisionFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c EnqueuerGitserverClientHeadFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnqueuerGitserverClientResolveRevisionFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
-}
-
-// MockGitserverClient is a mock implementation of the GitserverClient
-// interface (from the package
-// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
-// used for unit testing.
-type MockGitserverClient struct {
-	// CommitExistsFunc is an instance of a mock function object controlling
-	// the behavior of the method CommitExists.
-	CommitExistsFunc *GitserverClientCommitExistsFunc
-	// CommitGraphFunc is an instance of a mock function object controlling
-	// the behavior of the method CommitGraph.
-	CommitGraphFunc *GitserverClientCommitGraphFunc
-}
-
-// NewMockGitserverClient creates a new mock of the GitserverClient
-// interface. All methods return zero values for all results, unless
-// overwritten.
-func NewMockGitserverClient() *MockGitserverClient {
-	return &MockGitserverClient{
-		CommitExistsFunc: &GitserverClientCommitExistsFunc{
-			defaultHook: func(context.Context, int, string) (bool, error) {
-				return false, nil
-			},
-		},
-		CommitGraphFunc: &GitserverClientCommitGraphFunc{
-			defaultHook: func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error) {
-				return nil, nil
-			},
-		},
-	}
-}
-
-// NewStrictMockGitserverClient creates a new mock of the GitserverClient
-// interface. All methods panic on invocation, unless overwritten.
-func NewStrictMockGitserverClient() *MockGitserverClient {
-	return &MockGitserverClient{
-		CommitExistsFunc: &GitserverClientCommitExistsFunc{
-			defaultHook: func(context.Context, int, string) (bool, error) {
-				panic("unexpected invocation of MockGitserverClient.CommitExists")
-			},
-		},
-		CommitGraphFunc: &GitserverClientCommitGraphFunc{
-			defaultHook: func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error) {
-				panic("unexpected invocation of MockGitserverClient.CommitGraph")
-			},
-		},
-	}
-}
-
-// NewMockGitserverClientFrom creates a new mock of the MockGitserverClient
-// interface. All methods delegate to the given implementation, unless
-// overwritten.
-func NewMockGitserverClientFrom(i GitserverClient) *MockGitserverClient {
-	return &MockGitserverClient{
-		CommitExistsFunc: &GitserverClientCommitExistsFunc{
-			defaultHook: i.CommitExists,
-		},
-		CommitGraphFunc: &GitserverClientCommitGraphFunc{
-			defaultHook: i.CommitGraph,
-		},
-	}
+func (c EnqueuerGitserverClientHeadFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// GitserverClientCommitExistsFunc describes the behavior when the
-// CommitExists method of the parent MockGitserverClient instance is
+// EnqueuerGitserverClientListFilesFunc describes the behavior when the
+// ListFiles method of the parent MockEnqueuerGitserverClient instance is
 // invoked.
-type GitserverClientCommitExistsFunc struct {
-	defaultHook func(context.Context, int, string) (bool, error)
-	hooks       []func(context.Context, int, string) (bool, error)
-	history     []GitserverClientCommitExistsFuncCall
+type EnqueuerGitserverClientListFilesFunc struct {
+	defaultHook func(context.Context, int, string, *regexp.Regexp) ([]string, error)
+	hooks       []func(context.Context, int, string, *regexp.Regexp) ([]string, error)
+	history     []EnqueuerGitserverClientListFilesFuncCall
 	mutex       sync.Mutex
 }
 
-// CommitExists delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockGitserverClient) CommitExists(v0 context.Context, v1 int, v2 string) (bool, error) {
-	r0, r1 := m.CommitExistsFunc.nextHook()(v0, v1, v2)
-	m.CommitExistsFunc.appendCall(GitserverClientCommitExistsFuncCall{v0, v1, v2, r0, r1})
+// ListFiles delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockEnqueuerGitserverClient) ListFiles(v0 context.Context, v1 int, v2 string, v3 *regexp.Regexp) ([]string, error) {
+	r0, r1 := m.ListFilesFunc.nextHook()(v0, v1, v2, v3)
+	m.ListFilesFunc.appendCall(EnqueuerGitserverClientListFilesFuncCall{v0, v1, v2, v3, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the CommitExists method
-// of the parent MockGitserverClient instance is invoked and the hook queue
-// is empty.
-func (f *GitserverClientCommitExistsFunc) SetDefaultHook(hook func(context.Context, int, string) (bool, error)) {
+// SetDefaultHook sets function that is called when the ListFiles method of
+// the parent MockEnqueuerGitserverClient instance is invoked and the hook
+// queue is empty.
+func (f *EnqueuerGitserverClientListFilesFunc) SetDefaultHook(hook func(context.Context, int, string, *regexp.Regexp) ([]string, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CommitExists method of the parent MockGitserverClient instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *GitserverClientCommitExistsFunc) PushHook(hook func(context.Context, int, string) (bool, error)) {
+// ListFiles method of the parent MockEnqueuerGitserverClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *EnqueuerGitserverClientListFilesFunc) PushHook(hook func(context.Context, int, string, *regexp.Regexp) ([]string, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -5244,21 +5359,21 @@ func (f *GitserverClientCommitExistsFunc) PushHook(hook func(context.Context, in
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *GitserverClientCommitExistsFunc) SetDefaultReturn(r0 bool, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string) (bool, error) {
+func (f *EnqueuerGitserverClientListFilesFunc) SetDefaultReturn(r0 []string, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, *regexp.Regexp) ([]string, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *GitserverClientCommitExistsFunc) PushReturn(r0 bool, r1 error) {
-	f.PushHook(func(context.Context, int, string) (bool, error) {
+func (f *EnqueuerGitserverClientListFilesFunc) PushReturn(r0 []string, r1 error) {
+	f.PushHook(func(context.Context, int, string, *regexp.Regexp) ([]string, error) {
 		return r0, r1
 	})
 }
 
-func (f *GitserverClientCommitExistsFunc) nextHook() func(context.Context, int, string) (bool, error) {
+func (f *EnqueuerGitserverClientListFilesFunc) nextHook() func(context.Context, int, string, *regexp.Regexp) ([]string, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -5271,26 +5386,27 @@ func (f *GitserverClientCommitExistsFunc) nextHook() func(context.Context, int,
 	return hook
 }
 
-func (f *GitserverClientCommitExistsFunc) appendCall(r0 GitserverClientCommitExistsFuncCall) {
+func (f *EnqueuerGitserverClientListFilesFunc) appendCall(r0 EnqueuerGitserverClientListFilesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of GitserverClientCommitExistsFuncCall objects
-// describing the invocations of this function.
-func (f *GitserverClientCommitExistsFunc) History() []GitserverClientCommitExistsFuncCall {
+// History returns a sequence of EnqueuerGitserverClientListFilesFuncCall
+// objects describing the invocations of this function.
+func (f *EnqueuerGitserverClientListFilesFunc) History() []EnqueuerGitserverClientListFilesFuncCall {
 	f.mutex.Lock()
-	history := make([]GitserverClientCommitExistsFuncCall, len(f.history))
+	history := make([]EnqueuerGitserverClientListFilesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitserverClientCommitExistsFuncCall is an object that describes an
-// invocation of method CommitExists on an instance of MockGitserverClient.
-type GitserverClientCommitExistsFuncCall struct {
+// EnqueuerGitserverClientListFilesFuncCall is an object that describes an
+// invocation of method ListFiles on an instance of
+// MockEnqueuerGitserverClient.
+type EnqueuerGitserverClientListFilesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -5300,9 +5416,12 @@ type GitserverClientCommitExistsFuncCall struct {
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
 	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 *regexp.Regexp
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 bool
+	Result0 []string
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -5310,45 +5429,47 @@ type GitserverClientCommitExistsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c GitserverClientCommitExistsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c EnqueuerGitserverClientListFilesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitserverClientCommitExistsFuncCall) Results() []interface{} {
+func (c EnqueuerGitserverClientListFilesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// GitserverClientCommitGraphFunc describes the behavior when the
-// CommitGraph method of the parent MockGitserverClient instance is invoked.
-type GitserverClientCommitGraphFunc struct {
-	defaultHook func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error)
-	hooks       []func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error)
-	history     []GitserverClientCommitGraphFuncCall
+// EnqueuerGitserverClientRawContentsFunc describes the behavior when the
+// RawContents method of the parent MockEnqueuerGitserverClient instance is
+// invoked.
+type EnqueuerGitserverClientRawContentsFunc struct {
+	defaultHook func(context.Context, int, string, string) ([]byte, error)
+	hooks       []func(context.Context, int, string, string) ([]byte, error)
+	history     []EnqueuerGitserverClientRawContentsFuncCall
 	mutex       sync.Mutex
 }
 
-// CommitGraph delegates to the next hook function in the queue and stores
+// RawContents delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockGitserverClient) CommitGraph(v0 context.Context, v1 int, v2 gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error) {
-	r0, r1 := m.CommitGraphFunc.nextHook()(v0, v1, v2)
-	m.CommitGraphFunc.appendCall(GitserverClientCommitGraphFuncCall{v0, v1, v2, r0, r1})
+func (m *MockEnqueuerGitserverClient) RawContents(v0 context.Context, v1 int, v2 string, v3 string) ([]byte, error) {
+	r0, r1 := m.RawContentsFunc.nextHook()(v0, v1, v2, v3)
+	m.RawContentsFunc.appendCall(EnqueuerGitserverClientRawContentsFuncCall{v0, v1, v2, v3, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the CommitGraph method
-// of the parent MockGitserverClient instance is invoked and the hook queue
-// is empty.
-func (f *GitserverClientCommitGraphFunc) SetDefaultHook(hook func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error)) {
+// SetDefaultHook sets function that is called when the RawContents method
+// of the parent MockEnqueuerGitserverClient instance is invoked and the
+// hook queue is empty.
+func (f *EnqueuerGitserverClientRawContentsFunc) SetDefaultHook(hook func(context.Context, int, string, string) ([]byte, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CommitGraph method of the parent MockGitserverClient instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *GitserverClientCommitGraphFunc) PushHook(hook func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error)) {
+// RawContents method of the parent MockEnqueuerGitserverClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *EnqueuerGitserverClientRawContentsFunc) PushHook(hook func(context.Context, int, string, string) ([]byte, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -5356,21 +5477,21 @@ func (f *GitserverClientCommitGraphFunc) PushHook(hook func(context.Context, int
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *GitserverClientCommitGraphFunc) SetDefaultReturn(r0 *gitserver.CommitGraph, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error) {
+func (f *EnqueuerGitserverClientRawContentsFunc) SetDefaultReturn(r0 []byte, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, string) ([]byte, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *GitserverClientCommitGraphFunc) PushReturn(r0 *gitserver.CommitGraph, r1 error) {
-	f.PushHook(func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error) {
+func (f *EnqueuerGitserverClientRawContentsFunc) PushReturn(r0 []byte, r1 error) {
+	f.PushHook(func(context.Context, int, string, string) ([]byte, error) {
 		return r0, r1
 	})
 }
 
-func (f *GitserverClientCommitGraphFunc) nextHook() func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error) {
+func (f *EnqueuerGitserverClientRawContentsFunc) nextHook() func(context.Context, int, string, string) ([]byte, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -5383,26 +5504,27 @@ func (f *GitserverClientCommitGraphFunc) nextHook() func(context.Context, int, g
 	return hook
 }
 
-func (f *GitserverClientCommitGraphFunc) appendCall(r0 GitserverClientCommitGraphFuncCall) {
+func (f *EnqueuerGitserverClientRawContentsFunc) appendCall(r0 EnqueuerGitserverClientRawContentsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of GitserverClientCommitGraphFuncCall objects
-// describing the invocations of this function.
-func (f *GitserverClientCommitGraphFunc) History() []GitserverClientCommitGraphFuncCall {
+// History returns a sequence of EnqueuerGitserverClientRawContentsFuncCall
+// objects describing the invocations of this function.
+func (f *EnqueuerGitserverClientRawContentsFunc) History() []EnqueuerGitserverClientRawContentsFuncCall {
 	f.mutex.Lock()
-	history := make([]GitserverClientCommitGraphFuncCall, len(f.history))
+	history := make([]EnqueuerGitserverClientRawContentsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitserverClientCommitGraphFuncCall is an object that describes an
-// invocation of method CommitGraph on an instance of MockGitserverClient.
-type GitserverClientCommitGraphFuncCall struct {
+// EnqueuerGitserverClientRawContentsFuncCall is an object that describes an
+// invocation of method RawContents on an instance of
+// MockEnqueuerGitserverClient.
+type EnqueuerGitserverClientRawContentsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -5411,10 +5533,13 @@ type GitserverClientCommitGraphFuncCall struct {
 	Arg1 int
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 gitserver.CommitGraphOptions
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *gitserver.CommitGraph
+	Result0 []byte
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -5422,108 +5547,47 @@ type GitserverClientCommitGraphFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c GitserverClientCommitGraphFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c EnqueuerGitserverClientRawContentsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitserverClientCommitGraphFuncCall) Results() []interface{} {
+func (c EnqueuerGitserverClientRawContentsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// MockIndexEnqueuer is a mock implementation of the IndexEnqueuer interface
-// (from the package
-// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
-// used for unit testing.
-type MockIndexEnqueuer struct {
-	// InferIndexConfigurationFunc is an instance of a mock function object
-	// controlling the behavior of the method InferIndexConfiguration.
-	InferIndexConfigurationFunc *IndexEnqueuerInferIndexConfigurationFunc
-	// QueueIndexesFunc is an instance of a mock function object controlling
-	// the behavior of the method QueueIndexes.
-	QueueIndexesFunc *IndexEnqueuerQueueIndexesFunc
-}
-
-// NewMockIndexEnqueuer creates a new mock of the IndexEnqueuer interface.
-// All methods return zero values for all results, unless overwritten.
-func NewMockIndexEnqueuer() *MockIndexEnqueuer {
-	return &MockIndexEnqueuer{
-		InferIndexConfigurationFunc: &IndexEnqueuerInferIndexConfigurationFunc{
-			defaultHook: func(context.Context, int) (*config.IndexConfiguration, error) {
-				return nil, nil
-			},
-		},
-		QueueIndexesFunc: &IndexEnqueuerQueueIndexesFunc{
-			defaultHook: func(context.Context, int, string, string, bool) ([]dbstore.Index, error) {
-				return nil, nil
-			},
-		},
-	}
-}
-
-// NewStrictMockIndexEnqueuer creates a new mock of the IndexEnqueuer
-// interface. All methods panic on invocation, unless overwritten.
-func NewStrictMockIndexEnqueuer() *MockIndexEnqueuer {
-	return &MockIndexEnqueuer{
-		InferIndexConfigurationFunc: &IndexEnqueuerInferIndexConfigurationFunc{
-			defaultHook: func(context.Context, int) (*config.IndexConfiguration, error) {
-				panic("unexpected invocation of MockIndexEnqueuer.InferIndexConfiguration")
-			},
-		},
-		QueueIndexesFunc: &IndexEnqueuerQueueIndexesFunc{
-			defaultHook: func(context.Context, int, string, string, bool) ([]dbstore.Index, error) {
-				panic("unexpected invocation of MockIndexEnqueuer.QueueIndexes")
-			},
-		},
-	}
-}
-
-// NewMockIndexEnqueuerFrom creates a new mock of the MockIndexEnqueuer
-// interface. All methods delegate to the given implementation, unless
-// overwritten.
-func NewMockIndexEnqueuerFrom(i IndexEnqueuer) *MockIndexEnqueuer {
-	return &MockIndexEnqueuer{
-		InferIndexConfigurationFunc: &IndexEnqueuerInferIndexConfigurationFunc{
-			defaultHook: i.InferIndexConfiguration,
-		},
-		QueueIndexesFunc: &IndexEnqueuerQueueIndexesFunc{
-			defaultHook: i.QueueIndexes,
-		},
-	}
-}
-
-// IndexEnqueuerInferIndexConfigurationFunc describes the behavior when the
-// InferIndexConfiguration method of the parent MockIndexEnqueuer instance
-// is invoked.
-type IndexEnqueuerInferIndexConfigurationFunc struct {
-	defaultHook func(context.Context, int) (*config.IndexConfiguration, error)
-	hooks       []func(context.Context, int) (*config.IndexConfiguration, error)
-	history     []IndexEnqueuerInferIndexConfigurationFuncCall
+// EnqueuerGitserverClientResolveRevisionFunc describes the behavior when
+// the ResolveRevision method of the parent MockEnqueuerGitserverClient
+// instance is invoked.
+type EnqueuerGitserverClientResolveRevisionFunc struct {
+	defaultHook func(context.Context, int, string) (api.CommitID, error)
+	hooks       []func(context.Context, int, string) (api.CommitID, error)
+	history     []EnqueuerGitserverClientResolveRevisionFuncCall
 	mutex       sync.Mutex
 }
 
-// InferIndexConfiguration delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockIndexEnqueuer) InferIndexConfiguration(v0 context.Context, v1 int) (*config.IndexConfiguration, error) {
-	r0, r1 := m.InferIndexConfigurationFunc.nextHook()(v0, v1)
-	m.InferIndexConfigurationFunc.appendCall(IndexEnqueuerInferIndexConfigurationFuncCall{v0, v1, r0, r1})
+// ResolveRevision delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockEnqueuerGitserverClient) ResolveRevision(v0 context.Context, v1 int, v2 string) (api.CommitID, error) {
+	r0, r1 := m.ResolveRevisionFunc.nextHook()(v0, v1, v2)
+	m.ResolveRevisionFunc.appendCall(EnqueuerGitserverClientResolveRevisionFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// InferIndexConfiguration method of the parent MockIndexEnqueuer instance
-// is invoked and the hook queue is empty.
-func (f *IndexEnqueuerInferIndexConfigurationFunc) SetDefaultHook(hook func(context.Context, int) (*config.IndexConfiguration, error)) {
+// SetDefaultHook sets function that is called when the ResolveRevision
+// method of the parent MockEnqueuerGitserverClient instance is invoked and
+// the hook queue is empty.
+func (f *EnqueuerGitserverClientResolveRevisionFunc) SetDefaultHook(hook func(context.Context, int, string) (api.CommitID, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// InferIndexConfiguration method of the parent MockIndexEnqueuer instance
+// ResolveRevision method of the parent MockEnqueuerGitserverClient instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *IndexEnqueuerInferIndexConfigurationFunc) PushHook(hook func(context.Context, int) (*config.IndexConfiguration, error)) {
+func (f *EnqueuerGitserverClientResolveRevisionFunc) PushHook(hook func(context.Context, int, string) (api.CommitID, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -5531,21 +5595,21 @@ func (f *IndexEnqueuerInferIndexConfigurationFunc) PushHook(hook func(context.Co
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *IndexEnqueuerInferIndexConfigurationFunc) SetDefaultReturn(r0 *config.IndexConfiguration, r1 error) {
-	f.SetDefaultHook(func(context.Context, int) (*config.IndexConfiguration, error) {
+func (f *EnqueuerGitserverClientResolveRevisionFunc) SetDefaultReturn(r0 api.CommitID, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string) (api.CommitID, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *IndexEnqueuerInferIndexConfigurationFunc) PushReturn(r0 *config.IndexConfiguration, r1 error) {
-	f.PushHook(func(context.Context, int) (*config.IndexConfiguration, error) {
+func (f *EnqueuerGitserverClientResolveRevisionFunc) PushReturn(r0 api.CommitID, r1 error) {
+	f.PushHook(func(context.Context, int, string) (api.CommitID, error) {
 		return r0, r1
 	})
 }
 
-func (f *IndexEnqueuerInferIndexConfigurationFunc) nextHook() func(context.Context, int) (*config.IndexConfiguration, error) {
+func (f *EnqueuerGitserverClientResolveRevisionFunc) nextHook() func(context.Context, int, string) (api.CommitID, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -5558,37 +5622,40 @@ func (f *IndexEnqueuerInferIndexConfigurationFunc) nextHook() func(context.Conte
 	return hook
 }
 
-func (f *IndexEnqueuerInferIndexConfigurationFunc) appendCall(r0 IndexEnqueuerInferIndexConfigurationFuncCall) {
+func (f *EnqueuerGitserverClientResolveRevisionFunc) appendCall(r0 EnqueuerGitserverClientResolveRevisionFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// IndexEnqueuerInferIndexConfigurationFuncCall objects describing the
+// EnqueuerGitserverClientResolveRevisionFuncCall objects describing the
 // invocations of this function.
-func (f *IndexEnqueuerInferIndexConfigurationFunc) History() []IndexEnqueuerInferIndexConfigurationFuncCall {
+func (f *EnqueuerGitserverClientResolveRevisionFunc) History() []EnqueuerGitserverClientResolveRevisionFuncCall {
 	f.mutex.Lock()
-	history := make([]IndexEnqueuerInferIndexConfigurationFuncCall, len(f.history))
+	history := make([]EnqueuerGitserverClientResolveRevisionFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// IndexEnqueuerInferIndexConfigurationFuncCall is an object that describes
-// an invocation of method InferIndexConfiguration on an instance of
-// MockIndexEnqueuer.
-type IndexEnqueuerInferIndexConfigurationFuncCall struct {
+// EnqueuerGitserverClientResolveRevisionFuncCall is an object that
+// describes an invocation of method ResolveRevision on an instance of
+// MockEnqueuerGitserverClient.
+type EnqueuerGitserverClientResolveRevisionFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *config.IndexConfiguration
+	Result0 api.CommitID
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -5596,67 +5663,146 @@ type IndexEnqueuerInferIndexConfigurationFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c IndexEnqueuerInferIndexConfigurationFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c EnqueuerGitserverClientResolveRevisionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c IndexEnqueuerInferIndexConfigurationFuncCall) Results() []interface{} {
+func (c EnqueuerGitserverClientResolveRevisionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// IndexEnqueuerQueueIndexesFunc describes the behavior when the
-// QueueIndexes method of the parent MockIndexEnqueuer instance is invoked.
-type IndexEnqueuerQueueIndexesFunc struct {
-	defaultHook func(context.Context, int, string, string, bool) ([]dbstore.Index, error)
-	hooks       []func(context.Context, int, string, string, bool) ([]dbstore.Index, error)
-	history     []IndexEnqueuerQueueIndexesFuncCall
-	mutex       sync.Mutex
-}
-
-// QueueIndexes delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockIndexEnqueuer) QueueIndexes(v0 context.Context, v1 int, v2 string, v3 string, v4 bool) ([]dbstore.Index, error) {
-	r0, r1 := m.QueueIndexesFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.QueueIndexesFunc.appendCall(IndexEnqueuerQueueIndexesFuncCall{v0, v1, v2, v3, v4, r0, r1})
-	return r0, r1
+// MockGitserverClient is a mock implementation of the GitserverClient
+// interface (from the package
+// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
+// used for unit testing.
+type MockGitserverClient struct {
+	// CommitExistsFunc is an instance of a mock function object controlling
+	// the behavior of the method CommitExists.
+	CommitExistsFunc *GitserverClientCommitExistsFunc
+	// CommitGraphFunc is an instance of a mock function object controlling
+	// the behavior of the method CommitGraph.
+	CommitGraphFunc *GitserverClientCommitGraphFunc
+	// RawContentsFunc is an instance of a mock function object controlling
+	// the behavior of the method RawContents.
+	RawContentsFunc *GitserverClientRawContentsFunc
 }
 
-// SetDefaultHook sets function that is called when the QueueIndexes method
-// of the parent MockIndexEnqueuer instance is invoked and the hook queue is
-// empty.
-func (f *IndexEnqueuerQueueIndexesFunc) SetDefaultHook(hook func(context.Context, int, string, string, bool) ([]dbstore.Index, error)) {
-	f.defaultHook = hook
+// NewMockGitserverClient creates a new mock of the GitserverClient
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockGitserverClient() *MockGitserverClient {
+	return &MockGitserverClient{
+		CommitExistsFunc: &GitserverClientCommitExistsFunc{
+			defaultHook: func(context.Context, int, string) (bool, error) {
+				return false, nil
+			},
+		},
+		CommitGraphFunc: &GitserverClientCommitGraphFunc{
+			defaultHook: func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error) {
+				return nil, nil
+			},
+		},
+		RawContentsFunc: &GitserverClientRawContentsFunc{
+			defaultHook: func(context.Context, int, string, string) ([]byte, error) {
+				return nil, nil
+			},
+		},
+	}
 }
 
-// PushHook adds a function to the end of hook queue. Each invocation of the
-// QueueIndexes method of the parent MockIndexEnqueuer instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *IndexEnqueuerQueueIndexesFunc) PushHook(hook func(context.Context, int, string, string, bool) ([]dbstore.Index, error)) {
-	f.mutex.Lock()
-	f.hooks = append(f.hooks, hook)
-	f.mutex.Unlock()
+// NewStrictMockGitserverClient creates a new mock of the GitserverClient
+// interface. All methods panic on invocation, unless overwritten.
+func NewStrictMockGitserverClient() *MockGitserverClient {
+	return &MockGitserverClient{
+		CommitExistsFunc: &GitserverClientCommitExistsFunc{
+			defaultHook: func(context.Context, int, string) (bool, error) {
+				panic("unexpected invocation of MockGitserverClient.CommitExists")
+			},
+		},
+		CommitGraphFunc: &GitserverClientCommitGraphFunc{
+			defaultHook: func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error) {
+				panic("unexpected invocation of MockGitserverClient.CommitGraph")
+			},
+		},
+		RawContentsFunc: &GitserverClientRawContentsFunc{
+			defaultHook: func(context.Context, int, string, string) ([]byte, error) {
+				panic("unexpected invocation of MockGitserverClient.RawContents")
+			},
+		},
+	}
+}
+
+// NewMockGitserverClientFrom creates a new mock of the MockGitserverClient
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockGitserverClientFrom(i GitserverClient) *MockGitserverClient {
+	return &MockGitserverClient{
+		CommitExistsFunc: &GitserverClientCommitExistsFunc{
+			defaultHook: i.CommitExists,
+		},
+		CommitGraphFunc: &GitserverClientCommitGraphFunc{
+			defaultHook: i.CommitGraph,
+		},
+		RawContentsFunc: &GitserverClientRawContentsFunc{
+			defaultHook: i.RawContents,
+		},
+	}
+}
+
+// GitserverClientCommitExistsFunc describes the behavior when the
+// CommitExists method of the parent MockGitserverClient instance is
+// invoked.
+type GitserverClientCommitExistsFunc struct {
+	defaultHook func(context.Context, int, string) (bool, error)
+	hooks       []func(context.Context, int, string) (bool, error)
+	history     []GitserverClientCommitExistsFuncCall
+	mutex       sync.Mutex
+}
+
+// CommitExists delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitserverClient) CommitExists(v0 context.Context, v1 int, v2 string) (bool, error) {
+	r0, r1 := m.CommitExistsFunc.nextHook()(v0, v1, v2)
+	m.CommitExistsFunc.appendCall(GitserverClientCommitExistsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CommitExists method
+// of the parent MockGitserverClient instance is invoked and the hook queue
+// is empty.
+func (f *GitserverClientCommitExistsFunc) SetDefaultHook(hook func(context.Context, int, string) (bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CommitExists method of the parent MockGitserverClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GitserverClientCommitExistsFunc) PushHook(hook func(context.Context, int, string) (bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
 }
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *IndexEnqueuerQueueIndexesFunc) SetDefaultReturn(r0 []dbstore.Index, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string, string, bool) ([]dbstore.Index, error) {
+func (f *GitserverClientCommitExistsFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string) (bool, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *IndexEnqueuerQueueIndexesFunc) PushReturn(r0 []dbstore.Index, r1 error) {
-	f.PushHook(func(context.Context, int, string, string, bool) ([]dbstore.Index, error) {
+func (f *GitserverClientCommitExistsFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, int, string) (bool, error) {
 		return r0, r1
 	})
 }
 
-func (f *IndexEnqueuerQueueIndexesFunc) nextHook() func(context.Context, int, string, string, bool) ([]dbstore.Index, error) {
+func (f *GitserverClientCommitExistsFunc) nextHook() func(context.Context, int, string) (bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -5669,26 +5815,26 @@ func (f *IndexEnqueuerQueueIndexesFunc) nextHook() func(context.Context, int, st
 	return hook
 }
 
-func (f *IndexEnqueuerQueueIndexesFunc) appendCall(r0 IndexEnqueuerQueueIndexesFuncCall) {
+func (f *GitserverClientCommitExistsFunc) appendCall(r0 GitserverClientCommitExistsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of IndexEnqueuerQueueIndexesFuncCall objects
+// History returns a sequence of GitserverClientCommitExistsFuncCall objects
 // describing the invocations of this function.
-func (f *IndexEnqueuerQueueIndexesFunc) History() []IndexEnqueuerQueueIndexesFuncCall {
+func (f *GitserverClientCommitExistsFunc) History() []GitserverClientCommitExistsFuncCall {
 	f.mutex.Lock()
-	history := make([]IndexEnqueuerQueueIndexesFuncCall, len(f.history))
+	history := make([]GitserverClientCommitExistsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// IndexEnqueuerQueueIndexesFuncCall is an object that describes an
-// invocation of method QueueIndexes on an instance of MockIndexEnqueuer.
-type IndexEnqueuerQueueIndexesFuncCall struct {
+// GitserverClientCommitExistsFuncCall is an object that describes an
+// invocation of method CommitExists on an instance of MockGitserverClient.
+type GitserverClientCommitExistsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -5698,15 +5844,9 @@ type IndexEnqueuerQueueIndexesFuncCall struct {
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
 	Arg2 string
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 string
-	// Arg4 is the value of the 5th argument passed to this method
-	// invocation.
-	Arg4 bool
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []dbstore.Index
+	Result0 bool
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -5714,330 +5854,1646 @@ type IndexEnqueuerQueueIndexesFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c IndexEnqueuerQueueIndexesFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+func (c GitserverClientCommitExistsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c IndexEnqueuerQueueIndexesFuncCall) Results() []interface{} {
+func (c GitserverClientCommitExistsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// MockLSIFStore is a mock implementation of the LSIFStore interface (from
-// the package
-// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
-// used for unit testing.
-type MockLSIFStore struct {
-	// BulkMonikerResultsFunc is an instance of a mock function object
-	// controlling the behavior of the method BulkMonikerResults.
-	BulkMonikerResultsFunc *LSIFStoreBulkMonikerResultsFunc
-	// DefinitionsFunc is an instance of a mock function object controlling
-	// the behavior of the method Definitions.
-	DefinitionsFunc *LSIFStoreDefinitionsFunc
-	// DiagnosticsFunc is an instance of a mock function object controlling
-	// the behavior of the method Diagnostics.
-	DiagnosticsFunc *LSIFStoreDiagnosticsFunc
-	// DocumentationAtPositionFunc is an instance of a mock function object
-	// controlling the behavior of the method DocumentationAtPosition.
-	DocumentationAtPositionFunc *LSIFStoreDocumentationAtPositionFunc
-	// DocumentationDefinitionsFunc is an instance of a mock function object
-	// controlling the behavior of the method DocumentationDefinitions.
-	DocumentationDefinitionsFunc *LSIFStoreDocumentationDefinitionsFunc
-	// DocumentationPageFunc is an instance of a mock function object
-	// controlling the behavior of the method DocumentationPage.
-	DocumentationPageFunc *LSIFStoreDocumentationPageFunc
-	// DocumentationPathInfoFunc is an instance of a mock function object
-	// controlling the behavior of the method DocumentationPathInfo.
-	DocumentationPathInfoFunc *LSIFStoreDocumentationPathInfoFunc
-	// DocumentationSearchFunc is an instance of a mock function object
-	// controlling the behavior of the method DocumentationSearch.
-	DocumentationSearchFunc *LSIFStoreDocumentationSearchFunc
-	// ExistsFunc is an instance of a mock function object controlling the
-	// behavior of the method Exists.
-	ExistsFunc *LSIFStoreExistsFunc
-	// HoverFunc is an instance of a mock function object controlling the
-	// behavior of the method Hover.
-	HoverFunc *LSIFStoreHoverFunc
-	// ImplementationsFunc is an instance of a mock function object
-	// controlling the behavior of the method Implementations.
-	ImplementationsFunc *LSIFStoreImplementationsFunc
-	// MonikersByPositionFunc is an instance of a mock function object
-	// controlling the behavior of the method MonikersByPosition.
-	MonikersByPositionFunc *LSIFStoreMonikersByPositionFunc
-	// PackageInformationFunc is an instance of a mock function object
-	// controlling the behavior of the method PackageInformation.
-	PackageInformationFunc *LSIFStorePackageInformationFunc
-	// RangesFunc is an instance of a mock function object controlling the
-	// behavior of the method Ranges.
-	RangesFunc *LSIFStoreRangesFunc
-	// ReferencesFunc is an instance of a mock function object controlling
-	// the behavior of the method References.
-	ReferencesFunc *LSIFStoreReferencesFunc
-	// StencilFunc is an instance of a mock function object controlling the
-	// behavior of the method Stencil.
-	StencilFunc *LSIFStoreStencilFunc
-}
-
-// NewMockLSIFStore creates a new mock of the LSIFStore interface. All
-// methods return zero values for all results, unless overwritten.
-func NewMockLSIFStore() *MockLSIFStore {
-	return &MockLSIFStore{
-		BulkMonikerResultsFunc: &LSIFStoreBulkMonikerResultsFunc{
-			defaultHook: func(context.Context, string, []int, []precise.MonikerData, int, int) ([]lsifstore.Location, int, error) {
-				return nil, 0, nil
-			},
-		},
-		DefinitionsFunc: &LSIFStoreDefinitionsFunc{
-			defaultHook: func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
-				return nil, 0, nil
-			},
-		},
-		DiagnosticsFunc: &LSIFStoreDiagnosticsFunc{
-			defaultHook: func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error) {
-				return nil, 0, nil
-			},
-		},
-		DocumentationAtPositionFunc: &LSIFStoreDocumentationAtPositionFunc{
-			defaultHook: func(context.Context, int, string, int, int) ([]string, error) {
-				return nil, nil
-			},
-		},
-		DocumentationDefinitionsFunc: &LSIFStoreDocumentationDefinitionsFunc{
-			defaultHook: func(context.Context, int, string, int, int) ([]lsifstore.Location, int, error) {
-				return nil, 0, nil
-			},
-		},
-		DocumentationPageFunc: &LSIFStoreDocumentationPageFunc{
-			defaultHook: func(context.Context, int, string) (*precise.DocumentationPageData, error) {
-				return nil, nil
-			},
-		},
-		DocumentationPathInfoFunc: &LSIFStoreDocumentationPathInfoFunc{
-			defaultHook: func(context.Context, int, string) (*precise.DocumentationPathInfoData, error) {
-				return nil, nil
-			},
-		},
-		DocumentationSearchFunc: &LSIFStoreDocumentationSearchFunc{
-			defaultHook: func(context.Context, string, string, []string) ([]precise.DocumentationSearchResult, error) {
-				return nil, nil
-			},
-		},
-		ExistsFunc: &LSIFStoreExistsFunc{
-			defaultHook: func(context.Context, int, string) (bool, error) {
-				return false, nil
-			},
-		},
-		HoverFunc: &LSIFStoreHoverFunc{
-			defaultHook: func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error) {
-				return "", lsifstore.Range{}, false, nil
-			},
-		},
-		ImplementationsFunc: &LSIFStoreImplementationsFunc{
-			defaultHook: func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
-				return nil, 0, nil
-			},
-		},
-		MonikersByPositionFunc: &LSIFStoreMonikersByPositionFunc{
-			defaultHook: func(context.Context, int, string, int, int) ([][]precise.MonikerData, error) {
-				return nil, nil
-			},
-		},
-		PackageInformationFunc: &LSIFStorePackageInformationFunc{
-			defaultHook: func(context.Context, int, string, string) (precise.PackageInformationData, bool, error) {
-				return precise.PackageInformationData{}, false, nil
-			},
-		},
-		RangesFunc: &LSIFStoreRangesFunc{
-			defaultHook: func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error) {
-				return nil, nil
-			},
-		},
-		ReferencesFunc: &LSIFStoreReferencesFunc{
-			defaultHook: func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
-				return nil, 0, nil
-			},
-		},
-		StencilFunc: &LSIFStoreStencilFunc{
-			defaultHook: func(context.Context, int, string) ([]lsifstore.Range, error) {
-				return nil, nil
-			},
-		},
-	}
+// GitserverClientCommitGraphFunc describes the behavior when the
+// CommitGraph method of the parent MockGitserverClient instance is invoked.
+type GitserverClientCommitGraphFunc struct {
+	defaultHook func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error)
+	hooks       []func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error)
+	history     []GitserverClientCommitGraphFuncCall
+	mutex       sync.Mutex
 }
 
-// NewStrictMockLSIFStore creates a new mock of the LSIFStore interface. All
-// methods panic on invocation, unless overwritten.
-func NewStrictMockLSIFStore() *MockLSIFStore {
-	return &MockLSIFStore{
-		BulkMonikerResultsFunc: &LSIFStoreBulkMonikerResultsFunc{
-			defaultHook: func(context.Context, string, []int, []precise.MonikerData, int, int) ([]lsifstore.Location, int, error) {
-				panic("unexpected invocation of MockLSIFStore.BulkMonikerResults")
-			},
-		},
-		DefinitionsFunc: &LSIFStoreDefinitionsFunc{
-			defaultHook: func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
-				panic("unexpected invocation of MockLSIFStore.Definitions")
+// CommitGraph delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitserverClient) CommitGraph(v0 context.Context, v1 int, v2 gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error) {
+	r0, r1 := m.CommitGraphFunc.nextHook()(v0, v1, v2)
+	m.CommitGraphFunc.appendCall(GitserverClientCommitGraphFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CommitGraph method
+// of the parent MockGitserverClient instance is invoked and the hook queue
+// is empty.
+func (f *GitserverClientCommitGraphFunc) SetDefaultHook(hook func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CommitGraph method of the parent MockGitserverClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GitserverClientCommitGraphFunc) PushHook(hook func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *GitserverClientCommitGraphFunc) SetDefaultReturn(r0 *gitserver.CommitGraph, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *GitserverClientCommitGraphFunc) PushReturn(r0 *gitserver.CommitGraph, r1 error) {
+	f.PushHook(func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverClientCommitGraphFunc) nextHook() func(context.Context, int, gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverClientCommitGraphFunc) appendCall(r0 GitserverClientCommitGraphFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverClientCommitGraphFuncCall objects
+// describing the invocations of this function.
+func (f *GitserverClientCommitGraphFunc) History() []GitserverClientCommitGraphFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverClientCommitGraphFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverClientCommitGraphFuncCall is an object that describes an
+// invocation of method CommitGraph on an instance of MockGitserverClient.
+type GitserverClientCommitGraphFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 gitserver.CommitGraphOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *gitserver.CommitGraph
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverClientCommitGraphFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverClientCommitGraphFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitserverClientRawContentsFunc describes the behavior when the
+// RawContents method of the parent MockGitserverClient instance is invoked.
+type GitserverClientRawContentsFunc struct {
+	defaultHook func(context.Context, int, string, string) ([]byte, error)
+	hooks       []func(context.Context, int, string, string) ([]byte, error)
+	history     []GitserverClientRawContentsFuncCall
+	mutex       sync.Mutex
+}
+
+// RawContents delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitserverClient) RawContents(v0 context.Context, v1 int, v2 string, v3 string) ([]byte, error) {
+	r0, r1 := m.RawContentsFunc.nextHook()(v0, v1, v2, v3)
+	m.RawContentsFunc.appendCall(GitserverClientRawContentsFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RawContents method
+// of the parent MockGitserverClient instance is invoked and the hook queue
+// is empty.
+func (f *GitserverClientRawContentsFunc) SetDefaultHook(hook func(context.Context, int, string, string) ([]byte, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RawContents method of the parent MockGitserverClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GitserverClientRawContentsFunc) PushHook(hook func(context.Context, int, string, string) ([]byte, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *GitserverClientRawContentsFunc) SetDefaultReturn(r0 []byte, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, string) ([]byte, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *GitserverClientRawContentsFunc) PushReturn(r0 []byte, r1 error) {
+	f.PushHook(func(context.Context, int, string, string) ([]byte, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverClientRawContentsFunc) nextHook() func(context.Context, int, string, string) ([]byte, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverClientRawContentsFunc) appendCall(r0 GitserverClientRawContentsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverClientRawContentsFuncCall objects
+// describing the invocations of this function.
+func (f *GitserverClientRawContentsFunc) History() []GitserverClientRawContentsFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverClientRawContentsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverClientRawContentsFuncCall is an object that describes an
+// invocation of method RawContents on an instance of MockGitserverClient.
+type GitserverClientRawContentsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []byte
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverClientRawContentsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverClientRawContentsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// MockIndexEnqueuer is a mock implementation of the IndexEnqueuer interface
+// (from the package
+// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
+// used for unit testing.
+type MockIndexEnqueuer struct {
+	// InferIndexConfigurationFunc is an instance of a mock function object
+	// controlling the behavior of the method InferIndexConfiguration.
+	InferIndexConfigurationFunc *IndexEnqueuerInferIndexConfigurationFunc
+	// QueueIndexesFunc is an instance of a mock function object controlling
+	// the behavior of the method QueueIndexes.
+	QueueIndexesFunc *IndexEnqueuerQueueIndexesFunc
+}
+
+// NewMockIndexEnqueuer creates a new mock of the IndexEnqueuer interface.
+// All methods return zero values for all results, unless overwritten.
+func NewMockIndexEnqueuer() *MockIndexEnqueuer {
+	return &MockIndexEnqueuer{
+		InferIndexConfigurationFunc: &IndexEnqueuerInferIndexConfigurationFunc{
+			defaultHook: func(context.Context, int) (*config.IndexConfiguration, error) {
+				return nil, nil
 			},
 		},
-		DiagnosticsFunc: &LSIFStoreDiagnosticsFunc{
-			defaultHook: func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error) {
-				panic("unexpected invocation of MockLSIFStore.Diagnostics")
+		QueueIndexesFunc: &IndexEnqueuerQueueIndexesFunc{
+			defaultHook: func(context.Context, int, string, string, bool) ([]dbstore.Index, error) {
+				return nil, nil
 			},
 		},
-		DocumentationAtPositionFunc: &LSIFStoreDocumentationAtPositionFunc{
-			defaultHook: func(context.Context, int, string, int, int) ([]string, error) {
-				panic("unexpected invocation of MockLSIFStore.DocumentationAtPosition")
+	}
+}
+
+// NewStrictMockIndexEnqueuer creates a new mock of the IndexEnqueuer
+// interface. All methods panic on invocation, unless overwritten.
+func NewStrictMockIndexEnqueuer() *MockIndexEnqueuer {
+	return &MockIndexEnqueuer{
+		InferIndexConfigurationFunc: &IndexEnqueuerInferIndexConfigurationFunc{
+			defaultHook: func(context.Context, int) (*config.IndexConfiguration, error) {
+				panic("unexpected invocation of MockIndexEnqueuer.InferIndexConfiguration")
 			},
 		},
-		DocumentationDefinitionsFunc: &LSIFStoreDocumentationDefinitionsFunc{
-			defaultHook: func(context.Context, int, string, int, int) ([]lsifstore.Location, int, error) {
-				panic("unexpected invocation of MockLSIFStore.DocumentationDefinitions")
-			},
-		},
-		DocumentationPageFunc: &LSIFStoreDocumentationPageFunc{
-			defaultHook: func(context.Context, int, string) (*precise.DocumentationPageData, error) {
-				panic("unexpected invocation of MockLSIFStore.DocumentationPage")
-			},
-		},
-		DocumentationPathInfoFunc: &LSIFStoreDocumentationPathInfoFunc{
-			defaultHook: func(context.Context, int, string) (*precise.DocumentationPathInfoData, error) {
-				panic("unexpected invocation of MockLSIFStore.DocumentationPathInfo")
-			},
-		},
-		DocumentationSearchFunc: &LSIFStoreDocumentationSearchFunc{
-			defaultHook: func(context.Context, string, string, []string) ([]precise.DocumentationSearchResult, error) {
-				panic("unexpected invocation of MockLSIFStore.DocumentationSearch")
-			},
-		},
-		ExistsFunc: &LSIFStoreExistsFunc{
-			defaultHook: func(context.Context, int, string) (bool, error) {
-				panic("unexpected invocation of MockLSIFStore.Exists")
-			},
-		},
-		HoverFunc: &LSIFStoreHoverFunc{
-			defaultHook: func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error) {
-				panic("unexpected invocation of MockLSIFStore.Hover")
-			},
-		},
-		ImplementationsFunc: &LSIFStoreImplementationsFunc{
-			defaultHook: func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
-				panic("unexpected invocation of MockLSIFStore.Implementations")
-			},
-		},
-		MonikersByPositionFunc: &LSIFStoreMonikersByPositionFunc{
-			defaultHook: func(context.Context, int, string, int, int) ([][]precise.MonikerData, error) {
-				panic("unexpected invocation of MockLSIFStore.MonikersByPosition")
-			},
-		},
-		PackageInformationFunc: &LSIFStorePackageInformationFunc{
-			defaultHook: func(context.Context, int, string, string) (precise.PackageInformationData, bool, error) {
-				panic("unexpected invocation of MockLSIFStore.PackageInformation")
-			},
-		},
-		RangesFunc: &LSIFStoreRangesFunc{
-			defaultHook: func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error) {
-				panic("unexpected invocation of MockLSIFStore.Ranges")
-			},
-		},
-		ReferencesFunc: &LSIFStoreReferencesFunc{
-			defaultHook: func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
-				panic("unexpected invocation of MockLSIFStore.References")
-			},
-		},
-		StencilFunc: &LSIFStoreStencilFunc{
-			defaultHook: func(context.Context, int, string) ([]lsifstore.Range, error) {
-				panic("unexpected invocation of MockLSIFStore.Stencil")
+		QueueIndexesFunc: &IndexEnqueuerQueueIndexesFunc{
+			defaultHook: func(context.Context, int, string, string, bool) ([]dbstore.Index, error) {
+				panic("unexpected invocation of MockIndexEnqueuer.QueueIndexes")
 			},
 		},
 	}
 }
 
-// NewMockLSIFStoreFrom creates a new mock of the MockLSIFStore interface.
-// All methods delegate to the given implementation, unless overwritten.
-func NewMockLSIFStoreFrom(i LSIFStore) *MockLSIFStore {
-	return &MockLSIFStore{
-		BulkMonikerResultsFunc: &LSIFStoreBulkMonikerResultsFunc{
-			defaultHook: i.BulkMonikerResults,
-		},
-		DefinitionsFunc: &LSIFStoreDefinitionsFunc{
-			defaultHook: i.Definitions,
-		},
-		DiagnosticsFunc: &LSIFStoreDiagnosticsFunc{
-			defaultHook: i.Diagnostics,
-		},
-		DocumentationAtPositionFunc: &LSIFStoreDocumentationAtPositionFunc{
-			defaultHook: i.DocumentationAtPosition,
-		},
-		DocumentationDefinitionsFunc: &LSIFStoreDocumentationDefinitionsFunc{
-			defaultHook: i.DocumentationDefinitions,
-		},
-		DocumentationPageFunc: &LSIFStoreDocumentationPageFunc{
-			defaultHook: i.DocumentationPage,
-		},
-		DocumentationPathInfoFunc: &LSIFStoreDocumentationPathInfoFunc{
-			defaultHook: i.DocumentationPathInfo,
-		},
-		DocumentationSearchFunc: &LSIFStoreDocumentationSearchFunc{
-			defaultHook: i.DocumentationSearch,
-		},
-		ExistsFunc: &LSIFStoreExistsFunc{
-			defaultHook: i.Exists,
-		},
-		HoverFunc: &LSIFStoreHoverFunc{
-			defaultHook: i.Hover,
-		},
-		ImplementationsFunc: &LSIFStoreImplementationsFunc{
-			defaultHook: i.Implementations,
-		},
-		MonikersByPositionFunc: &LSIFStoreMonikersByPositionFunc{
-			defaultHook: i.MonikersByPosition,
-		},
-		PackageInformationFunc: &LSIFStorePackageInformationFunc{
-			defaultHook: i.PackageInformation,
-		},
-		RangesFunc: &LSIFStoreRangesFunc{
-			defaultHook: i.Ranges,
-		},
-		ReferencesFunc: &LSIFStoreReferencesFunc{
-			defaultHook: i.References,
+// NewMockIndexEnqueuerFrom creates a new mock of the MockIndexEnqueuer
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockIndexEnqueuerFrom(i IndexEnqueuer) *MockIndexEnqueuer {
+	return &MockIndexEnqueuer{
+		InferIndexConfigurationFunc: &IndexEnqueuerInferIndexConfigurationFunc{
+			defaultHook: i.InferIndexConfiguration,
 		},
-		StencilFunc: &LSIFStoreStencilFunc{
-			defaultHook: i.Stencil,
+		QueueIndexesFunc: &IndexEnqueuerQueueIndexesFunc{
+			defaultHook: i.QueueIndexes,
 		},
 	}
 }
 
-// LSIFStoreBulkMonikerResultsFunc describes the behavior when the
-// BulkMonikerResults method of the parent MockLSIFStore instance is
-// invoked.
-type LSIFStoreBulkMonikerResultsFunc struct {
-	defaultHook func(context.Context, string, []int, []precise.MonikerData, int, int) ([]lsifstore.Location, int, error)
-	hooks       []func(context.Context, string, []int, []precise.MonikerData, int, int) ([]lsifstore.Location, int, error)
-	history     []LSIFStoreBulkMonikerResultsFuncCall
+// IndexEnqueuerInferIndexConfigurationFunc describes the behavior when the
+// InferIndexConfiguration method of the parent MockIndexEnqueuer instance
+// is invoked.
+type IndexEnqueuerInferIndexConfigurationFunc struct {
+	defaultHook func(context.Context, int) (*config.IndexConfiguration, error)
+	hooks       []func(context.Context, int) (*config.IndexConfiguration, error)
+	history     []IndexEnqueuerInferIndexConfigurationFuncCall
+	mutex       sync.Mutex
+}
+
+// InferIndexConfiguration delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockIndexEnqueuer) InferIndexConfiguration(v0 context.Context, v1 int) (*config.IndexConfiguration, error) {
+	r0, r1 := m.InferIndexConfigurationFunc.nextHook()(v0, v1)
+	m.InferIndexConfigurationFunc.appendCall(IndexEnqueuerInferIndexConfigurationFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// InferIndexConfiguration method of the parent MockIndexEnqueuer instance
+// is invoked and the hook queue is empty.
+func (f *IndexEnqueuerInferIndexConfigurationFunc) SetDefaultHook(hook func(context.Context, int) (*config.IndexConfiguration, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// InferIndexConfiguration method of the parent MockIndexEnqueuer instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *IndexEnqueuerInferIndexConfigurationFunc) PushHook(hook func(context.Context, int) (*config.IndexConfiguration, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *IndexEnqueuerInferIndexConfigurationFunc) SetDefaultReturn(r0 *config.IndexConfiguration, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) (*config.IndexConfiguration, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *IndexEnqueuerInferIndexConfigurationFunc) PushReturn(r0 *config.IndexConfiguration, r1 error) {
+	f.PushHook(func(context.Context, int) (*config.IndexConfiguration, error) {
+		return r0, r1
+	})
+}
+
+func (f *IndexEnqueuerInferIndexConfigurationFunc) nextHook() func(context.Context, int) (*config.IndexConfiguration, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *IndexEnqueuerInferIndexConfigurationFunc) appendCall(r0 IndexEnqueuerInferIndexConfigurationFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// IndexEnqueuerInferIndexConfigurationFuncCall objects describing the
+// invocations of this function.
+func (f *IndexEnqueuerInferIndexConfigurationFunc) History() []IndexEnqueuerInferIndexConfigurationFuncCall {
+	f.mutex.Lock()
+	history := make([]IndexEnqueuerInferIndexConfigurationFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// IndexEnqueuerInferIndexConfigurationFuncCall is an object that describes
+// an invocation of method InferIndexConfiguration on an instance of
+// MockIndexEnqueuer.
+type IndexEnqueuerInferIndexConfigurationFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *config.IndexConfiguration
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c IndexEnqueuerInferIndexConfigurationFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c IndexEnqueuerInferIndexConfigurationFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// IndexEnqueuerQueueIndexesFunc describes the behavior when the
+// QueueIndexes method of the parent MockIndexEnqueuer instance is invoked.
+type IndexEnqueuerQueueIndexesFunc struct {
+	defaultHook func(context.Context, int, string, string, bool) ([]dbstore.Index, error)
+	hooks       []func(context.Context, int, string, string, bool) ([]dbstore.Index, error)
+	history     []IndexEnqueuerQueueIndexesFuncCall
+	mutex       sync.Mutex
+}
+
+// QueueIndexes delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockIndexEnqueuer) QueueIndexes(v0 context.Context, v1 int, v2 string, v3 string, v4 bool) ([]dbstore.Index, error) {
+	r0, r1 := m.QueueIndexesFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.QueueIndexesFunc.appendCall(IndexEnqueuerQueueIndexesFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the QueueIndexes method
+// of the parent MockIndexEnqueuer instance is invoked and the hook queue is
+// empty.
+func (f *IndexEnqueuerQueueIndexesFunc) SetDefaultHook(hook func(context.Context, int, string, string, bool) ([]dbstore.Index, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// QueueIndexes method of the parent MockIndexEnqueuer instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *IndexEnqueuerQueueIndexesFunc) PushHook(hook func(context.Context, int, string, string, bool) ([]dbstore.Index, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *IndexEnqueuerQueueIndexesFunc) SetDefaultReturn(r0 []dbstore.Index, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, string, bool) ([]dbstore.Index, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *IndexEnqueuerQueueIndexesFunc) PushReturn(r0 []dbstore.Index, r1 error) {
+	f.PushHook(func(context.Context, int, string, string, bool) ([]dbstore.Index, error) {
+		return r0, r1
+	})
+}
+
+func (f *IndexEnqueuerQueueIndexesFunc) nextHook() func(context.Context, int, string, string, bool) ([]dbstore.Index, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *IndexEnqueuerQueueIndexesFunc) appendCall(r0 IndexEnqueuerQueueIndexesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of IndexEnqueuerQueueIndexesFuncCall objects
+// describing the invocations of this function.
+func (f *IndexEnqueuerQueueIndexesFunc) History() []IndexEnqueuerQueueIndexesFuncCall {
+	f.mutex.Lock()
+	history := make([]IndexEnqueuerQueueIndexesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// IndexEnqueuerQueueIndexesFuncCall is an object that describes an
+// invocation of method QueueIndexes on an instance of MockIndexEnqueuer.
+type IndexEnqueuerQueueIndexesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 bool
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []dbstore.Index
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c IndexEnqueuerQueueIndexesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c IndexEnqueuerQueueIndexesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// MockLSIFStore is a mock implementation of the LSIFStore interface (from
+// the package
+// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
+// used for unit testing.
+type MockLSIFStore struct {
+	// BulkMonikerLocationsFunc is an instance of a mock function object
+	// controlling the behavior of the method BulkMonikerLocations.
+	BulkMonikerLocationsFunc *LSIFStoreBulkMonikerLocationsFunc
+	// BulkMonikerResultsFunc is an instance of a mock function object
+	// controlling the behavior of the method BulkMonikerResults.
+	BulkMonikerResultsFunc *LSIFStoreBulkMonikerResultsFunc
+	// DefinitionsFunc is an instance of a mock function object controlling
+	// the behavior of the method Definitions.
+	DefinitionsFunc *LSIFStoreDefinitionsFunc
+	// DiagnosticsFunc is an instance of a mock function object controlling
+	// the behavior of the method Diagnostics.
+	DiagnosticsFunc *LSIFStoreDiagnosticsFunc
+	// DocumentationAtPositionFunc is an instance of a mock function object
+	// controlling the behavior of the method DocumentationAtPosition.
+	DocumentationAtPositionFunc *LSIFStoreDocumentationAtPositionFunc
+	// DocumentationDefinitionsFunc is an instance of a mock function object
+	// controlling the behavior of the method DocumentationDefinitions.
+	DocumentationDefinitionsFunc *LSIFStoreDocumentationDefinitionsFunc
+	// DocumentationPageFunc is an instance of a mock function object
+	// controlling the behavior of the method DocumentationPage.
+	DocumentationPageFunc *LSIFStoreDocumentationPageFunc
+	// DocumentationPathInfoFunc is an instance of a mock function object
+	// controlling the behavior of the method DocumentationPathInfo.
+	DocumentationPathInfoFunc *LSIFStoreDocumentationPathInfoFunc
+	// DocumentationSearchFunc is an instance of a mock function object
+	// controlling the behavior of the method DocumentationSearch.
+	DocumentationSearchFunc *LSIFStoreDocumentationSearchFunc
+	// EnclosingSymbolsFunc is an instance of a mock function object
+	// controlling the behavior of the method EnclosingSymbols.
+	EnclosingSymbolsFunc *LSIFStoreEnclosingSymbolsFunc
+	// ExistsFunc is an instance of a mock function object controlling the
+	// behavior of the method Exists.
+	ExistsFunc *LSIFStoreExistsFunc
+	// HoverFunc is an instance of a mock function object controlling the
+	// behavior of the method Hover.
+	HoverFunc *LSIFStoreHoverFunc
+	// ImplementationsFunc is an instance of a mock function object
+	// controlling the behavior of the method Implementations.
+	ImplementationsFunc *LSIFStoreImplementationsFunc
+	// MonikersByPositionFunc is an instance of a mock function object
+	// controlling the behavior of the method MonikersByPosition.
+	MonikersByPositionFunc *LSIFStoreMonikersByPositionFunc
+	// PackageInformationFunc is an instance of a mock function object
+	// controlling the behavior of the method PackageInformation.
+	PackageInformationFunc *LSIFStorePackageInformationFunc
+	// RangesFunc is an instance of a mock function object controlling the
+	// behavior of the method Ranges.
+	RangesFunc *LSIFStoreRangesFunc
+	// ReferencesFunc is an instance of a mock function object controlling
+	// the behavior of the method References.
+	ReferencesFunc *LSIFStoreReferencesFunc
+	// StencilFunc is an instance of a mock function object controlling the
+	// behavior of the method Stencil.
+	StencilFunc *LSIFStoreStencilFunc
+	// SymbolsFunc is an instance of a mock function object controlling the
+	// behavior of the method Symbols.
+	SymbolsFunc *LSIFStoreSymbolsFunc
+	// TypeDefinitionsFunc is an instance of a mock function object
+	// controlling the behavior of the method TypeDefinitions.
+	TypeDefinitionsFunc *LSIFStoreTypeDefinitionsFunc
+}
+
+// NewMockLSIFStore creates a new mock of the LSIFStore interface. All
+// methods return zero values for all results, unless overwritten.
+func NewMockLSIFStore() *MockLSIFStore {
+	return &MockLSIFStore{
+		BulkMonikerLocationsFunc: &LSIFStoreBulkMonikerLocationsFunc{
+			defaultHook: func(context.Context, string, []int, []precise.MonikerData) ([]lsifstore.QualifiedMonikerLocations, error) {
+				return nil, nil
+			},
+		},
+		BulkMonikerResultsFunc: &LSIFStoreBulkMonikerResultsFunc{
+			defaultHook: func(context.Context, string, []int, []precise.MonikerData, int, int) ([]lsifstore.Location, int, error) {
+				return nil, 0, nil
+			},
+		},
+		DefinitionsFunc: &LSIFStoreDefinitionsFunc{
+			defaultHook: func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+				return nil, 0, nil
+			},
+		},
+		DiagnosticsFunc: &LSIFStoreDiagnosticsFunc{
+			defaultHook: func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error) {
+				return nil, 0, nil
+			},
+		},
+		DocumentationAtPositionFunc: &LSIFStoreDocumentationAtPositionFunc{
+			defaultHook: func(context.Context, int, string, int, int) ([]string, error) {
+				return nil, nil
+			},
+		},
+		DocumentationDefinitionsFunc: &LSIFStoreDocumentationDefinitionsFunc{
+			defaultHook: func(context.Context, int, string, int, int) ([]lsifstore.Location, int, error) {
+				return nil, 0, nil
+			},
+		},
+		DocumentationPageFunc: &LSIFStoreDocumentationPageFunc{
+			defaultHook: func(context.Context, int, string) (*precise.DocumentationPageData, error) {
+				return nil, nil
+			},
+		},
+		DocumentationPathInfoFunc: &LSIFStoreDocumentationPathInfoFunc{
+			defaultHook: func(context.Context, int, string) (*precise.DocumentationPathInfoData, error) {
+				return nil, nil
+			},
+		},
+		DocumentationSearchFunc: &LSIFStoreDocumentationSearchFunc{
+			defaultHook: func(context.Context, string, string, []string) ([]precise.DocumentationSearchResult, error) {
+				return nil, nil
+			},
+		},
+		EnclosingSymbolsFunc: &LSIFStoreEnclosingSymbolsFunc{
+			defaultHook: func(context.Context, int, string, []lsifstore.Range) ([]*lsifstore.Symbol, error) {
+				return nil, nil
+			},
+		},
+		ExistsFunc: &LSIFStoreExistsFunc{
+			defaultHook: func(context.Context, int, string) (bool, error) {
+				return false, nil
+			},
+		},
+		HoverFunc: &LSIFStoreHoverFunc{
+			defaultHook: func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error) {
+				return "", lsifstore.Range{}, false, nil
+			},
+		},
+		ImplementationsFunc: &LSIFStoreImplementationsFunc{
+			defaultHook: func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+				return nil, 0, nil
+			},
+		},
+		MonikersByPositionFunc: &LSIFStoreMonikersByPositionFunc{
+			defaultHook: func(context.Context, int, string, int, int) ([][]precise.MonikerData, error) {
+				return nil, nil
+			},
+		},
+		PackageInformationFunc: &LSIFStorePackageInformationFunc{
+			defaultHook: func(context.Context, int, string, string) (precise.PackageInformationData, bool, error) {
+				return precise.PackageInformationData{}, false, nil
+			},
+		},
+		RangesFunc: &LSIFStoreRangesFunc{
+			defaultHook: func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error) {
+				return nil, nil
+			},
+		},
+		ReferencesFunc: &LSIFStoreReferencesFunc{
+			defaultHook: func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+				return nil, 0, nil
+			},
+		},
+		StencilFunc: &LSIFStoreStencilFunc{
+			defaultHook: func(context.Context, int, string) ([]lsifstore.Range, error) {
+				return nil, nil
+			},
+		},
+		SymbolsFunc: &LSIFStoreSymbolsFunc{
+			defaultHook: func(context.Context, int, string, []lsifstore.Range) ([]*lsifstore.Symbol, error) {
+				return nil, nil
+			},
+		},
+		TypeDefinitionsFunc: &LSIFStoreTypeDefinitionsFunc{
+			defaultHook: func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+				return nil, 0, nil
+			},
+		},
+	}
+}
+
+// NewStrictMockLSIFStore creates a new mock of the LSIFStore interface. All
+// methods panic on invocation, unless overwritten.
+func NewStrictMockLSIFStore() *MockLSIFStore {
+	return &MockLSIFStore{
+		BulkMonikerLocationsFunc: &LSIFStoreBulkMonikerLocationsFunc{
+			defaultHook: func(context.Context, string, []int, []precise.MonikerData) ([]lsifstore.QualifiedMonikerLocations, error) {
+				panic("unexpected invocation of MockLSIFStore.BulkMonikerLocations")
+			},
+		},
+		BulkMonikerResultsFunc: &LSIFStoreBulkMonikerResultsFunc{
+			defaultHook: func(context.Context, string, []int, []precise.MonikerData, int, int) ([]lsifstore.Location, int, error) {
+				panic("unexpected invocation of MockLSIFStore.BulkMonikerResults")
+			},
+		},
+		DefinitionsFunc: &LSIFStoreDefinitionsFunc{
+			defaultHook: func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+				panic("unexpected invocation of MockLSIFStore.Definitions")
+			},
+		},
+		DiagnosticsFunc: &LSIFStoreDiagnosticsFunc{
+			defaultHook: func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error) {
+				panic("unexpected invocation of MockLSIFStore.Diagnostics")
+			},
+		},
+		DocumentationAtPositionFunc: &LSIFStoreDocumentationAtPositionFunc{
+			defaultHook: func(context.Context, int, string, int, int) ([]string, error) {
+				panic("unexpected invocation of MockLSIFStore.DocumentationAtPosition")
+			},
+		},
+		DocumentationDefinitionsFunc: &LSIFStoreDocumentationDefinitionsFunc{
+			defaultHook: func(context.Context, int, string, int, int) ([]lsifstore.Location, int, error) {
+				panic("unexpected invocation of MockLSIFStore.DocumentationDefinitions")
+			},
+		},
+		DocumentationPageFunc: &LSIFStoreDocumentationPageFunc{
+			defaultHook: func(context.Context, int, string) (*precise.DocumentationPageData, error) {
+				panic("unexpected invocation of MockLSIFStore.DocumentationPage")
+			},
+		},
+		DocumentationPathInfoFunc: &LSIFStoreDocumentationPathInfoFunc{
+			defaultHook: func(context.Context, int, string) (*precise.DocumentationPathInfoData, error) {
+				panic("unexpected invocation of MockLSIFStore.DocumentationPathInfo")
+			},
+		},
+		DocumentationSearchFunc: &LSIFStoreDocumentationSearchFunc{
+			defaultHook: func(context.Context, string, string, []string) ([]precise.DocumentationSearchResult, error) {
+				panic("unexpected invocation of MockLSIFStore.DocumentationSearch")
+			},
+		},
+		EnclosingSymbolsFunc: &LSIFStoreEnclosingSymbolsFunc{
+			defaultHook: func(context.Context, int, string, []lsifstore.Range) ([]*lsifstore.Symbol, error) {
+				panic("unexpected invocation of MockLSIFStore.EnclosingSymbols")
+			},
+		},
+		ExistsFunc: &LSIFStoreExistsFunc{
+			defaultHook: func(context.Context, int, string) (bool, error) {
+				panic("unexpected invocation of MockLSIFStore.Exists")
+			},
+		},
+		HoverFunc: &LSIFStoreHoverFunc{
+			defaultHook: func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error) {
+				panic("unexpected invocation of MockLSIFStore.Hover")
+			},
+		},
+		ImplementationsFunc: &LSIFStoreImplementationsFunc{
+			defaultHook: func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+				panic("unexpected invocation of MockLSIFStore.Implementations")
+			},
+		},
+		MonikersByPositionFunc: &LSIFStoreMonikersByPositionFunc{
+			defaultHook: func(context.Context, int, string, int, int) ([][]precise.MonikerData, error) {
+				panic("unexpected invocation of MockLSIFStore.MonikersByPosition")
+			},
+		},
+		PackageInformationFunc: &LSIFStorePackageInformationFunc{
+			defaultHook: func(context.Context, int, string, string) (precise.PackageInformationData, bool, error) {
+				panic("unexpected invocation of MockLSIFStore.PackageInformation")
+			},
+		},
+		RangesFunc: &LSIFStoreRangesFunc{
+			defaultHook: func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error) {
+				panic("unexpected invocation of MockLSIFStore.Ranges")
+			},
+		},
+		ReferencesFunc: &LSIFStoreReferencesFunc{
+			defaultHook: func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+				panic("unexpected invocation of MockLSIFStore.References")
+			},
+		},
+		StencilFunc: &LSIFStoreStencilFunc{
+			defaultHook: func(context.Context, int, string) ([]lsifstore.Range, error) {
+				panic("unexpected invocation of MockLSIFStore.Stencil")
+			},
+		},
+		SymbolsFunc: &LSIFStoreSymbolsFunc{
+			defaultHook: func(context.Context, int, string, []lsifstore.Range) ([]*lsifstore.Symbol, error) {
+				panic("unexpected invocation of MockLSIFStore.Symbols")
+			},
+		},
+		TypeDefinitionsFunc: &LSIFStoreTypeDefinitionsFunc{
+			defaultHook: func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+				panic("unexpected invocation of MockLSIFStore.TypeDefinitions")
+			},
+		},
+	}
+}
+
+// NewMockLSIFStoreFrom creates a new mock of the MockLSIFStore interface.
+// All methods delegate to the given implementation, unless overwritten.
+func NewMockLSIFStoreFrom(i LSIFStore) *MockLSIFStore {
+	return &MockLSIFStore{
+		BulkMonikerLocationsFunc: &LSIFStoreBulkMonikerLocationsFunc{
+			defaultHook: i.BulkMonikerLocations,
+		},
+		BulkMonikerResultsFunc: &LSIFStoreBulkMonikerResultsFunc{
+			defaultHook: i.BulkMonikerResults,
+		},
+		DefinitionsFunc: &LSIFStoreDefinitionsFunc{
+			defaultHook: i.Definitions,
+		},
+		DiagnosticsFunc: &LSIFStoreDiagnosticsFunc{
+			defaultHook: i.Diagnostics,
+		},
+		DocumentationAtPositionFunc: &LSIFStoreDocumentationAtPositionFunc{
+			defaultHook: i.DocumentationAtPosition,
+		},
+		DocumentationDefinitionsFunc: &LSIFStoreDocumentationDefinitionsFunc{
+			defaultHook: i.DocumentationDefinitions,
+		},
+		DocumentationPageFunc: &LSIFStoreDocumentationPageFunc{
+			defaultHook: i.DocumentationPage,
+		},
+		DocumentationPathInfoFunc: &LSIFStoreDocumentationPathInfoFunc{
+			defaultHook: i.DocumentationPathInfo,
+		},
+		DocumentationSearchFunc: &LSIFStoreDocumentationSearchFunc{
+			defaultHook: i.DocumentationSearch,
+		},
+		EnclosingSymbolsFunc: &LSIFStoreEnclosingSymbolsFunc{
+			defaultHook: i.EnclosingSymbols,
+		},
+		ExistsFunc: &LSIFStoreExistsFunc{
+			defaultHook: i.Exists,
+		},
+		HoverFunc: &LSIFStoreHoverFunc{
+			defaultHook: i.Hover,
+		},
+		ImplementationsFunc: &LSIFStoreImplementationsFunc{
+			defaultHook: i.Implementations,
+		},
+		MonikersByPositionFunc: &LSIFStoreMonikersByPositionFunc{
+			defaultHook: i.MonikersByPosition,
+		},
+		PackageInformationFunc: &LSIFStorePackageInformationFunc{
+			defaultHook: i.PackageInformation,
+		},
+		RangesFunc: &LSIFStoreRangesFunc{
+			defaultHook: i.Ranges,
+		},
+		ReferencesFunc: &LSIFStoreReferencesFunc{
+			defaultHook: i.References,
+		},
+		StencilFunc: &LSIFStoreStencilFunc{
+			defaultHook: i.Stencil,
+		},
+		SymbolsFunc: &LSIFStoreSymbolsFunc{
+			defaultHook: i.Symbols,
+		},
+		TypeDefinitionsFunc: &LSIFStoreTypeDefinitionsFunc{
+			defaultHook: i.TypeDefinitions,
+		},
+	}
+}
+
+// LSIFStoreBulkMonikerLocationsFunc describes the behavior when the
+// BulkMonikerLocations method of the parent MockLSIFStore instance is
+// invoked.
+type LSIFStoreBulkMonikerLocationsFunc struct {
+	defaultHook func(context.Context, string, []int, []precise.MonikerData) ([]lsifstore.QualifiedMonikerLocations, error)
+	hooks       []func(context.Context, string, []int, []precise.MonikerData) ([]lsifstore.QualifiedMonikerLocations, error)
+	history     []LSIFStoreBulkMonikerLocationsFuncCall
+	mutex       sync.Mutex
+}
+
+// BulkMonikerLocations delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockLSIFStore) BulkMonikerLocations(v0 context.Context, v1 string, v2 []int, v3 []precise.MonikerData) ([]lsifstore.QualifiedMonikerLocations, error) {
+	r0, r1 := m.BulkMonikerLocationsFunc.nextHook()(v0, v1, v2, v3)
+	m.BulkMonikerLocationsFunc.appendCall(LSIFStoreBulkMonikerLocationsFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the BulkMonikerLocations
+// method of the parent MockLSIFStore instance is invoked and the hook queue
+// is empty.
+func (f *LSIFStoreBulkMonikerLocationsFunc) SetDefaultHook(hook func(context.Context, string, []int, []precise.MonikerData) ([]lsifstore.QualifiedMonikerLocations, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// BulkMonikerLocations method of the parent MockLSIFStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *LSIFStoreBulkMonikerLocationsFunc) PushHook(hook func(context.Context, string, []int, []precise.MonikerData) ([]lsifstore.QualifiedMonikerLocations, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *LSIFStoreBulkMonikerLocationsFunc) SetDefaultReturn(r0 []lsifstore.QualifiedMonikerLocations, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, []int, []precise.MonikerData) ([]lsifstore.QualifiedMonikerLocations, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *LSIFStoreBulkMonikerLocationsFunc) PushReturn(r0 []lsifstore.QualifiedMonikerLocations, r1 error) {
+	f.PushHook(func(context.Context, string, []int, []precise.MonikerData) ([]lsifstore.QualifiedMonikerLocations, error) {
+		return r0, r1
+	})
+}
+
+func (f *LSIFStoreBulkMonikerLocationsFunc) nextHook() func(context.Context, string, []int, []precise.MonikerData) ([]lsifstore.QualifiedMonikerLocations, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *LSIFStoreBulkMonikerLocationsFunc) appendCall(r0 LSIFStoreBulkMonikerLocationsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of LSIFStoreBulkMonikerLocationsFuncCall objects
+// describing the invocations of this function.
+func (f *LSIFStoreBulkMonikerLocationsFunc) History() []LSIFStoreBulkMonikerLocationsFuncCall {
+	f.mutex.Lock()
+	history := make([]LSIFStoreBulkMonikerLocationsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// LSIFStoreBulkMonikerLocationsFuncCall is an object that describes an
+// invocation of method BulkMonikerLocations on an instance of MockLSIFStore.
+type LSIFStoreBulkMonikerLocationsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 []precise.MonikerData
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []lsifstore.QualifiedMonikerLocations
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c LSIFStoreBulkMonikerLocationsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c LSIFStoreBulkMonikerLocationsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// LSIFStoreBulkMonikerResultsFunc describes the behavior when the
+// BulkMonikerResults method of the parent MockLSIFStore instance is
+// invoked.
+type LSIFStoreBulkMonikerResultsFunc struct {
+	defaultHook func(context.Context, string, []int, []precise.MonikerData, int, int) ([]lsifstore.Location, int, error)
+	hooks       []func(context.Context, string, []int, []precise.MonikerData, int, int) ([]lsifstore.Location, int, error)
+	history     []LSIFStoreBulkMonikerResultsFuncCall
+	mutex       sync.Mutex
+}
+
+// BulkMonikerResults delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockLSIFStore) BulkMonikerResults(v0 context.Context, v1 string, v2 []int, v3 []precise.MonikerData, v4 int, v5 int) ([]lsifstore.Location, int, error) {
+	r0, r1, r2 := m.BulkMonikerResultsFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.BulkMonikerResultsFunc.appendCall(LSIFStoreBulkMonikerResultsFuncCall{v0, v1, v2, v3, v4, v5, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the BulkMonikerResults
+// method of the parent MockLSIFStore instance is invoked and the hook queue
+// is empty.
+func (f *LSIFStoreBulkMonikerResultsFunc) SetDefaultHook(hook func(context.Context, string, []int, []precise.MonikerData, int, int) ([]lsifstore.Location, int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// BulkMonikerResults method of the parent MockLSIFStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *LSIFStoreBulkMonikerResultsFunc) PushHook(hook func(context.Context, string, []int, []precise.MonikerData, int, int) ([]lsifstore.Location, int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *LSIFStoreBulkMonikerResultsFunc) SetDefaultReturn(r0 []lsifstore.Location, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, string, []int, []precise.MonikerData, int, int) ([]lsifstore.Location, int, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *LSIFStoreBulkMonikerResultsFunc) PushReturn(r0 []lsifstore.Location, r1 int, r2 error) {
+	f.PushHook(func(context.Context, string, []int, []precise.MonikerData, int, int) ([]lsifstore.Location, int, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *LSIFStoreBulkMonikerResultsFunc) nextHook() func(context.Context, string, []int, []precise.MonikerData, int, int) ([]lsifstore.Location, int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *LSIFStoreBulkMonikerResultsFunc) appendCall(r0 LSIFStoreBulkMonikerResultsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of LSIFStoreBulkMonikerResultsFuncCall objects
+// describing the invocations of this function.
+func (f *LSIFStoreBulkMonikerResultsFunc) History() []LSIFStoreBulkMonikerResultsFuncCall {
+	f.mutex.Lock()
+	history := make([]LSIFStoreBulkMonikerResultsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// LSIFStoreBulkMonikerResultsFuncCall is an object that describes an
+// invocation of method BulkMonikerResults on an instance of MockLSIFStore.
+type LSIFStoreBulkMonikerResultsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 []precise.MonikerData
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []lsifstore.Location
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c LSIFStoreBulkMonikerResultsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c LSIFStoreBulkMonikerResultsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// LSIFStoreDefinitionsFunc describes the behavior when the Definitions
+// method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreDefinitionsFunc struct {
+	defaultHook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
+	hooks       []func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
+	history     []LSIFStoreDefinitionsFuncCall
+	mutex       sync.Mutex
+}
+
+// Definitions delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockLSIFStore) Definitions(v0 context.Context, v1 int, v2 string, v3 int, v4 int, v5 int, v6 int) ([]lsifstore.Location, int, error) {
+	r0, r1, r2 := m.DefinitionsFunc.nextHook()(v0, v1, v2, v3, v4, v5, v6)
+	m.DefinitionsFunc.appendCall(LSIFStoreDefinitionsFuncCall{v0, v1, v2, v3, v4, v5, v6, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the Definitions method
+// of the parent MockLSIFStore instance is invoked and the hook queue is
+// empty.
+func (f *LSIFStoreDefinitionsFunc) SetDefaultHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Definitions method of the parent MockLSIFStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *LSIFStoreDefinitionsFunc) PushHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *LSIFStoreDefinitionsFunc) SetDefaultReturn(r0 []lsifstore.Location, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *LSIFStoreDefinitionsFunc) PushReturn(r0 []lsifstore.Location, r1 int, r2 error) {
+	f.PushHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *LSIFStoreDefinitionsFunc) nextHook() func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *LSIFStoreDefinitionsFunc) appendCall(r0 LSIFStoreDefinitionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of LSIFStoreDefinitionsFuncCall objects
+// describing the invocations of this function.
+func (f *LSIFStoreDefinitionsFunc) History() []LSIFStoreDefinitionsFuncCall {
+	f.mutex.Lock()
+	history := make([]LSIFStoreDefinitionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// LSIFStoreDefinitionsFuncCall is an object that describes an invocation of
+// method Definitions on an instance of MockLSIFStore.
+type LSIFStoreDefinitionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 int
+	// Arg6 is the value of the 7th argument passed to this method
+	// invocation.
+	Arg6 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []lsifstore.Location
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c LSIFStoreDefinitionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5, c.Arg6}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c LSIFStoreDefinitionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// LSIFStoreDiagnosticsFunc describes the behavior when the Diagnostics
+// method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreDiagnosticsFunc struct {
+	defaultHook func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error)
+	hooks       []func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error)
+	history     []LSIFStoreDiagnosticsFuncCall
+	mutex       sync.Mutex
+}
+
+// Diagnostics delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockLSIFStore) Diagnostics(v0 context.Context, v1 int, v2 string, v3 int, v4 int) ([]lsifstore.Diagnostic, int, error) {
+	r0, r1, r2 := m.DiagnosticsFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.DiagnosticsFunc.appendCall(LSIFStoreDiagnosticsFuncCall{v0, v1, v2, v3, v4, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the Diagnostics method
+// of the parent MockLSIFStore instance is invoked and the hook queue is
+// empty.
+func (f *LSIFStoreDiagnosticsFunc) SetDefaultHook(hook func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Diagnostics method of the parent MockLSIFStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *LSIFStoreDiagnosticsFunc) PushHook(hook func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *LSIFStoreDiagnosticsFunc) SetDefaultReturn(r0 []lsifstore.Diagnostic, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *LSIFStoreDiagnosticsFunc) PushReturn(r0 []lsifstore.Diagnostic, r1 int, r2 error) {
+	f.PushHook(func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *LSIFStoreDiagnosticsFunc) nextHook() func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *LSIFStoreDiagnosticsFunc) appendCall(r0 LSIFStoreDiagnosticsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of LSIFStoreDiagnosticsFuncCall objects
+// describing the invocations of this function.
+func (f *LSIFStoreDiagnosticsFunc) History() []LSIFStoreDiagnosticsFuncCall {
+	f.mutex.Lock()
+	history := make([]LSIFStoreDiagnosticsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// LSIFStoreDiagnosticsFuncCall is an object that describes an invocation of
+// method Diagnostics on an instance of MockLSIFStore.
+type LSIFStoreDiagnosticsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []lsifstore.Diagnostic
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c LSIFStoreDiagnosticsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c LSIFStoreDiagnosticsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// LSIFStoreDocumentationAtPositionFunc describes the behavior when the
+// DocumentationAtPosition method of the parent MockLSIFStore instance is
+// invoked.
+type LSIFStoreDocumentationAtPositionFunc struct {
+	defaultHook func(context.Context, int, string, int, int) ([]string, error)
+	hooks       []func(context.Context, int, string, int, int) ([]string, error)
+	history     []LSIFStoreDocumentationAtPositionFuncCall
+	mutex       sync.Mutex
+}
+
+// DocumentationAtPosition delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockLSIFStore) DocumentationAtPosition(v0 context.Context, v1 int, v2 string, v3 int, v4 int) ([]string, error) {
+	r0, r1 := m.DocumentationAtPositionFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.DocumentationAtPositionFunc.appendCall(LSIFStoreDocumentationAtPositionFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// DocumentationAtPosition method of the parent MockLSIFStore instance is
+// invoked and the hook queue is empty.
+func (f *LSIFStoreDocumentationAtPositionFunc) SetDefaultHook(hook func(context.Context, int, string, int, int) ([]string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DocumentationAtPosition method of the parent MockLSIFStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *LSIFStoreDocumentationAtPositionFunc) PushHook(hook func(context.Context, int, string, int, int) ([]string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *LSIFStoreDocumentationAtPositionFunc) SetDefaultReturn(r0 []string, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, int, int) ([]string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *LSIFStoreDocumentationAtPositionFunc) PushReturn(r0 []string, r1 error) {
+	f.PushHook(func(context.Context, int, string, int, int) ([]string, error) {
+		return r0, r1
+	})
+}
+
+func (f *LSIFStoreDocumentationAtPositionFunc) nextHook() func(context.Context, int, string, int, int) ([]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *LSIFStoreDocumentationAtPositionFunc) appendCall(r0 LSIFStoreDocumentationAtPositionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of LSIFStoreDocumentationAtPositionFuncCall
+// objects describing the invocations of this function.
+func (f *LSIFStoreDocumentationAtPositionFunc) History() []LSIFStoreDocumentationAtPositionFuncCall {
+	f.mutex.Lock()
+	history := make([]LSIFStoreDocumentationAtPositionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// LSIFStoreDocumentationAtPositionFuncCall is an object that describes an
+// invocation of method DocumentationAtPosition on an instance of
+// MockLSIFStore.
+type LSIFStoreDocumentationAtPositionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c LSIFStoreDocumentationAtPositionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c LSIFStoreDocumentationAtPositionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// LSIFStoreDocumentationDefinitionsFunc describes the behavior when the
+// DocumentationDefinitions method of the parent MockLSIFStore instance is
+// invoked.
+type LSIFStoreDocumentationDefinitionsFunc struct {
+	defaultHook func(context.Context, int, string, int, int) ([]lsifstore.Location, int, error)
+	hooks       []func(context.Context, int, string, int, int) ([]lsifstore.Location, int, error)
+	history     []LSIFStoreDocumentationDefinitionsFuncCall
+	mutex       sync.Mutex
+}
+
+// DocumentationDefinitions delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockLSIFStore) DocumentationDefinitions(v0 context.Context, v1 int, v2 string, v3 int, v4 int) ([]lsifstore.Location, int, error) {
+	r0, r1, r2 := m.DocumentationDefinitionsFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.DocumentationDefinitionsFunc.appendCall(LSIFStoreDocumentationDefinitionsFuncCall{v0, v1, v2, v3, v4, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the
+// DocumentationDefinitions method of the parent MockLSIFStore instance is
+// invoked and the hook queue is empty.
+func (f *LSIFStoreDocumentationDefinitionsFunc) SetDefaultHook(hook func(context.Context, int, string, int, int) ([]lsifstore.Location, int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DocumentationDefinitions method of the parent MockLSIFStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *LSIFStoreDocumentationDefinitionsFunc) PushHook(hook func(context.Context, int, string, int, int) ([]lsifstore.Location, int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *LSIFStoreDocumentationDefinitionsFunc) SetDefaultReturn(r0 []lsifstore.Location, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, int, string, int, int) ([]lsifstore.Location, int, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *LSIFStoreDocumentationDefinitionsFunc) PushReturn(r0 []lsifstore.Location, r1 int, r2 error) {
+	f.PushHook(func(context.Context, int, string, int, int) ([]lsifstore.Location, int, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *LSIFStoreDocumentationDefinitionsFunc) nextHook() func(context.Context, int, string, int, int) ([]lsifstore.Location, int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *LSIFStoreDocumentationDefinitionsFunc) appendCall(r0 LSIFStoreDocumentationDefinitionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of LSIFStoreDocumentationDefinitionsFuncCall
+// objects describing the invocations of this function.
+func (f *LSIFStoreDocumentationDefinitionsFunc) History() []LSIFStoreDocumentationDefinitionsFuncCall {
+	f.mutex.Lock()
+	history := make([]LSIFStoreDocumentationDefinitionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// LSIFStoreDocumentationDefinitionsFuncCall is an object that describes an
+// invocation of method DocumentationDefinitions on an instance of
+// MockLSIFStore.
+type LSIFStoreDocumentationDefinitionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []lsifstore.Location
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c LSIFStoreDocumentationDefinitionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c LSIFStoreDocumentationDefinitionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// LSIFStoreDocumentationPageFunc describes the behavior when the
+// DocumentationPage method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreDocumentationPageFunc struct {
+	defaultHook func(context.Context, int, string) (*precise.DocumentationPageData, error)
+	hooks       []func(context.Context, int, string) (*precise.DocumentationPageData, error)
+	history     []LSIFStoreDocumentationPageFuncCall
 	mutex       sync.Mutex
 }
 
-// BulkMonikerResults delegates to the next hook function in the queue and
+// DocumentationPage delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockLSIFStore) BulkMonikerResults(v0 context.Context, v1 string, v2 []int, v3 []precise.MonikerData, v4 int, v5 int) ([]lsifstore.Location, int, error) {
-	r0, r1, r2 := m.BulkMonikerResultsFunc.nextHook()(v0, v1, v2, v3, v4, v5)
-	m.BulkMonikerResultsFunc.appendCall(LSIFStoreBulkMonikerResultsFuncCall{v0, v1, v2, v3, v4, v5, r0, r1, r2})
-	return r0, r1, r2
+func (m *MockLSIFStore) DocumentationPage(v0 context.Context, v1 int, v2 string) (*precise.DocumentationPageData, error) {
+	r0, r1 := m.DocumentationPageFunc.nextHook()(v0, v1, v2)
+	m.DocumentationPageFunc.appendCall(LSIFStoreDocumentationPageFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the BulkMonikerResults
+// SetDefaultHook sets function that is called when the DocumentationPage
 // method of the parent MockLSIFStore instance is invoked and the hook queue
 // is empty.
-func (f *LSIFStoreBulkMonikerResultsFunc) SetDefaultHook(hook func(context.Context, string, []int, []precise.MonikerData, int, int) ([]lsifstore.Location, int, error)) {
+func (f *LSIFStoreDocumentationPageFunc) SetDefaultHook(hook func(context.Context, int, string) (*precise.DocumentationPageData, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// BulkMonikerResults method of the parent MockLSIFStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *LSIFStoreBulkMonikerResultsFunc) PushHook(hook func(context.Context, string, []int, []precise.MonikerData, int, int) ([]lsifstore.Location, int, error)) {
+// DocumentationPage method of the parent MockLSIFStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *LSIFStoreDocumentationPageFunc) PushHook(hook func(context.Context, int, string) (*precise.DocumentationPageData, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6045,21 +7501,21 @@ func (f *LSIFStoreBulkMonikerResultsFunc) PushHook(hook func(context.Context, st
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStoreBulkMonikerResultsFunc) SetDefaultReturn(r0 []lsifstore.Location, r1 int, r2 error) {
-	f.SetDefaultHook(func(context.Context, string, []int, []precise.MonikerData, int, int) ([]lsifstore.Location, int, error) {
-		return r0, r1, r2
+func (f *LSIFStoreDocumentationPageFunc) SetDefaultReturn(r0 *precise.DocumentationPageData, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string) (*precise.DocumentationPageData, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStoreBulkMonikerResultsFunc) PushReturn(r0 []lsifstore.Location, r1 int, r2 error) {
-	f.PushHook(func(context.Context, string, []int, []precise.MonikerData, int, int) ([]lsifstore.Location, int, error) {
-		return r0, r1, r2
+func (f *LSIFStoreDocumentationPageFunc) PushReturn(r0 *precise.DocumentationPageData, r1 error) {
+	f.PushHook(func(context.Context, int, string) (*precise.DocumentationPageData, error) {
+		return r0, r1
 	})
 }
 
-func (f *LSIFStoreBulkMonikerResultsFunc) nextHook() func(context.Context, string, []int, []precise.MonikerData, int, int) ([]lsifstore.Location, int, error) {
+func (f *LSIFStoreDocumentationPageFunc) nextHook() func(context.Context, int, string) (*precise.DocumentationPageData, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6072,96 +7528,199 @@ func (f *LSIFStoreBulkMonikerResultsFunc) nextHook() func(context.Context, strin
 	return hook
 }
 
-func (f *LSIFStoreBulkMonikerResultsFunc) appendCall(r0 LSIFStoreBulkMonikerResultsFuncCall) {
+func (f *LSIFStoreDocumentationPageFunc) appendCall(r0 LSIFStoreDocumentationPageFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreBulkMonikerResultsFuncCall objects
+// History returns a sequence of LSIFStoreDocumentationPageFuncCall objects
 // describing the invocations of this function.
-func (f *LSIFStoreBulkMonikerResultsFunc) History() []LSIFStoreBulkMonikerResultsFuncCall {
+func (f *LSIFStoreDocumentationPageFunc) History() []LSIFStoreDocumentationPageFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreBulkMonikerResultsFuncCall, len(f.history))
+	history := make([]LSIFStoreDocumentationPageFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreBulkMonikerResultsFuncCall is an object that describes an
-// invocation of method BulkMonikerResults on an instance of MockLSIFStore.
-type LSIFStoreBulkMonikerResultsFuncCall struct {
+// LSIFStoreDocumentationPageFuncCall is an object that describes an
+// invocation of method DocumentationPage on an instance of MockLSIFStore.
+type LSIFStoreDocumentationPageFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 string
+	Arg1 int
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 []int
-	// Arg3 is the value of the 4th argument passed to this method
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Arg3 []precise.MonikerData
-	// Arg4 is the value of the 5th argument passed to this method
+	Result0 *precise.DocumentationPageData
+	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Arg4 int
-	// Arg5 is the value of the 6th argument passed to this method
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c LSIFStoreDocumentationPageFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c LSIFStoreDocumentationPageFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// LSIFStoreDocumentationPathInfoFunc describes the behavior when the
+// DocumentationPathInfo method of the parent MockLSIFStore instance is
+// invoked.
+type LSIFStoreDocumentationPathInfoFunc struct {
+	defaultHook func(context.Context, int, string) (*precise.DocumentationPathInfoData, error)
+	hooks       []func(context.Context, int, string) (*precise.DocumentationPathInfoData, error)
+	history     []LSIFStoreDocumentationPathInfoFuncCall
+	mutex       sync.Mutex
+}
+
+// DocumentationPathInfo delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockLSIFStore) DocumentationPathInfo(v0 context.Context, v1 int, v2 string) (*precise.DocumentationPathInfoData, error) {
+	r0, r1 := m.DocumentationPathInfoFunc.nextHook()(v0, v1, v2)
+	m.DocumentationPathInfoFunc.appendCall(LSIFStoreDocumentationPathInfoFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// DocumentationPathInfo method of the parent MockLSIFStore instance is
+// invoked and the hook queue is empty.
+func (f *LSIFStoreDocumentationPathInfoFunc) SetDefaultHook(hook func(context.Context, int, string) (*precise.DocumentationPathInfoData, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DocumentationPathInfo method of the parent MockLSIFStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *LSIFStoreDocumentationPathInfoFunc) PushHook(hook func(context.Context, int, string) (*precise.DocumentationPathInfoData, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *LSIFStoreDocumentationPathInfoFunc) SetDefaultReturn(r0 *precise.DocumentationPathInfoData, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string) (*precise.DocumentationPathInfoData, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *LSIFStoreDocumentationPathInfoFunc) PushReturn(r0 *precise.DocumentationPathInfoData, r1 error) {
+	f.PushHook(func(context.Context, int, string) (*precise.DocumentationPathInfoData, error) {
+		return r0, r1
+	})
+}
+
+func (f *LSIFStoreDocumentationPathInfoFunc) nextHook() func(context.Context, int, string) (*precise.DocumentationPathInfoData, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *LSIFStoreDocumentationPathInfoFunc) appendCall(r0 LSIFStoreDocumentationPathInfoFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of LSIFStoreDocumentationPathInfoFuncCall
+// objects describing the invocations of this function.
+func (f *LSIFStoreDocumentationPathInfoFunc) History() []LSIFStoreDocumentationPathInfoFuncCall {
+	f.mutex.Lock()
+	history := make([]LSIFStoreDocumentationPathInfoFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// LSIFStoreDocumentationPathInfoFuncCall is an object that describes an
+// invocation of method DocumentationPathInfo on an instance of
+// MockLSIFStore.
+type LSIFStoreDocumentationPathInfoFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg5 int
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []lsifstore.Location
+	Result0 *precise.DocumentationPathInfoData
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 int
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreBulkMonikerResultsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
+func (c LSIFStoreDocumentationPathInfoFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreBulkMonikerResultsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+func (c LSIFStoreDocumentationPathInfoFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// LSIFStoreDefinitionsFunc describes the behavior when the Definitions
-// method of the parent MockLSIFStore instance is invoked.
-type LSIFStoreDefinitionsFunc struct {
-	defaultHook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
-	hooks       []func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
-	history     []LSIFStoreDefinitionsFuncCall
+// LSIFStoreDocumentationSearchFunc describes the behavior when the
+// DocumentationSearch method of the parent MockLSIFStore instance is
+// invoked.
+type LSIFStoreDocumentationSearchFunc struct {
+	defaultHook func(context.Context, string, string, []string) ([]precise.DocumentationSearchResult, error)
+	hooks       []func(context.Context, string, string, []string) ([]precise.DocumentationSearchResult, error)
+	history     []LSIFStoreDocumentationSearchFuncCall
 	mutex       sync.Mutex
 }
 
-// Definitions delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockLSIFStore) Definitions(v0 context.Context, v1 int, v2 string, v3 int, v4 int, v5 int, v6 int) ([]lsifstore.Location, int, error) {
-	r0, r1, r2 := m.DefinitionsFunc.nextHook()(v0, v1, v2, v3, v4, v5, v6)
-	m.DefinitionsFunc.appendCall(LSIFStoreDefinitionsFuncCall{v0, v1, v2, v3, v4, v5, v6, r0, r1, r2})
-	return r0, r1, r2
+// DocumentationSearch delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockLSIFStore) DocumentationSearch(v0 context.Context, v1 string, v2 string, v3 []string) ([]precise.DocumentationSearchResult, error) {
+	r0, r1 := m.DocumentationSearchFunc.nextHook()(v0, v1, v2, v3)
+	m.DocumentationSearchFunc.appendCall(LSIFStoreDocumentationSearchFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Definitions method
-// of the parent MockLSIFStore instance is invoked and the hook queue is
-// empty.
-func (f *LSIFStoreDefinitionsFunc) SetDefaultHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
+// SetDefaultHook sets function that is called when the DocumentationSearch
+// method of the parent MockLSIFStore instance is invoked and the hook queue
+// is empty.
+func (f *LSIFStoreDocumentationSearchFunc) SetDefaultHook(hook func(context.Context, string, string, []string) ([]precise.DocumentationSearchResult, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Definitions method of the parent MockLSIFStore instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *LSIFStoreDefinitionsFunc) PushHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
+// DocumentationSearch method of the parent MockLSIFStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *LSIFStoreDocumentationSearchFunc) PushHook(hook func(context.Context, string, string, []string) ([]precise.DocumentationSearchResult, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6169,21 +7728,21 @@ func (f *LSIFStoreDefinitionsFunc) PushHook(hook func(context.Context, int, stri
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStoreDefinitionsFunc) SetDefaultReturn(r0 []lsifstore.Location, r1 int, r2 error) {
-	f.SetDefaultHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
-		return r0, r1, r2
+func (f *LSIFStoreDocumentationSearchFunc) SetDefaultReturn(r0 []precise.DocumentationSearchResult, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, []string) ([]precise.DocumentationSearchResult, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStoreDefinitionsFunc) PushReturn(r0 []lsifstore.Location, r1 int, r2 error) {
-	f.PushHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
-		return r0, r1, r2
+func (f *LSIFStoreDocumentationSearchFunc) PushReturn(r0 []precise.DocumentationSearchResult, r1 error) {
+	f.PushHook(func(context.Context, string, string, []string) ([]precise.DocumentationSearchResult, error) {
+		return r0, r1
 	})
 }
 
-func (f *LSIFStoreDefinitionsFunc) nextHook() func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+func (f *LSIFStoreDocumentationSearchFunc) nextHook() func(context.Context, string, string, []string) ([]precise.DocumentationSearchResult, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6196,99 +7755,87 @@ func (f *LSIFStoreDefinitionsFunc) nextHook() func(context.Context, int, string,
 	return hook
 }
 
-func (f *LSIFStoreDefinitionsFunc) appendCall(r0 LSIFStoreDefinitionsFuncCall) {
+func (f *LSIFStoreDocumentationSearchFunc) appendCall(r0 LSIFStoreDocumentationSearchFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreDefinitionsFuncCall objects
-// describing the invocations of this function.
-func (f *LSIFStoreDefinitionsFunc) History() []LSIFStoreDefinitionsFuncCall {
+// History returns a sequence of LSIFStoreDocumentationSearchFuncCall
+// objects describing the invocations of this function.
+func (f *LSIFStoreDocumentationSearchFunc) History() []LSIFStoreDocumentationSearchFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreDefinitionsFuncCall, len(f.history))
+	history := make([]LSIFStoreDocumentationSearchFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreDefinitionsFuncCall is an object that describes an invocation of
-// method Definitions on an instance of MockLSIFStore.
-type LSIFStoreDefinitionsFuncCall struct {
+// LSIFStoreDocumentationSearchFuncCall is an object that describes an
+// invocation of method DocumentationSearch on an instance of MockLSIFStore.
+type LSIFStoreDocumentationSearchFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int
+	Arg1 string
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
 	Arg2 string
 	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
-	Arg3 int
-	// Arg4 is the value of the 5th argument passed to this method
-	// invocation.
-	Arg4 int
-	// Arg5 is the value of the 6th argument passed to this method
-	// invocation.
-	Arg5 int
-	// Arg6 is the value of the 7th argument passed to this method
-	// invocation.
-	Arg6 int
+	Arg3 []string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []lsifstore.Location
+	Result0 []precise.DocumentationSearchResult
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 int
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreDefinitionsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5, c.Arg6}
+func (c LSIFStoreDocumentationSearchFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreDefinitionsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+func (c LSIFStoreDocumentationSearchFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// LSIFStoreDiagnosticsFunc describes the behavior when the Diagnostics
-// method of the parent MockLSIFStore instance is invoked.
-type LSIFStoreDiagnosticsFunc struct {
-	defaultHook func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error)
-	hooks       []func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error)
-	history     []LSIFStoreDiagnosticsFuncCall
+// LSIFStoreEnclosingSymbolsFunc describes the behavior when the
+// EnclosingSymbols method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreEnclosingSymbolsFunc struct {
+	defaultHook func(context.Context, int, string, []lsifstore.Range) ([]*lsifstore.Symbol, error)
+	hooks       []func(context.Context, int, string, []lsifstore.Range) ([]*lsifstore.Symbol, error)
+	history     []LSIFStoreEnclosingSymbolsFuncCall
 	mutex       sync.Mutex
 }
 
-// Diagnostics delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockLSIFStore) Diagnostics(v0 context.Context, v1 int, v2 string, v3 int, v4 int) ([]lsifstore.Diagnostic, int, error) {
-	r0, r1, r2 := m.DiagnosticsFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.DiagnosticsFunc.appendCall(LSIFStoreDiagnosticsFuncCall{v0, v1, v2, v3, v4, r0, r1, r2})
-	return r0, r1, r2
+// EnclosingSymbols delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockLSIFStore) EnclosingSymbols(v0 context.Context, v1 int, v2 string, v3 []lsifstore.Range) ([]*lsifstore.Symbol, error) {
+	r0, r1 := m.EnclosingSymbolsFunc.nextHook()(v0, v1, v2, v3)
+	m.EnclosingSymbolsFunc.appendCall(LSIFStoreEnclosingSymbolsFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Diagnostics method
-// of the parent MockLSIFStore instance is invoked and the hook queue is
-// empty.
-func (f *LSIFStoreDiagnosticsFunc) SetDefaultHook(hook func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error)) {
+// SetDefaultHook sets function that is called when the EnclosingSymbols
+// method of the parent MockLSIFStore instance is invoked and the hook queue
+// is empty.
+func (f *LSIFStoreEnclosingSymbolsFunc) SetDefaultHook(hook func(context.Context, int, string, []lsifstore.Range) ([]*lsifstore.Symbol, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Diagnostics method of the parent MockLSIFStore instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *LSIFStoreDiagnosticsFunc) PushHook(hook func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error)) {
+// EnclosingSymbols method of the parent MockLSIFStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *LSIFStoreEnclosingSymbolsFunc) PushHook(hook func(context.Context, int, string, []lsifstore.Range) ([]*lsifstore.Symbol, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6296,21 +7843,21 @@ func (f *LSIFStoreDiagnosticsFunc) PushHook(hook func(context.Context, int, stri
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStoreDiagnosticsFunc) SetDefaultReturn(r0 []lsifstore.Diagnostic, r1 int, r2 error) {
-	f.SetDefaultHook(func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error) {
-		return r0, r1, r2
+func (f *LSIFStoreEnclosingSymbolsFunc) SetDefaultReturn(r0 []*lsifstore.Symbol, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, []lsifstore.Range) ([]*lsifstore.Symbol, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStoreDiagnosticsFunc) PushReturn(r0 []lsifstore.Diagnostic, r1 int, r2 error) {
-	f.PushHook(func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error) {
-		return r0, r1, r2
+func (f *LSIFStoreEnclosingSymbolsFunc) PushReturn(r0 []*lsifstore.Symbol, r1 error) {
+	f.PushHook(func(context.Context, int, string, []lsifstore.Range) ([]*lsifstore.Symbol, error) {
+		return r0, r1
 	})
 }
 
-func (f *LSIFStoreDiagnosticsFunc) nextHook() func(context.Context, int, string, int, int) ([]lsifstore.Diagnostic, int, error) {
+func (f *LSIFStoreEnclosingSymbolsFunc) nextHook() func(context.Context, int, string, []lsifstore.Range) ([]*lsifstore.Symbol, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6323,26 +7870,26 @@ func (f *LSIFStoreDiagnosticsFunc) nextHook() func(context.Context, int, string,
 	return hook
 }
 
-func (f *LSIFStoreDiagnosticsFunc) appendCall(r0 LSIFStoreDiagnosticsFuncCall) {
+func (f *LSIFStoreEnclosingSymbolsFunc) appendCall(r0 LSIFStoreEnclosingSymbolsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreDiagnosticsFuncCall objects
+// History returns a sequence of LSIFStoreEnclosingSymbolsFuncCall objects
 // describing the invocations of this function.
-func (f *LSIFStoreDiagnosticsFunc) History() []LSIFStoreDiagnosticsFuncCall {
+func (f *LSIFStoreEnclosingSymbolsFunc) History() []LSIFStoreEnclosingSymbolsFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreDiagnosticsFuncCall, len(f.history))
+	history := make([]LSIFStoreEnclosingSymbolsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreDiagnosticsFuncCall is an object that describes an invocation of
-// method Diagnostics on an instance of MockLSIFStore.
-type LSIFStoreDiagnosticsFuncCall struct {
+// LSIFStoreEnclosingSymbolsFuncCall is an object that describes an
+// invocation of method EnclosingSymbols on an instance of MockLSIFStore.
+type LSIFStoreEnclosingSymbolsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -6354,64 +7901,55 @@ type LSIFStoreDiagnosticsFuncCall struct {
 	Arg2 string
 	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
-	Arg3 int
-	// Arg4 is the value of the 5th argument passed to this method
-	// invocation.
-	Arg4 int
+	Arg3 []lsifstore.Range
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []lsifstore.Diagnostic
+	Result0 []*lsifstore.Symbol
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 int
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreDiagnosticsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+func (c LSIFStoreEnclosingSymbolsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreDiagnosticsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+func (c LSIFStoreEnclosingSymbolsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// LSIFStoreDocumentationAtPositionFunc describes the behavior when the
-// DocumentationAtPosition method of the parent MockLSIFStore instance is
-// invoked.
-type LSIFStoreDocumentationAtPositionFunc struct {
-	defaultHook func(context.Context, int, string, int, int) ([]string, error)
-	hooks       []func(context.Context, int, string, int, int) ([]string, error)
-	history     []LSIFStoreDocumentationAtPositionFuncCall
+// LSIFStoreExistsFunc describes the behavior when the Exists method of the
+// parent MockLSIFStore instance is invoked.
+type LSIFStoreExistsFunc struct {
+	defaultHook func(context.Context, int, string) (bool, error)
+	hooks       []func(context.Context, int, string) (bool, error)
+	history     []LSIFStoreExistsFuncCall
 	mutex       sync.Mutex
 }
 
-// DocumentationAtPosition delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockLSIFStore) DocumentationAtPosition(v0 context.Context, v1 int, v2 string, v3 int, v4 int) ([]string, error) {
-	r0, r1 := m.DocumentationAtPositionFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.DocumentationAtPositionFunc.appendCall(LSIFStoreDocumentationAtPositionFuncCall{v0, v1, v2, v3, v4, r0, r1})
+// Exists delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockLSIFStore) Exists(v0 context.Context, v1 int, v2 string) (bool, error) {
+	r0, r1 := m.ExistsFunc.nextHook()(v0, v1, v2)
+	m.ExistsFunc.appendCall(LSIFStoreExistsFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// DocumentationAtPosition method of the parent MockLSIFStore instance is
-// invoked and the hook queue is empty.
-func (f *LSIFStoreDocumentationAtPositionFunc) SetDefaultHook(hook func(context.Context, int, string, int, int) ([]string, error)) {
+// SetDefaultHook sets function that is called when the Exists method of the
+// parent MockLSIFStore instance is invoked and the hook queue is empty.
+func (f *LSIFStoreExistsFunc) SetDefaultHook(hook func(context.Context, int, string) (bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// DocumentationAtPosition method of the parent MockLSIFStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *LSIFStoreDocumentationAtPositionFunc) PushHook(hook func(context.Context, int, string, int, int) ([]string, error)) {
+// Exists method of the parent MockLSIFStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *LSIFStoreExistsFunc) PushHook(hook func(context.Context, int, string) (bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6419,21 +7957,21 @@ func (f *LSIFStoreDocumentationAtPositionFunc) PushHook(hook func(context.Contex
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStoreDocumentationAtPositionFunc) SetDefaultReturn(r0 []string, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string, int, int) ([]string, error) {
+func (f *LSIFStoreExistsFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string) (bool, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStoreDocumentationAtPositionFunc) PushReturn(r0 []string, r1 error) {
-	f.PushHook(func(context.Context, int, string, int, int) ([]string, error) {
+func (f *LSIFStoreExistsFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, int, string) (bool, error) {
 		return r0, r1
 	})
 }
 
-func (f *LSIFStoreDocumentationAtPositionFunc) nextHook() func(context.Context, int, string, int, int) ([]string, error) {
+func (f *LSIFStoreExistsFunc) nextHook() func(context.Context, int, string) (bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6446,27 +7984,26 @@ func (f *LSIFStoreDocumentationAtPositionFunc) nextHook() func(context.Context,
 	return hook
 }
 
-func (f *LSIFStoreDocumentationAtPositionFunc) appendCall(r0 LSIFStoreDocumentationAtPositionFuncCall) {
+func (f *LSIFStoreExistsFunc) appendCall(r0 LSIFStoreExistsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreDocumentationAtPositionFuncCall
-// objects describing the invocations of this function.
-func (f *LSIFStoreDocumentationAtPositionFunc) History() []LSIFStoreDocumentationAtPositionFuncCall {
+// History returns a sequence of LSIFStoreExistsFuncCall objects describing
+// the invocations of this function.
+func (f *LSIFStoreExistsFunc) History() []LSIFStoreExistsFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreDocumentationAtPositionFuncCall, len(f.history))
+	history := make([]LSIFStoreExistsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreDocumentationAtPositionFuncCall is an object that describes an
-// invocation of method DocumentationAtPosition on an instance of
-// MockLSIFStore.
-type LSIFStoreDocumentationAtPositionFuncCall struct {
+// LSIFStoreExistsFuncCall is an object that describes an invocation of
+// method Exists on an instance of MockLSIFStore.
+type LSIFStoreExistsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -6476,15 +8013,9 @@ type LSIFStoreDocumentationAtPositionFuncCall struct {
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
 	Arg2 string
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 int
-	// Arg4 is the value of the 5th argument passed to this method
-	// invocation.
-	Arg4 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []string
+	Result0 bool
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -6492,47 +8023,44 @@ type LSIFStoreDocumentationAtPositionFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreDocumentationAtPositionFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+func (c LSIFStoreExistsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreDocumentationAtPositionFuncCall) Results() []interface{} {
+func (c LSIFStoreExistsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// LSIFStoreDocumentationDefinitionsFunc describes the behavior when the
-// DocumentationDefinitions method of the parent MockLSIFStore instance is
-// invoked.
-type LSIFStoreDocumentationDefinitionsFunc struct {
-	defaultHook func(context.Context, int, string, int, int) ([]lsifstore.Location, int, error)
-	hooks       []func(context.Context, int, string, int, int) ([]lsifstore.Location, int, error)
-	history     []LSIFStoreDocumentationDefinitionsFuncCall
+// LSIFStoreHoverFunc describes the behavior when the Hover method of the
+// parent MockLSIFStore instance is invoked.
+type LSIFStoreHoverFunc struct {
+	defaultHook func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error)
+	hooks       []func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error)
+	history     []LSIFStoreHoverFuncCall
 	mutex       sync.Mutex
 }
 
-// DocumentationDefinitions delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockLSIFStore) DocumentationDefinitions(v0 context.Context, v1 int, v2 string, v3 int, v4 int) ([]lsifstore.Location, int, error) {
-	r0, r1, r2 := m.DocumentationDefinitionsFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.DocumentationDefinitionsFunc.appendCall(LSIFStoreDocumentationDefinitionsFuncCall{v0, v1, v2, v3, v4, r0, r1, r2})
-	return r0, r1, r2
+// Hover delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockLSIFStore) Hover(v0 context.Context, v1 int, v2 string, v3 int, v4 int) (string, lsifstore.Range, bool, error) {
+	r0, r1, r2, r3 := m.HoverFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.HoverFunc.appendCall(LSIFStoreHoverFuncCall{v0, v1, v2, v3, v4, r0, r1, r2, r3})
+	return r0, r1, r2, r3
 }
 
-// SetDefaultHook sets function that is called when the
-// DocumentationDefinitions method of the parent MockLSIFStore instance is
-// invoked and the hook queue is empty.
-func (f *LSIFStoreDocumentationDefinitionsFunc) SetDefaultHook(hook func(context.Context, int, string, int, int) ([]lsifstore.Location, int, error)) {
+// SetDefaultHook sets function that is called when the Hover method of the
+// parent MockLSIFStore instance is invoked and the hook queue is empty.
+func (f *LSIFStoreHoverFunc) SetDefaultHook(hook func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// DocumentationDefinitions method of the parent MockLSIFStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *LSIFStoreDocumentationDefinitionsFunc) PushHook(hook func(context.Context, int, string, int, int) ([]lsifstore.Location, int, error)) {
+// Hover method of the parent MockLSIFStore instance invokes the hook at the
+// front of the queue and discards it. After the queue is empty, the default
+// hook function is invoked for any future action.
+func (f *LSIFStoreHoverFunc) PushHook(hook func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6540,21 +8068,21 @@ func (f *LSIFStoreDocumentationDefinitionsFunc) PushHook(hook func(context.Conte
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStoreDocumentationDefinitionsFunc) SetDefaultReturn(r0 []lsifstore.Location, r1 int, r2 error) {
-	f.SetDefaultHook(func(context.Context, int, string, int, int) ([]lsifstore.Location, int, error) {
-		return r0, r1, r2
+func (f *LSIFStoreHoverFunc) SetDefaultReturn(r0 string, r1 lsifstore.Range, r2 bool, r3 error) {
+	f.SetDefaultHook(func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error) {
+		return r0, r1, r2, r3
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStoreDocumentationDefinitionsFunc) PushReturn(r0 []lsifstore.Location, r1 int, r2 error) {
-	f.PushHook(func(context.Context, int, string, int, int) ([]lsifstore.Location, int, error) {
-		return r0, r1, r2
+func (f *LSIFStoreHoverFunc) PushReturn(r0 string, r1 lsifstore.Range, r2 bool, r3 error) {
+	f.PushHook(func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error) {
+		return r0, r1, r2, r3
 	})
 }
 
-func (f *LSIFStoreDocumentationDefinitionsFunc) nextHook() func(context.Context, int, string, int, int) ([]lsifstore.Location, int, error) {
+func (f *LSIFStoreHoverFunc) nextHook() func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6567,27 +8095,26 @@ func (f *LSIFStoreDocumentationDefinitionsFunc) nextHook() func(context.Context,
 	return hook
 }
 
-func (f *LSIFStoreDocumentationDefinitionsFunc) appendCall(r0 LSIFStoreDocumentationDefinitionsFuncCall) {
+func (f *LSIFStoreHoverFunc) appendCall(r0 LSIFStoreHoverFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreDocumentationDefinitionsFuncCall
-// objects describing the invocations of this function.
-func (f *LSIFStoreDocumentationDefinitionsFunc) History() []LSIFStoreDocumentationDefinitionsFuncCall {
+// History returns a sequence of LSIFStoreHoverFuncCall objects describing
+// the invocations of this function.
+func (f *LSIFStoreHoverFunc) History() []LSIFStoreHoverFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreDocumentationDefinitionsFuncCall, len(f.history))
+	history := make([]LSIFStoreHoverFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreDocumentationDefinitionsFuncCall is an object that describes an
-// invocation of method DocumentationDefinitions on an instance of
-// MockLSIFStore.
-type LSIFStoreDocumentationDefinitionsFuncCall struct {
+// LSIFStoreHoverFuncCall is an object that describes an invocation of
+// method Hover on an instance of MockLSIFStore.
+type LSIFStoreHoverFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -6605,56 +8132,59 @@ type LSIFStoreDocumentationDefinitionsFuncCall struct {
 	Arg4 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []lsifstore.Location
+	Result0 string
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 int
+	Result1 lsifstore.Range
 	// Result2 is the value of the 3rd result returned from this method
 	// invocation.
-	Result2 error
+	Result2 bool
+	// Result3 is the value of the 4th result returned from this method
+	// invocation.
+	Result3 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreDocumentationDefinitionsFuncCall) Args() []interface{} {
+func (c LSIFStoreHoverFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreDocumentationDefinitionsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+func (c LSIFStoreHoverFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2, c.Result3}
 }
 
-// LSIFStoreDocumentationPageFunc describes the behavior when the
-// DocumentationPage method of the parent MockLSIFStore instance is invoked.
-type LSIFStoreDocumentationPageFunc struct {
-	defaultHook func(context.Context, int, string) (*precise.DocumentationPageData, error)
-	hooks       []func(context.Context, int, string) (*precise.DocumentationPageData, error)
-	history     []LSIFStoreDocumentationPageFuncCall
+// LSIFStoreImplementationsFunc describes the behavior when the
+// Implementations method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreImplementationsFunc struct {
+	defaultHook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
+	hooks       []func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
+	history     []LSIFStoreImplementationsFuncCall
 	mutex       sync.Mutex
 }
 
-// DocumentationPage delegates to the next hook function in the queue and
+// Implementations delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockLSIFStore) DocumentationPage(v0 context.Context, v1 int, v2 string) (*precise.DocumentationPageData, error) {
-	r0, r1 := m.DocumentationPageFunc.nextHook()(v0, v1, v2)
-	m.DocumentationPageFunc.appendCall(LSIFStoreDocumentationPageFuncCall{v0, v1, v2, r0, r1})
-	return r0, r1
+func (m *MockLSIFStore) Implementations(v0 context.Context, v1 int, v2 string, v3 int, v4 int, v5 int, v6 int) ([]lsifstore.Location, int, error) {
+	r0, r1, r2 := m.ImplementationsFunc.nextHook()(v0, v1, v2, v3, v4, v5, v6)
+	m.ImplementationsFunc.appendCall(LSIFStoreImplementationsFuncCall{v0, v1, v2, v3, v4, v5, v6, r0, r1, r2})
+	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the DocumentationPage
+// SetDefaultHook sets function that is called when the Implementations
 // method of the parent MockLSIFStore instance is invoked and the hook queue
 // is empty.
-func (f *LSIFStoreDocumentationPageFunc) SetDefaultHook(hook func(context.Context, int, string) (*precise.DocumentationPageData, error)) {
+func (f *LSIFStoreImplementationsFunc) SetDefaultHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// DocumentationPage method of the parent MockLSIFStore instance invokes the
+// Implementations method of the parent MockLSIFStore instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *LSIFStoreDocumentationPageFunc) PushHook(hook func(context.Context, int, string) (*precise.DocumentationPageData, error)) {
+func (f *LSIFStoreImplementationsFunc) PushHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6662,21 +8192,21 @@ func (f *LSIFStoreDocumentationPageFunc) PushHook(hook func(context.Context, int
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStoreDocumentationPageFunc) SetDefaultReturn(r0 *precise.DocumentationPageData, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string) (*precise.DocumentationPageData, error) {
-		return r0, r1
+func (f *LSIFStoreImplementationsFunc) SetDefaultReturn(r0 []lsifstore.Location, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStoreDocumentationPageFunc) PushReturn(r0 *precise.DocumentationPageData, r1 error) {
-	f.PushHook(func(context.Context, int, string) (*precise.DocumentationPageData, error) {
-		return r0, r1
+func (f *LSIFStoreImplementationsFunc) PushReturn(r0 []lsifstore.Location, r1 int, r2 error) {
+	f.PushHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *LSIFStoreDocumentationPageFunc) nextHook() func(context.Context, int, string) (*precise.DocumentationPageData, error) {
+func (f *LSIFStoreImplementationsFunc) nextHook() func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6689,26 +8219,26 @@ func (f *LSIFStoreDocumentationPageFunc) nextHook() func(context.Context, int, s
 	return hook
 }
 
-func (f *LSIFStoreDocumentationPageFunc) appendCall(r0 LSIFStoreDocumentationPageFuncCall) {
+func (f *LSIFStoreImplementationsFunc) appendCall(r0 LSIFStoreImplementationsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreDocumentationPageFuncCall objects
+// History returns a sequence of LSIFStoreImplementationsFuncCall objects
 // describing the invocations of this function.
-func (f *LSIFStoreDocumentationPageFunc) History() []LSIFStoreDocumentationPageFuncCall {
+func (f *LSIFStoreImplementationsFunc) History() []LSIFStoreImplementationsFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreDocumentationPageFuncCall, len(f.history))
+	history := make([]LSIFStoreImplementationsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreDocumentationPageFuncCall is an object that describes an
-// invocation of method DocumentationPage on an instance of MockLSIFStore.
-type LSIFStoreDocumentationPageFuncCall struct {
+// LSIFStoreImplementationsFuncCall is an object that describes an
+// invocation of method Implementations on an instance of MockLSIFStore.
+type LSIFStoreImplementationsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -6718,56 +8248,71 @@ type LSIFStoreDocumentationPageFuncCall struct {
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
 	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 int
+	// Arg6 is the value of the 7th argument passed to this method
+	// invocation.
+	Arg6 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *precise.DocumentationPageData
+	Result0 []lsifstore.Location
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 error
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreDocumentationPageFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c LSIFStoreImplementationsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5, c.Arg6}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreDocumentationPageFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c LSIFStoreImplementationsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// LSIFStoreDocumentationPathInfoFunc describes the behavior when the
-// DocumentationPathInfo method of the parent MockLSIFStore instance is
+// LSIFStoreMonikersByPositionFunc describes the behavior when the
+// MonikersByPosition method of the parent MockLSIFStore instance is
 // invoked.
-type LSIFStoreDocumentationPathInfoFunc struct {
-	defaultHook func(context.Context, int, string) (*precise.DocumentationPathInfoData, error)
-	hooks       []func(context.Context, int, string) (*precise.DocumentationPathInfoData, error)
-	history     []LSIFStoreDocumentationPathInfoFuncCall
+type LSIFStoreMonikersByPositionFunc struct {
+	defaultHook func(context.Context, int, string, int, int) ([][]precise.MonikerData, error)
+	hooks       []func(context.Context, int, string, int, int) ([][]precise.MonikerData, error)
+	history     []LSIFStoreMonikersByPositionFuncCall
 	mutex       sync.Mutex
 }
 
-// DocumentationPathInfo delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockLSIFStore) DocumentationPathInfo(v0 context.Context, v1 int, v2 string) (*precise.DocumentationPathInfoData, error) {
-	r0, r1 := m.DocumentationPathInfoFunc.nextHook()(v0, v1, v2)
-	m.DocumentationPathInfoFunc.appendCall(LSIFStoreDocumentationPathInfoFuncCall{v0, v1, v2, r0, r1})
+// MonikersByPosition delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockLSIFStore) MonikersByPosition(v0 context.Context, v1 int, v2 string, v3 int, v4 int) ([][]precise.MonikerData, error) {
+	r0, r1 := m.MonikersByPositionFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.MonikersByPositionFunc.appendCall(LSIFStoreMonikersByPositionFuncCall{v0, v1, v2, v3, v4, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// DocumentationPathInfo method of the parent MockLSIFStore instance is
-// invoked and the hook queue is empty.
-func (f *LSIFStoreDocumentationPathInfoFunc) SetDefaultHook(hook func(context.Context, int, string) (*precise.DocumentationPathInfoData, error)) {
+// SetDefaultHook sets function that is called when the MonikersByPosition
+// method of the parent MockLSIFStore instance is invoked and the hook queue
+// is empty.
+func (f *LSIFStoreMonikersByPositionFunc) SetDefaultHook(hook func(context.Context, int, string, int, int) ([][]precise.MonikerData, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// DocumentationPathInfo method of the parent MockLSIFStore instance invokes
+// MonikersByPosition method of the parent MockLSIFStore instance invokes
 // the hook at the front of the queue and discards it. After the queue is
 // empty, the default hook function is invoked for any future action.
-func (f *LSIFStoreDocumentationPathInfoFunc) PushHook(hook func(context.Context, int, string) (*precise.DocumentationPathInfoData, error)) {
+func (f *LSIFStoreMonikersByPositionFunc) PushHook(hook func(context.Context, int, string, int, int) ([][]precise.MonikerData, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6775,21 +8320,21 @@ func (f *LSIFStoreDocumentationPathInfoFunc) PushHook(hook func(context.Context,
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStoreDocumentationPathInfoFunc) SetDefaultReturn(r0 *precise.DocumentationPathInfoData, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string) (*precise.DocumentationPathInfoData, error) {
+func (f *LSIFStoreMonikersByPositionFunc) SetDefaultReturn(r0 [][]precise.MonikerData, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, int, int) ([][]precise.MonikerData, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStoreDocumentationPathInfoFunc) PushReturn(r0 *precise.DocumentationPathInfoData, r1 error) {
-	f.PushHook(func(context.Context, int, string) (*precise.DocumentationPathInfoData, error) {
+func (f *LSIFStoreMonikersByPositionFunc) PushReturn(r0 [][]precise.MonikerData, r1 error) {
+	f.PushHook(func(context.Context, int, string, int, int) ([][]precise.MonikerData, error) {
 		return r0, r1
 	})
 }
 
-func (f *LSIFStoreDocumentationPathInfoFunc) nextHook() func(context.Context, int, string) (*precise.DocumentationPathInfoData, error) {
+func (f *LSIFStoreMonikersByPositionFunc) nextHook() func(context.Context, int, string, int, int) ([][]precise.MonikerData, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6802,27 +8347,26 @@ func (f *LSIFStoreDocumentationPathInfoFunc) nextHook() func(context.Context, in
 	return hook
 }
 
-func (f *LSIFStoreDocumentationPathInfoFunc) appendCall(r0 LSIFStoreDocumentationPathInfoFuncCall) {
+func (f *LSIFStoreMonikersByPositionFunc) appendCall(r0 LSIFStoreMonikersByPositionFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreDocumentationPathInfoFuncCall
-// objects describing the invocations of this function.
-func (f *LSIFStoreDocumentationPathInfoFunc) History() []LSIFStoreDocumentationPathInfoFuncCall {
+// History returns a sequence of LSIFStoreMonikersByPositionFuncCall objects
+// describing the invocations of this function.
+func (f *LSIFStoreMonikersByPositionFunc) History() []LSIFStoreMonikersByPositionFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreDocumentationPathInfoFuncCall, len(f.history))
+	history := make([]LSIFStoreMonikersByPositionFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreDocumentationPathInfoFuncCall is an object that describes an
-// invocation of method DocumentationPathInfo on an instance of
-// MockLSIFStore.
-type LSIFStoreDocumentationPathInfoFuncCall struct {
+// LSIFStoreMonikersByPositionFuncCall is an object that describes an
+// invocation of method MonikersByPosition on an instance of MockLSIFStore.
+type LSIFStoreMonikersByPositionFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -6832,9 +8376,15 @@ type LSIFStoreDocumentationPathInfoFuncCall struct {
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
 	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *precise.DocumentationPathInfoData
+	Result0 [][]precise.MonikerData
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -6842,46 +8392,46 @@ type LSIFStoreDocumentationPathInfoFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreDocumentationPathInfoFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c LSIFStoreMonikersByPositionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreDocumentationPathInfoFuncCall) Results() []interface{} {
+func (c LSIFStoreMonikersByPositionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// LSIFStoreDocumentationSearchFunc describes the behavior when the
-// DocumentationSearch method of the parent MockLSIFStore instance is
+// LSIFStorePackageInformationFunc describes the behavior when the
+// PackageInformation method of the parent MockLSIFStore instance is
 // invoked.
-type LSIFStoreDocumentationSearchFunc struct {
-	defaultHook func(context.Context, string, string, []string) ([]precise.DocumentationSearchResult, error)
-	hooks       []func(context.Context, string, string, []string) ([]precise.DocumentationSearchResult, error)
-	history     []LSIFStoreDocumentationSearchFuncCall
+type LSIFStorePackageInformationFunc struct {
+	defaultHook func(context.Context, int, string, string) (precise.PackageInformationData, bool, error)
+	hooks       []func(context.Context, int, string, string) (precise.PackageInformationData, bool, error)
+	history     []LSIFStorePackageInformationFuncCall
 	mutex       sync.Mutex
 }
 
-// DocumentationSearch delegates to the next hook function in the queue and
+// PackageInformation delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockLSIFStore) DocumentationSearch(v0 context.Context, v1 string, v2 string, v3 []string) ([]precise.DocumentationSearchResult, error) {
-	r0, r1 := m.DocumentationSearchFunc.nextHook()(v0, v1, v2, v3)
-	m.DocumentationSearchFunc.appendCall(LSIFStoreDocumentationSearchFuncCall{v0, v1, v2, v3, r0, r1})
-	return r0, r1
+func (m *MockLSIFStore) PackageInformation(v0 context.Context, v1 int, v2 string, v3 string) (precise.PackageInformationData, bool, error) {
+	r0, r1, r2 := m.PackageInformationFunc.nextHook()(v0, v1, v2, v3)
+	m.PackageInformationFunc.appendCall(LSIFStorePackageInformationFuncCall{v0, v1, v2, v3, r0, r1, r2})
+	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the DocumentationSearch
+// SetDefaultHook sets function that is called when the PackageInformation
 // method of the parent MockLSIFStore instance is invoked and the hook queue
 // is empty.
-func (f *LSIFStoreDocumentationSearchFunc) SetDefaultHook(hook func(context.Context, string, string, []string) ([]precise.DocumentationSearchResult, error)) {
+func (f *LSIFStorePackageInformationFunc) SetDefaultHook(hook func(context.Context, int, string, string) (precise.PackageInformationData, bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// DocumentationSearch method of the parent MockLSIFStore instance invokes
+// PackageInformation method of the parent MockLSIFStore instance invokes
 // the hook at the front of the queue and discards it. After the queue is
 // empty, the default hook function is invoked for any future action.
-func (f *LSIFStoreDocumentationSearchFunc) PushHook(hook func(context.Context, string, string, []string) ([]precise.DocumentationSearchResult, error)) {
+func (f *LSIFStorePackageInformationFunc) PushHook(hook func(context.Context, int, string, string) (precise.PackageInformationData, bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6889,21 +8439,21 @@ func (f *LSIFStoreDocumentationSearchFunc) PushHook(hook func(context.Context, s
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStoreDocumentationSearchFunc) SetDefaultReturn(r0 []precise.DocumentationSearchResult, r1 error) {
-	f.SetDefaultHook(func(context.Context, string, string, []string) ([]precise.DocumentationSearchResult, error) {
-		return r0, r1
+func (f *LSIFStorePackageInformationFunc) SetDefaultReturn(r0 precise.PackageInformationData, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, int, string, string) (precise.PackageInformationData, bool, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStoreDocumentationSearchFunc) PushReturn(r0 []precise.DocumentationSearchResult, r1 error) {
-	f.PushHook(func(context.Context, string, string, []string) ([]precise.DocumentationSearchResult, error) {
-		return r0, r1
+func (f *LSIFStorePackageInformationFunc) PushReturn(r0 precise.PackageInformationData, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, int, string, string) (precise.PackageInformationData, bool, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *LSIFStoreDocumentationSearchFunc) nextHook() func(context.Context, string, string, []string) ([]precise.DocumentationSearchResult, error) {
+func (f *LSIFStorePackageInformationFunc) nextHook() func(context.Context, int, string, string) (precise.PackageInformationData, bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6916,86 +8466,89 @@ func (f *LSIFStoreDocumentationSearchFunc) nextHook() func(context.Context, stri
 	return hook
 }
 
-func (f *LSIFStoreDocumentationSearchFunc) appendCall(r0 LSIFStoreDocumentationSearchFuncCall) {
+func (f *LSIFStorePackageInformationFunc) appendCall(r0 LSIFStorePackageInformationFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreDocumentationSearchFuncCall
-// objects describing the invocations of this function.
-func (f *LSIFStoreDocumentationSearchFunc) History() []LSIFStoreDocumentationSearchFuncCall {
+// History returns a sequence of LSIFStorePackageInformationFuncCall objects
+// describing the invocations of this function.
+func (f *LSIFStorePackageInformationFunc) History() []LSIFStorePackageInformationFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreDocumentationSearchFuncCall, len(f.history))
+	history := make([]LSIFStorePackageInformationFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreDocumentationSearchFuncCall is an object that describes an
-// invocation of method DocumentationSearch on an instance of MockLSIFStore.
-type LSIFStoreDocumentationSearchFuncCall struct {
+// LSIFStorePackageInformationFuncCall is an object that describes an
+// invocation of method PackageInformation on an instance of MockLSIFStore.
+type LSIFStorePackageInformationFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 string
+	Arg1 int
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
 	Arg2 string
 	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
-	Arg3 []string
+	Arg3 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []precise.DocumentationSearchResult
+	Result0 precise.PackageInformationData
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 error
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreDocumentationSearchFuncCall) Args() []interface{} {
+func (c LSIFStorePackageInformationFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreDocumentationSearchFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c LSIFStorePackageInformationFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// LSIFStoreExistsFunc describes the behavior when the Exists method of the
+// LSIFStoreRangesFunc describes the behavior when the Ranges method of the
 // parent MockLSIFStore instance is invoked.
-type LSIFStoreExistsFunc struct {
-	defaultHook func(context.Context, int, string) (bool, error)
-	hooks       []func(context.Context, int, string) (bool, error)
-	history     []LSIFStoreExistsFuncCall
+type LSIFStoreRangesFunc struct {
+	defaultHook func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error)
+	hooks       []func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error)
+	history     []LSIFStoreRangesFuncCall
 	mutex       sync.Mutex
 }
 
-// Exists delegates to the next hook function in the queue and stores the
+// Ranges delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockLSIFStore) Exists(v0 context.Context, v1 int, v2 string) (bool, error) {
-	r0, r1 := m.ExistsFunc.nextHook()(v0, v1, v2)
-	m.ExistsFunc.appendCall(LSIFStoreExistsFuncCall{v0, v1, v2, r0, r1})
+func (m *MockLSIFStore) Ranges(v0 context.Context, v1 int, v2 string, v3 int, v4 int) ([]lsifstore.CodeIntelligenceRange, error) {
+	r0, r1 := m.RangesFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.RangesFunc.appendCall(LSIFStoreRangesFuncCall{v0, v1, v2, v3, v4, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Exists method of the
+// SetDefaultHook sets function that is called when the Ranges method of the
 // parent MockLSIFStore instance is invoked and the hook queue is empty.
-func (f *LSIFStoreExistsFunc) SetDefaultHook(hook func(context.Context, int, string) (bool, error)) {
+func (f *LSIFStoreRangesFunc) SetDefaultHook(hook func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Exists method of the parent MockLSIFStore instance invokes the hook at
+// Ranges method of the parent MockLSIFStore instance invokes the hook at
 // the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *LSIFStoreExistsFunc) PushHook(hook func(context.Context, int, string) (bool, error)) {
+func (f *LSIFStoreRangesFunc) PushHook(hook func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -7003,21 +8556,21 @@ func (f *LSIFStoreExistsFunc) PushHook(hook func(context.Context, int, string) (
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStoreExistsFunc) SetDefaultReturn(r0 bool, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string) (bool, error) {
+func (f *LSIFStoreRangesFunc) SetDefaultReturn(r0 []lsifstore.CodeIntelligenceRange, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStoreExistsFunc) PushReturn(r0 bool, r1 error) {
-	f.PushHook(func(context.Context, int, string) (bool, error) {
+func (f *LSIFStoreRangesFunc) PushReturn(r0 []lsifstore.CodeIntelligenceRange, r1 error) {
+	f.PushHook(func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error) {
 		return r0, r1
 	})
 }
 
-func (f *LSIFStoreExistsFunc) nextHook() func(context.Context, int, string) (bool, error) {
+func (f *LSIFStoreRangesFunc) nextHook() func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -7030,26 +8583,26 @@ func (f *LSIFStoreExistsFunc) nextHook() func(context.Context, int, string) (boo
 	return hook
 }
 
-func (f *LSIFStoreExistsFunc) appendCall(r0 LSIFStoreExistsFuncCall) {
+func (f *LSIFStoreRangesFunc) appendCall(r0 LSIFStoreRangesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreExistsFuncCall objects describing
+// History returns a sequence of LSIFStoreRangesFuncCall objects describing
 // the invocations of this function.
-func (f *LSIFStoreExistsFunc) History() []LSIFStoreExistsFuncCall {
+func (f *LSIFStoreRangesFunc) History() []LSIFStoreRangesFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreExistsFuncCall, len(f.history))
+	history := make([]LSIFStoreRangesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreExistsFuncCall is an object that describes an invocation of
-// method Exists on an instance of MockLSIFStore.
-type LSIFStoreExistsFuncCall struct {
+// LSIFStoreRangesFuncCall is an object that describes an invocation of
+// method Ranges on an instance of MockLSIFStore.
+type LSIFStoreRangesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -7059,9 +8612,15 @@ type LSIFStoreExistsFuncCall struct {
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
 	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 bool
+	Result0 []lsifstore.CodeIntelligenceRange
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -7069,44 +8628,44 @@ type LSIFStoreExistsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreExistsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c LSIFStoreRangesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreExistsFuncCall) Results() []interface{} {
+func (c LSIFStoreRangesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// LSIFStoreHoverFunc describes the behavior when the Hover method of the
-// parent MockLSIFStore instance is invoked.
-type LSIFStoreHoverFunc struct {
-	defaultHook func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error)
-	hooks       []func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error)
-	history     []LSIFStoreHoverFuncCall
+// LSIFStoreReferencesFunc describes the behavior when the References method
+// of the parent MockLSIFStore instance is invoked.
+type LSIFStoreReferencesFunc struct {
+	defaultHook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
+	hooks       []func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
+	history     []LSIFStoreReferencesFuncCall
 	mutex       sync.Mutex
 }
 
-// Hover delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockLSIFStore) Hover(v0 context.Context, v1 int, v2 string, v3 int, v4 int) (string, lsifstore.Range, bool, error) {
-	r0, r1, r2, r3 := m.HoverFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.HoverFunc.appendCall(LSIFStoreHoverFuncCall{v0, v1, v2, v3, v4, r0, r1, r2, r3})
-	return r0, r1, r2, r3
+// References delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockLSIFStore) References(v0 context.Context, v1 int, v2 string, v3 int, v4 int, v5 int, v6 int) ([]lsifstore.Location, int, error) {
+	r0, r1, r2 := m.ReferencesFunc.nextHook()(v0, v1, v2, v3, v4, v5, v6)
+	m.ReferencesFunc.appendCall(LSIFStoreReferencesFuncCall{v0, v1, v2, v3, v4, v5, v6, r0, r1, r2})
+	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the Hover method of the
-// parent MockLSIFStore instance is invoked and the hook queue is empty.
-func (f *LSIFStoreHoverFunc) SetDefaultHook(hook func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error)) {
+// SetDefaultHook sets function that is called when the References method of
+// the parent MockLSIFStore instance is invoked and the hook queue is empty.
+func (f *LSIFStoreReferencesFunc) SetDefaultHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Hover method of the parent MockLSIFStore instance invokes the hook at the
-// front of the queue and discards it. After the queue is empty, the default
-// hook function is invoked for any future action.
-func (f *LSIFStoreHoverFunc) PushHook(hook func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error)) {
+// References method of the parent MockLSIFStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *LSIFStoreReferencesFunc) PushHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -7114,21 +8673,21 @@ func (f *LSIFStoreHoverFunc) PushHook(hook func(context.Context, int, string, in
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStoreHoverFunc) SetDefaultReturn(r0 string, r1 lsifstore.Range, r2 bool, r3 error) {
-	f.SetDefaultHook(func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error) {
-		return r0, r1, r2, r3
+func (f *LSIFStoreReferencesFunc) SetDefaultReturn(r0 []lsifstore.Location, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStoreHoverFunc) PushReturn(r0 string, r1 lsifstore.Range, r2 bool, r3 error) {
-	f.PushHook(func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error) {
-		return r0, r1, r2, r3
+func (f *LSIFStoreReferencesFunc) PushReturn(r0 []lsifstore.Location, r1 int, r2 error) {
+	f.PushHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *LSIFStoreHoverFunc) nextHook() func(context.Context, int, string, int, int) (string, lsifstore.Range, bool, error) {
+func (f *LSIFStoreReferencesFunc) nextHook() func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -7141,26 +8700,26 @@ func (f *LSIFStoreHoverFunc) nextHook() func(context.Context, int, string, int,
 	return hook
 }
 
-func (f *LSIFStoreHoverFunc) appendCall(r0 LSIFStoreHoverFuncCall) {
+func (f *LSIFStoreReferencesFunc) appendCall(r0 LSIFStoreReferencesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreHoverFuncCall objects describing
-// the invocations of this function.
-func (f *LSIFStoreHoverFunc) History() []LSIFStoreHoverFuncCall {
+// History returns a sequence of LSIFStoreReferencesFuncCall objects
+// describing the invocations of this function.
+func (f *LSIFStoreReferencesFunc) History() []LSIFStoreReferencesFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreHoverFuncCall, len(f.history))
+	history := make([]LSIFStoreReferencesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreHoverFuncCall is an object that describes an invocation of
-// method Hover on an instance of MockLSIFStore.
-type LSIFStoreHoverFuncCall struct {
+// LSIFStoreReferencesFuncCall is an object that describes an invocation of
+// method References on an instance of MockLSIFStore.
+type LSIFStoreReferencesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -7176,61 +8735,63 @@ type LSIFStoreHoverFuncCall struct {
 	// Arg4 is the value of the 5th argument passed to this method
 	// invocation.
 	Arg4 int
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 int
+	// Arg6 is the value of the 7th argument passed to this method
+	// invocation.
+	Arg6 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 string
+	Result0 []lsifstore.Location
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 lsifstore.Range
+	Result1 int
 	// Result2 is the value of the 3rd result returned from this method
 	// invocation.
-	Result2 bool
-	// Result3 is the value of the 4th result returned from this method
-	// invocation.
-	Result3 error
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreHoverFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+func (c LSIFStoreReferencesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5, c.Arg6}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreHoverFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2, c.Result3}
+func (c LSIFStoreReferencesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// LSIFStoreImplementationsFunc describes the behavior when the
-// Implementations method of the parent MockLSIFStore instance is invoked.
-type LSIFStoreImplementationsFunc struct {
-	defaultHook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
-	hooks       []func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
-	history     []LSIFStoreImplementationsFuncCall
+// LSIFStoreStencilFunc describes the behavior when the Stencil method of
+// the parent MockLSIFStore instance is invoked.
+type LSIFStoreStencilFunc struct {
+	defaultHook func(context.Context, int, string) ([]lsifstore.Range, error)
+	hooks       []func(context.Context, int, string) ([]lsifstore.Range, error)
+	history     []LSIFStoreStencilFuncCall
 	mutex       sync.Mutex
 }
 
-// Implementations delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockLSIFStore) Implementations(v0 context.Context, v1 int, v2 string, v3 int, v4 int, v5 int, v6 int) ([]lsifstore.Location, int, error) {
-	r0, r1, r2 := m.ImplementationsFunc.nextHook()(v0, v1, v2, v3, v4, v5, v6)
-	m.ImplementationsFunc.appendCall(LSIFStoreImplementationsFuncCall{v0, v1, v2, v3, v4, v5, v6, r0, r1, r2})
-	return r0, r1, r2
+// Stencil delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockLSIFStore) Stencil(v0 context.Context, v1 int, v2 string) ([]lsifstore.Range, error) {
+	r0, r1 := m.StencilFunc.nextHook()(v0, v1, v2)
+	m.StencilFunc.appendCall(LSIFStoreStencilFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Implementations
-// method of the parent MockLSIFStore instance is invoked and the hook queue
-// is empty.
-func (f *LSIFStoreImplementationsFunc) SetDefaultHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
+// SetDefaultHook sets function that is called when the Stencil method of
+// the parent MockLSIFStore instance is invoked and the hook queue is empty.
+func (f *LSIFStoreStencilFunc) SetDefaultHook(hook func(context.Context, int, string) ([]lsifstore.Range, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Implementations method of the parent MockLSIFStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *LSIFStoreImplementationsFunc) PushHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
+// Stencil method of the parent MockLSIFStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *LSIFStoreStencilFunc) PushHook(hook func(context.Context, int, string) ([]lsifstore.Range, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -7238,21 +8799,21 @@ func (f *LSIFStoreImplementationsFunc) PushHook(hook func(context.Context, int,
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStoreImplementationsFunc) SetDefaultReturn(r0 []lsifstore.Location, r1 int, r2 error) {
-	f.SetDefaultHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
-		return r0, r1, r2
+func (f *LSIFStoreStencilFunc) SetDefaultReturn(r0 []lsifstore.Range, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string) ([]lsifstore.Range, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStoreImplementationsFunc) PushReturn(r0 []lsifstore.Location, r1 int, r2 error) {
-	f.PushHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
-		return r0, r1, r2
+func (f *LSIFStoreStencilFunc) PushReturn(r0 []lsifstore.Range, r1 error) {
+	f.PushHook(func(context.Context, int, string) ([]lsifstore.Range, error) {
+		return r0, r1
 	})
 }
 
-func (f *LSIFStoreImplementationsFunc) nextHook() func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+func (f *LSIFStoreStencilFunc) nextHook() func(context.Context, int, string) ([]lsifstore.Range, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -7265,26 +8826,26 @@ func (f *LSIFStoreImplementationsFunc) nextHook() func(context.Context, int, str
 	return hook
 }
 
-func (f *LSIFStoreImplementationsFunc) appendCall(r0 LSIFStoreImplementationsFuncCall) {
+func (f *LSIFStoreStencilFunc) appendCall(r0 LSIFStoreStencilFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreImplementationsFuncCall objects
-// describing the invocations of this function.
-func (f *LSIFStoreImplementationsFunc) History() []LSIFStoreImplementationsFuncCall {
+// History returns a sequence of LSIFStoreStencilFuncCall objects describing
+// the invocations of this function.
+func (f *LSIFStoreStencilFunc) History() []LSIFStoreStencilFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreImplementationsFuncCall, len(f.history))
+	history := make([]LSIFStoreStencilFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreImplementationsFuncCall is an object that describes an
-// invocation of method Implementations on an instance of MockLSIFStore.
-type LSIFStoreImplementationsFuncCall struct {
+// LSIFStoreStencilFuncCall is an object that describes an invocation of
+// method Stencil on an instance of MockLSIFStore.
+type LSIFStoreStencilFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -7294,71 +8855,54 @@ type LSIFStoreImplementationsFuncCall struct {
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
 	Arg2 string
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 int
-	// Arg4 is the value of the 5th argument passed to this method
-	// invocation.
-	Arg4 int
-	// Arg5 is the value of the 6th argument passed to this method
-	// invocation.
-	Arg5 int
-	// Arg6 is the value of the 7th argument passed to this method
-	// invocation.
-	Arg6 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []lsifstore.Location
+	Result0 []lsifstore.Range
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 int
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreImplementationsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5, c.Arg6}
+func (c LSIFStoreStencilFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreImplementationsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+func (c LSIFStoreStencilFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// LSIFStoreMonikersByPositionFunc describes the behavior when the
-// MonikersByPosition method of the parent MockLSIFStore instance is
-// invoked.
-type LSIFStoreMonikersByPositionFunc struct {
-	defaultHook func(context.Context, int, string, int, int) ([][]precise.MonikerData, error)
-	hooks       []func(context.Context, int, string, int, int) ([][]precise.MonikerData, error)
-	history     []LSIFStoreMonikersByPositionFuncCall
+// LSIFStoreSymbolsFunc describes the behavior when the Symbols method of
+// the parent MockLSIFStore instance is invoked.
+type LSIFStoreSymbolsFunc struct {
+	defaultHook func(context.Context, int, string, []lsifstore.Range) ([]*lsifstore.Symbol, error)
+	hooks       []func(context.Context, int, string, []lsifstore.Range) ([]*lsifstore.Symbol, error)
+	history     []LSIFStoreSymbolsFuncCall
 	mutex       sync.Mutex
 }
 
-// MonikersByPosition delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockLSIFStore) MonikersByPosition(v0 context.Context, v1 int, v2 string, v3 int, v4 int) ([][]precise.MonikerData, error) {
-	r0, r1 := m.MonikersByPositionFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.MonikersByPositionFunc.appendCall(LSIFStoreMonikersByPositionFuncCall{v0, v1, v2, v3, v4, r0, r1})
+// Symbols delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockLSIFStore) Symbols(v0 context.Context, v1 int, v2 string, v3 []lsifstore.Range) ([]*lsifstore.Symbol, error) {
+	r0, r1 := m.SymbolsFunc.nextHook()(v0, v1, v2, v3)
+	m.SymbolsFunc.appendCall(LSIFStoreSymbolsFuncCall{v0, v1, v2, v3, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the MonikersByPosition
-// method of the parent MockLSIFStore instance is invoked and the hook queue
-// is empty.
-func (f *LSIFStoreMonikersByPositionFunc) SetDefaultHook(hook func(context.Context, int, string, int, int) ([][]precise.MonikerData, error)) {
+// SetDefaultHook sets function that is called when the Symbols method of
+// the parent MockLSIFStore instance is invoked and the hook queue is empty.
+func (f *LSIFStoreSymbolsFunc) SetDefaultHook(hook func(context.Context, int, string, []lsifstore.Range) ([]*lsifstore.Symbol, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// MonikersByPosition method of the parent MockLSIFStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *LSIFStoreMonikersByPositionFunc) PushHook(hook func(context.Context, int, string, int, int) ([][]precise.MonikerData, error)) {
+// Symbols method of the parent MockLSIFStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *LSIFStoreSymbolsFunc) PushHook(hook func(context.Context, int, string, []lsifstore.Range) ([]*lsifstore.Symbol, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -7366,21 +8910,21 @@ func (f *LSIFStoreMonikersByPositionFunc) PushHook(hook func(context.Context, in
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStoreMonikersByPositionFunc) SetDefaultReturn(r0 [][]precise.MonikerData, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string, int, int) ([][]precise.MonikerData, error) {
+func (f *LSIFStoreSymbolsFunc) SetDefaultReturn(r0 []*lsifstore.Symbol, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, string, []lsifstore.Range) ([]*lsifstore.Symbol, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStoreMonikersByPositionFunc) PushReturn(r0 [][]precise.MonikerData, r1 error) {
-	f.PushHook(func(context.Context, int, string, int, int) ([][]precise.MonikerData, error) {
+func (f *LSIFStoreSymbolsFunc) PushReturn(r0 []*lsifstore.Symbol, r1 error) {
+	f.PushHook(func(context.Context, int, string, []lsifstore.Range) ([]*lsifstore.Symbol, error) {
 		return r0, r1
 	})
 }
 
-func (f *LSIFStoreMonikersByPositionFunc) nextHook() func(context.Context, int, string, int, int) ([][]precise.MonikerData, error) {
+func (f *LSIFStoreSymbolsFunc) nextHook() func(context.Context, int, string, []lsifstore.Range) ([]*lsifstore.Symbol, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -7393,26 +8937,26 @@ func (f *LSIFStoreMonikersByPositionFunc) nextHook() func(context.Context, int,
 	return hook
 }
 
-func (f *LSIFStoreMonikersByPositionFunc) appendCall(r0 LSIFStoreMonikersByPositionFuncCall) {
+func (f *LSIFStoreSymbolsFunc) appendCall(r0 LSIFStoreSymbolsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreMonikersByPositionFuncCall objects
-// describing the invocations of this function.
-func (f *LSIFStoreMonikersByPositionFunc) History() []LSIFStoreMonikersByPositionFuncCall {
+// History returns a sequence of LSIFStoreSymbolsFuncCall objects describing
+// the invocations of this function.
+func (f *LSIFStoreSymbolsFunc) History() []LSIFStoreSymbolsFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreMonikersByPositionFuncCall, len(f.history))
+	history := make([]LSIFStoreSymbolsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreMonikersByPositionFuncCall is an object that describes an
-// invocation of method MonikersByPosition on an instance of MockLSIFStore.
-type LSIFStoreMonikersByPositionFuncCall struct {
+// LSIFStoreSymbolsFuncCall is an object that describes an invocation of
+// method Symbols on an instance of MockLSIFStore.
+type LSIFStoreSymbolsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -7424,13 +8968,10 @@ type LSIFStoreMonikersByPositionFuncCall struct {
 	Arg2 string
 	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
-	Arg3 int
-	// Arg4 is the value of the 5th argument passed to this method
-	// invocation.
-	Arg4 int
+	Arg3 []lsifstore.Range
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 [][]precise.MonikerData
+	Result0 []*lsifstore.Symbol
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -7438,46 +8979,45 @@ type LSIFStoreMonikersByPositionFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreMonikersByPositionFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+func (c LSIFStoreSymbolsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreMonikersByPositionFuncCall) Results() []interface{} {
+func (c LSIFStoreSymbolsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// LSIFStorePackageInformationFunc describes the behavior when the
-// PackageInformation method of the parent MockLSIFStore instance is
-// invoked.
-type LSIFStorePackageInformationFunc struct {
-	defaultHook func(context.Context, int, string, string) (precise.PackageInformationData, bool, error)
-	hooks       []func(context.Context, int, string, string) (precise.PackageInformationData, bool, error)
-	history     []LSIFStorePackageInformationFuncCall
+// LSIFStoreTypeDefinitionsFunc describes the behavior when the
+// TypeDefinitions method of the parent MockLSIFStore instance is invoked.
+type LSIFStoreTypeDefinitionsFunc struct {
+	defaultHook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
+	hooks       []func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
+	history     []LSIFStoreTypeDefinitionsFuncCall
 	mutex       sync.Mutex
 }
 
-// PackageInformation delegates to the next hook function in the queue and
+// TypeDefinitions delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockLSIFStore) PackageInformation(v0 context.Context, v1 int, v2 string, v3 string) (precise.PackageInformationData, bool, error) {
-	r0, r1, r2 := m.PackageInformationFunc.nextHook()(v0, v1, v2, v3)
-	m.PackageInformationFunc.appendCall(LSIFStorePackageInformationFuncCall{v0, v1, v2, v3, r0, r1, r2})
+func (m *MockLSIFStore) TypeDefinitions(v0 context.Context, v1 int, v2 string, v3 int, v4 int, v5 int, v6 int) ([]lsifstore.Location, int, error) {
+	r0, r1, r2 := m.TypeDefinitionsFunc.nextHook()(v0, v1, v2, v3, v4, v5, v6)
+	m.TypeDefinitionsFunc.appendCall(LSIFStoreTypeDefinitionsFuncCall{v0, v1, v2, v3, v4, v5, v6, r0, r1, r2})
 	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the PackageInformation
+// SetDefaultHook sets function that is called when the TypeDefinitions
 // method of the parent MockLSIFStore instance is invoked and the hook queue
 // is empty.
-func (f *LSIFStorePackageInformationFunc) SetDefaultHook(hook func(context.Context, int, string, string) (precise.PackageInformationData, bool, error)) {
+func (f *LSIFStoreTypeDefinitionsFunc) SetDefaultHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// PackageInformation method of the parent MockLSIFStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *LSIFStorePackageInformationFunc) PushHook(hook func(context.Context, int, string, string) (precise.PackageInformationData, bool, error)) {
+// TypeDefinitions method of the parent MockLSIFStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *LSIFStoreTypeDefinitionsFunc) PushHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -7485,21 +9025,21 @@ func (f *LSIFStorePackageInformationFunc) PushHook(hook func(context.Context, in
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStorePackageInformationFunc) SetDefaultReturn(r0 precise.PackageInformationData, r1 bool, r2 error) {
-	f.SetDefaultHook(func(context.Context, int, string, string) (precise.PackageInformationData, bool, error) {
+func (f *LSIFStoreTypeDefinitionsFunc) SetDefaultReturn(r0 []lsifstore.Location, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
 		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStorePackageInformationFunc) PushReturn(r0 precise.PackageInformationData, r1 bool, r2 error) {
-	f.PushHook(func(context.Context, int, string, string) (precise.PackageInformationData, bool, error) {
+func (f *LSIFStoreTypeDefinitionsFunc) PushReturn(r0 []lsifstore.Location, r1 int, r2 error) {
+	f.PushHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
 		return r0, r1, r2
 	})
 }
 
-func (f *LSIFStorePackageInformationFunc) nextHook() func(context.Context, int, string, string) (precise.PackageInformationData, bool, error) {
+func (f *LSIFStoreTypeDefinitionsFunc) nextHook() func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -7512,26 +9052,26 @@ func (f *LSIFStorePackageInformationFunc) nextHook() func(context.Context, int,
 	return hook
 }
 
-func (f *LSIFStorePackageInformationFunc) appendCall(r0 LSIFStorePackageInformationFuncCall) {
+func (f *LSIFStoreTypeDefinitionsFunc) appendCall(r0 LSIFStoreTypeDefinitionsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStorePackageInformationFuncCall objects
+// History returns a sequence of LSIFStoreTypeDefinitionsFuncCall objects
 // describing the invocations of this function.
-func (f *LSIFStorePackageInformationFunc) History() []LSIFStorePackageInformationFuncCall {
+func (f *LSIFStoreTypeDefinitionsFunc) History() []LSIFStoreTypeDefinitionsFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStorePackageInformationFuncCall, len(f.history))
+	history := make([]LSIFStoreTypeDefinitionsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStorePackageInformationFuncCall is an object that describes an
-// invocation of method PackageInformation on an instance of MockLSIFStore.
-type LSIFStorePackageInformationFuncCall struct {
+// LSIFStoreTypeDefinitionsFuncCall is an object that describes an
+// invocation of method TypeDefinitions on an instance of MockLSIFStore.
+type LSIFStoreTypeDefinitionsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -7543,13 +9083,22 @@ type LSIFStorePackageInformationFuncCall struct {
 	Arg2 string
 	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
-	Arg3 string
+	Arg3 int
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 int
+	// Arg6 is the value of the 7th argument passed to this method
+	// invocation.
+	Arg6 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 precise.PackageInformationData
+	Result0 []lsifstore.Location
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 bool
+	Result1 int
 	// Result2 is the value of the 3rd result returned from this method
 	// invocation.
 	Result2 error
@@ -7557,44 +9106,92 @@ type LSIFStorePackageInformationFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStorePackageInformationFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+func (c LSIFStoreTypeDefinitionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5, c.Arg6}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStorePackageInformationFuncCall) Results() []interface{} {
+func (c LSIFStoreTypeDefinitionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// LSIFStoreRangesFunc describes the behavior when the Ranges method of the
-// parent MockLSIFStore instance is invoked.
-type LSIFStoreRangesFunc struct {
-	defaultHook func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error)
-	hooks       []func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error)
-	history     []LSIFStoreRangesFuncCall
+// MockPolicyMatcher is a mock implementation of the PolicyMatcher interface
+// (from the package
+// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
+// used for unit testing.
+type MockPolicyMatcher struct {
+	// CommitsDescribedByPolicyFunc is an instance of a mock function object
+	// controlling the behavior of the method CommitsDescribedByPolicy.
+	CommitsDescribedByPolicyFunc *PolicyMatcherCommitsDescribedByPolicyFunc
+}
+
+// NewMockPolicyMatcher creates a new mock of the PolicyMatcher interface.
+// All methods return zero values for all results, unless overwritten.
+func NewMockPolicyMatcher() *MockPolicyMatcher {
+	return &MockPolicyMatcher{
+		CommitsDescribedByPolicyFunc: &PolicyMatcherCommitsDescribedByPolicyFunc{
+			defaultHook: func(context.Context, int, []dbstore.ConfigurationPolicy, time.Time) (map[string][]policies.PolicyMatch, error) {
+				return nil, nil
+			},
+		},
+	}
+}
+
+// NewStrictMockPolicyMatcher creates a new mock of the PolicyMatcher
+// interface. All methods panic on invocation, unless overwritten.
+func NewStrictMockPolicyMatcher() *MockPolicyMatcher {
+	return &MockPolicyMatcher{
+		CommitsDescribedByPolicyFunc: &PolicyMatcherCommitsDescribedByPolicyFunc{
+			defaultHook: func(context.Context, int, []dbstore.ConfigurationPolicy, time.Time) (map[string][]policies.PolicyMatch, error) {
+				panic("unexpected invocation of MockPolicyMatcher.CommitsDescribedByPolicy")
+			},
+		},
+	}
+}
+
+// NewMockPolicyMatcherFrom creates a new mock of the MockPolicyMatcher
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockPolicyMatcherFrom(i PolicyMatcher) *MockPolicyMatcher {
+	return &MockPolicyMatcher{
+		CommitsDescribedByPolicyFunc: &PolicyMatcherCommitsDescribedByPolicyFunc{
+			defaultHook: i.CommitsDescribedByPolicy,
+		},
+	}
+}
+
+// PolicyMatcherCommitsDescribedByPolicyFunc describes the behavior when the
+// CommitsDescribedByPolicy method of the parent MockPolicyMatcher instance
+// is invoked.
+type PolicyMatcherCommitsDescribedByPolicyFunc struct {
+	defaultHook func(context.Context, int, []dbstore.ConfigurationPolicy, time.Time) (map[string][]policies.PolicyMatch, error)
+	hooks       []func(context.Context, int, []dbstore.ConfigurationPolicy, time.Time) (map[string][]policies.PolicyMatch, error)
+	history     []PolicyMatcherCommitsDescribedByPolicyFuncCall
 	mutex       sync.Mutex
 }
 
-// Ranges delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockLSIFStore) Ranges(v0 context.Context, v1 int, v2 string, v3 int, v4 int) ([]lsifstore.CodeIntelligenceRange, error) {
-	r0, r1 := m.RangesFunc.nextHook()(v0, v1, v2, v3, v4)
-	m.RangesFunc.appendCall(LSIFStoreRangesFuncCall{v0, v1, v2, v3, v4, r0, r1})
+// CommitsDescribedByPolicy delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockPolicyMatcher) CommitsDescribedByPolicy(v0 context.Context, v1 int, v2 []dbstore.ConfigurationPolicy, v3 time.Time) (map[string][]policies.PolicyMatch, error) {
+	r0, r1 := m.CommitsDescribedByPolicyFunc.nextHook()(v0, v1, v2, v3)
+	m.CommitsDescribedByPolicyFunc.appendCall(PolicyMatcherCommitsDescribedByPolicyFuncCall{v0, v1, v2, v3, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Ranges method of the
-// parent MockLSIFStore instance is invoked and the hook queue is empty.
-func (f *LSIFStoreRangesFunc) SetDefaultHook(hook func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error)) {
+// SetDefaultHook sets function that is called when the
+// CommitsDescribedByPolicy method of the parent MockPolicyMatcher instance
+// is invoked and the hook queue is empty.
+func (f *PolicyMatcherCommitsDescribedByPolicyFunc) SetDefaultHook(hook func(context.Context, int, []dbstore.ConfigurationPolicy, time.Time) (map[string][]policies.PolicyMatch, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Ranges method of the parent MockLSIFStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *LSIFStoreRangesFunc) PushHook(hook func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error)) {
+// CommitsDescribedByPolicy method of the parent MockPolicyMatcher instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *PolicyMatcherCommitsDescribedByPolicyFunc) PushHook(hook func(context.Context, int, []dbstore.ConfigurationPolicy, time.Time) (map[string][]policies.PolicyMatch, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -7602,21 +9199,21 @@ func (f *LSIFStoreRangesFunc) PushHook(hook func(context.Context, int, string, i
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStoreRangesFunc) SetDefaultReturn(r0 []lsifstore.CodeIntelligenceRange, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error) {
+func (f *PolicyMatcherCommitsDescribedByPolicyFunc) SetDefaultReturn(r0 map[string][]policies.PolicyMatch, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, []dbstore.ConfigurationPolicy, time.Time) (map[string][]policies.PolicyMatch, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStoreRangesFunc) PushReturn(r0 []lsifstore.CodeIntelligenceRange, r1 error) {
-	f.PushHook(func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error) {
+func (f *PolicyMatcherCommitsDescribedByPolicyFunc) PushReturn(r0 map[string][]policies.PolicyMatch, r1 error) {
+	f.PushHook(func(context.Context, int, []dbstore.ConfigurationPolicy, time.Time) (map[string][]policies.PolicyMatch, error) {
 		return r0, r1
 	})
 }
 
-func (f *LSIFStoreRangesFunc) nextHook() func(context.Context, int, string, int, int) ([]lsifstore.CodeIntelligenceRange, error) {
+func (f *PolicyMatcherCommitsDescribedByPolicyFunc) nextHook() func(context.Context, int, []dbstore.ConfigurationPolicy, time.Time) (map[string][]policies.PolicyMatch, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -7629,26 +9226,28 @@ func (f *LSIFStoreRangesFunc) nextHook() func(context.Context, int, string, int,
 	return hook
 }
 
-func (f *LSIFStoreRangesFunc) appendCall(r0 LSIFStoreRangesFuncCall) {
+func (f *PolicyMatcherCommitsDescribedByPolicyFunc) appendCall(r0 PolicyMatcherCommitsDescribedByPolicyFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreRangesFuncCall objects describing
-// the invocations of this function.
-func (f *LSIFStoreRangesFunc) History() []LSIFStoreRangesFuncCall {
+// History returns a sequence of
+// PolicyMatcherCommitsDescribedByPolicyFuncCall objects describing the
+// invocations of this function.
+func (f *PolicyMatcherCommitsDescribedByPolicyFunc) History() []PolicyMatcherCommitsDescribedByPolicyFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreRangesFuncCall, len(f.history))
+	history := make([]PolicyMatcherCommitsDescribedByPolicyFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreRangesFuncCall is an object that describes an invocation of
-// method Ranges on an instance of MockLSIFStore.
-type LSIFStoreRangesFuncCall struct {
+// PolicyMatcherCommitsDescribedByPolicyFuncCall is an object that describes
+// an invocation of method CommitsDescribedByPolicy on an instance of
+// MockPolicyMatcher.
+type PolicyMatcherCommitsDescribedByPolicyFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -7657,16 +9256,13 @@ type LSIFStoreRangesFuncCall struct {
 	Arg1 int
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 string
+	Arg2 []dbstore.ConfigurationPolicy
 	// Arg3 is the value of the 4th argument passed to this method
 	// invocation.
-	Arg3 int
-	// Arg4 is the value of the 5th argument passed to this method
-	// invocation.
-	Arg4 int
+	Arg3 time.Time
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []lsifstore.CodeIntelligenceRange
+	Result0 map[string][]policies.PolicyMatch
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -7674,44 +9270,94 @@ type LSIFStoreRangesFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreRangesFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+func (c PolicyMatcherCommitsDescribedByPolicyFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreRangesFuncCall) Results() []interface{} {
+func (c PolicyMatcherCommitsDescribedByPolicyFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// LSIFStoreReferencesFunc describes the behavior when the References method
-// of the parent MockLSIFStore instance is invoked.
-type LSIFStoreReferencesFunc struct {
-	defaultHook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
-	hooks       []func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)
-	history     []LSIFStoreReferencesFuncCall
+// MockRepoUpdaterClient is a mock implementation of the RepoUpdaterClient
+// interface (from the package
+// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
+// used for unit testing.
+type MockRepoUpdaterClient struct {
+	// EnqueueRepoUpdateFunc is an instance of a mock function object
+	// controlling the behavior of the method EnqueueRepoUpdate.
+	EnqueueRepoUpdateFunc *RepoUpdaterClientEnqueueRepoUpdateFunc
+}
+
+// NewMockRepoUpdaterClient creates a new mock of the RepoUpdaterClient
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockRepoUpdaterClient() *MockRepoUpdaterClient {
+	return &MockRepoUpdaterClient{
+		EnqueueRepoUpdateFunc: &RepoUpdaterClientEnqueueRepoUpdateFunc{
+			defaultHook: func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error) {
+				return nil, nil
+			},
+		},
+	}
+}
+
+// NewStrictMockRepoUpdaterClient creates a new mock of the
+// RepoUpdaterClient interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockRepoUpdaterClient() *MockRepoUpdaterClient {
+	return &MockRepoUpdaterClient{
+		EnqueueRepoUpdateFunc: &RepoUpdaterClientEnqueueRepoUpdateFunc{
+			defaultHook: func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error) {
+				panic("unexpected invocation of MockRepoUpdaterClient.EnqueueRepoUpdate")
+			},
+		},
+	}
+}
+
+// NewMockRepoUpdaterClientFrom creates a new mock of the
+// MockRepoUpdaterClient interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockRepoUpdaterClientFrom(i RepoUpdaterClient) *MockRepoUpdaterClient {
+	return &MockRepoUpdaterClient{
+		EnqueueRepoUpdateFunc: &RepoUpdaterClientEnqueueRepoUpdateFunc{
+			defaultHook: i.EnqueueRepoUpdate,
+		},
+	}
+}
+
+// RepoUpdaterClientEnqueueRepoUpdateFunc describes the behavior when the
+// EnqueueRepoUpdate method of the parent MockRepoUpdaterClient instance is
+// invoked.
+type RepoUpdaterClientEnqueueRepoUpdateFunc struct {
+	defaultHook func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error)
+	hooks       []func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error)
+	history     []RepoUpdaterClientEnqueueRepoUpdateFuncCall
 	mutex       sync.Mutex
 }
 
-// References delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockLSIFStore) References(v0 context.Context, v1 int, v2 string, v3 int, v4 int, v5 int, v6 int) ([]lsifstore.Location, int, error) {
-	r0, r1, r2 := m.ReferencesFunc.nextHook()(v0, v1, v2, v3, v4, v5, v6)
-	m.ReferencesFunc.appendCall(LSIFStoreReferencesFuncCall{v0, v1, v2, v3, v4, v5, v6, r0, r1, r2})
-	return r0, r1, r2
+// EnqueueRepoUpdate delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockRepoUpdaterClient) EnqueueRepoUpdate(v0 context.Context, v1 api.RepoName) (*protocol.RepoUpdateResponse, error) {
+	r0, r1 := m.EnqueueRepoUpdateFunc.nextHook()(v0, v1)
+	m.EnqueueRepoUpdateFunc.appendCall(RepoUpdaterClientEnqueueRepoUpdateFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the References method of
-// the parent MockLSIFStore instance is invoked and the hook queue is empty.
-func (f *LSIFStoreReferencesFunc) SetDefaultHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
+// SetDefaultHook sets function that is called when the EnqueueRepoUpdate
+// method of the parent MockRepoUpdaterClient instance is invoked and the
+// hook queue is empty.
+func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// References method of the parent MockLSIFStore instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *LSIFStoreReferencesFunc) PushHook(hook func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error)) {
+// EnqueueRepoUpdate method of the parent MockRepoUpdaterClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) PushHook(hook func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -7719,21 +9365,21 @@ func (f *LSIFStoreReferencesFunc) PushHook(hook func(context.Context, int, strin
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStoreReferencesFunc) SetDefaultReturn(r0 []lsifstore.Location, r1 int, r2 error) {
-	f.SetDefaultHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
-		return r0, r1, r2
+func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) SetDefaultReturn(r0 *protocol.RepoUpdateResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStoreReferencesFunc) PushReturn(r0 []lsifstore.Location, r1 int, r2 error) {
-	f.PushHook(func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
-		return r0, r1, r2
+func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) PushReturn(r0 *protocol.RepoUpdateResponse, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error) {
+		return r0, r1
 	})
 }
 
-func (f *LSIFStoreReferencesFunc) nextHook() func(context.Context, int, string, int, int, int, int) ([]lsifstore.Location, int, error) {
+func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) nextHook() func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -7746,98 +9392,127 @@ func (f *LSIFStoreReferencesFunc) nextHook() func(context.Context, int, string,
 	return hook
 }
 
-func (f *LSIFStoreReferencesFunc) appendCall(r0 LSIFStoreReferencesFuncCall) {
+func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) appendCall(r0 RepoUpdaterClientEnqueueRepoUpdateFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreReferencesFuncCall objects
-// describing the invocations of this function.
-func (f *LSIFStoreReferencesFunc) History() []LSIFStoreReferencesFuncCall {
+// History returns a sequence of RepoUpdaterClientEnqueueRepoUpdateFuncCall
+// objects describing the invocations of this function.
+func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) History() []RepoUpdaterClientEnqueueRepoUpdateFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreReferencesFuncCall, len(f.history))
+	history := make([]RepoUpdaterClientEnqueueRepoUpdateFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreReferencesFuncCall is an object that describes an invocation of
-// method References on an instance of MockLSIFStore.
-type LSIFStoreReferencesFuncCall struct {
+// RepoUpdaterClientEnqueueRepoUpdateFuncCall is an object that describes an
+// invocation of method EnqueueRepoUpdate on an instance of
+// MockRepoUpdaterClient.
+type RepoUpdaterClientEnqueueRepoUpdateFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 string
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 int
-	// Arg4 is the value of the 5th argument passed to this method
-	// invocation.
-	Arg4 int
-	// Arg5 is the value of the 6th argument passed to this method
-	// invocation.
-	Arg5 int
-	// Arg6 is the value of the 7th argument passed to this method
-	// invocation.
-	Arg6 int
+	Arg1 api.RepoName
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []lsifstore.Location
+	Result0 *protocol.RepoUpdateResponse
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 int
-	// Result2 is the value of the 3rd result returned from this method
-	// invocation.
-	Result2 error
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreReferencesFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5, c.Arg6}
+func (c RepoUpdaterClientEnqueueRepoUpdateFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreReferencesFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1, c.Result2}
+func (c RepoUpdaterClientEnqueueRepoUpdateFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// LSIFStoreStencilFunc describes the behavior when the Stencil method of
-// the parent MockLSIFStore instance is invoked.
-type LSIFStoreStencilFunc struct {
-	defaultHook func(context.Context, int, string) ([]lsifstore.Range, error)
-	hooks       []func(context.Context, int, string) ([]lsifstore.Range, error)
-	history     []LSIFStoreStencilFuncCall
+// MockSearcherClient is a mock implementation of the SearcherClient
+// interface (from the package
+// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
+// used for unit testing.
+type MockSearcherClient struct {
+	// SearchFunc is an instance of a mock function object controlling the
+	// behavior of the method Search.
+	SearchFunc *SearcherClientSearchFunc
+}
+
+// NewMockSearcherClient creates a new mock of the SearcherClient interface.
+// All methods return zero values for all results, unless overwritten.
+func NewMockSearcherClient() *MockSearcherClient {
+	return &MockSearcherClient{
+		SearchFunc: &SearcherClientSearchFunc{
+			defaultHook: func(context.Context, api.RepoName, api.RepoID, api.CommitID, *search.TextPatternInfo, func([]*protocol1.FileMatch)) (bool, error) {
+				return false, nil
+			},
+		},
+	}
+}
+
+// NewStrictMockSearcherClient creates a new mock of the SearcherClient
+// interface. All methods panic on invocation, unless overwritten.
+func NewStrictMockSearcherClient() *MockSearcherClient {
+	return &MockSearcherClient{
+		SearchFunc: &SearcherClientSearchFunc{
+			defaultHook: func(context.Context, api.RepoName, api.RepoID, api.CommitID, *search.TextPatternInfo, func([]*protocol1.FileMatch)) (bool, error) {
+				panic("unexpected invocation of MockSearcherClient.Search")
+			},
+		},
+	}
+}
+
+// NewMockSearcherClientFrom creates a new mock of the MockSearcherClient
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockSearcherClientFrom(i SearcherClient) *MockSearcherClient {
+	return &MockSearcherClient{
+		SearchFunc: &SearcherClientSearchFunc{
+			defaultHook: i.Search,
+		},
+	}
+}
+
+// SearcherClientSearchFunc describes the behavior when the Search method of
+// the parent MockSearcherClient instance is invoked.
+type SearcherClientSearchFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.RepoID, api.CommitID, *search.TextPatternInfo, func([]*protocol1.FileMatch)) (bool, error)
+	hooks       []func(context.Context, api.RepoName, api.RepoID, api.CommitID, *search.TextPatternInfo, func([]*protocol1.FileMatch)) (bool, error)
+	history     []SearcherClientSearchFuncCall
 	mutex       sync.Mutex
 }
 
-// Stencil delegates to the next hook function in the queue and stores the
+// Search delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockLSIFStore) Stencil(v0 context.Context, v1 int, v2 string) ([]lsifstore.Range, error) {
-	r0, r1 := m.StencilFunc.nextHook()(v0, v1, v2)
-	m.StencilFunc.appendCall(LSIFStoreStencilFuncCall{v0, v1, v2, r0, r1})
+func (m *MockSearcherClient) Search(v0 context.Context, v1 api.RepoName, v2 api.RepoID, v3 api.CommitID, v4 *search.TextPatternInfo, v5 func([]*protocol1.FileMatch)) (bool, error) {
+	r0, r1 := m.SearchFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.SearchFunc.appendCall(SearcherClientSearchFuncCall{v0, v1, v2, v3, v4, v5, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Stencil method of
-// the parent MockLSIFStore instance is invoked and the hook queue is empty.
-func (f *LSIFStoreStencilFunc) SetDefaultHook(hook func(context.Context, int, string) ([]lsifstore.Range, error)) {
+// SetDefaultHook sets function that is called when the Search method of the
+// parent MockSearcherClient instance is invoked and the hook queue is
+// empty.
+func (f *SearcherClientSearchFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.RepoID, api.CommitID, *search.TextPatternInfo, func([]*protocol1.FileMatch)) (bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Stencil method of the parent MockLSIFStore instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
+// Search method of the parent MockSearcherClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *LSIFStoreStencilFunc) PushHook(hook func(context.Context, int, string) ([]lsifstore.Range, error)) {
+func (f *SearcherClientSearchFunc) PushHook(hook func(context.Context, api.RepoName, api.RepoID, api.CommitID, *search.TextPatternInfo, func([]*protocol1.FileMatch)) (bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -7845,21 +9520,21 @@ func (f *LSIFStoreStencilFunc) PushHook(hook func(context.Context, int, string)
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *LSIFStoreStencilFunc) SetDefaultReturn(r0 []lsifstore.Range, r1 error) {
-	f.SetDefaultHook(func(context.Context, int, string) ([]lsifstore.Range, error) {
+func (f *SearcherClientSearchFunc) SetDefaultReturn(r0 bool, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.RepoID, api.CommitID, *search.TextPatternInfo, func([]*protocol1.FileMatch)) (bool, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *LSIFStoreStencilFunc) PushReturn(r0 []lsifstore.Range, r1 error) {
-	f.PushHook(func(context.Context, int, string) ([]lsifstore.Range, error) {
+func (f *SearcherClientSearchFunc) PushReturn(r0 bool, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.RepoID, api.CommitID, *search.TextPatternInfo, func([]*protocol1.FileMatch)) (bool, error) {
 		return r0, r1
 	})
 }
 
-func (f *LSIFStoreStencilFunc) nextHook() func(context.Context, int, string) ([]lsifstore.Range, error) {
+func (f *SearcherClientSearchFunc) nextHook() func(context.Context, api.RepoName, api.RepoID, api.CommitID, *search.TextPatternInfo, func([]*protocol1.FileMatch)) (bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -7872,38 +9547,47 @@ func (f *LSIFStoreStencilFunc) nextHook() func(context.Context, int, string) ([]
 	return hook
 }
 
-func (f *LSIFStoreStencilFunc) appendCall(r0 LSIFStoreStencilFuncCall) {
+func (f *SearcherClientSearchFunc) appendCall(r0 SearcherClientSearchFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of LSIFStoreStencilFuncCall objects describing
-// the invocations of this function.
-func (f *LSIFStoreStencilFunc) History() []LSIFStoreStencilFuncCall {
+// History returns a sequence of SearcherClientSearchFuncCall objects
+// describing the invocations of this function.
+func (f *SearcherClientSearchFunc) History() []SearcherClientSearchFuncCall {
 	f.mutex.Lock()
-	history := make([]LSIFStoreStencilFuncCall, len(f.history))
+	history := make([]SearcherClientSearchFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// LSIFStoreStencilFuncCall is an object that describes an invocation of
-// method Stencil on an instance of MockLSIFStore.
-type LSIFStoreStencilFuncCall struct {
+// SearcherClientSearchFuncCall is an object that describes an invocation of
+// method Search on an instance of MockSearcherClient.
+type SearcherClientSearchFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 int
+	Arg1 api.RepoName
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 string
+	Arg2 api.RepoID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 api.CommitID
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 *search.TextPatternInfo
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 func([]*protocol1.FileMatch)
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []lsifstore.Range
+	Result0 bool
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -7911,94 +9595,89 @@ type LSIFStoreStencilFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c LSIFStoreStencilFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c SearcherClientSearchFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c LSIFStoreStencilFuncCall) Results() []interface{} {
+func (c SearcherClientSearchFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// MockRepoUpdaterClient is a mock implementation of the RepoUpdaterClient
-// interface (from the package
+// MockSymbolsClient is a mock implementation of the SymbolsClient interface
+// (from the package
 // github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
 // used for unit testing.
-type MockRepoUpdaterClient struct {
-	// EnqueueRepoUpdateFunc is an instance of a mock function object
-	// controlling the behavior of the method EnqueueRepoUpdate.
-	EnqueueRepoUpdateFunc *RepoUpdaterClientEnqueueRepoUpdateFunc
+type MockSymbolsClient struct {
+	// SearchFunc is an instance of a mock function object controlling the
+	// behavior of the method Search.
+	SearchFunc *SymbolsClientSearchFunc
 }
 
-// NewMockRepoUpdaterClient creates a new mock of the RepoUpdaterClient
-// interface. All methods return zero values for all results, unless
-// overwritten.
-func NewMockRepoUpdaterClient() *MockRepoUpdaterClient {
-	return &MockRepoUpdaterClient{
-		EnqueueRepoUpdateFunc: &RepoUpdaterClientEnqueueRepoUpdateFunc{
-			defaultHook: func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error) {
+// NewMockSymbolsClient creates a new mock of the SymbolsClient interface.
+// All methods return zero values for all results, unless overwritten.
+func NewMockSymbolsClient() *MockSymbolsClient {
+	return &MockSymbolsClient{
+		SearchFunc: &SymbolsClientSearchFunc{
+			defaultHook: func(context.Context, search.SymbolsParameters) (*[]result.Symbol, error) {
 				return nil, nil
 			},
 		},
 	}
 }
 
-// NewStrictMockRepoUpdaterClient creates a new mock of the
-// RepoUpdaterClient interface. All methods panic on invocation, unless
-// overwritten.
-func NewStrictMockRepoUpdaterClient() *MockRepoUpdaterClient {
-	return &MockRepoUpdaterClient{
-		EnqueueRepoUpdateFunc: &RepoUpdaterClientEnqueueRepoUpdateFunc{
-			defaultHook: func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error) {
-				panic("unexpected invocation of MockRepoUpdaterClient.EnqueueRepoUpdate")
+// NewStrictMockSymbolsClient creates a new mock of the SymbolsClient
+// interface. All methods panic on invocation, unless overwritten.
+func NewStrictMockSymbolsClient() *MockSymbolsClient {
+	return &MockSymbolsClient{
+		SearchFunc: &SymbolsClientSearchFunc{
+			defaultHook: func(context.Context, search.SymbolsParameters) (*[]result.Symbol, error) {
+				panic("unexpected invocation of MockSymbolsClient.Search")
 			},
 		},
 	}
 }
 
-// NewMockRepoUpdaterClientFrom creates a new mock of the
-// MockRepoUpdaterClient interface. All methods delegate to the given
-// implementation, unless overwritten.
-func NewMockRepoUpdaterClientFrom(i RepoUpdaterClient) *MockRepoUpdaterClient {
-	return &MockRepoUpdaterClient{
-		EnqueueRepoUpdateFunc: &RepoUpdaterClientEnqueueRepoUpdateFunc{
-			defaultHook: i.EnqueueRepoUpdate,
+// NewMockSymbolsClientFrom creates a new mock of the MockSymbolsClient
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockSymbolsClientFrom(i SymbolsClient) *MockSymbolsClient {
+	return &MockSymbolsClient{
+		SearchFunc: &SymbolsClientSearchFunc{
+			defaultHook: i.Search,
 		},
 	}
 }
 
-// RepoUpdaterClientEnqueueRepoUpdateFunc describes the behavior when the
-// EnqueueRepoUpdate method of the parent MockRepoUpdaterClient instance is
-// invoked.
-type RepoUpdaterClientEnqueueRepoUpdateFunc struct {
-	defaultHook func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error)
-	hooks       []func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error)
-	history     []RepoUpdaterClientEnqueueRepoUpdateFuncCall
+// SymbolsClientSearchFunc describes the behavior when the Search method of
+// the parent MockSymbolsClient instance is invoked.
+type SymbolsClientSearchFunc struct {
+	defaultHook func(context.Context, search.SymbolsParameters) (*[]result.Symbol, error)
+	hooks       []func(context.Context, search.SymbolsParameters) (*[]result.Symbol, error)
+	history     []SymbolsClientSearchFuncCall
 	mutex       sync.Mutex
 }
 
-// EnqueueRepoUpdate delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockRepoUpdaterClient) EnqueueRepoUpdate(v0 context.Context, v1 api.RepoName) (*protocol.RepoUpdateResponse, error) {
-	r0, r1 := m.EnqueueRepoUpdateFunc.nextHook()(v0, v1)
-	m.EnqueueRepoUpdateFunc.appendCall(RepoUpdaterClientEnqueueRepoUpdateFuncCall{v0, v1, r0, r1})
+// Search delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockSymbolsClient) Search(v0 context.Context, v1 search.SymbolsParameters) (*[]result.Symbol, error) {
+	r0, r1 := m.SearchFunc.nextHook()(v0, v1)
+	m.SearchFunc.appendCall(SymbolsClientSearchFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the EnqueueRepoUpdate
-// method of the parent MockRepoUpdaterClient instance is invoked and the
-// hook queue is empty.
-func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) SetDefaultHook(hook func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error)) {
+// SetDefaultHook sets function that is called when the Search method of the
+// parent MockSymbolsClient instance is invoked and the hook queue is empty.
+func (f *SymbolsClientSearchFunc) SetDefaultHook(hook func(context.Context, search.SymbolsParameters) (*[]result.Symbol, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// EnqueueRepoUpdate method of the parent MockRepoUpdaterClient instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) PushHook(hook func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error)) {
+// Search method of the parent MockSymbolsClient instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *SymbolsClientSearchFunc) PushHook(hook func(context.Context, search.SymbolsParameters) (*[]result.Symbol, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -8006,21 +9685,21 @@ func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) PushHook(hook func(context.Cont
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) SetDefaultReturn(r0 *protocol.RepoUpdateResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error) {
+func (f *SymbolsClientSearchFunc) SetDefaultReturn(r0 *[]result.Symbol, r1 error) {
+	f.SetDefaultHook(func(context.Context, search.SymbolsParameters) (*[]result.Symbol, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) PushReturn(r0 *protocol.RepoUpdateResponse, r1 error) {
-	f.PushHook(func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error) {
+func (f *SymbolsClientSearchFunc) PushReturn(r0 *[]result.Symbol, r1 error) {
+	f.PushHook(func(context.Context, search.SymbolsParameters) (*[]result.Symbol, error) {
 		return r0, r1
 	})
 }
 
-func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) nextHook() func(context.Context, api.RepoName) (*protocol.RepoUpdateResponse, error) {
+func (f *SymbolsClientSearchFunc) nextHook() func(context.Context, search.SymbolsParameters) (*[]result.Symbol, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -8033,36 +9712,35 @@ func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) nextHook() func(context.Context
 	return hook
 }
 
-func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) appendCall(r0 RepoUpdaterClientEnqueueRepoUpdateFuncCall) {
+func (f *SymbolsClientSearchFunc) appendCall(r0 SymbolsClientSearchFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of RepoUpdaterClientEnqueueRepoUpdateFuncCall
-// objects describing the invocations of this function.
-func (f *RepoUpdaterClientEnqueueRepoUpdateFunc) History() []RepoUpdaterClientEnqueueRepoUpdateFuncCall {
+// History returns a sequence of SymbolsClientSearchFuncCall objects
+// describing the invocations of this function.
+func (f *SymbolsClientSearchFunc) History() []SymbolsClientSearchFuncCall {
 	f.mutex.Lock()
-	history := make([]RepoUpdaterClientEnqueueRepoUpdateFuncCall, len(f.history))
+	history := make([]SymbolsClientSearchFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// RepoUpdaterClientEnqueueRepoUpdateFuncCall is an object that describes an
-// invocation of method EnqueueRepoUpdate on an instance of
-// MockRepoUpdaterClient.
-type RepoUpdaterClientEnqueueRepoUpdateFuncCall struct {
+// SymbolsClientSearchFuncCall is an object that describes an invocation of
+// method Search on an instance of MockSymbolsClient.
+type SymbolsClientSearchFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 api.RepoName
+	Arg1 search.SymbolsParameters
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *protocol.RepoUpdateResponse
+	Result0 *[]result.Symbol
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -8070,12 +9748,12 @@ type RepoUpdaterClientEnqueueRepoUpdateFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c RepoUpdaterClientEnqueueRepoUpdateFuncCall) Args() []interface{} {
+func (c SymbolsClientSearchFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c RepoUpdaterClientEnqueueRepoUpdateFuncCall) Results() []interface{} {
+func (c SymbolsClientSearchFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
@@ -23,6 +23,9 @@ type MockPositionAdjuster struct {
 	// AdjustRangeFunc is an instance of a mock function object controlling
 	// the behavior of the method AdjustRange.
 	AdjustRangeFunc *PositionAdjusterAdjustRangeFunc
+	// AdjustRangeApproximateFunc is an instance of a mock function object
+	// controlling the behavior of the method AdjustRangeApproximate.
+	AdjustRangeApproximateFunc *PositionAdjusterAdjustRangeApproximateFunc
 }
 
 // NewMockPositionAdjuster creates a new mock of the PositionAdjuster
@@ -45,6 +48,11 @@ func NewMockPositionAdjuster() *MockPositionAdjuster {
 				return "", lsifstore.Range{}, false, nil
 			},
 		},
+		AdjustRangeApproximateFunc: &PositionAdjusterAdjustRangeApproximateFunc{
+			defaultHook: func(context.Context, string, string, lsifstore.Range, bool) (string, lsifstore.Range, bool, bool, error) {
+				return "", lsifstore.Range{}, false, false, nil
+			},
+		},
 	}
 }
 
@@ -67,6 +75,11 @@ func NewStrictMockPositionAdjuster() *MockPositionAdjuster {
 				panic("unexpected invocation of MockPositionAdjuster.AdjustRange")
 			},
 		},
+		AdjustRangeApproximateFunc: &PositionAdjusterAdjustRangeApproximateFunc{
+			defaultHook: func(context.Context, string, string, lsifstore.Range, bool) (string, lsifstore.Range, bool, bool, error) {
+				panic("unexpected invocation of MockPositionAdjuster.AdjustRangeApproximate")
+			},
+		},
 	}
 }
 
@@ -84,6 +97,9 @@ func NewMockPositionAdjusterFrom(i PositionAdjuster) *MockPositionAdjuster {
 		AdjustRangeFunc: &PositionAdjusterAdjustRangeFunc{
 			defaultHook: i.AdjustRange,
 		},
+		AdjustRangeApproximateFunc: &PositionAdjusterAdjustRangeApproximateFunc{
+			defaultHook: i.AdjustRangeApproximate,
+		},
 	}
 }
 
@@ -455,3 +471,134 @@ func (c PositionAdjusterAdjustRangeFuncCall) Args() []interface{} {
 func (c PositionAdjusterAdjustRangeFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2, c.Result3}
 }
+
+// PositionAdjusterAdjustRangeApproximateFunc describes the behavior when
+// the AdjustRangeApproximate method of the parent MockPositionAdjuster
+// instance is invoked.
+type PositionAdjusterAdjustRangeApproximateFunc struct {
+	defaultHook func(context.Context, string, string, lsifstore.Range, bool) (string, lsifstore.Range, bool, bool, error)
+	hooks       []func(context.Context, string, string, lsifstore.Range, bool) (string, lsifstore.Range, bool, bool, error)
+	history     []PositionAdjusterAdjustRangeApproximateFuncCall
+	mutex       sync.Mutex
+}
+
+// AdjustRangeApproximate delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockPositionAdjuster) AdjustRangeApproximate(v0 context.Context, v1 string, v2 string, v3 lsifstore.Range, v4 bool) (string, lsifstore.Range, bool, bool, error) {
+	r0, r1, r2, r3, r4 := m.AdjustRangeApproximateFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.AdjustRangeApproximateFunc.appendCall(PositionAdjusterAdjustRangeApproximateFuncCall{v0, v1, v2, v3, v4, r0, r1, r2, r3, r4})
+	return r0, r1, r2, r3, r4
+}
+
+// SetDefaultHook sets function that is called when the
+// AdjustRangeApproximate method of the parent MockPositionAdjuster instance
+// is invoked and the hook queue is empty.
+func (f *PositionAdjusterAdjustRangeApproximateFunc) SetDefaultHook(hook func(context.Context, string, string, lsifstore.Range, bool) (string, lsifstore.Range, bool, bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// AdjustRangeApproximate method of the parent MockPositionAdjuster instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *PositionAdjusterAdjustRangeApproximateFunc) PushHook(hook func(context.Context, string, string, lsifstore.Range, bool) (string, lsifstore.Range, bool, bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *PositionAdjusterAdjustRangeApproximateFunc) SetDefaultReturn(r0 string, r1 lsifstore.Range, r2 bool, r3 bool, r4 error) {
+	f.SetDefaultHook(func(context.Context, string, string, lsifstore.Range, bool) (string, lsifstore.Range, bool, bool, error) {
+		return r0, r1, r2, r3, r4
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *PositionAdjusterAdjustRangeApproximateFunc) PushReturn(r0 string, r1 lsifstore.Range, r2 bool, r3 bool, r4 error) {
+	f.PushHook(func(context.Context, string, string, lsifstore.Range, bool) (string, lsifstore.Range, bool, bool, error) {
+		return r0, r1, r2, r3, r4
+	})
+}
+
+func (f *PositionAdjusterAdjustRangeApproximateFunc) nextHook() func(context.Context, string, string, lsifstore.Range, bool) (string, lsifstore.Range, bool, bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *PositionAdjusterAdjustRangeApproximateFunc) appendCall(r0 PositionAdjusterAdjustRangeApproximateFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// PositionAdjusterAdjustRangeApproximateFuncCall objects describing the
+// invocations of this function.
+func (f *PositionAdjusterAdjustRangeApproximateFunc) History() []PositionAdjusterAdjustRangeApproximateFuncCall {
+	f.mutex.Lock()
+	history := make([]PositionAdjusterAdjustRangeApproximateFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// PositionAdjusterAdjustRangeApproximateFuncCall is an object that
+// describes an invocation of method AdjustRangeApproximate on an instance
+// of MockPositionAdjuster.
+type PositionAdjusterAdjustRangeApproximateFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 lsifstore.Range
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 bool
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 lsifstore.Range
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 bool
+	// Result3 is the value of the 4th result returned from this method
+	// invocation.
+	Result3 bool
+	// Result4 is the value of the 5th result returned from this method
+	// invocation.
+	Result4 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c PositionAdjusterAdjustRangeApproximateFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c PositionAdjusterAdjustRangeApproximateFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2, c.Result3, c.Result4}
+}
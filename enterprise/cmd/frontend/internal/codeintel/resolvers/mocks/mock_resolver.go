@@ -51,6 +51,12 @@ type MockResolver struct {
 	// GetIndexesByIDsFunc is an instance of a mock function object
 	// controlling the behavior of the method GetIndexesByIDs.
 	GetIndexesByIDsFunc *ResolverGetIndexesByIDsFunc
+	// GetPackageDependenciesFunc is an instance of a mock function object
+	// controlling the behavior of the method GetPackageDependencies.
+	GetPackageDependenciesFunc *ResolverGetPackageDependenciesFunc
+	// GetPackageDependentsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetPackageDependents.
+	GetPackageDependentsFunc *ResolverGetPackageDependentsFunc
 	// GetUploadByIDFunc is an instance of a mock function object
 	// controlling the behavior of the method GetUploadByID.
 	GetUploadByIDFunc *ResolverGetUploadByIDFunc
@@ -70,9 +76,15 @@ type MockResolver struct {
 	// PreviewGitObjectFilterFunc is an instance of a mock function object
 	// controlling the behavior of the method PreviewGitObjectFilter.
 	PreviewGitObjectFilterFunc *ResolverPreviewGitObjectFilterFunc
+	// PreviewIndexingFunc is an instance of a mock function object
+	// controlling the behavior of the method PreviewIndexing.
+	PreviewIndexingFunc *ResolverPreviewIndexingFunc
 	// PreviewRepositoryFilterFunc is an instance of a mock function object
 	// controlling the behavior of the method PreviewRepositoryFilter.
 	PreviewRepositoryFilterFunc *ResolverPreviewRepositoryFilterFunc
+	// PreviewRetentionFunc is an instance of a mock function object
+	// controlling the behavior of the method PreviewRetention.
+	PreviewRetentionFunc *ResolverPreviewRetentionFunc
 	// QueryResolverFunc is an instance of a mock function object
 	// controlling the behavior of the method QueryResolver.
 	QueryResolverFunc *ResolverQueryResolverFunc
@@ -80,6 +92,9 @@ type MockResolver struct {
 	// object controlling the behavior of the method
 	// QueueAutoIndexJobsForRepo.
 	QueueAutoIndexJobsForRepoFunc *ResolverQueueAutoIndexJobsForRepoFunc
+	// SearchBasedQueryResolverFunc is an instance of a mock function object
+	// controlling the behavior of the method SearchBasedQueryResolver.
+	SearchBasedQueryResolverFunc *ResolverSearchBasedQueryResolverFunc
 	// UpdateConfigurationPolicyFunc is an instance of a mock function
 	// object controlling the behavior of the method
 	// UpdateConfigurationPolicy.
@@ -91,6 +106,9 @@ type MockResolver struct {
 	// UploadConnectionResolverFunc is an instance of a mock function object
 	// controlling the behavior of the method UploadConnectionResolver.
 	UploadConnectionResolverFunc *ResolverUploadConnectionResolverFunc
+	// UploadValidationFindingsFunc is an instance of a mock function object
+	// controlling the behavior of the method UploadValidationFindings.
+	UploadValidationFindingsFunc *ResolverUploadValidationFindingsFunc
 }
 
 // NewMockResolver creates a new mock of the Resolver interface. All methods
@@ -147,6 +165,16 @@ func NewMockResolver() *MockResolver {
 				return nil, nil
 			},
 		},
+		GetPackageDependenciesFunc: &ResolverGetPackageDependenciesFunc{
+			defaultHook: func(context.Context, dbstore.DependencyGraphOptions) ([]precise.Package, int, error) {
+				return nil, 0, nil
+			},
+		},
+		GetPackageDependentsFunc: &ResolverGetPackageDependentsFunc{
+			defaultHook: func(context.Context, dbstore.DependencyGraphOptions) ([]dbstore.PackageDependent, int, error) {
+				return nil, 0, nil
+			},
+		},
 		GetUploadByIDFunc: &ResolverGetUploadByIDFunc{
 			defaultHook: func(context.Context, int) (dbstore.Upload, bool, error) {
 				return dbstore.Upload{}, false, nil
@@ -177,11 +205,21 @@ func NewMockResolver() *MockResolver {
 				return nil, nil
 			},
 		},
+		PreviewIndexingFunc: &ResolverPreviewIndexingFunc{
+			defaultHook: func(context.Context, int, dbstore.ConfigurationPolicy) (map[string][]string, error) {
+				return nil, nil
+			},
+		},
 		PreviewRepositoryFilterFunc: &ResolverPreviewRepositoryFilterFunc{
 			defaultHook: func(context.Context, []string, int, int) ([]int, int, *int, error) {
 				return nil, 0, nil, nil
 			},
 		},
+		PreviewRetentionFunc: &ResolverPreviewRetentionFunc{
+			defaultHook: func(context.Context, int, dbstore.ConfigurationPolicy, int, int) ([]resolvers.RetentionPreview, int, error) {
+				return nil, 0, nil
+			},
+		},
 		QueryResolverFunc: &ResolverQueryResolverFunc{
 			defaultHook: func(context.Context, *graphqlbackend.GitBlobLSIFDataArgs) (resolvers.QueryResolver, error) {
 				return nil, nil
@@ -192,6 +230,11 @@ func NewMockResolver() *MockResolver {
 				return nil, nil
 			},
 		},
+		SearchBasedQueryResolverFunc: &ResolverSearchBasedQueryResolverFunc{
+			defaultHook: func(*graphqlbackend.GitBlobLSIFDataArgs) resolvers.SearchBasedQueryResolver {
+				return nil
+			},
+		},
 		UpdateConfigurationPolicyFunc: &ResolverUpdateConfigurationPolicyFunc{
 			defaultHook: func(context.Context, dbstore.ConfigurationPolicy) error {
 				return nil
@@ -207,6 +250,11 @@ func NewMockResolver() *MockResolver {
 				return nil
 			},
 		},
+		UploadValidationFindingsFunc: &ResolverUploadValidationFindingsFunc{
+			defaultHook: func(context.Context, int) ([]dbstore.UploadValidationFinding, error) {
+				return nil, nil
+			},
+		},
 	}
 }
 
@@ -264,6 +312,16 @@ func NewStrictMockResolver() *MockResolver {
 				panic("unexpected invocation of MockResolver.GetIndexesByIDs")
 			},
 		},
+		GetPackageDependenciesFunc: &ResolverGetPackageDependenciesFunc{
+			defaultHook: func(context.Context, dbstore.DependencyGraphOptions) ([]precise.Package, int, error) {
+				panic("unexpected invocation of MockResolver.GetPackageDependencies")
+			},
+		},
+		GetPackageDependentsFunc: &ResolverGetPackageDependentsFunc{
+			defaultHook: func(context.Context, dbstore.DependencyGraphOptions) ([]dbstore.PackageDependent, int, error) {
+				panic("unexpected invocation of MockResolver.GetPackageDependents")
+			},
+		},
 		GetUploadByIDFunc: &ResolverGetUploadByIDFunc{
 			defaultHook: func(context.Context, int) (dbstore.Upload, bool, error) {
 				panic("unexpected invocation of MockResolver.GetUploadByID")
@@ -294,11 +352,21 @@ func NewStrictMockResolver() *MockResolver {
 				panic("unexpected invocation of MockResolver.PreviewGitObjectFilter")
 			},
 		},
+		PreviewIndexingFunc: &ResolverPreviewIndexingFunc{
+			defaultHook: func(context.Context, int, dbstore.ConfigurationPolicy) (map[string][]string, error) {
+				panic("unexpected invocation of MockResolver.PreviewIndexing")
+			},
+		},
 		PreviewRepositoryFilterFunc: &ResolverPreviewRepositoryFilterFunc{
 			defaultHook: func(context.Context, []string, int, int) ([]int, int, *int, error) {
 				panic("unexpected invocation of MockResolver.PreviewRepositoryFilter")
 			},
 		},
+		PreviewRetentionFunc: &ResolverPreviewRetentionFunc{
+			defaultHook: func(context.Context, int, dbstore.ConfigurationPolicy, int, int) ([]resolvers.RetentionPreview, int, error) {
+				panic("unexpected invocation of MockResolver.PreviewRetention")
+			},
+		},
 		QueryResolverFunc: &ResolverQueryResolverFunc{
 			defaultHook: func(context.Context, *graphqlbackend.GitBlobLSIFDataArgs) (resolvers.QueryResolver, error) {
 				panic("unexpected invocation of MockResolver.QueryResolver")
@@ -309,6 +377,11 @@ func NewStrictMockResolver() *MockResolver {
 				panic("unexpected invocation of MockResolver.QueueAutoIndexJobsForRepo")
 			},
 		},
+		SearchBasedQueryResolverFunc: &ResolverSearchBasedQueryResolverFunc{
+			defaultHook: func(*graphqlbackend.GitBlobLSIFDataArgs) resolvers.SearchBasedQueryResolver {
+				panic("unexpected invocation of MockResolver.SearchBasedQueryResolver")
+			},
+		},
 		UpdateConfigurationPolicyFunc: &ResolverUpdateConfigurationPolicyFunc{
 			defaultHook: func(context.Context, dbstore.ConfigurationPolicy) error {
 				panic("unexpected invocation of MockResolver.UpdateConfigurationPolicy")
@@ -324,6 +397,11 @@ func NewStrictMockResolver() *MockResolver {
 				panic("unexpected invocation of MockResolver.UploadConnectionResolver")
 			},
 		},
+		UploadValidationFindingsFunc: &ResolverUploadValidationFindingsFunc{
+			defaultHook: func(context.Context, int) ([]dbstore.UploadValidationFinding, error) {
+				panic("unexpected invocation of MockResolver.UploadValidationFindings")
+			},
+		},
 	}
 }
 
@@ -361,6 +439,12 @@ func NewMockResolverFrom(i resolvers.Resolver) *MockResolver {
 		GetIndexesByIDsFunc: &ResolverGetIndexesByIDsFunc{
 			defaultHook: i.GetIndexesByIDs,
 		},
+		GetPackageDependenciesFunc: &ResolverGetPackageDependenciesFunc{
+			defaultHook: i.GetPackageDependencies,
+		},
+		GetPackageDependentsFunc: &ResolverGetPackageDependentsFunc{
+			defaultHook: i.GetPackageDependents,
+		},
 		GetUploadByIDFunc: &ResolverGetUploadByIDFunc{
 			defaultHook: i.GetUploadByID,
 		},
@@ -379,15 +463,24 @@ func NewMockResolverFrom(i resolvers.Resolver) *MockResolver {
 		PreviewGitObjectFilterFunc: &ResolverPreviewGitObjectFilterFunc{
 			defaultHook: i.PreviewGitObjectFilter,
 		},
+		PreviewIndexingFunc: &ResolverPreviewIndexingFunc{
+			defaultHook: i.PreviewIndexing,
+		},
 		PreviewRepositoryFilterFunc: &ResolverPreviewRepositoryFilterFunc{
 			defaultHook: i.PreviewRepositoryFilter,
 		},
+		PreviewRetentionFunc: &ResolverPreviewRetentionFunc{
+			defaultHook: i.PreviewRetention,
+		},
 		QueryResolverFunc: &ResolverQueryResolverFunc{
 			defaultHook: i.QueryResolver,
 		},
 		QueueAutoIndexJobsForRepoFunc: &ResolverQueueAutoIndexJobsForRepoFunc{
 			defaultHook: i.QueueAutoIndexJobsForRepo,
 		},
+		SearchBasedQueryResolverFunc: &ResolverSearchBasedQueryResolverFunc{
+			defaultHook: i.SearchBasedQueryResolver,
+		},
 		UpdateConfigurationPolicyFunc: &ResolverUpdateConfigurationPolicyFunc{
 			defaultHook: i.UpdateConfigurationPolicy,
 		},
@@ -397,6 +490,9 @@ func NewMockResolverFrom(i resolvers.Resolver) *MockResolver {
 		UploadConnectionResolverFunc: &ResolverUploadConnectionResolverFunc{
 			defaultHook: i.UploadConnectionResolver,
 		},
+		UploadValidationFindingsFunc: &ResolverUploadValidationFindingsFunc{
+			defaultHook: i.UploadValidationFindings,
+		},
 	}
 }
 
@@ -1514,6 +1610,233 @@ func (c ResolverGetIndexesByIDsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// ResolverGetPackageDependenciesFunc describes the behavior when the
+// GetPackageDependencies method of the parent MockResolver instance is
+// invoked.
+type ResolverGetPackageDependenciesFunc struct {
+	defaultHook func(context.Context, dbstore.DependencyGraphOptions) ([]precise.Package, int, error)
+	hooks       []func(context.Context, dbstore.DependencyGraphOptions) ([]precise.Package, int, error)
+	history     []ResolverGetPackageDependenciesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetPackageDependencies delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockResolver) GetPackageDependencies(v0 context.Context, v1 dbstore.DependencyGraphOptions) ([]precise.Package, int, error) {
+	r0, r1, r2 := m.GetPackageDependenciesFunc.nextHook()(v0, v1)
+	m.GetPackageDependenciesFunc.appendCall(ResolverGetPackageDependenciesFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the
+// GetPackageDependencies method of the parent MockResolver instance is
+// invoked and the hook queue is empty.
+func (f *ResolverGetPackageDependenciesFunc) SetDefaultHook(hook func(context.Context, dbstore.DependencyGraphOptions) ([]precise.Package, int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetPackageDependencies method of the parent MockResolver instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *ResolverGetPackageDependenciesFunc) PushHook(hook func(context.Context, dbstore.DependencyGraphOptions) ([]precise.Package, int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *ResolverGetPackageDependenciesFunc) SetDefaultReturn(r0 []precise.Package, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, dbstore.DependencyGraphOptions) ([]precise.Package, int, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *ResolverGetPackageDependenciesFunc) PushReturn(r0 []precise.Package, r1 int, r2 error) {
+	f.PushHook(func(context.Context, dbstore.DependencyGraphOptions) ([]precise.Package, int, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *ResolverGetPackageDependenciesFunc) nextHook() func(context.Context, dbstore.DependencyGraphOptions) ([]precise.Package, int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ResolverGetPackageDependenciesFunc) appendCall(r0 ResolverGetPackageDependenciesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ResolverGetPackageDependenciesFuncCall
+// objects describing the invocations of this function.
+func (f *ResolverGetPackageDependenciesFunc) History() []ResolverGetPackageDependenciesFuncCall {
+	f.mutex.Lock()
+	history := make([]ResolverGetPackageDependenciesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ResolverGetPackageDependenciesFuncCall is an object that describes an
+// invocation of method GetPackageDependencies on an instance of
+// MockResolver.
+type ResolverGetPackageDependenciesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 dbstore.DependencyGraphOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []precise.Package
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ResolverGetPackageDependenciesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ResolverGetPackageDependenciesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// ResolverGetPackageDependentsFunc describes the behavior when the
+// GetPackageDependents method of the parent MockResolver instance is
+// invoked.
+type ResolverGetPackageDependentsFunc struct {
+	defaultHook func(context.Context, dbstore.DependencyGraphOptions) ([]dbstore.PackageDependent, int, error)
+	hooks       []func(context.Context, dbstore.DependencyGraphOptions) ([]dbstore.PackageDependent, int, error)
+	history     []ResolverGetPackageDependentsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetPackageDependents delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockResolver) GetPackageDependents(v0 context.Context, v1 dbstore.DependencyGraphOptions) ([]dbstore.PackageDependent, int, error) {
+	r0, r1, r2 := m.GetPackageDependentsFunc.nextHook()(v0, v1)
+	m.GetPackageDependentsFunc.appendCall(ResolverGetPackageDependentsFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the GetPackageDependents
+// method of the parent MockResolver instance is invoked and the hook queue
+// is empty.
+func (f *ResolverGetPackageDependentsFunc) SetDefaultHook(hook func(context.Context, dbstore.DependencyGraphOptions) ([]dbstore.PackageDependent, int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetPackageDependents method of the parent MockResolver instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *ResolverGetPackageDependentsFunc) PushHook(hook func(context.Context, dbstore.DependencyGraphOptions) ([]dbstore.PackageDependent, int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *ResolverGetPackageDependentsFunc) SetDefaultReturn(r0 []dbstore.PackageDependent, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, dbstore.DependencyGraphOptions) ([]dbstore.PackageDependent, int, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *ResolverGetPackageDependentsFunc) PushReturn(r0 []dbstore.PackageDependent, r1 int, r2 error) {
+	f.PushHook(func(context.Context, dbstore.DependencyGraphOptions) ([]dbstore.PackageDependent, int, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *ResolverGetPackageDependentsFunc) nextHook() func(context.Context, dbstore.DependencyGraphOptions) ([]dbstore.PackageDependent, int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ResolverGetPackageDependentsFunc) appendCall(r0 ResolverGetPackageDependentsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ResolverGetPackageDependentsFuncCall
+// objects describing the invocations of this function.
+func (f *ResolverGetPackageDependentsFunc) History() []ResolverGetPackageDependentsFuncCall {
+	f.mutex.Lock()
+	history := make([]ResolverGetPackageDependentsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ResolverGetPackageDependentsFuncCall is an object that describes an
+// invocation of method GetPackageDependents on an instance of MockResolver.
+type ResolverGetPackageDependentsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 dbstore.DependencyGraphOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []dbstore.PackageDependent
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ResolverGetPackageDependentsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ResolverGetPackageDependentsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
 // ResolverGetUploadByIDFunc describes the behavior when the GetUploadByID
 // method of the parent MockResolver instance is invoked.
 type ResolverGetUploadByIDFunc struct {
@@ -2192,37 +2515,35 @@ func (c ResolverPreviewGitObjectFilterFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// ResolverPreviewRepositoryFilterFunc describes the behavior when the
-// PreviewRepositoryFilter method of the parent MockResolver instance is
-// invoked.
-type ResolverPreviewRepositoryFilterFunc struct {
-	defaultHook func(context.Context, []string, int, int) ([]int, int, *int, error)
-	hooks       []func(context.Context, []string, int, int) ([]int, int, *int, error)
-	history     []ResolverPreviewRepositoryFilterFuncCall
+// ResolverPreviewIndexingFunc describes the behavior when the
+// PreviewIndexing method of the parent MockResolver instance is invoked.
+type ResolverPreviewIndexingFunc struct {
+	defaultHook func(context.Context, int, dbstore.ConfigurationPolicy) (map[string][]string, error)
+	hooks       []func(context.Context, int, dbstore.ConfigurationPolicy) (map[string][]string, error)
+	history     []ResolverPreviewIndexingFuncCall
 	mutex       sync.Mutex
 }
 
-// PreviewRepositoryFilter delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockResolver) PreviewRepositoryFilter(v0 context.Context, v1 []string, v2 int, v3 int) ([]int, int, *int, error) {
-	r0, r1, r2, r3 := m.PreviewRepositoryFilterFunc.nextHook()(v0, v1, v2, v3)
-	m.PreviewRepositoryFilterFunc.appendCall(ResolverPreviewRepositoryFilterFuncCall{v0, v1, v2, v3, r0, r1, r2, r3})
-	return r0, r1, r2, r3
+// PreviewIndexing delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockResolver) PreviewIndexing(v0 context.Context, v1 int, v2 dbstore.ConfigurationPolicy) (map[string][]string, error) {
+	r0, r1 := m.PreviewIndexingFunc.nextHook()(v0, v1, v2)
+	m.PreviewIndexingFunc.appendCall(ResolverPreviewIndexingFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// PreviewRepositoryFilter method of the parent MockResolver instance is
-// invoked and the hook queue is empty.
-func (f *ResolverPreviewRepositoryFilterFunc) SetDefaultHook(hook func(context.Context, []string, int, int) ([]int, int, *int, error)) {
+// SetDefaultHook sets function that is called when the PreviewIndexing
+// method of the parent MockResolver instance is invoked and the hook queue
+// is empty.
+func (f *ResolverPreviewIndexingFunc) SetDefaultHook(hook func(context.Context, int, dbstore.ConfigurationPolicy) (map[string][]string, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// PreviewRepositoryFilter method of the parent MockResolver instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *ResolverPreviewRepositoryFilterFunc) PushHook(hook func(context.Context, []string, int, int) ([]int, int, *int, error)) {
+// PreviewIndexing method of the parent MockResolver instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ResolverPreviewIndexingFunc) PushHook(hook func(context.Context, int, dbstore.ConfigurationPolicy) (map[string][]string, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -2230,21 +2551,21 @@ func (f *ResolverPreviewRepositoryFilterFunc) PushHook(hook func(context.Context
 
 // SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
 // the given values.
-func (f *ResolverPreviewRepositoryFilterFunc) SetDefaultReturn(r0 []int, r1 int, r2 *int, r3 error) {
-	f.SetDefaultHook(func(context.Context, []string, int, int) ([]int, int, *int, error) {
-		return r0, r1, r2, r3
+func (f *ResolverPreviewIndexingFunc) SetDefaultReturn(r0 map[string][]string, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, dbstore.ConfigurationPolicy) (map[string][]string, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushDefaultHook with a function that returns the given
 // values.
-func (f *ResolverPreviewRepositoryFilterFunc) PushReturn(r0 []int, r1 int, r2 *int, r3 error) {
-	f.PushHook(func(context.Context, []string, int, int) ([]int, int, *int, error) {
-		return r0, r1, r2, r3
+func (f *ResolverPreviewIndexingFunc) PushReturn(r0 map[string][]string, r1 error) {
+	f.PushHook(func(context.Context, int, dbstore.ConfigurationPolicy) (map[string][]string, error) {
+		return r0, r1
 	})
 }
 
-func (f *ResolverPreviewRepositoryFilterFunc) nextHook() func(context.Context, []string, int, int) ([]int, int, *int, error) {
+func (f *ResolverPreviewIndexingFunc) nextHook() func(context.Context, int, dbstore.ConfigurationPolicy) (map[string][]string, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -2257,39 +2578,153 @@ func (f *ResolverPreviewRepositoryFilterFunc) nextHook() func(context.Context, [
 	return hook
 }
 
-func (f *ResolverPreviewRepositoryFilterFunc) appendCall(r0 ResolverPreviewRepositoryFilterFuncCall) {
+func (f *ResolverPreviewIndexingFunc) appendCall(r0 ResolverPreviewIndexingFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of ResolverPreviewRepositoryFilterFuncCall
-// objects describing the invocations of this function.
-func (f *ResolverPreviewRepositoryFilterFunc) History() []ResolverPreviewRepositoryFilterFuncCall {
+// History returns a sequence of ResolverPreviewIndexingFuncCall objects
+// describing the invocations of this function.
+func (f *ResolverPreviewIndexingFunc) History() []ResolverPreviewIndexingFuncCall {
 	f.mutex.Lock()
-	history := make([]ResolverPreviewRepositoryFilterFuncCall, len(f.history))
+	history := make([]ResolverPreviewIndexingFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// ResolverPreviewRepositoryFilterFuncCall is an object that describes an
-// invocation of method PreviewRepositoryFilter on an instance of
-// MockResolver.
-type ResolverPreviewRepositoryFilterFuncCall struct {
+// ResolverPreviewIndexingFuncCall is an object that describes an invocation
+// of method PreviewIndexing on an instance of MockResolver.
+type ResolverPreviewIndexingFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 []string
+	Arg1 int
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 int
-	// Arg3 is the value of the 4th argument passed to this method
-	// invocation.
-	Arg3 int
+	Arg2 dbstore.ConfigurationPolicy
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 map[string][]string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ResolverPreviewIndexingFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ResolverPreviewIndexingFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// ResolverPreviewRepositoryFilterFunc describes the behavior when the
+// PreviewRepositoryFilter method of the parent MockResolver instance is
+// invoked.
+type ResolverPreviewRepositoryFilterFunc struct {
+	defaultHook func(context.Context, []string, int, int) ([]int, int, *int, error)
+	hooks       []func(context.Context, []string, int, int) ([]int, int, *int, error)
+	history     []ResolverPreviewRepositoryFilterFuncCall
+	mutex       sync.Mutex
+}
+
+// PreviewRepositoryFilter delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockResolver) PreviewRepositoryFilter(v0 context.Context, v1 []string, v2 int, v3 int) ([]int, int, *int, error) {
+	r0, r1, r2, r3 := m.PreviewRepositoryFilterFunc.nextHook()(v0, v1, v2, v3)
+	m.PreviewRepositoryFilterFunc.appendCall(ResolverPreviewRepositoryFilterFuncCall{v0, v1, v2, v3, r0, r1, r2, r3})
+	return r0, r1, r2, r3
+}
+
+// SetDefaultHook sets function that is called when the
+// PreviewRepositoryFilter method of the parent MockResolver instance is
+// invoked and the hook queue is empty.
+func (f *ResolverPreviewRepositoryFilterFunc) SetDefaultHook(hook func(context.Context, []string, int, int) ([]int, int, *int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// PreviewRepositoryFilter method of the parent MockResolver instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *ResolverPreviewRepositoryFilterFunc) PushHook(hook func(context.Context, []string, int, int) ([]int, int, *int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *ResolverPreviewRepositoryFilterFunc) SetDefaultReturn(r0 []int, r1 int, r2 *int, r3 error) {
+	f.SetDefaultHook(func(context.Context, []string, int, int) ([]int, int, *int, error) {
+		return r0, r1, r2, r3
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *ResolverPreviewRepositoryFilterFunc) PushReturn(r0 []int, r1 int, r2 *int, r3 error) {
+	f.PushHook(func(context.Context, []string, int, int) ([]int, int, *int, error) {
+		return r0, r1, r2, r3
+	})
+}
+
+func (f *ResolverPreviewRepositoryFilterFunc) nextHook() func(context.Context, []string, int, int) ([]int, int, *int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ResolverPreviewRepositoryFilterFunc) appendCall(r0 ResolverPreviewRepositoryFilterFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ResolverPreviewRepositoryFilterFuncCall
+// objects describing the invocations of this function.
+func (f *ResolverPreviewRepositoryFilterFunc) History() []ResolverPreviewRepositoryFilterFuncCall {
+	f.mutex.Lock()
+	history := make([]ResolverPreviewRepositoryFilterFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ResolverPreviewRepositoryFilterFuncCall is an object that describes an
+// invocation of method PreviewRepositoryFilter on an instance of
+// MockResolver.
+type ResolverPreviewRepositoryFilterFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 []string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 []int
@@ -2316,6 +2751,127 @@ func (c ResolverPreviewRepositoryFilterFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2, c.Result3}
 }
 
+// ResolverPreviewRetentionFunc describes the behavior when the
+// PreviewRetention method of the parent MockResolver instance is invoked.
+type ResolverPreviewRetentionFunc struct {
+	defaultHook func(context.Context, int, dbstore.ConfigurationPolicy, int, int) ([]resolvers.RetentionPreview, int, error)
+	hooks       []func(context.Context, int, dbstore.ConfigurationPolicy, int, int) ([]resolvers.RetentionPreview, int, error)
+	history     []ResolverPreviewRetentionFuncCall
+	mutex       sync.Mutex
+}
+
+// PreviewRetention delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockResolver) PreviewRetention(v0 context.Context, v1 int, v2 dbstore.ConfigurationPolicy, v3 int, v4 int) ([]resolvers.RetentionPreview, int, error) {
+	r0, r1, r2 := m.PreviewRetentionFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.PreviewRetentionFunc.appendCall(ResolverPreviewRetentionFuncCall{v0, v1, v2, v3, v4, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the PreviewRetention
+// method of the parent MockResolver instance is invoked and the hook queue
+// is empty.
+func (f *ResolverPreviewRetentionFunc) SetDefaultHook(hook func(context.Context, int, dbstore.ConfigurationPolicy, int, int) ([]resolvers.RetentionPreview, int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// PreviewRetention method of the parent MockResolver instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *ResolverPreviewRetentionFunc) PushHook(hook func(context.Context, int, dbstore.ConfigurationPolicy, int, int) ([]resolvers.RetentionPreview, int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *ResolverPreviewRetentionFunc) SetDefaultReturn(r0 []resolvers.RetentionPreview, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, int, dbstore.ConfigurationPolicy, int, int) ([]resolvers.RetentionPreview, int, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *ResolverPreviewRetentionFunc) PushReturn(r0 []resolvers.RetentionPreview, r1 int, r2 error) {
+	f.PushHook(func(context.Context, int, dbstore.ConfigurationPolicy, int, int) ([]resolvers.RetentionPreview, int, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *ResolverPreviewRetentionFunc) nextHook() func(context.Context, int, dbstore.ConfigurationPolicy, int, int) ([]resolvers.RetentionPreview, int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ResolverPreviewRetentionFunc) appendCall(r0 ResolverPreviewRetentionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ResolverPreviewRetentionFuncCall objects
+// describing the invocations of this function.
+func (f *ResolverPreviewRetentionFunc) History() []ResolverPreviewRetentionFuncCall {
+	f.mutex.Lock()
+	history := make([]ResolverPreviewRetentionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ResolverPreviewRetentionFuncCall is an object that describes an
+// invocation of method PreviewRetention on an instance of MockResolver.
+type ResolverPreviewRetentionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 dbstore.ConfigurationPolicy
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []resolvers.RetentionPreview
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ResolverPreviewRetentionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ResolverPreviewRetentionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
 // ResolverQueryResolverFunc describes the behavior when the QueryResolver
 // method of the parent MockResolver instance is invoked.
 type ResolverQueryResolverFunc struct {
@@ -2543,6 +3099,112 @@ func (c ResolverQueueAutoIndexJobsForRepoFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// ResolverSearchBasedQueryResolverFunc describes the behavior when the
+// SearchBasedQueryResolver method of the parent MockResolver instance is
+// invoked.
+type ResolverSearchBasedQueryResolverFunc struct {
+	defaultHook func(*graphqlbackend.GitBlobLSIFDataArgs) resolvers.SearchBasedQueryResolver
+	hooks       []func(*graphqlbackend.GitBlobLSIFDataArgs) resolvers.SearchBasedQueryResolver
+	history     []ResolverSearchBasedQueryResolverFuncCall
+	mutex       sync.Mutex
+}
+
+// SearchBasedQueryResolver delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockResolver) SearchBasedQueryResolver(v0 *graphqlbackend.GitBlobLSIFDataArgs) resolvers.SearchBasedQueryResolver {
+	r0 := m.SearchBasedQueryResolverFunc.nextHook()(v0)
+	m.SearchBasedQueryResolverFunc.appendCall(ResolverSearchBasedQueryResolverFuncCall{v0, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// SearchBasedQueryResolver method of the parent MockResolver instance is
+// invoked and the hook queue is empty.
+func (f *ResolverSearchBasedQueryResolverFunc) SetDefaultHook(hook func(*graphqlbackend.GitBlobLSIFDataArgs) resolvers.SearchBasedQueryResolver) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SearchBasedQueryResolver method of the parent MockResolver instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *ResolverSearchBasedQueryResolverFunc) PushHook(hook func(*graphqlbackend.GitBlobLSIFDataArgs) resolvers.SearchBasedQueryResolver) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *ResolverSearchBasedQueryResolverFunc) SetDefaultReturn(r0 resolvers.SearchBasedQueryResolver) {
+	f.SetDefaultHook(func(*graphqlbackend.GitBlobLSIFDataArgs) resolvers.SearchBasedQueryResolver {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *ResolverSearchBasedQueryResolverFunc) PushReturn(r0 resolvers.SearchBasedQueryResolver) {
+	f.PushHook(func(*graphqlbackend.GitBlobLSIFDataArgs) resolvers.SearchBasedQueryResolver {
+		return r0
+	})
+}
+
+func (f *ResolverSearchBasedQueryResolverFunc) nextHook() func(*graphqlbackend.GitBlobLSIFDataArgs) resolvers.SearchBasedQueryResolver {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ResolverSearchBasedQueryResolverFunc) appendCall(r0 ResolverSearchBasedQueryResolverFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ResolverSearchBasedQueryResolverFuncCall
+// objects describing the invocations of this function.
+func (f *ResolverSearchBasedQueryResolverFunc) History() []ResolverSearchBasedQueryResolverFuncCall {
+	f.mutex.Lock()
+	history := make([]ResolverSearchBasedQueryResolverFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ResolverSearchBasedQueryResolverFuncCall is an object that describes an
+// invocation of method SearchBasedQueryResolver on an instance of
+// MockResolver.
+type ResolverSearchBasedQueryResolverFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 *graphqlbackend.GitBlobLSIFDataArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 resolvers.SearchBasedQueryResolver
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ResolverSearchBasedQueryResolverFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ResolverSearchBasedQueryResolverFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // ResolverUpdateConfigurationPolicyFunc describes the behavior when the
 // UpdateConfigurationPolicy method of the parent MockResolver instance is
 // invoked.
@@ -2871,3 +3533,115 @@ func (c ResolverUploadConnectionResolverFuncCall) Args() []interface{} {
 func (c ResolverUploadConnectionResolverFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
+
+// ResolverUploadValidationFindingsFunc describes the behavior when the
+// UploadValidationFindings method of the parent MockResolver instance is
+// invoked.
+type ResolverUploadValidationFindingsFunc struct {
+	defaultHook func(context.Context, int) ([]dbstore.UploadValidationFinding, error)
+	hooks       []func(context.Context, int) ([]dbstore.UploadValidationFinding, error)
+	history     []ResolverUploadValidationFindingsFuncCall
+	mutex       sync.Mutex
+}
+
+// UploadValidationFindings delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockResolver) UploadValidationFindings(v0 context.Context, v1 int) ([]dbstore.UploadValidationFinding, error) {
+	r0, r1 := m.UploadValidationFindingsFunc.nextHook()(v0, v1)
+	m.UploadValidationFindingsFunc.appendCall(ResolverUploadValidationFindingsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// UploadValidationFindings method of the parent MockResolver instance is
+// invoked and the hook queue is empty.
+func (f *ResolverUploadValidationFindingsFunc) SetDefaultHook(hook func(context.Context, int) ([]dbstore.UploadValidationFinding, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UploadValidationFindings method of the parent MockResolver instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *ResolverUploadValidationFindingsFunc) PushHook(hook func(context.Context, int) ([]dbstore.UploadValidationFinding, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *ResolverUploadValidationFindingsFunc) SetDefaultReturn(r0 []dbstore.UploadValidationFinding, r1 error) {
+	f.SetDefaultHook(func(context.Context, int) ([]dbstore.UploadValidationFinding, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *ResolverUploadValidationFindingsFunc) PushReturn(r0 []dbstore.UploadValidationFinding, r1 error) {
+	f.PushHook(func(context.Context, int) ([]dbstore.UploadValidationFinding, error) {
+		return r0, r1
+	})
+}
+
+func (f *ResolverUploadValidationFindingsFunc) nextHook() func(context.Context, int) ([]dbstore.UploadValidationFinding, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ResolverUploadValidationFindingsFunc) appendCall(r0 ResolverUploadValidationFindingsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ResolverUploadValidationFindingsFuncCall
+// objects describing the invocations of this function.
+func (f *ResolverUploadValidationFindingsFunc) History() []ResolverUploadValidationFindingsFuncCall {
+	f.mutex.Lock()
+	history := make([]ResolverUploadValidationFindingsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ResolverUploadValidationFindingsFuncCall is an object that describes an
+// invocation of method UploadValidationFindings on an instance of
+// MockResolver.
+type ResolverUploadValidationFindingsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []dbstore.UploadValidationFinding
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ResolverUploadValidationFindingsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ResolverUploadValidationFindingsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
@@ -0,0 +1,309 @@
+// Code generated by go-mockgen 1.1.2; DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	resolvers "github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers"
+)
+
+// MockSearchBasedQueryResolver is a mock implementation of the
+// SearchBasedQueryResolver interface (from the package
+// github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/codeintel/resolvers)
+// used for unit testing.
+type MockSearchBasedQueryResolver struct {
+	// DefinitionsFunc is an instance of a mock function object controlling
+	// the behavior of the method Definitions.
+	DefinitionsFunc *SearchBasedQueryResolverDefinitionsFunc
+	// ReferencesFunc is an instance of a mock function object controlling
+	// the behavior of the method References.
+	ReferencesFunc *SearchBasedQueryResolverReferencesFunc
+}
+
+// NewMockSearchBasedQueryResolver creates a new mock of the
+// SearchBasedQueryResolver interface. All methods return zero values for
+// all results, unless overwritten.
+func NewMockSearchBasedQueryResolver() *MockSearchBasedQueryResolver {
+	return &MockSearchBasedQueryResolver{
+		DefinitionsFunc: &SearchBasedQueryResolverDefinitionsFunc{
+			defaultHook: func(context.Context, int, int) ([]resolvers.SearchBasedLocation, error) {
+				return nil, nil
+			},
+		},
+		ReferencesFunc: &SearchBasedQueryResolverReferencesFunc{
+			defaultHook: func(context.Context, int, int, int) ([]resolvers.SearchBasedLocation, bool, error) {
+				return nil, false, nil
+			},
+		},
+	}
+}
+
+// NewStrictMockSearchBasedQueryResolver creates a new mock of the
+// SearchBasedQueryResolver interface. All methods panic on invocation,
+// unless overwritten.
+func NewStrictMockSearchBasedQueryResolver() *MockSearchBasedQueryResolver {
+	return &MockSearchBasedQueryResolver{
+		DefinitionsFunc: &SearchBasedQueryResolverDefinitionsFunc{
+			defaultHook: func(context.Context, int, int) ([]resolvers.SearchBasedLocation, error) {
+				panic("unexpected invocation of MockSearchBasedQueryResolver.Definitions")
+			},
+		},
+		ReferencesFunc: &SearchBasedQueryResolverReferencesFunc{
+			defaultHook: func(context.Context, int, int, int) ([]resolvers.SearchBasedLocation, bool, error) {
+				panic("unexpected invocation of MockSearchBasedQueryResolver.References")
+			},
+		},
+	}
+}
+
+// NewMockSearchBasedQueryResolverFrom creates a new mock of the
+// MockSearchBasedQueryResolver interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockSearchBasedQueryResolverFrom(i resolvers.SearchBasedQueryResolver) *MockSearchBasedQueryResolver {
+	return &MockSearchBasedQueryResolver{
+		DefinitionsFunc: &SearchBasedQueryResolverDefinitionsFunc{
+			defaultHook: i.Definitions,
+		},
+		ReferencesFunc: &SearchBasedQueryResolverReferencesFunc{
+			defaultHook: i.References,
+		},
+	}
+}
+
+// SearchBasedQueryResolverDefinitionsFunc describes the behavior when the
+// Definitions method of the parent MockSearchBasedQueryResolver instance is
+// invoked.
+type SearchBasedQueryResolverDefinitionsFunc struct {
+	defaultHook func(context.Context, int, int) ([]resolvers.SearchBasedLocation, error)
+	hooks       []func(context.Context, int, int) ([]resolvers.SearchBasedLocation, error)
+	history     []SearchBasedQueryResolverDefinitionsFuncCall
+	mutex       sync.Mutex
+}
+
+// Definitions delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockSearchBasedQueryResolver) Definitions(v0 context.Context, v1 int, v2 int) ([]resolvers.SearchBasedLocation, error) {
+	r0, r1 := m.DefinitionsFunc.nextHook()(v0, v1, v2)
+	m.DefinitionsFunc.appendCall(SearchBasedQueryResolverDefinitionsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Definitions method
+// of the parent MockSearchBasedQueryResolver instance is invoked and the
+// hook queue is empty.
+func (f *SearchBasedQueryResolverDefinitionsFunc) SetDefaultHook(hook func(context.Context, int, int) ([]resolvers.SearchBasedLocation, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Definitions method of the parent MockSearchBasedQueryResolver instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SearchBasedQueryResolverDefinitionsFunc) PushHook(hook func(context.Context, int, int) ([]resolvers.SearchBasedLocation, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SearchBasedQueryResolverDefinitionsFunc) SetDefaultReturn(r0 []resolvers.SearchBasedLocation, r1 error) {
+	f.SetDefaultHook(func(context.Context, int, int) ([]resolvers.SearchBasedLocation, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SearchBasedQueryResolverDefinitionsFunc) PushReturn(r0 []resolvers.SearchBasedLocation, r1 error) {
+	f.PushHook(func(context.Context, int, int) ([]resolvers.SearchBasedLocation, error) {
+		return r0, r1
+	})
+}
+
+func (f *SearchBasedQueryResolverDefinitionsFunc) nextHook() func(context.Context, int, int) ([]resolvers.SearchBasedLocation, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SearchBasedQueryResolverDefinitionsFunc) appendCall(r0 SearchBasedQueryResolverDefinitionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SearchBasedQueryResolverDefinitionsFuncCall
+// objects describing the invocations of this function.
+func (f *SearchBasedQueryResolverDefinitionsFunc) History() []SearchBasedQueryResolverDefinitionsFuncCall {
+	f.mutex.Lock()
+	history := make([]SearchBasedQueryResolverDefinitionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SearchBasedQueryResolverDefinitionsFuncCall is an object that describes
+// an invocation of method Definitions on an instance of
+// MockSearchBasedQueryResolver.
+type SearchBasedQueryResolverDefinitionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []resolvers.SearchBasedLocation
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c SearchBasedQueryResolverDefinitionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SearchBasedQueryResolverDefinitionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// SearchBasedQueryResolverReferencesFunc describes the behavior when the
+// References method of the parent MockSearchBasedQueryResolver instance is
+// invoked.
+type SearchBasedQueryResolverReferencesFunc struct {
+	defaultHook func(context.Context, int, int, int) ([]resolvers.SearchBasedLocation, bool, error)
+	hooks       []func(context.Context, int, int, int) ([]resolvers.SearchBasedLocation, bool, error)
+	history     []SearchBasedQueryResolverReferencesFuncCall
+	mutex       sync.Mutex
+}
+
+// References delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockSearchBasedQueryResolver) References(v0 context.Context, v1 int, v2 int, v3 int) ([]resolvers.SearchBasedLocation, bool, error) {
+	r0, r1, r2 := m.ReferencesFunc.nextHook()(v0, v1, v2, v3)
+	m.ReferencesFunc.appendCall(SearchBasedQueryResolverReferencesFuncCall{v0, v1, v2, v3, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the References method of
+// the parent MockSearchBasedQueryResolver instance is invoked and the hook
+// queue is empty.
+func (f *SearchBasedQueryResolverReferencesFunc) SetDefaultHook(hook func(context.Context, int, int, int) ([]resolvers.SearchBasedLocation, bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// References method of the parent MockSearchBasedQueryResolver instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *SearchBasedQueryResolverReferencesFunc) PushHook(hook func(context.Context, int, int, int) ([]resolvers.SearchBasedLocation, bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *SearchBasedQueryResolverReferencesFunc) SetDefaultReturn(r0 []resolvers.SearchBasedLocation, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, int, int, int) ([]resolvers.SearchBasedLocation, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *SearchBasedQueryResolverReferencesFunc) PushReturn(r0 []resolvers.SearchBasedLocation, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, int, int, int) ([]resolvers.SearchBasedLocation, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *SearchBasedQueryResolverReferencesFunc) nextHook() func(context.Context, int, int, int) ([]resolvers.SearchBasedLocation, bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *SearchBasedQueryResolverReferencesFunc) appendCall(r0 SearchBasedQueryResolverReferencesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of SearchBasedQueryResolverReferencesFuncCall
+// objects describing the invocations of this function.
+func (f *SearchBasedQueryResolverReferencesFunc) History() []SearchBasedQueryResolverReferencesFuncCall {
+	f.mutex.Lock()
+	history := make([]SearchBasedQueryResolverReferencesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// SearchBasedQueryResolverReferencesFuncCall is an object that describes an
+// invocation of method References on an instance of
+// MockSearchBasedQueryResolver.
+type SearchBasedQueryResolverReferencesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []resolvers.SearchBasedLocation
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c SearchBasedQueryResolverReferencesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c SearchBasedQueryResolverReferencesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
@@ -24,11 +24,18 @@ type operations struct {
 	documentationReferences   *observation.Operation
 	documentationSearch       *observation.Operation
 	hover                     *observation.Operation
+	incomingCalls             *observation.Operation
+	outgoingCalls             *observation.Operation
+	previewIndexing           *observation.Operation
+	previewRetention          *observation.Operation
 	queryResolver             *observation.Operation
 	ranges                    *observation.Operation
 	references                *observation.Operation
 	implementations           *observation.Operation
+	searchBasedDefinitions    *observation.Operation
+	searchBasedReferences     *observation.Operation
 	stencil                   *observation.Operation
+	typeDefinitions           *observation.Operation
 
 	findClosestDumps *observation.Operation
 }
@@ -68,11 +75,18 @@ func newOperations(observationContext *observation.Context) *operations {
 		documentationReferences:   op("DocumentationReferences"),
 		documentationSearch:       op("DocumentationSearch"),
 		hover:                     op("Hover"),
+		incomingCalls:             op("IncomingCalls"),
+		outgoingCalls:             op("OutgoingCalls"),
+		previewIndexing:           op("PreviewIndexing"),
+		previewRetention:          op("PreviewRetention"),
 		queryResolver:             op("QueryResolver"),
 		ranges:                    op("Ranges"),
 		references:                op("References"),
 		implementations:           op("Implementations"),
+		searchBasedDefinitions:    op("SearchBasedDefinitions"),
+		searchBasedReferences:     op("SearchBasedReferences"),
 		stencil:                   op("Stencil"),
+		typeDefinitions:           op("TypeDefinitions"),
 
 		findClosestDumps: subOp("findClosestDumps"),
 	}
@@ -0,0 +1,185 @@
+package resolvers
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/policies"
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/timeutil"
+)
+
+// RetentionPreview describes whether an upload would be retained by the data retention policies of its
+// repository if a draft configuration policy were saved.
+type RetentionPreview struct {
+	Upload store.Upload
+
+	// Retained indicates that the upload is protected by the draft policy or by any other data retention
+	// policy that applies to the repository. Uploads that are not retained are expired by the janitor.
+	Retained bool
+
+	// RetainedByPolicy indicates that the upload is protected by the draft policy.
+	RetainedByPolicy bool
+}
+
+// previewPolicyBatchSize is the number of configuration policies fetched at once when collecting the
+// data retention policies that apply to a repository.
+const previewPolicyBatchSize = 100
+
+// previewCommitBatchSize is the number of commits visible to an upload fetched at once when checking
+// whether the upload is retained.
+const previewCommitBatchSize = 1000
+
+// PreviewRetention evaluates the data retention rules of the given draft configuration policy, together with
+// the saved data retention policies that apply to the given repository, against the completed uploads of the
+// given repository. The uploads are returned oldest first, along with the total number of completed uploads.
+//
+// If the draft policy has an identifier, it replaces the saved policy with the same identifier. This mirrors
+// the evaluation done by the upload expirer in the worker, without updating any upload records.
+func (r *resolver) PreviewRetention(ctx context.Context, repositoryID int, policy store.ConfigurationPolicy, limit, offset int) (_ []RetentionPreview, totalCount int, err error) {
+	ctx, traceLog, endObservation := r.operations.previewRetention.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("repositoryID", repositoryID),
+		log.Int("policyID", policy.ID),
+		log.Int("limit", limit),
+		log.Int("offset", offset),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	configurationPolicies, err := r.retentionPolicies(ctx, repositoryID, policy.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if policy.RetentionEnabled {
+		configurationPolicies = append(configurationPolicies, policy)
+	}
+	traceLog(log.Int("numPolicies", len(configurationPolicies)))
+
+	now := timeutil.Now()
+
+	commitMap, err := r.retentionMatcher.CommitsDescribedByPolicy(ctx, repositoryID, configurationPolicies, now)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "policyMatcher.CommitsDescribedByPolicy")
+	}
+
+	uploads, totalCount, err := r.dbStore.GetUploads(ctx, store.GetUploadsOptions{
+		State:        "completed",
+		RepositoryID: repositoryID,
+		OldestFirst:  true,
+		Limit:        limit,
+		Offset:       offset,
+	})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "dbstore.GetUploads")
+	}
+	traceLog(log.Int("numUploads", len(uploads)))
+
+	previews := make([]RetentionPreview, 0, len(uploads))
+	for _, upload := range uploads {
+		preview, err := r.previewUploadRetention(ctx, commitMap, upload, policy.ID, now)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		previews = append(previews, preview)
+	}
+
+	return previews, totalCount, nil
+}
+
+// retentionPolicies returns the saved data retention policies that apply to the given repository, except
+// for the policy with the given identifier.
+func (r *resolver) retentionPolicies(ctx context.Context, repositoryID, excludedPolicyID int) ([]store.ConfigurationPolicy, error) {
+	var configurationPolicies []store.ConfigurationPolicy
+
+	for offset := 0; ; {
+		policyBatch, totalCount, err := r.dbStore.GetConfigurationPolicies(ctx, store.GetConfigurationPoliciesOptions{
+			RepositoryID:     repositoryID,
+			ForDataRetention: true,
+			Limit:            previewPolicyBatchSize,
+			Offset:           offset,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "dbstore.GetConfigurationPolicies")
+		}
+
+		for _, policy := range policyBatch {
+			if excludedPolicyID == 0 || policy.ID != excludedPolicyID {
+				configurationPolicies = append(configurationPolicies, policy)
+			}
+		}
+
+		offset += len(policyBatch)
+		if len(policyBatch) == 0 || offset >= totalCount {
+			break
+		}
+	}
+
+	return configurationPolicies, nil
+}
+
+// previewUploadRetention determines whether the given upload is protected by any policy match of the commits
+// visible to it, and whether it is protected by a match of the policy with the given identifier.
+func (r *resolver) previewUploadRetention(ctx context.Context, commitMap map[string][]policies.PolicyMatch, upload store.Upload, policyID int, now time.Time) (RetentionPreview, error) {
+	preview := RetentionPreview{Upload: upload}
+
+	var token *string
+	for first := true; first || token != nil; first = false {
+		commits, nextToken, err := r.dbStore.CommitsVisibleToUpload(ctx, upload.ID, previewCommitBatchSize, token)
+		if err != nil {
+			return RetentionPreview{}, errors.Wrap(err, "dbstore.CommitsVisibleToUpload")
+		}
+		token = nextToken
+
+		for _, commit := range commits {
+			for _, policyMatch := range commitMap[commit] {
+				if !policies.ProtectsUpload(policyMatch, upload.UploadedAt, now) {
+					continue
+				}
+
+				preview.Retained = true
+				if policyMatch.PolicyID != nil && *policyMatch.PolicyID == policyID {
+					preview.RetainedByPolicy = true
+					return preview, nil
+				}
+			}
+		}
+	}
+
+	return preview, nil
+}
+
+// PreviewIndexing returns the commits of the given repository that the given draft configuration policy
+// would schedule for auto-indexing, mapped to the names of the branches or tags that match the policy.
+func (r *resolver) PreviewIndexing(ctx context.Context, repositoryID int, policy store.ConfigurationPolicy) (_ map[string][]string, err error) {
+	ctx, traceLog, endObservation := r.operations.previewIndexing.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("repositoryID", repositoryID),
+		log.Int("policyID", policy.ID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if !policy.IndexingEnabled {
+		return nil, nil
+	}
+
+	policyMatches, err := r.indexingMatcher.CommitsDescribedByPolicy(ctx, repositoryID, []store.ConfigurationPolicy{policy}, timeutil.Now())
+	if err != nil {
+		return nil, errors.Wrap(err, "policyMatcher.CommitsDescribedByPolicy")
+	}
+	traceLog(log.Int("numCommits", len(policyMatches)))
+
+	namesByCommit := make(map[string][]string, len(policyMatches))
+	for commit, policyMatches := range policyMatches {
+		names := make([]string, 0, len(policyMatches))
+		for _, policyMatch := range policyMatches {
+			names = append(names, policyMatch.Name)
+		}
+
+		namesByCommit[commit] = names
+	}
+
+	return namesByCommit, nil
+}
@@ -0,0 +1,115 @@
+package resolvers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/policies"
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestPreviewRetention(t *testing.T) {
+	now := time.Now()
+	hour := time.Hour
+	policyID1 := 1
+	policyID2 := 2
+
+	savedPolicy1 := store.ConfigurationPolicy{ID: 1, Name: "releases", RetentionEnabled: true, RetentionDuration: &hour}
+	savedPolicy2 := store.ConfigurationPolicy{ID: 2, Name: "tags (saved)", RetentionEnabled: true}
+	draftPolicy := store.ConfigurationPolicy{ID: 2, Name: "tags (draft)", RetentionEnabled: true}
+
+	mockDBStore := NewMockDBStore()
+	mockDBStore.GetConfigurationPoliciesFunc.SetDefaultReturn([]store.ConfigurationPolicy{savedPolicy1, savedPolicy2}, 2, nil)
+	mockDBStore.GetUploadsFunc.SetDefaultReturn([]store.Upload{
+		{ID: 50, UploadedAt: now},
+		{ID: 51, UploadedAt: now},
+		{ID: 52, UploadedAt: now.Add(-2 * time.Hour)},
+		{ID: 53, UploadedAt: now},
+	}, 10, nil)
+	mockDBStore.CommitsVisibleToUploadFunc.SetDefaultHook(func(ctx context.Context, uploadID, limit int, token *string) ([]string, *string, error) {
+		return map[int][]string{
+			50: {"deadbeef1"},
+			51: {"deadbeef2", "deadbeef3"},
+			52: {"deadbeef3"},
+			53: {"deadbeef4"},
+		}[uploadID], nil, nil
+	})
+
+	mockRetentionMatcher := NewMockPolicyMatcher()
+	mockRetentionMatcher.CommitsDescribedByPolicyFunc.SetDefaultReturn(map[string][]policies.PolicyMatch{
+		"deadbeef1": {{Name: "main"}},
+		"deadbeef2": {{Name: "v1.0.0", PolicyID: &policyID2}},
+		"deadbeef3": {{Name: "release", PolicyID: &policyID1, PolicyDuration: &hour}},
+	}, nil)
+
+	resolver := newResolver(mockDBStore, nil, nil, nil, nil, nil, mockRetentionMatcher, nil, nil, nil, false, &observation.TestContext)
+	previews, totalCount, err := resolver.PreviewRetention(context.Background(), 42, draftPolicy, 4, 0)
+	if err != nil {
+		t.Fatalf("unexpected error previewing retention: %s", err)
+	}
+
+	if history := mockRetentionMatcher.CommitsDescribedByPolicyFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected number of calls to CommitsDescribedByPolicy. want=%d have=%d", 1, len(history))
+	} else if diff := cmp.Diff([]store.ConfigurationPolicy{savedPolicy1, draftPolicy}, history[0].Arg2); diff != "" {
+		t.Errorf("unexpected policies (-want +got):\n%s", diff)
+	}
+
+	if totalCount != 10 {
+		t.Errorf("unexpected total count. want=%d have=%d", 10, totalCount)
+	}
+
+	type retention struct {
+		UploadID         int
+		Retained         bool
+		RetainedByPolicy bool
+	}
+	var retentions []retention
+	for _, preview := range previews {
+		retentions = append(retentions, retention{preview.Upload.ID, preview.Retained, preview.RetainedByPolicy})
+	}
+
+	expectedRetentions := []retention{
+		{UploadID: 50, Retained: true, RetainedByPolicy: false},
+		{UploadID: 51, Retained: true, RetainedByPolicy: true},
+		{UploadID: 52, Retained: false, RetainedByPolicy: false},
+		{UploadID: 53, Retained: false, RetainedByPolicy: false},
+	}
+	if diff := cmp.Diff(expectedRetentions, retentions); diff != "" {
+		t.Errorf("unexpected retention previews (-want +got):\n%s", diff)
+	}
+}
+
+func TestPreviewIndexing(t *testing.T) {
+	mockIndexingMatcher := NewMockPolicyMatcher()
+	mockIndexingMatcher.CommitsDescribedByPolicyFunc.SetDefaultReturn(map[string][]policies.PolicyMatch{
+		"deadbeef1": {{Name: "main"}, {Name: "develop"}},
+		"deadbeef2": {{Name: "feature"}},
+	}, nil)
+
+	resolver := newResolver(nil, nil, nil, nil, nil, nil, nil, mockIndexingMatcher, nil, nil, false, &observation.TestContext)
+
+	namesByCommit, err := resolver.PreviewIndexing(context.Background(), 42, store.ConfigurationPolicy{IndexingEnabled: false})
+	if err != nil {
+		t.Fatalf("unexpected error previewing indexing: %s", err)
+	}
+	if len(namesByCommit) != 0 {
+		t.Errorf("expected no commits to be indexed by a policy with indexing disabled")
+	}
+
+	namesByCommit, err = resolver.PreviewIndexing(context.Background(), 42, store.ConfigurationPolicy{IndexingEnabled: true})
+	if err != nil {
+		t.Fatalf("unexpected error previewing indexing: %s", err)
+	}
+
+	expectedNamesByCommit := map[string][]string{
+		"deadbeef1": {"main", "develop"},
+		"deadbeef2": {"feature"},
+	}
+	if diff := cmp.Diff(expectedNamesByCommit, namesByCommit); diff != "" {
+		t.Errorf("unexpected commits (-want +got):\n%s", diff)
+	}
+}
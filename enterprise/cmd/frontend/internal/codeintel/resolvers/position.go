@@ -2,11 +2,13 @@ package resolvers
 
 import (
 	"context"
-	"strconv"
 	"strings"
 
+	"github.com/cockroachdb/errors"
 	"github.com/sourcegraph/go-diff/diff"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/commitgraph"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/gitserver"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
@@ -31,23 +33,43 @@ type PositionAdjuster interface {
 	// that the translation was successful. If revese is true, then the source and target commits
 	// are swapped.
 	AdjustRange(ctx context.Context, commit, path string, rx lsifstore.Range, reverse bool) (string, lsifstore.Range, bool, error)
+
+	// AdjustRangeApproximate translates the given range from the source commit into the given
+	// target commit. Unlike AdjustRange, the translation does not fail when the range has been
+	// edited between the two commits and the target commit is an ancestor of the source commit
+	// (or the other way around, if reverse is true); the range is instead moved to the nearest
+	// surviving line. The adjusted path and range are returned, along with a boolean flag
+	// indicating that the translation is approximate and a boolean flag indicating that the
+	// translation was successful. If revese is true, then the source and target commits are
+	// swapped.
+	AdjustRangeApproximate(ctx context.Context, commit, path string, rx lsifstore.Range, reverse bool) (string, lsifstore.Range, bool, bool, error)
 }
 
 type positionAdjuster struct {
-	repo      *types.Repo
-	commit    string
-	hunkCache HunkCache
+	gitserverClient GitserverClient
+	repo            *types.Repo
+	commit          string
+	hunkCache       HunkCache
 }
 
 // NewPositionAdjuster creates a new PositionAdjuster with the given repository and source commit.
-func NewPositionAdjuster(repo *types.Repo, commit string, hunkCache HunkCache) PositionAdjuster {
+func NewPositionAdjuster(gitserverClient GitserverClient, repo *types.Repo, commit string, hunkCache HunkCache) PositionAdjuster {
 	return &positionAdjuster{
-		repo:      repo,
-		commit:    commit,
-		hunkCache: hunkCache,
+		gitserverClient: gitserverClient,
+		repo:            repo,
+		commit:          commit,
+		hunkCache:       hunkCache,
 	}
 }
 
+// maxAdjustmentHops is the maximum number of diffs that are composed when translating a range
+// along the commit graph path between the source and target commits.
+const maxAdjustmentHops = 16
+
+// maxAdjustmentCommitGraphSize is the maximum number of commits requested from gitserver when
+// searching for the commit graph path between the source and target commits.
+const maxAdjustmentCommitGraphSize = 1000
+
 // AdjustPath translates the given path from the source commit into the given target
 // commit. If revese is true, then the source and target commits are swapped.
 func (p *positionAdjuster) AdjustPath(ctx context.Context, commit, path string, reverse bool) (string, bool, error) {
@@ -82,6 +104,64 @@ func (p *positionAdjuster) AdjustRange(ctx context.Context, commit, path string,
 	return path, adjusted, ok, nil
 }
 
+// AdjustRangeApproximate translates the given range from the source commit into the given
+// target commit. Unlike AdjustRange, the translation does not fail when the range has been
+// edited between the two commits and the target commit is an ancestor of the source commit
+// (or the other way around, if reverse is true); the range is instead moved to the nearest
+// surviving line. The adjusted path and range are returned, along with a boolean flag
+// indicating that the translation is approximate and a boolean flag indicating that the
+// translation was successful. If revese is true, then the source and target commits are
+// swapped.
+func (p *positionAdjuster) AdjustRangeApproximate(ctx context.Context, commit, path string, rx lsifstore.Range, reverse bool) (string, lsifstore.Range, bool, bool, error) {
+	hunks, err := p.readHunksCached(ctx, p.repo, p.commit, commit, path, reverse)
+	if err != nil {
+		return "", lsifstore.Range{}, false, false, err
+	}
+
+	if adjusted, ok := adjustRange(hunks, rx); ok {
+		return path, adjusted, false, true, nil
+	}
+
+	// The commit of the position adjuster is the requested commit, and the uploads used to
+	// answer a request are usually ancestors of that commit. We only search the ancestors
+	// of the requested commit, which bounds the cost of a translation to a single commit
+	// graph request.
+	hops, err := p.readCommitPathCached(ctx, p.repo, p.commit, commit)
+	if err != nil {
+		return "", lsifstore.Range{}, false, false, err
+	}
+	if len(hops) == 0 {
+		// The commits are not connected within the commit graph we've fetched
+		return "", lsifstore.Range{}, false, false, nil
+	}
+	if reverse {
+		// The path runs from the target commit to the source commit
+		reversed := make([]string, 0, len(hops))
+		for i := len(hops) - 1; i >= 0; i-- {
+			reversed = append(reversed, hops[i])
+		}
+		hops = reversed
+	}
+
+	// Translate the range one hop at a time. The diffs between adjacent hops are smaller than
+	// the direct diff between the source and target commits, so lines that happen to sit next
+	// to unrelated edits are less likely to be swallowed by a hunk. The translation remains
+	// exact if the range is not edited in any of the hops.
+	adjusted, exact := rx, true
+	for i := 0; i < len(hops)-1; i++ {
+		hunks, err := p.readHunksCached(ctx, p.repo, hops[i], hops[i+1], path, false)
+		if err != nil {
+			return "", lsifstore.Range{}, false, false, err
+		}
+
+		var ok bool
+		adjusted, ok = approximateRange(hunks, adjusted)
+		exact = exact && ok
+	}
+
+	return path, adjusted, !exact, true, nil
+}
+
 // readHunksCached returns a position-ordered slice of changes (additions or deletions) of
 // the given path between the given source and target commits. If revese is true, then the
 // source and target commits are swapped. If the position adjuster has a hunk cache, it
@@ -99,7 +179,7 @@ func (p *positionAdjuster) readHunksCached(ctx context.Context, repo *types.Repo
 		return p.readHunks(ctx, repo, sourceCommit, targetCommit, path)
 	}
 
-	key := makeKey(strconv.FormatInt(int64(repo.ID), 10), sourceCommit, targetCommit, path)
+	key := makeHunksKey(repo, sourceCommit, targetCommit, path)
 	if hunks, ok := p.hunkCache.Get(key); ok {
 		if hunks == nil {
 			return nil, nil
@@ -124,6 +204,71 @@ func (p *positionAdjuster) readHunks(ctx context.Context, repo *types.Repo, sour
 	return git.DiffPath(ctx, repo.Name, sourceCommit, targetCommit, path)
 }
 
+// readCommitPathCached returns the commits on a path through the commit graph from the given
+// descendant commit to the given ancestor commit. Long paths are thinned out to at most
+// maxAdjustmentHops hops. An empty path is returned if the ancestor is not reachable within a
+// commit graph of maxAdjustmentCommitGraphSize commits. If the position adjuster has a hunk
+// cache, it will read from it before attempting to contact a remote server, and populate the
+// cache with new results. The returned slice must not be modified.
+func (p *positionAdjuster) readCommitPathCached(ctx context.Context, repo *types.Repo, descendant, ancestor string) ([]string, error) {
+	if p.hunkCache == nil {
+		return p.readCommitPath(ctx, repo, descendant, ancestor)
+	}
+
+	key := makeCommitPathKey(repo, descendant, ancestor)
+	if path, ok := p.hunkCache.Get(key); ok {
+		return path.([]string), nil
+	}
+
+	path, err := p.readCommitPath(ctx, repo, descendant, ancestor)
+	if err != nil {
+		return nil, err
+	}
+
+	p.hunkCache.Set(key, path, int64(len(path)))
+
+	return path, nil
+}
+
+// readCommitPath returns the commits on a path through the commit graph from the given descendant
+// commit to the given ancestor commit. See readCommitPathCached for details.
+func (p *positionAdjuster) readCommitPath(ctx context.Context, repo *types.Repo, descendant, ancestor string) ([]string, error) {
+	if p.gitserverClient == nil {
+		return []string{}, nil
+	}
+
+	commitGraph, err := p.gitserverClient.CommitGraph(ctx, int(repo.ID), gitserver.CommitGraphOptions{
+		Commit: descendant,
+		Limit:  maxAdjustmentCommitGraphSize,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "gitserver.CommitGraph")
+	}
+
+	path, ok := commitgraph.FindPath(commitGraph.Graph(), descendant, ancestor)
+	if !ok {
+		return []string{}, nil
+	}
+
+	return thinCommitPath(path, maxAdjustmentHops), nil
+}
+
+// thinCommitPath returns a subsequence of the given commit path with at most the given number of
+// hops. The first and last commits of the path are always retained, and the retained intermediate
+// commits are spread evenly along the path.
+func thinCommitPath(path []string, maxHops int) []string {
+	if len(path) <= maxHops+1 {
+		return path
+	}
+
+	thinned := make([]string, 0, maxHops+1)
+	for i := 0; i <= maxHops; i++ {
+		thinned = append(thinned, path[i*(len(path)-1)/maxHops])
+	}
+
+	return thinned
+}
+
 // adjustPosition translates the given position by adjusting the line number based on the
 // number of additions and deletions that occur before that line. This function returns a
 // boolean flag indicating that the translation is successful. A translation fails when the
@@ -141,6 +286,18 @@ func adjustPosition(hunks []*diff.Hunk, pos lsifstore.Position) (lsifstore.Posit
 // that occur before that line. This function returns a boolean flag indicating that the
 // translation is successful. A translation fails when the given line has been edited.
 func adjustLine(hunks []*diff.Hunk, line int) (int, bool) {
+	if adjustedLine, ok := approximateLine(hunks, line); ok {
+		return adjustedLine, true
+	}
+
+	return 0, false
+}
+
+// approximateLine translates the given line number based on the number of additions and deletions
+// that occur before that line. If the given line has been edited, then the line is translated to
+// the line that replaced it (or the line that follows it when it was only removed) and a false-valued
+// flag is returned to indicate that the translation is approximate.
+func approximateLine(hunks []*diff.Hunk, line int) (int, bool) {
 	// Translate from bundle/lsp zero-index to git diff one-index
 	line = line + 1
 
@@ -186,8 +343,10 @@ func adjustLine(hunks []*diff.Hunk, line int) (int, bool) {
 			// If it was removed, there is nothing to point to in the target file.
 			// If it was added, then we don't have any index information for it in
 			// our source file. In any case, we won't have a precise translation.
+			// The target offset currently points to the line that took the place
+			// of the target line, which is our best guess.
 			if isAdded || isRemoved {
-				return 0, false
+				return approximateHunkLine(hunk, targetOffset) - 1, false
 			}
 
 			// Translate from git diff one-index to bundle/lsp zero-index
@@ -208,6 +367,20 @@ func adjustLine(hunks []*diff.Hunk, line int) (int, bool) {
 	panic("Malformed hunk body")
 }
 
+// approximateHunkLine clamps the given (one-indexed) line of the target file to the lines
+// covered by the given hunk. Lines removed from the end of a file have no following line
+// in the target file, so the last line of the hunk is used instead.
+func approximateHunkLine(hunk *diff.Hunk, line int) int {
+	if lastLine := int(hunk.NewStartLine + hunk.NewLines - 1); line > lastLine {
+		line = lastLine
+	}
+	if line < 1 {
+		line = 1
+	}
+
+	return line
+}
+
 // findHunk returns the last thunk that does not begin after the given line.
 func findHunk(hunks []*diff.Hunk, line int) *diff.Hunk {
 	i := 0
@@ -238,6 +411,21 @@ func adjustRange(hunks []*diff.Hunk, r lsifstore.Range) (lsifstore.Range, bool)
 	return lsifstore.Range{Start: start, End: end}, true
 }
 
-func makeKey(parts ...string) string {
-	return strings.Join(parts, ":")
+// approximateRange translates the given range by calling approximateLine on both of the range's
+// endpoints. This function returns a boolean flag indicating that the translation is exact (which
+// occurs when neither endpoint of the range has been edited). The end of an approximate range is
+// never moved before its start.
+func approximateRange(hunks []*diff.Hunk, r lsifstore.Range) (lsifstore.Range, bool) {
+	startLine, startOk := approximateLine(hunks, r.Start.Line)
+	endLine, endOk := approximateLine(hunks, r.End.Line)
+
+	adjusted := lsifstore.Range{
+		Start: lsifstore.Position{Line: startLine, Character: r.Start.Character},
+		End:   lsifstore.Position{Line: endLine, Character: r.End.Character},
+	}
+	if adjusted.End.Line < adjusted.Start.Line || (adjusted.End.Line == adjusted.Start.Line && adjusted.End.Character < adjusted.Start.Character) {
+		adjusted.End = adjusted.Start
+	}
+
+	return adjusted, startOk && endOk
 }
@@ -10,13 +10,14 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/sourcegraph/go-diff/diff"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/gitserver"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
 )
 
 func TestAdjustPath(t *testing.T) {
-	adjuster := NewPositionAdjuster(&types.Repo{ID: 50}, "deadbeef1", nil)
+	adjuster := NewPositionAdjuster(nil, &types.Repo{ID: 50}, "deadbeef1", nil)
 	path, ok, err := adjuster.AdjustPath(context.Background(), "deadbeef2", "/foo/bar.go", false)
 	if err != nil {
 		t.Fatalf("unexpected error: %s", err)
@@ -45,7 +46,7 @@ func TestAdjustPosition(t *testing.T) {
 
 	posIn := lsifstore.Position{Line: 302, Character: 15}
 
-	adjuster := NewPositionAdjuster(&types.Repo{ID: 50}, "deadbeef1", nil)
+	adjuster := NewPositionAdjuster(nil, &types.Repo{ID: 50}, "deadbeef1", nil)
 	path, posOut, ok, err := adjuster.AdjustPosition(context.Background(), "deadbeef2", "/foo/bar.go", posIn, false)
 	if err != nil {
 		t.Fatalf("unexpected error: %s", err)
@@ -74,7 +75,7 @@ func TestAdjustPositionEmptyDiff(t *testing.T) {
 
 	posIn := lsifstore.Position{Line: 10, Character: 15}
 
-	adjuster := NewPositionAdjuster(&types.Repo{ID: 50}, "deadbeef1", nil)
+	adjuster := NewPositionAdjuster(nil, &types.Repo{ID: 50}, "deadbeef1", nil)
 	path, posOut, ok, err := adjuster.AdjustPosition(context.Background(), "deadbeef2", "/foo/bar.go", posIn, false)
 	if err != nil {
 		t.Fatalf("unexpected error: %s", err)
@@ -106,7 +107,7 @@ func TestAdjustPositionReverse(t *testing.T) {
 
 	posIn := lsifstore.Position{Line: 302, Character: 15}
 
-	adjuster := NewPositionAdjuster(&types.Repo{ID: 50}, "deadbeef1", nil)
+	adjuster := NewPositionAdjuster(nil, &types.Repo{ID: 50}, "deadbeef1", nil)
 	path, posOut, ok, err := adjuster.AdjustPosition(context.Background(), "deadbeef2", "/foo/bar.go", posIn, true)
 	if err != nil {
 		t.Fatalf("unexpected error: %s", err)
@@ -143,7 +144,7 @@ func TestAdjustRange(t *testing.T) {
 		End:   lsifstore.Position{Line: 305, Character: 20},
 	}
 
-	adjuster := NewPositionAdjuster(&types.Repo{ID: 50}, "deadbeef1", nil)
+	adjuster := NewPositionAdjuster(nil, &types.Repo{ID: 50}, "deadbeef1", nil)
 	path, rOut, ok, err := adjuster.AdjustRange(context.Background(), "deadbeef2", "/foo/bar.go", rIn, false)
 	if err != nil {
 		t.Fatalf("unexpected error: %s", err)
@@ -178,7 +179,7 @@ func TestAdjustRangeEmptyDiff(t *testing.T) {
 		End:   lsifstore.Position{Line: 305, Character: 20},
 	}
 
-	adjuster := NewPositionAdjuster(&types.Repo{ID: 50}, "deadbeef1", nil)
+	adjuster := NewPositionAdjuster(nil, &types.Repo{ID: 50}, "deadbeef1", nil)
 	path, rOut, ok, err := adjuster.AdjustRange(context.Background(), "deadbeef2", "/foo/bar.go", rIn, false)
 	if err != nil {
 		t.Fatalf("unexpected error: %s", err)
@@ -213,7 +214,7 @@ func TestAdjustRangeReverse(t *testing.T) {
 		End:   lsifstore.Position{Line: 305, Character: 20},
 	}
 
-	adjuster := NewPositionAdjuster(&types.Repo{ID: 50}, "deadbeef1", nil)
+	adjuster := NewPositionAdjuster(nil, &types.Repo{ID: 50}, "deadbeef1", nil)
 	path, rOut, ok, err := adjuster.AdjustRange(context.Background(), "deadbeef2", "/foo/bar.go", rIn, true)
 	if err != nil {
 		t.Fatalf("unexpected error: %s", err)
@@ -235,6 +236,161 @@ func TestAdjustRangeReverse(t *testing.T) {
 	}
 }
 
+func TestAdjustRangeApproximate(t *testing.T) {
+	t.Cleanup(func() {
+		git.Mocks.ExecReader = nil
+	})
+
+	// rIn is on a line that is removed in the direct diff between deadbeef1 and deadbeef3
+	rIn := lsifstore.Range{
+		Start: lsifstore.Position{Line: 294, Character: 4},
+		End:   lsifstore.Position{Line: 294, Character: 10},
+	}
+
+	testCases := []struct {
+		description         string
+		commitGraph         []string
+		hopDiffs            map[string]string
+		expectedRange       lsifstore.Range
+		expectedApproximate bool
+		expectedOk          bool
+	}{
+		{
+			description: "unedited in each hop",
+			commitGraph: []string{"deadbeef1 deadbeef2", "deadbeef2 deadbeef3", "deadbeef3"},
+			hopDiffs: map[string]string{
+				"deadbeef1..deadbeef2": prometheusDiff,
+				"deadbeef2..deadbeef3": "",
+			},
+			expectedRange:       rIn,
+			expectedApproximate: false,
+			expectedOk:          true,
+		},
+		{
+			description: "edited in a hop",
+			commitGraph: []string{"deadbeef1 deadbeef2", "deadbeef2 deadbeef3", "deadbeef3"},
+			hopDiffs: map[string]string{
+				"deadbeef1..deadbeef2": "",
+				"deadbeef2..deadbeef3": hugoDiff,
+			},
+			expectedRange: lsifstore.Range{
+				Start: lsifstore.Position{Line: 293, Character: 4},
+				End:   lsifstore.Position{Line: 293, Character: 10},
+			},
+			expectedApproximate: true,
+			expectedOk:          true,
+		},
+		{
+			description: "unconnected commits",
+			commitGraph: []string{"deadbeef1 deadbeef2", "deadbeef2"},
+			expectedOk:  false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			git.Mocks.ExecReader = func(args []string) (reader io.ReadCloser, err error) {
+				if args[1] == "deadbeef1" && args[2] == "deadbeef3" {
+					return io.NopCloser(bytes.NewReader([]byte(hugoDiff))), nil
+				}
+
+				hopDiff, ok := testCase.hopDiffs[args[1]+".."+args[2]]
+				if !ok {
+					t.Fatalf("unexpected exec reader args: %v", args)
+				}
+				return io.NopCloser(bytes.NewReader([]byte(hopDiff))), nil
+			}
+
+			mockGitserverClient := NewMockGitserverClient()
+			mockGitserverClient.CommitGraphFunc.SetDefaultHook(func(ctx context.Context, repositoryID int, opts gitserver.CommitGraphOptions) (*gitserver.CommitGraph, error) {
+				if opts.Commit != "deadbeef1" {
+					return gitserver.ParseCommitGraph(nil), nil
+				}
+
+				return gitserver.ParseCommitGraph(append([]string(nil), testCase.commitGraph...)), nil
+			})
+
+			adjuster := NewPositionAdjuster(mockGitserverClient, &types.Repo{ID: 50}, "deadbeef1", nil)
+			path, rOut, approximate, ok, err := adjuster.AdjustRangeApproximate(context.Background(), "deadbeef3", "/foo/bar.go", rIn, false)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if ok != testCase.expectedOk {
+				t.Fatalf("unexpected ok flag. want=%v have=%v", testCase.expectedOk, ok)
+			}
+			if calls := len(mockGitserverClient.CommitGraphFunc.History()); calls != 1 {
+				t.Errorf("unexpected number of commit graph requests. want=%d have=%d", 1, calls)
+			}
+			if !ok {
+				return
+			}
+
+			if approximate != testCase.expectedApproximate {
+				t.Errorf("unexpected approximate flag. want=%v have=%v", testCase.expectedApproximate, approximate)
+			}
+			if path != "/foo/bar.go" {
+				t.Errorf("unexpected path. want=%s have=%s", "/foo/bar.go", path)
+			}
+			if diff := cmp.Diff(testCase.expectedRange, rOut); diff != "" {
+				t.Errorf("unexpected range (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAdjustRangeApproximateExact(t *testing.T) {
+	t.Cleanup(func() {
+		git.Mocks.ExecReader = nil
+	})
+	git.Mocks.ExecReader = func(args []string) (reader io.ReadCloser, err error) {
+		return io.NopCloser(bytes.NewReader([]byte(hugoDiff))), nil
+	}
+
+	rIn := lsifstore.Range{
+		Start: lsifstore.Position{Line: 302, Character: 15},
+		End:   lsifstore.Position{Line: 305, Character: 20},
+	}
+
+	mockGitserverClient := NewMockGitserverClient()
+	adjuster := NewPositionAdjuster(mockGitserverClient, &types.Repo{ID: 50}, "deadbeef1", nil)
+	_, rOut, approximate, ok, err := adjuster.AdjustRangeApproximate(context.Background(), "deadbeef2", "/foo/bar.go", rIn, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if !ok {
+		t.Fatalf("expected translation to succeed")
+	}
+
+	if approximate {
+		t.Errorf("expected translation to be exact")
+	}
+	if len(mockGitserverClient.CommitGraphFunc.History()) != 0 {
+		t.Errorf("unexpected commit graph request")
+	}
+
+	expectedRange := lsifstore.Range{
+		Start: lsifstore.Position{Line: 294, Character: 15},
+		End:   lsifstore.Position{Line: 297, Character: 20},
+	}
+	if diff := cmp.Diff(expectedRange, rOut); diff != "" {
+		t.Errorf("unexpected range (-want +got):\n%s", diff)
+	}
+}
+
+func TestThinCommitPath(t *testing.T) {
+	path := []string{"c0", "c1", "c2", "c3", "c4", "c5", "c6", "c7", "c8"}
+
+	if diff := cmp.Diff(path, thinCommitPath(path, 8)); diff != "" {
+		t.Errorf("unexpected path (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"c0", "c2", "c4", "c6", "c8"}, thinCommitPath(path, 4)); diff != "" {
+		t.Errorf("unexpected path (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"c0", "c2", "c5", "c8"}, thinCommitPath(path, 3)); diff != "" {
+		t.Errorf("unexpected path (-want +got):\n%s", diff)
+	}
+}
+
 type adjustPositionTestCase struct {
 	diff         string // The git diff output
 	diffName     string // The git diff output name
@@ -378,3 +534,34 @@ func TestRawAdjustPosition(t *testing.T) {
 		})
 	}
 }
+
+func TestRawApproximatePosition(t *testing.T) {
+	testCases := []adjustPositionTestCase{
+		{hugoDiff, "hugo", "on first hunk deletion", 39, false, 39},
+		{hugoDiff, "hugo", "on edited hunk edit", 238, false, 237},
+		{hugoDiff, "hugo", "on third hunk deletion", 295, false, 294},
+		{hugoDiff, "hugo", "on third hunk deletion", 301, false, 294},
+		{hugoDiff, "hugo", "after third hunk deletion", 302, true, 294},
+		{prometheusDiff, "prometheus", "on deletion 1", 296, false, 296},
+		{prometheusDiff, "prometheus", "on deletion 3", 298, false, 296},
+	}
+
+	for _, testCase := range testCases {
+		name := fmt.Sprintf("%s : %s", testCase.diffName, testCase.description)
+
+		t.Run(name, func(t *testing.T) {
+			diff, err := diff.NewFileDiffReader(bytes.NewReader([]byte(testCase.diff))).Read()
+			if err != nil {
+				t.Fatalf("unexpected error reading file diff: %s", err)
+			}
+
+			line, ok := approximateLine(diff.Hunks, testCase.line-1) // 1-index -> 0-index
+			if ok != testCase.expectedOk {
+				t.Errorf("unexpected ok. want=%v have=%v", testCase.expectedOk, ok)
+			}
+			if line+1 != testCase.expectedLine {
+				t.Errorf("unexpected line. want=%d have=%d", testCase.expectedLine, line+1) // 0-index -> 1-index
+			}
+		})
+	}
+}
@@ -5,16 +5,31 @@ import (
 
 	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
 )
 
 // AdjustedLocation is a path and range pair from within a particular upload. The adjusted commit
 // denotes the target commit for which the location was adjusted (the originally requested commit).
+// The approximate flag denotes that the range was edited between the two commits, and the adjusted
+// range is only a best guess.
 type AdjustedLocation struct {
 	Dump           store.Dump
 	Path           string
 	AdjustedCommit string
 	AdjustedRange  lsifstore.Range
+	Approximate    bool
+}
+
+// AdjustedCall is a callable symbol (function, method, or constructor) that calls or is called by the
+// symbol at a requested position. The location denotes the name of the symbol. The from ranges denote
+// the calls, and are relative to the document of the calling symbol. Both have been adjusted to fit the
+// target (originally requested) commit.
+type AdjustedCall struct {
+	Name       string
+	Kind       protocol.SymbolKind
+	Location   AdjustedLocation
+	FromRanges []lsifstore.Range
 }
 
 // AdjustedDiagnostic is a diagnostic from within a particular upload. The adjusted commit denotes
@@ -53,8 +68,11 @@ type QueryResolver interface {
 	Stencil(ctx context.Context) ([]lsifstore.Range, error)
 	Ranges(ctx context.Context, startLine, endLine int) ([]AdjustedCodeIntelligenceRange, error)
 	Definitions(ctx context.Context, line, character int) ([]AdjustedLocation, error)
+	TypeDefinitions(ctx context.Context, line, character int) ([]AdjustedLocation, error)
 	References(ctx context.Context, line, character, limit int, rawCursor string) ([]AdjustedLocation, string, error)
 	Implementations(ctx context.Context, line, character, limit int, rawCursor string) ([]AdjustedLocation, string, error)
+	IncomingCalls(ctx context.Context, line, character, limit int, rawCursor string) ([]AdjustedCall, string, error)
+	OutgoingCalls(ctx context.Context, line, character, limit int, rawCursor string) ([]AdjustedCall, string, error)
 	Hover(ctx context.Context, line, character int) (string, lsifstore.Range, bool, error)
 	Diagnostics(ctx context.Context, limit int) ([]AdjustedDiagnostic, int, error)
 	DocumentationPage(ctx context.Context, pathID string) (*precise.DocumentationPageData, error)
@@ -79,6 +97,11 @@ type queryResolver struct {
 	uploads             []store.Dump
 	uploadCache         map[int]store.Dump
 	operations          *operations
+
+	// approximateLocations enables moving locations that have been edited since the indexed
+	// commit to the nearest surviving line instead of returning them relative to the indexed
+	// commit.
+	approximateLocations bool
 }
 
 // NewQueryResolver create a new query resolver with the given services. The methods of this
@@ -0,0 +1,458 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+const slowCallsRequestThreshold = time.Second
+
+// IncomingCalls returns the list of callable symbols (functions, methods, and constructors) whose body
+// references the symbol at the given position. References are paginated exactly as in References, and
+// the references of each page are grouped by their enclosing symbol. A caller whose calls span multiple
+// pages is returned once per page.
+func (r *queryResolver) IncomingCalls(ctx context.Context, line, character, limit int, rawCursor string) (_ []AdjustedCall, _ string, err error) {
+	ctx, traceLog, endObservation := observeResolver(ctx, &err, "IncomingCalls", r.operations.incomingCalls, slowCallsRequestThreshold, observation.Args{
+		LogFields: []log.Field{
+			log.Int("repositoryID", r.repositoryID),
+			log.String("commit", r.commit),
+			log.String("path", r.path),
+			log.Int("numUploads", len(r.uploads)),
+			log.String("uploads", uploadIDsToString(r.uploads)),
+			log.Int("line", line),
+			log.Int("character", character),
+		},
+	})
+	defer endObservation()
+
+	cursor, err := decodeReferencesCursor(rawCursor)
+	if err != nil {
+		return nil, "", errors.Wrap(err, fmt.Sprintf("invalid cursor: %q", rawCursor))
+	}
+
+	locations, err := r.pageReferences(ctx, line, character, limit, &cursor, traceLog)
+	if err != nil {
+		return nil, "", err
+	}
+	traceLog(log.Int("numLocations", len(locations)))
+
+	// Group the references of this page by document so that we only need to load each document once
+	// to determine the symbols enclosing each of its references.
+
+	callers := newCallSet()
+	for _, group := range groupLocationsByDocument(locations) {
+		ranges := make([]lsifstore.Range, 0, len(group))
+		for _, location := range group {
+			ranges = append(ranges, location.Range)
+		}
+
+		symbols, err := r.lsifStore.EnclosingSymbols(ctx, group[0].DumpID, group[0].Path, ranges)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "lsifStore.EnclosingSymbols")
+		}
+
+		for i, symbol := range symbols {
+			if symbol != nil {
+				callers.add(group[i].DumpID, group[i].Path, symbol, group[i].DumpID, group[i].Path, group[i].Range)
+			}
+		}
+	}
+	traceLog(log.Int("numCallers", len(callers.calls)))
+
+	adjustedCalls, err := r.adjustCalls(ctx, callers.calls)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextCursor := ""
+	if cursor.Phase != "done" {
+		nextCursor = encodeReferencesCursor(cursor)
+	}
+
+	return adjustedCalls, nextCursor, nil
+}
+
+// OutgoingCalls returns the list of callable symbols (functions, methods, and constructors) referenced
+// from within the body of the symbol at the given position. The callees are determined via the local
+// definitions of each range in the body of the symbol, falling back to a moniker search for ranges that
+// refer to a symbol defined in another upload. Callees are ordered by their first call.
+func (r *queryResolver) OutgoingCalls(ctx context.Context, line, character, limit int, rawCursor string) (_ []AdjustedCall, _ string, err error) {
+	ctx, traceLog, endObservation := observeResolver(ctx, &err, "OutgoingCalls", r.operations.outgoingCalls, slowCallsRequestThreshold, observation.Args{
+		LogFields: []log.Field{
+			log.Int("repositoryID", r.repositoryID),
+			log.String("commit", r.commit),
+			log.String("path", r.path),
+			log.Int("numUploads", len(r.uploads)),
+			log.String("uploads", uploadIDsToString(r.uploads)),
+			log.Int("line", line),
+			log.Int("character", character),
+		},
+	})
+	defer endObservation()
+
+	cursor, err := decodeOutgoingCallsCursor(rawCursor)
+	if err != nil {
+		return nil, "", errors.Wrap(err, fmt.Sprintf("invalid cursor: %q", rawCursor))
+	}
+
+	adjustedUploads, err := r.adjustUploads(ctx, line, character)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Find the definition of the symbol at the given position, which may live in another upload,
+	// and determine the extent of its body.
+
+	definitions, err := r.definitionLocations(ctx, adjustedUploads, traceLog)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(definitions) == 0 {
+		return nil, "", nil
+	}
+	definition := definitions[0]
+
+	symbols, err := r.lsifStore.Symbols(ctx, definition.DumpID, definition.Path, []lsifstore.Range{definition.Range})
+	if err != nil {
+		return nil, "", errors.Wrap(err, "lsifStore.Symbols")
+	}
+	if len(symbols) == 0 || symbols[0] == nil || !symbols[0].Callable() {
+		return nil, "", nil
+	}
+	symbol := symbols[0]
+	traceLog(log.String("symbol", symbol.Name))
+
+	ranges, err := r.lsifStore.Ranges(ctx, definition.DumpID, definition.Path, symbol.FullRange.Start.Line, symbol.FullRange.End.Line+1)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "lsifStore.Ranges")
+	}
+
+	// Resolve the definitions of each range within the body of the symbol. These are the candidate
+	// callees, which are filtered down to callable symbols below. Ranges without a definition in the
+	// same upload are resolved together by a single moniker search.
+
+	bodyRanges := make([]lsifstore.CodeIntelligenceRange, 0, len(ranges))
+	for _, rn := range ranges {
+		if rn.Range == symbol.Range || !rangeContainsRange(symbol.FullRange, rn.Range) {
+			continue
+		}
+
+		bodyRanges = append(bodyRanges, rn)
+	}
+
+	remoteLocations, err := r.remoteDefinitionLocations(ctx, definition.DumpID, definition.Path, bodyRanges)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var candidates []callSite
+	for i, rn := range bodyRanges {
+		calleeLocations := rn.Definitions
+		if len(calleeLocations) == 0 {
+			calleeLocations = remoteLocations[i]
+		}
+
+		for _, location := range calleeLocations {
+			if location.DumpID == definition.DumpID && location.Path == definition.Path && location.Range == rn.Range {
+				// Declarations within the body define themselves
+				continue
+			}
+
+			candidates = append(candidates, callSite{callee: location, from: rn.Range})
+		}
+	}
+	traceLog(log.Int("numCandidates", len(candidates)))
+
+	calleeLocations := make([]lsifstore.Location, 0, len(candidates))
+	seen := map[lsifstore.Location]struct{}{}
+	for _, candidate := range candidates {
+		if _, ok := seen[candidate.callee]; !ok {
+			seen[candidate.callee] = struct{}{}
+			calleeLocations = append(calleeLocations, candidate.callee)
+		}
+	}
+
+	callees := newCallSet()
+	for _, group := range groupLocationsByDocument(calleeLocations) {
+		ranges := make([]lsifstore.Range, 0, len(group))
+		for _, location := range group {
+			ranges = append(ranges, location.Range)
+		}
+
+		symbols, err := r.lsifStore.Symbols(ctx, group[0].DumpID, group[0].Path, ranges)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "lsifStore.Symbols")
+		}
+
+		for i, symbol := range symbols {
+			if symbol == nil || !symbol.Callable() {
+				continue
+			}
+
+			for _, candidate := range candidates {
+				if candidate.callee == group[i] {
+					callees.add(group[i].DumpID, group[i].Path, symbol, definition.DumpID, definition.Path, candidate.from)
+				}
+			}
+		}
+	}
+	traceLog(log.Int("numCallees", len(callees.calls)))
+
+	calls := callees.calls
+	sort.SliceStable(calls, func(i, j int) bool {
+		return positionBefore(calls[i].fromRanges[0].Start, calls[j].fromRanges[0].Start)
+	})
+
+	nextCursor := ""
+	if cursor.Offset < len(calls) {
+		calls = calls[cursor.Offset:]
+	} else {
+		calls = nil
+	}
+	if len(calls) > limit {
+		calls = calls[:limit]
+		nextCursor = encodeOutgoingCallsCursor(outgoingCallsCursor{Offset: cursor.Offset + limit})
+	}
+
+	adjustedCalls, err := r.adjustCalls(ctx, calls)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return adjustedCalls, nextCursor, nil
+}
+
+// remoteDefinitionLocations returns, for each of the given ranges of a document within the given
+// upload that has no local definition, the definitions of the symbol at that range. The monikers of
+// all such ranges are collected first so that the uploads providing those symbols and the locations
+// of their definitions can each be fetched in a single request.
+func (r *queryResolver) remoteDefinitionLocations(ctx context.Context, dumpID int, path string, ranges []lsifstore.CodeIntelligenceRange) ([][]lsifstore.Location, error) {
+	locations := make([][]lsifstore.Location, len(ranges))
+
+	dump, ok := r.uploadCache[dumpID]
+	if !ok {
+		return locations, nil
+	}
+
+	rangeMonikers := make([][]precise.QualifiedMonikerData, len(ranges))
+	monikerSet := newQualifiedMonikerSet()
+	for i, rn := range ranges {
+		if len(rn.Definitions) != 0 {
+			continue
+		}
+
+		monikers, err := r.orderedMonikers(ctx, []adjustedUpload{{
+			Upload:               dump,
+			AdjustedPath:         dump.Root + path,
+			AdjustedPosition:     rn.Range.Start,
+			AdjustedPathInBundle: path,
+		}}, "import")
+		if err != nil {
+			return nil, err
+		}
+		rangeMonikers[i] = monikers
+
+		for _, moniker := range monikers {
+			monikerSet.add(moniker)
+		}
+	}
+	orderedMonikers := monikerSet.monikers
+	if len(orderedMonikers) == 0 {
+		return locations, nil
+	}
+
+	uploads, err := r.definitionUploads(ctx, orderedMonikers)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, 0, len(uploads))
+	for i := range uploads {
+		ids = append(ids, uploads[i].ID)
+	}
+
+	args := make([]precise.MonikerData, 0, len(orderedMonikers))
+	for _, moniker := range orderedMonikers {
+		args = append(args, moniker.MonikerData)
+	}
+
+	monikerLocations, err := r.lsifStore.BulkMonikerLocations(ctx, "definitions", ids, args)
+	if err != nil {
+		return nil, errors.Wrap(err, "lsifStore.BulkMonikerLocations")
+	}
+
+	for i, monikers := range rangeMonikers {
+		locations[i] = definitionLocationsForMonikers(monikerLocations, monikers)
+	}
+
+	return locations, nil
+}
+
+// definitionLocationsForMonikers returns the locations attached to any of the given monikers, up to
+// DefinitionsLimit locations.
+func definitionLocationsForMonikers(monikerLocations []lsifstore.QualifiedMonikerLocations, monikers []precise.QualifiedMonikerData) []lsifstore.Location {
+	var locations []lsifstore.Location
+	for _, monikerLocation := range monikerLocations {
+		if !containsMoniker(monikers, monikerLocation.Scheme, monikerLocation.Identifier) {
+			continue
+		}
+
+		for _, row := range monikerLocation.Locations {
+			locations = append(locations, lsifstore.Location{
+				DumpID: monikerLocation.DumpID,
+				Path:   row.URI,
+				Range: lsifstore.Range{
+					Start: lsifstore.Position{Line: row.StartLine, Character: row.StartCharacter},
+					End:   lsifstore.Position{Line: row.EndLine, Character: row.EndCharacter},
+				},
+			})
+
+			if len(locations) >= DefinitionsLimit {
+				return locations
+			}
+		}
+	}
+
+	return locations
+}
+
+// containsMoniker returns true if one of the given monikers has the given scheme and identifier.
+func containsMoniker(monikers []precise.QualifiedMonikerData, scheme, identifier string) bool {
+	for _, moniker := range monikers {
+		if moniker.Scheme == scheme && moniker.Identifier == identifier {
+			return true
+		}
+	}
+
+	return false
+}
+
+// adjustCalls translates a set of calls (relative to the indexed commits) into an equivalent set of
+// calls in the requested commit.
+func (r *queryResolver) adjustCalls(ctx context.Context, calls []*call) ([]AdjustedCall, error) {
+	adjustedCalls := make([]AdjustedCall, 0, len(calls))
+	for _, c := range calls {
+		adjustedLocation, err := r.adjustLocation(ctx, r.uploadCache[c.dumpID], lsifstore.Location{
+			DumpID: c.dumpID,
+			Path:   c.path,
+			Range:  c.symbol.Range,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		fromDump := r.uploadCache[c.fromDumpID]
+		adjustedFromRanges := make([]lsifstore.Range, 0, len(c.fromRanges))
+		for _, rn := range c.fromRanges {
+			_, adjustedRange, _, err := r.adjustRange(ctx, fromDump.RepositoryID, fromDump.Commit, fromDump.Root+c.fromPath, rn)
+			if err != nil {
+				return nil, err
+			}
+
+			adjustedFromRanges = append(adjustedFromRanges, adjustedRange)
+		}
+
+		adjustedCalls = append(adjustedCalls, AdjustedCall{
+			Name:       c.symbol.Name,
+			Kind:       c.symbol.Kind,
+			Location:   adjustedLocation,
+			FromRanges: adjustedFromRanges,
+		})
+	}
+
+	return adjustedCalls, nil
+}
+
+// callSite pairs the range of a call with the definition of the called symbol.
+type callSite struct {
+	callee lsifstore.Location
+	from   lsifstore.Range
+}
+
+// call is a symbol along with the ranges of its calls, which all occur in the same document.
+type call struct {
+	dumpID     int
+	path       string
+	symbol     *lsifstore.Symbol
+	fromDumpID int
+	fromPath   string
+	fromRanges []lsifstore.Range
+}
+
+type callKey struct {
+	dumpID int
+	path   string
+	rn     lsifstore.Range
+}
+
+// callSet groups call ranges by the symbol they belong to, preserving the order in which each
+// symbol was first added.
+type callSet struct {
+	calls []*call
+	index map[callKey]*call
+}
+
+func newCallSet() *callSet {
+	return &callSet{index: map[callKey]*call{}}
+}
+
+func (s *callSet) add(dumpID int, path string, symbol *lsifstore.Symbol, fromDumpID int, fromPath string, from lsifstore.Range) {
+	key := callKey{dumpID: dumpID, path: path, rn: symbol.Range}
+	c, ok := s.index[key]
+	if !ok {
+		c = &call{dumpID: dumpID, path: path, symbol: symbol, fromDumpID: fromDumpID, fromPath: fromPath}
+		s.index[key] = c
+		s.calls = append(s.calls, c)
+	}
+
+	for _, rn := range c.fromRanges {
+		if rn == from {
+			return
+		}
+	}
+	c.fromRanges = append(c.fromRanges, from)
+}
+
+// groupLocationsByDocument groups the given locations by upload and path, preserving the order in which
+// each document first occurs.
+func groupLocationsByDocument(locations []lsifstore.Location) [][]lsifstore.Location {
+	type documentKey struct {
+		dumpID int
+		path   string
+	}
+
+	var groups [][]lsifstore.Location
+	indexes := map[documentKey]int{}
+	for _, location := range locations {
+		key := documentKey{dumpID: location.DumpID, path: location.Path}
+		i, ok := indexes[key]
+		if !ok {
+			i = len(groups)
+			indexes[key] = i
+			groups = append(groups, nil)
+		}
+
+		groups[i] = append(groups[i], location)
+	}
+
+	return groups
+}
+
+// rangeContainsRange returns true if the outer range encloses the inner range.
+func rangeContainsRange(outer, inner lsifstore.Range) bool {
+	return !positionBefore(inner.Start, outer.Start) && !positionBefore(outer.End, inner.End)
+}
+
+// positionBefore returns true if the position a occurs before position b.
+func positionBefore(a, b lsifstore.Position) bool {
+	return a.Line < b.Line || (a.Line == b.Line && a.Character < b.Character)
+}
@@ -0,0 +1,35 @@
+package resolvers
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// outgoingCallsCursor stores (enough of) the state of a previous OutgoingCalls request used to
+// calculate the offset into the result set to be returned by the current request.
+type outgoingCallsCursor struct {
+	Offset int `json:"offset"`
+}
+
+// decodeOutgoingCallsCursor is the inverse of encodeOutgoingCallsCursor. If the given encoded string
+// is empty, then a fresh cursor is returned.
+func decodeOutgoingCallsCursor(rawEncoded string) (outgoingCallsCursor, error) {
+	if rawEncoded == "" {
+		return outgoingCallsCursor{}, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(rawEncoded)
+	if err != nil {
+		return outgoingCallsCursor{}, err
+	}
+
+	var cursor outgoingCallsCursor
+	err = json.Unmarshal(raw, &cursor)
+	return cursor, err
+}
+
+// encodeOutgoingCallsCursor returns an encoding of the given cursor suitable for a URL or a GraphQL token.
+func encodeOutgoingCallsCursor(cursor outgoingCallsCursor) string {
+	rawEncoded, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(rawEncoded)
+}
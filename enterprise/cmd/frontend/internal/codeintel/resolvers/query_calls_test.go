@@ -0,0 +1,296 @@
+package resolvers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+func TestIncomingCalls(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+
+	locations := []lsifstore.Location{
+		{DumpID: 50, Path: "a.go", Range: testRange1},
+		{DumpID: 50, Path: "b.go", Range: testRange2},
+		{DumpID: 50, Path: "a.go", Range: testRange3},
+	}
+	mockLSIFStore.ReferencesFunc.PushReturn(locations, 10, nil)
+
+	callerA := &lsifstore.Symbol{Name: "A", Kind: protocol.Function, Range: testRange4}
+	callerB := &lsifstore.Symbol{Name: "B", Kind: protocol.Method, Range: testRange5}
+	mockLSIFStore.EnclosingSymbolsFunc.SetDefaultHook(func(ctx context.Context, bundleID int, path string, ranges []lsifstore.Range) ([]*lsifstore.Symbol, error) {
+		if path == "a.go" {
+			return []*lsifstore.Symbol{callerA, callerA}, nil
+		}
+		return []*lsifstore.Symbol{callerB}, nil
+	})
+
+	uploads := []dbstore.Dump{
+		{ID: 50, Commit: "deadbeef", Root: "sub1/"},
+	}
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		uploads,
+		newOperations(&observation.TestContext),
+	)
+	adjustedCalls, cursor, err := resolver.IncomingCalls(context.Background(), 10, 20, 3, "")
+	if err != nil {
+		t.Fatalf("unexpected error querying incoming calls: %s", err)
+	}
+
+	expectedCalls := []AdjustedCall{
+		{
+			Name:       "A",
+			Kind:       protocol.Function,
+			Location:   AdjustedLocation{Dump: uploads[0], Path: "sub1/a.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange4},
+			FromRanges: []lsifstore.Range{testRange1, testRange3},
+		},
+		{
+			Name:       "B",
+			Kind:       protocol.Method,
+			Location:   AdjustedLocation{Dump: uploads[0], Path: "sub1/b.go", AdjustedCommit: "deadbeef", AdjustedRange: testRange5},
+			FromRanges: []lsifstore.Range{testRange2},
+		},
+	}
+	if diff := cmp.Diff(expectedCalls, adjustedCalls); diff != "" {
+		t.Errorf("unexpected calls (-want +got):\n%s", diff)
+	}
+
+	if cursor == "" {
+		t.Errorf("expected a cursor for the next page")
+	}
+}
+
+func TestOutgoingCalls(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+
+	nameRange := newTestRange(10, 5, 10, 9)
+	callRange1 := newTestRange(11, 2, 11, 5)
+	typeRange := newTestRange(12, 2, 12, 5)
+	callRange2 := newTestRange(13, 2, 13, 6)
+	callRange3 := newTestRange(14, 2, 14, 5)
+	outsideRange := newTestRange(30, 0, 30, 3)
+
+	calleeRange := newTestRange(1, 5, 1, 8)
+	otherCalleeRange := newTestRange(2, 5, 2, 9)
+	structRange := newTestRange(3, 5, 3, 6)
+
+	mockLSIFStore.DefinitionsFunc.PushReturn([]lsifstore.Location{{DumpID: 50, Path: "main.go", Range: nameRange}}, 1, nil)
+	mockLSIFStore.RangesFunc.PushReturn([]lsifstore.CodeIntelligenceRange{
+		{Range: nameRange, Definitions: []lsifstore.Location{{DumpID: 50, Path: "main.go", Range: nameRange}}},
+		{Range: callRange1, Definitions: []lsifstore.Location{{DumpID: 50, Path: "lib.go", Range: calleeRange}}},
+		{Range: typeRange, Definitions: []lsifstore.Location{{DumpID: 50, Path: "lib.go", Range: structRange}}},
+		{Range: callRange2, Definitions: []lsifstore.Location{{DumpID: 50, Path: "other.go", Range: otherCalleeRange}}},
+		{Range: callRange3, Definitions: []lsifstore.Location{{DumpID: 50, Path: "lib.go", Range: calleeRange}}},
+		{Range: outsideRange, Definitions: []lsifstore.Location{{DumpID: 50, Path: "lib.go", Range: calleeRange}}},
+	}, nil)
+
+	symbols := map[lsifstore.Range]*lsifstore.Symbol{
+		nameRange:        {Name: "main", Kind: protocol.Function, Range: nameRange, FullRange: newTestRange(10, 0, 15, 1)},
+		calleeRange:      {Name: "f", Kind: protocol.Function, Range: calleeRange},
+		otherCalleeRange: {Name: "g", Kind: protocol.Method, Range: otherCalleeRange},
+		structRange:      {Name: "T", Kind: protocol.Struct, Range: structRange},
+	}
+	mockLSIFStore.SymbolsFunc.SetDefaultHook(func(ctx context.Context, bundleID int, path string, ranges []lsifstore.Range) ([]*lsifstore.Symbol, error) {
+		result := make([]*lsifstore.Symbol, 0, len(ranges))
+		for _, r := range ranges {
+			result = append(result, symbols[r])
+		}
+		return result, nil
+	})
+
+	uploads := []dbstore.Dump{
+		{ID: 50, Commit: "deadbeef", Root: "sub1/"},
+	}
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		uploads,
+		newOperations(&observation.TestContext),
+	)
+	adjustedCalls, cursor, err := resolver.OutgoingCalls(context.Background(), 10, 6, 1, "")
+	if err != nil {
+		t.Fatalf("unexpected error querying outgoing calls: %s", err)
+	}
+
+	expectedCalls := []AdjustedCall{
+		{
+			Name:       "f",
+			Kind:       protocol.Function,
+			Location:   AdjustedLocation{Dump: uploads[0], Path: "sub1/lib.go", AdjustedCommit: "deadbeef", AdjustedRange: calleeRange},
+			FromRanges: []lsifstore.Range{callRange1, callRange3},
+		},
+	}
+	if diff := cmp.Diff(expectedCalls, adjustedCalls); diff != "" {
+		t.Errorf("unexpected calls (-want +got):\n%s", diff)
+	}
+
+	if history := mockLSIFStore.RangesFunc.History(); len(history) != 1 || history[0].Arg3 != 10 || history[0].Arg4 != 16 {
+		t.Errorf("unexpected ranges request: %v", history)
+	}
+
+	mockLSIFStore.DefinitionsFunc.PushReturn([]lsifstore.Location{{DumpID: 50, Path: "main.go", Range: nameRange}}, 1, nil)
+	mockLSIFStore.RangesFunc.PushReturn(mockLSIFStore.RangesFunc.History()[0].Result0, nil)
+
+	adjustedCalls, cursor, err = resolver.OutgoingCalls(context.Background(), 10, 6, 1, cursor)
+	if err != nil {
+		t.Fatalf("unexpected error querying outgoing calls: %s", err)
+	}
+
+	expectedCalls = []AdjustedCall{
+		{
+			Name:       "g",
+			Kind:       protocol.Method,
+			Location:   AdjustedLocation{Dump: uploads[0], Path: "sub1/other.go", AdjustedCommit: "deadbeef", AdjustedRange: otherCalleeRange},
+			FromRanges: []lsifstore.Range{callRange2},
+		},
+	}
+	if diff := cmp.Diff(expectedCalls, adjustedCalls); diff != "" {
+		t.Errorf("unexpected calls (-want +got):\n%s", diff)
+	}
+
+	if cursor != "" {
+		t.Errorf("unexpected cursor %q", cursor)
+	}
+}
+
+func TestOutgoingCallsRemote(t *testing.T) {
+	mockDBStore := NewMockDBStore()
+	mockLSIFStore := NewMockLSIFStore()
+	mockGitserverClient := NewMockGitserverClient()
+	mockPositionAdjuster := noopPositionAdjuster()
+
+	nameRange := newTestRange(10, 5, 10, 9)
+	callRange1 := newTestRange(11, 2, 11, 5)
+	callRange2 := newTestRange(12, 2, 12, 7)
+	callRange3 := newTestRange(13, 2, 13, 5)
+
+	padLeftRange := newTestRange(1, 9, 1, 16)
+	padRightRange := newTestRange(5, 9, 5, 17)
+
+	mockLSIFStore.DefinitionsFunc.PushReturn([]lsifstore.Location{{DumpID: 50, Path: "main.go", Range: nameRange}}, 1, nil)
+	mockLSIFStore.RangesFunc.PushReturn([]lsifstore.CodeIntelligenceRange{
+		{Range: nameRange, Definitions: []lsifstore.Location{{DumpID: 50, Path: "main.go", Range: nameRange}}},
+		{Range: callRange1},
+		{Range: callRange2},
+		{Range: callRange3},
+	}, nil)
+
+	padLeft := precise.MonikerData{Kind: "import", Scheme: "gomod", Identifier: "leftpad:PadLeft", PackageInformationID: "51"}
+	padRight := precise.MonikerData{Kind: "import", Scheme: "gomod", Identifier: "leftpad:PadRight", PackageInformationID: "51"}
+	mockLSIFStore.MonikersByPositionFunc.SetDefaultHook(func(ctx context.Context, bundleID int, path string, line, character int) ([][]precise.MonikerData, error) {
+		if line == callRange2.Start.Line {
+			return [][]precise.MonikerData{{padRight}}, nil
+		}
+		return [][]precise.MonikerData{{padLeft}}, nil
+	})
+	mockLSIFStore.PackageInformationFunc.SetDefaultReturn(precise.PackageInformationData{Name: "leftpad", Version: "0.1.0"}, true, nil)
+
+	remoteUploads := []dbstore.Dump{
+		{ID: 150, Commit: "deadbeef1", Root: "lib/"},
+	}
+	mockDBStore.DefinitionDumpsFunc.PushReturn(remoteUploads, nil)
+	mockGitserverClient.CommitExistsFunc.SetDefaultReturn(true, nil)
+
+	mockLSIFStore.BulkMonikerLocationsFunc.PushReturn([]lsifstore.QualifiedMonikerLocations{
+		{DumpID: 150, MonikerLocations: precise.MonikerLocations{Scheme: "gomod", Identifier: "leftpad:PadLeft", Locations: []precise.LocationData{
+			{URI: "pad.go", StartLine: 1, StartCharacter: 9, EndLine: 1, EndCharacter: 16},
+		}}},
+		{DumpID: 150, MonikerLocations: precise.MonikerLocations{Scheme: "gomod", Identifier: "leftpad:PadRight", Locations: []precise.LocationData{
+			{URI: "pad.go", StartLine: 5, StartCharacter: 9, EndLine: 5, EndCharacter: 17},
+		}}},
+	}, nil)
+
+	symbols := map[lsifstore.Range]*lsifstore.Symbol{
+		nameRange:     {Name: "main", Kind: protocol.Function, Range: nameRange, FullRange: newTestRange(10, 0, 15, 1)},
+		padLeftRange:  {Name: "PadLeft", Kind: protocol.Function, Range: padLeftRange},
+		padRightRange: {Name: "PadRight", Kind: protocol.Function, Range: padRightRange},
+	}
+	mockLSIFStore.SymbolsFunc.SetDefaultHook(func(ctx context.Context, bundleID int, path string, ranges []lsifstore.Range) ([]*lsifstore.Symbol, error) {
+		result := make([]*lsifstore.Symbol, 0, len(ranges))
+		for _, r := range ranges {
+			result = append(result, symbols[r])
+		}
+		return result, nil
+	})
+
+	uploads := []dbstore.Dump{
+		{ID: 50, Commit: "deadbeef", Root: "sub1/"},
+	}
+	resolver := newQueryResolver(
+		mockDBStore,
+		mockLSIFStore,
+		newCachedCommitChecker(mockGitserverClient),
+		mockPositionAdjuster,
+		42,
+		"deadbeef",
+		"s1/main.go",
+		uploads,
+		newOperations(&observation.TestContext),
+	)
+	adjustedCalls, _, err := resolver.OutgoingCalls(context.Background(), 10, 6, 10, "")
+	if err != nil {
+		t.Fatalf("unexpected error querying outgoing calls: %s", err)
+	}
+
+	expectedCalls := []AdjustedCall{
+		{
+			Name:       "PadLeft",
+			Kind:       protocol.Function,
+			Location:   AdjustedLocation{Dump: remoteUploads[0], Path: "lib/pad.go", AdjustedCommit: "deadbeef1", AdjustedRange: padLeftRange},
+			FromRanges: []lsifstore.Range{callRange1, callRange3},
+		},
+		{
+			Name:       "PadRight",
+			Kind:       protocol.Function,
+			Location:   AdjustedLocation{Dump: remoteUploads[0], Path: "lib/pad.go", AdjustedCommit: "deadbeef1", AdjustedRange: padRightRange},
+			FromRanges: []lsifstore.Range{callRange2},
+		},
+	}
+	if diff := cmp.Diff(expectedCalls, adjustedCalls); diff != "" {
+		t.Errorf("unexpected calls (-want +got):\n%s", diff)
+	}
+
+	if history := mockDBStore.DefinitionDumpsFunc.History(); len(history) != 1 {
+		t.Errorf("unexpected call count for dbstore.DefinitionDumps. want=%d have=%d", 1, len(history))
+	} else if len(history[0].Arg1) != 2 {
+		t.Errorf("unexpected monikers. want=%d have=%d", 2, len(history[0].Arg1))
+	}
+
+	if history := mockLSIFStore.BulkMonikerLocationsFunc.History(); len(history) != 1 {
+		t.Errorf("unexpected call count for lsifstore.BulkMonikerLocations. want=%d have=%d", 1, len(history))
+	} else if diff := cmp.Diff([]int{150}, history[0].Arg2); diff != "" {
+		t.Errorf("unexpected upload ids (-want +got):\n%s", diff)
+	}
+}
+
+func newTestRange(startLine, startCharacter, endLine, endCharacter int) lsifstore.Range {
+	return lsifstore.Range{
+		Start: lsifstore.Position{Line: startLine, Character: startCharacter},
+		End:   lsifstore.Position{Line: endLine, Character: endCharacter},
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/opentracing/opentracing-go/log"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 )
 
@@ -38,6 +39,29 @@ func (r *queryResolver) Definitions(ctx context.Context, line, character int) (_
 		return nil, err
 	}
 
+	locations, err := r.definitionLocations(ctx, adjustedUploads, traceLog)
+	if err != nil {
+		return nil, err
+	}
+	traceLog(log.Int("numLocations", len(locations)))
+
+	// Adjust the locations back to the appropriate range in the target commits. This adjusts
+	// locations within the repository the user is browsing so that it appears all definitions
+	// are occurring at the same commit they are looking at.
+
+	adjustedLocations, err := r.adjustLocations(ctx, locations)
+	if err != nil {
+		return nil, err
+	}
+	traceLog(log.Int("numAdjustedLocations", len(adjustedLocations)))
+
+	return adjustedLocations, nil
+}
+
+// definitionLocations returns the raw (unadjusted) locations that define the symbol at the given
+// adjusted position of each upload. Local definitions found via LSIF graph traversal are preferred;
+// a moniker search over the uploads providing the symbol is performed only if there are none.
+func (r *queryResolver) definitionLocations(ctx context.Context, adjustedUploads []adjustedUpload, traceLog observation.TraceLogger) ([]lsifstore.Location, error) {
 	// Gather the "local" reference locations that are reachable via a referenceResult vertex.
 	// If the definition exists within the index, it should be reachable via an LSIF graph
 	// traversal and should not require an additional moniker search in the same index.
@@ -59,7 +83,7 @@ func (r *queryResolver) Definitions(ctx context.Context, line, character int) (_
 		}
 		if len(locations) > 0 {
 			// If we have a local definition, we won't find a better one and can exit early
-			return r.adjustLocations(ctx, locations)
+			return locations, nil
 		}
 	}
 
@@ -90,17 +114,6 @@ func (r *queryResolver) Definitions(ctx context.Context, line, character int) (_
 	if err != nil {
 		return nil, err
 	}
-	traceLog(log.Int("numLocations", len(locations)))
-
-	// Adjust the locations back to the appropriate range in the target commits. This adjusts
-	// locations within the repository the user is browsing so that it appears all definitions
-	// are occurring at the same commit they are looking at.
 
-	adjustedLocations, err := r.adjustLocations(ctx, locations)
-	if err != nil {
-		return nil, err
-	}
-	traceLog(log.Int("numAdjustedLocations", len(adjustedLocations)))
-
-	return adjustedLocations, nil
+	return locations, nil
 }
@@ -44,8 +44,35 @@ func (r *queryResolver) References(ctx context.Context, line, character, limit i
 		return nil, "", errors.Wrap(err, fmt.Sprintf("invalid cursor: %q", rawCursor))
 	}
 
+	locations, err := r.pageReferences(ctx, line, character, limit, &cursor, traceLog)
+	if err != nil {
+		return nil, "", err
+	}
+	traceLog(log.Int("numLocations", len(locations)))
+
+	// Adjust the locations back to the appropriate range in the target commits. This adjusts
+	// locations within the repository the user is browsing so that it appears all references
+	// are occurring at the same commit they are looking at.
+
+	adjustedLocations, err := r.adjustLocations(ctx, locations)
+	if err != nil {
+		return nil, "", err
+	}
+	traceLog(log.Int("numAdjustedLocations", len(adjustedLocations)))
+
+	nextCursor := ""
+	if cursor.Phase != "done" {
+		nextCursor = encodeReferencesCursor(cursor)
+	}
+
+	return adjustedLocations, nextCursor, nil
+}
+
+// pageReferences returns the page of raw (unadjusted) reference locations denoted by the given cursor.
+// The cursor is modified in-place to become the cursor used to fetch the subsequent page of results.
+func (r *queryResolver) pageReferences(ctx context.Context, line, character, limit int, cursor *referencesCursor, traceLog observation.TraceLogger) ([]lsifstore.Location, error) {
 	// Adjust the path and position for each visible upload based on its git difference to
-	// the target commit. This data may already be stashed in the cursor decoded above, in
+	// the target commit. This data may already be stashed in the given cursor, in
 	// which case we don't need to hit the database.
 
 	// References at the given file:line:character could come from multiple uploads, so we
@@ -53,16 +80,16 @@ func (r *queryResolver) References(ctx context.Context, line, character, limit i
 
 	adjustedUploads, err := r.adjustedUploadsFromCursor(ctx, line, character, &cursor.AdjustedUploads)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
 	// Gather all monikers attached to the ranges enclosing the requested position. This data
-	// may already be stashed in the cursor decoded above, in which case we don't need to hit
+	// may already be stashed in the given cursor, in which case we don't need to hit
 	// the database.
 
 	if cursor.OrderedMonikers == nil {
 		if cursor.OrderedMonikers, err = r.orderedMonikers(ctx, adjustedUploads, "import", "export"); err != nil {
-			return nil, "", err
+			return nil, err
 		}
 	}
 	traceLog(
@@ -84,7 +111,7 @@ func (r *queryResolver) References(ctx context.Context, line, character, limit i
 			traceLog,
 		)
 		if err != nil {
-			return nil, "", err
+			return nil, err
 		}
 		locations = append(locations, localLocations...)
 
@@ -102,7 +129,7 @@ func (r *queryResolver) References(ctx context.Context, line, character, limit i
 			cursor.RemoteCursor.UploadBatchIDs = []int{}
 			definitionUploads, err := r.definitionUploads(ctx, cursor.OrderedMonikers)
 			if err != nil {
-				return nil, "", err
+				return nil, err
 			}
 			for i := range definitionUploads {
 				found := false
@@ -121,7 +148,7 @@ func (r *queryResolver) References(ctx context.Context, line, character, limit i
 		for len(locations) < limit {
 			remoteLocations, hasMore, err := r.pageRemoteLocations(ctx, "references", adjustedUploads, cursor.OrderedMonikers, &cursor.RemoteCursor, limit-len(locations), traceLog)
 			if err != nil {
-				return nil, "", err
+				return nil, err
 			}
 			locations = append(locations, remoteLocations...)
 
@@ -132,24 +159,7 @@ func (r *queryResolver) References(ctx context.Context, line, character, limit i
 		}
 	}
 
-	traceLog(log.Int("numLocations", len(locations)))
-
-	// Adjust the locations back to the appropriate range in the target commits. This adjusts
-	// locations within the repository the user is browsing so that it appears all references
-	// are occurring at the same commit they are looking at.
-
-	adjustedLocations, err := r.adjustLocations(ctx, locations)
-	if err != nil {
-		return nil, "", err
-	}
-	traceLog(log.Int("numAdjustedLocations", len(adjustedLocations)))
-
-	nextCursor := ""
-	if cursor.Phase != "done" {
-		nextCursor = encodeReferencesCursor(cursor)
-	}
-
-	return adjustedLocations, nextCursor, nil
+	return locations, nil
 }
 
 // ErrConcurrentModification occurs when a page of a references request cannot be resolved as
@@ -0,0 +1,67 @@
+package resolvers
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+const slowTypeDefinitionsRequestThreshold = time.Second
+
+// TypeDefinitionsLimit is maximum the number of locations returned from TypeDefinitions.
+const TypeDefinitionsLimit = 100
+
+// TypeDefinitions returns the list of source locations that define the type of the symbol at the given
+// position. Type definitions are only resolved via LSIF graph traversal. Types defined outside of the
+// indexes of the current repository are not returned.
+func (r *queryResolver) TypeDefinitions(ctx context.Context, line, character int) (_ []AdjustedLocation, err error) {
+	ctx, traceLog, endObservation := observeResolver(ctx, &err, "TypeDefinitions", r.operations.typeDefinitions, slowTypeDefinitionsRequestThreshold, observation.Args{
+		LogFields: []log.Field{
+			log.Int("repositoryID", r.repositoryID),
+			log.String("commit", r.commit),
+			log.String("path", r.path),
+			log.Int("numUploads", len(r.uploads)),
+			log.String("uploads", uploadIDsToString(r.uploads)),
+			log.Int("line", line),
+			log.Int("character", character),
+		},
+	})
+	defer endObservation()
+
+	// Adjust the path and position for each visible upload based on its git difference to
+	// the target commit.
+
+	adjustedUploads, err := r.adjustUploads(ctx, line, character)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range adjustedUploads {
+		traceLog(log.Int("uploadID", adjustedUploads[i].Upload.ID))
+
+		locations, _, err := r.lsifStore.TypeDefinitions(
+			ctx,
+			adjustedUploads[i].Upload.ID,
+			adjustedUploads[i].AdjustedPathInBundle,
+			adjustedUploads[i].AdjustedPosition.Line,
+			adjustedUploads[i].AdjustedPosition.Character,
+			TypeDefinitionsLimit,
+			0,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "lsifStore.TypeDefinitions")
+		}
+		if len(locations) > 0 {
+			traceLog(log.Int("numLocations", len(locations)))
+
+			// Adjust the locations back to the appropriate range in the target commits.
+			return r.adjustLocations(ctx, locations)
+		}
+	}
+
+	return nil, nil
+}
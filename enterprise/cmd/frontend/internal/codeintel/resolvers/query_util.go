@@ -172,9 +172,21 @@ func (r *queryResolver) adjustLocations(ctx context.Context, locations []lsifsto
 
 // adjustLocation translates a location (relative to the indexed commit) into an equivalent location in
 // the requested commit. If the translation fails, then the original commit and range are used as the
-// commit and range of the adjusted location.
+// commit and range of the adjusted location. If approximate locations are enabled, then a location that
+// has been edited between the two commits is instead moved to the nearest surviving line and the adjusted
+// location is marked as approximate.
 func (r *queryResolver) adjustLocation(ctx context.Context, dump store.Dump, location lsifstore.Location) (AdjustedLocation, error) {
-	adjustedCommit, adjustedRange, _, err := r.adjustRange(ctx, dump.RepositoryID, dump.Commit, dump.Root+location.Path, location.Range)
+	var (
+		adjustedCommit string
+		adjustedRange  lsifstore.Range
+		approximate    bool
+		err            error
+	)
+	if r.approximateLocations {
+		adjustedCommit, adjustedRange, approximate, err = r.adjustRangeApproximate(ctx, dump.RepositoryID, dump.Commit, dump.Root+location.Path, location.Range)
+	} else {
+		adjustedCommit, adjustedRange, _, err = r.adjustRange(ctx, dump.RepositoryID, dump.Commit, dump.Root+location.Path, location.Range)
+	}
 	if err != nil {
 		return AdjustedLocation{}, err
 	}
@@ -184,9 +196,32 @@ func (r *queryResolver) adjustLocation(ctx context.Context, dump store.Dump, loc
 		Path:           dump.Root + location.Path,
 		AdjustedCommit: adjustedCommit,
 		AdjustedRange:  adjustedRange,
+		Approximate:    approximate,
 	}, nil
 }
 
+// adjustRangeApproximate translates a range (relative to the indexed commit) into an equivalent range in
+// the requested commit. Unlike adjustRange, the translation does not fail when the range has been edited
+// between the two commits; the returned flag instead indicates that the adjusted range is approximate.
+// If the range cannot be translated at all, then the original commit and range are returned.
+func (r *queryResolver) adjustRangeApproximate(ctx context.Context, repositoryID int, commit, path string, rn lsifstore.Range) (string, lsifstore.Range, bool, error) {
+	if repositoryID != r.repositoryID {
+		// No diffs between distinct repositories
+		return commit, rn, false, nil
+	}
+
+	_, adjustedRange, approximate, ok, err := r.positionAdjuster.AdjustRangeApproximate(ctx, commit, path, rn, true)
+	if err != nil {
+		return "", lsifstore.Range{}, false, errors.Wrap(err, "positionAdjuster.AdjustRangeApproximate")
+	}
+	if !ok {
+		// Couldn't translate range, return original commit and range
+		return commit, rn, false, nil
+	}
+
+	return r.commit, adjustedRange, approximate, nil
+}
+
 // adjustRange translates a range (relative to the indexed commit) into an equivalent range in the requested
 // commit. If the translation fails, then the original commit and range are returned along with a false-valued
 // flag.
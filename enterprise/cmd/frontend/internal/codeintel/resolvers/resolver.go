@@ -26,12 +26,15 @@ type Resolver interface {
 	GetUploadByID(ctx context.Context, id int) (store.Upload, bool, error)
 	GetUploadsByIDs(ctx context.Context, ids ...int) ([]store.Upload, error)
 	DeleteUploadByID(ctx context.Context, uploadID int) error
+	UploadValidationFindings(ctx context.Context, uploadID int) ([]store.UploadValidationFinding, error)
 
 	GetIndexByID(ctx context.Context, id int) (store.Index, bool, error)
 	GetIndexesByIDs(ctx context.Context, ids ...int) ([]store.Index, error)
 	DeleteIndexByID(ctx context.Context, id int) error
 
 	GetConfigurationPolicies(ctx context.Context, opts store.GetConfigurationPoliciesOptions) ([]store.ConfigurationPolicy, int, error)
+	GetPackageDependents(ctx context.Context, opts store.DependencyGraphOptions) ([]store.PackageDependent, int, error)
+	GetPackageDependencies(ctx context.Context, opts store.DependencyGraphOptions) ([]precise.Package, int, error)
 	GetConfigurationPolicyByID(ctx context.Context, id int) (store.ConfigurationPolicy, bool, error)
 	CreateConfigurationPolicy(ctx context.Context, configurationPolicy store.ConfigurationPolicy) (store.ConfigurationPolicy, error)
 	UpdateConfigurationPolicy(ctx context.Context, policy store.ConfigurationPolicy) (err error)
@@ -45,21 +48,30 @@ type Resolver interface {
 	QueueAutoIndexJobsForRepo(ctx context.Context, repositoryID int, rev, configuration string) ([]store.Index, error)
 	PreviewRepositoryFilter(ctx context.Context, patterns []string, limit, offset int) (_ []int, totalCount int, repositoryMatchLimit *int, _ error)
 	PreviewGitObjectFilter(ctx context.Context, repositoryID int, gitObjectType dbstore.GitObjectType, pattern string) (map[string][]string, error)
+	PreviewRetention(ctx context.Context, repositoryID int, policy store.ConfigurationPolicy, limit, offset int) ([]RetentionPreview, int, error)
+	PreviewIndexing(ctx context.Context, repositoryID int, policy store.ConfigurationPolicy) (map[string][]string, error)
 	DocumentationSearch(ctx context.Context, query string, repos []string) ([]precise.DocumentationSearchResult, error)
 
 	UploadConnectionResolver(opts store.GetUploadsOptions) *UploadsResolver
 	IndexConnectionResolver(opts store.GetIndexesOptions) *IndexesResolver
 	QueryResolver(ctx context.Context, args *gql.GitBlobLSIFDataArgs) (QueryResolver, error)
+	SearchBasedQueryResolver(args *gql.GitBlobLSIFDataArgs) SearchBasedQueryResolver
 }
 
 type resolver struct {
-	dbStore         DBStore
-	lsifStore       LSIFStore
-	gitserverClient GitserverClient
-	policyMatcher   *policies.Matcher
-	indexEnqueuer   IndexEnqueuer
-	hunkCache       HunkCache
-	operations      *operations
+	dbStore          DBStore
+	lsifStore        LSIFStore
+	gitserverClient  GitserverClient
+	symbolsClient    SymbolsClient
+	searcherClient   SearcherClient
+	policyMatcher    *policies.Matcher
+	retentionMatcher PolicyMatcher
+	indexingMatcher  PolicyMatcher
+	indexEnqueuer    IndexEnqueuer
+	hunkCache        HunkCache
+	operations       *operations
+
+	approximateLocations bool
 }
 
 // NewResolver creates a new resolver with the given services.
@@ -67,31 +79,47 @@ func NewResolver(
 	dbStore DBStore,
 	lsifStore LSIFStore,
 	gitserverClient GitserverClient,
+	symbolsClient SymbolsClient,
+	searcherClient SearcherClient,
 	policyMatcher *policies.Matcher,
+	retentionMatcher PolicyMatcher,
+	indexingMatcher PolicyMatcher,
 	indexEnqueuer IndexEnqueuer,
 	hunkCache HunkCache,
+	approximateLocations bool,
 	observationContext *observation.Context,
 ) Resolver {
-	return newResolver(dbStore, lsifStore, gitserverClient, policyMatcher, indexEnqueuer, hunkCache, observationContext)
+	return newResolver(dbStore, lsifStore, gitserverClient, symbolsClient, searcherClient, policyMatcher, retentionMatcher, indexingMatcher, indexEnqueuer, hunkCache, approximateLocations, observationContext)
 }
 
 func newResolver(
 	dbStore DBStore,
 	lsifStore LSIFStore,
 	gitserverClient GitserverClient,
+	symbolsClient SymbolsClient,
+	searcherClient SearcherClient,
 	policyMatcher *policies.Matcher,
+	retentionMatcher PolicyMatcher,
+	indexingMatcher PolicyMatcher,
 	indexEnqueuer IndexEnqueuer,
 	hunkCache HunkCache,
+	approximateLocations bool,
 	observationContext *observation.Context,
 ) *resolver {
 	return &resolver{
-		dbStore:         dbStore,
-		lsifStore:       lsifStore,
-		gitserverClient: gitserverClient,
-		policyMatcher:   policyMatcher,
-		indexEnqueuer:   indexEnqueuer,
-		hunkCache:       hunkCache,
-		operations:      newOperations(observationContext),
+		dbStore:          dbStore,
+		lsifStore:        lsifStore,
+		gitserverClient:  gitserverClient,
+		symbolsClient:    symbolsClient,
+		searcherClient:   searcherClient,
+		policyMatcher:    policyMatcher,
+		retentionMatcher: retentionMatcher,
+		indexingMatcher:  indexingMatcher,
+		indexEnqueuer:    indexEnqueuer,
+		hunkCache:        hunkCache,
+		operations:       newOperations(observationContext),
+
+		approximateLocations: approximateLocations,
 	}
 }
 
@@ -124,6 +152,10 @@ func (r *resolver) DeleteUploadByID(ctx context.Context, uploadID int) error {
 	return err
 }
 
+func (r *resolver) UploadValidationFindings(ctx context.Context, uploadID int) ([]store.UploadValidationFinding, error) {
+	return r.dbStore.GetUploadValidationFindings(ctx, uploadID)
+}
+
 func (r *resolver) DeleteIndexByID(ctx context.Context, id int) error {
 	_, err := r.dbStore.DeleteIndexByID(ctx, id)
 	return err
@@ -175,23 +207,48 @@ func (r *resolver) QueryResolver(ctx context.Context, args *gql.GitBlobLSIFDataA
 		return nil, err
 	}
 
-	return NewQueryResolver(
+	queryResolver := newQueryResolver(
 		r.dbStore,
 		r.lsifStore,
 		cachedCommitChecker,
-		NewPositionAdjuster(args.Repo, string(args.Commit), r.hunkCache),
+		NewPositionAdjuster(r.gitserverClient, args.Repo, string(args.Commit), r.hunkCache),
 		int(args.Repo.ID),
 		string(args.Commit),
 		args.Path,
 		dumps,
 		r.operations,
-	), nil
+	)
+	queryResolver.approximateLocations = r.approximateLocations
+
+	return queryResolver, nil
+}
+
+// SearchBasedQueryResolver constructs a new query resolver that answers code navigation queries for
+// the given repository, commit, and path using search-based heuristics.
+func (r *resolver) SearchBasedQueryResolver(args *gql.GitBlobLSIFDataArgs) SearchBasedQueryResolver {
+	return NewSearchBasedQueryResolver(
+		r.gitserverClient,
+		r.symbolsClient,
+		r.searcherClient,
+		args.Repo,
+		string(args.Commit),
+		args.Path,
+		r.operations,
+	)
 }
 
 func (r *resolver) GetConfigurationPolicies(ctx context.Context, opts store.GetConfigurationPoliciesOptions) ([]store.ConfigurationPolicy, int, error) {
 	return r.dbStore.GetConfigurationPolicies(ctx, opts)
 }
 
+func (r *resolver) GetPackageDependents(ctx context.Context, opts store.DependencyGraphOptions) ([]store.PackageDependent, int, error) {
+	return r.dbStore.GetPackageDependents(ctx, opts)
+}
+
+func (r *resolver) GetPackageDependencies(ctx context.Context, opts store.DependencyGraphOptions) ([]precise.Package, int, error) {
+	return r.dbStore.GetPackageDependencies(ctx, opts)
+}
+
 func (r *resolver) GetConfigurationPolicyByID(ctx context.Context, id int) (store.ConfigurationPolicy, bool, error) {
 	return r.dbStore.GetConfigurationPolicyByID(ctx, id)
 }
@@ -0,0 +1,301 @@
+package resolvers
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/searcher/protocol"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// The confidence levels of search-based locations, from most to least likely to refer to the
+// same symbol as the requested position.
+const (
+	SearchBasedConfidenceHigh   = "HIGH"
+	SearchBasedConfidenceMedium = "MEDIUM"
+	SearchBasedConfidenceLow    = "LOW"
+)
+
+// SearchBasedDefinitionsLimit is the maximum number of locations returned from search-based definitions.
+const SearchBasedDefinitionsLimit = 100
+
+// SearchBasedLocation is a path and range pair found by searching the requested repository and commit
+// for the identifier at a requested position. Search-based locations are not backed by an index, so
+// each location carries the confidence that it refers to the same symbol as the requested position.
+type SearchBasedLocation struct {
+	Path       string
+	Range      lsifstore.Range
+	Confidence string
+}
+
+// SearchBasedQueryResolver answers code navigation queries for a single path-at-revision by searching
+// for the identifier under the requested position. This provides best-effort navigation when no LSIF
+// upload can answer queries for the path. Definitions are found via the symbols service, and references
+// via a case-sensitive word-boundary search over the repository.
+type SearchBasedQueryResolver interface {
+	Definitions(ctx context.Context, line, character int) ([]SearchBasedLocation, error)
+	References(ctx context.Context, line, character, limit int) (_ []SearchBasedLocation, limitHit bool, _ error)
+}
+
+type searchBasedQueryResolver struct {
+	gitserverClient GitserverClient
+	symbolsClient   SymbolsClient
+	searcherClient  SearcherClient
+	repo            *types.Repo
+	commit          string
+	path            string
+	operations      *operations
+}
+
+// NewSearchBasedQueryResolver creates a new SearchBasedQueryResolver for the given repository, commit,
+// and path.
+func NewSearchBasedQueryResolver(
+	gitserverClient GitserverClient,
+	symbolsClient SymbolsClient,
+	searcherClient SearcherClient,
+	repo *types.Repo,
+	commit string,
+	path string,
+	operations *operations,
+) SearchBasedQueryResolver {
+	return &searchBasedQueryResolver{
+		gitserverClient: gitserverClient,
+		symbolsClient:   symbolsClient,
+		searcherClient:  searcherClient,
+		repo:            repo,
+		commit:          commit,
+		path:            path,
+		operations:      operations,
+	}
+}
+
+const slowSearchBasedRequestThreshold = 5 * time.Second
+
+// Definitions returns the symbols named by the identifier at the given position.
+func (r *searchBasedQueryResolver) Definitions(ctx context.Context, line, character int) (_ []SearchBasedLocation, err error) {
+	ctx, traceLog, endObservation := observeResolver(ctx, &err, "SearchBasedDefinitions", r.operations.searchBasedDefinitions, slowSearchBasedRequestThreshold, observation.Args{
+		LogFields: []log.Field{
+			log.Int("repositoryID", int(r.repo.ID)),
+			log.String("commit", r.commit),
+			log.String("path", r.path),
+			log.Int("line", line),
+			log.Int("character", character),
+		},
+	})
+	defer endObservation()
+
+	identifier, err := r.identifierAt(ctx, line, character)
+	if err != nil || identifier == "" {
+		return nil, err
+	}
+	traceLog(log.String("identifier", identifier))
+
+	symbols, err := r.symbols(ctx, identifier)
+	if err != nil {
+		return nil, err
+	}
+	traceLog(log.Int("numSymbols", len(symbols)))
+
+	locations := make([]SearchBasedLocation, 0, len(symbols))
+	for _, symbol := range symbols {
+		symbolRange := symbol.Range()
+
+		locations = append(locations, SearchBasedLocation{
+			Path: symbol.Path,
+			Range: lsifstore.Range{
+				Start: lsifstore.Position{Line: symbolRange.Start.Line, Character: symbolRange.Start.Character},
+				End:   lsifstore.Position{Line: symbolRange.End.Line, Character: symbolRange.End.Character},
+			},
+			Confidence: searchBasedConfidence(r.path, symbol.Path, len(symbols) > 1),
+		})
+	}
+	sortSearchBasedLocations(locations)
+
+	return locations, nil
+}
+
+// References returns the occurrences of the identifier at the given position as a whole word. At most
+// limit locations are returned. The returned flag indicates whether the limit prevented locations from
+// being returned.
+func (r *searchBasedQueryResolver) References(ctx context.Context, line, character, limit int) (_ []SearchBasedLocation, limitHit bool, err error) {
+	ctx, traceLog, endObservation := observeResolver(ctx, &err, "SearchBasedReferences", r.operations.searchBasedReferences, slowSearchBasedRequestThreshold, observation.Args{
+		LogFields: []log.Field{
+			log.Int("repositoryID", int(r.repo.ID)),
+			log.String("commit", r.commit),
+			log.String("path", r.path),
+			log.Int("line", line),
+			log.Int("character", character),
+			log.Int("limit", limit),
+		},
+	})
+	defer endObservation()
+
+	identifier, err := r.identifierAt(ctx, line, character)
+	if err != nil || identifier == "" {
+		return nil, false, err
+	}
+	traceLog(log.String("identifier", identifier))
+
+	// Determine if the identifier is ambiguous within the repository. Occurrences of an identifier
+	// that names multiple symbols are less likely to refer to the requested symbol.
+	symbols, err := r.symbols(ctx, identifier)
+	if err != nil {
+		return nil, false, err
+	}
+	ambiguous := len(symbols) > 1
+
+	var fileMatches []*protocol.FileMatch
+	searchLimitHit, err := r.searcherClient.Search(ctx, r.repo.Name, r.repo.ID, api.CommitID(r.commit), &search.TextPatternInfo{
+		Pattern:               identifier,
+		IsWordMatch:           true,
+		IsCaseSensitive:       true,
+		FileMatchLimit:        int32(limit),
+		PatternMatchesContent: true,
+	}, func(matches []*protocol.FileMatch) {
+		fileMatches = append(fileMatches, matches...)
+	})
+	if err != nil {
+		return nil, false, errors.Wrap(err, "searcher.Search")
+	}
+	traceLog(log.Int("numFileMatches", len(fileMatches)))
+
+	var locations []SearchBasedLocation
+	for _, fileMatch := range fileMatches {
+		for _, lineMatch := range fileMatch.LineMatches {
+			for _, offsetAndLength := range lineMatch.OffsetAndLengths {
+				locations = append(locations, SearchBasedLocation{
+					Path: fileMatch.Path,
+					Range: lsifstore.Range{
+						Start: lsifstore.Position{Line: lineMatch.LineNumber, Character: offsetAndLength[0]},
+						End:   lsifstore.Position{Line: lineMatch.LineNumber, Character: offsetAndLength[0] + offsetAndLength[1]},
+					},
+					Confidence: searchBasedConfidence(r.path, fileMatch.Path, ambiguous),
+				})
+			}
+		}
+	}
+	sortSearchBasedLocations(locations)
+
+	if len(locations) > limit {
+		locations = locations[:limit]
+		searchLimitHit = true
+	}
+
+	return locations, searchLimitHit, nil
+}
+
+// symbols returns the symbols of the target repository and commit with exactly the given name.
+func (r *searchBasedQueryResolver) symbols(ctx context.Context, name string) ([]result.Symbol, error) {
+	symbols, err := r.symbolsClient.Search(ctx, search.SymbolsParameters{
+		Repo:            r.repo.Name,
+		CommitID:        api.CommitID(r.commit),
+		Query:           "^" + regexp.QuoteMeta(name) + "$",
+		IsRegExp:        true,
+		IsCaseSensitive: true,
+		First:           SearchBasedDefinitionsLimit,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "symbols.Search")
+	}
+	if symbols == nil {
+		return nil, nil
+	}
+
+	return *symbols, nil
+}
+
+// identifierAt returns the identifier enclosing the given position of the target file. An empty
+// string is returned if the position does not fall on an identifier.
+func (r *searchBasedQueryResolver) identifierAt(ctx context.Context, line, character int) (string, error) {
+	contents, err := r.gitserverClient.RawContents(ctx, int(r.repo.ID), r.commit, r.path)
+	if err != nil {
+		return "", errors.Wrap(err, "gitserver.RawContents")
+	}
+
+	lines := bytes.Split(contents, []byte("\n"))
+	if line < 0 || line >= len(lines) {
+		return "", nil
+	}
+
+	return identifierAt([]rune(string(lines[line])), character), nil
+}
+
+// identifierAt returns the identifier of the given line that encloses or directly precedes the
+// given (zero-indexed) character.
+func identifierAt(line []rune, character int) string {
+	if character < 0 || character > len(line) {
+		return ""
+	}
+
+	start := character
+	for start > 0 && isIdentifierRune(line[start-1]) {
+		start--
+	}
+	end := character
+	for end < len(line) && isIdentifierRune(line[end]) {
+		end++
+	}
+
+	identifier := string(line[start:end])
+	if first, _ := utf8.DecodeRuneInString(identifier); identifier == "" || unicode.IsDigit(first) {
+		return ""
+	}
+
+	return identifier
+}
+
+func isIdentifierRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// searchBasedConfidence returns the confidence that a location in the given path refers to the same
+// symbol as the identifier in the requested path. Locations in the requested file are most likely to
+// refer to the same symbol, followed by locations in files with the same extension (and likely in the
+// same language). If the identifier names multiple symbols, no location is of high confidence.
+func searchBasedConfidence(requestedPath, path string, ambiguous bool) string {
+	switch {
+	case path == requestedPath && !ambiguous:
+		return SearchBasedConfidenceHigh
+	case path == requestedPath || filepath.Ext(path) == filepath.Ext(requestedPath):
+		return SearchBasedConfidenceMedium
+	default:
+		return SearchBasedConfidenceLow
+	}
+}
+
+var searchBasedConfidenceRanks = map[string]int{
+	SearchBasedConfidenceHigh:   0,
+	SearchBasedConfidenceMedium: 1,
+	SearchBasedConfidenceLow:    2,
+}
+
+// sortSearchBasedLocations sorts the given locations by descending confidence, then by path and range.
+func sortSearchBasedLocations(locations []SearchBasedLocation) {
+	sort.SliceStable(locations, func(i, j int) bool {
+		if ri, rj := searchBasedConfidenceRanks[locations[i].Confidence], searchBasedConfidenceRanks[locations[j].Confidence]; ri != rj {
+			return ri < rj
+		}
+		if locations[i].Path != locations[j].Path {
+			return locations[i].Path < locations[j].Path
+		}
+		if locations[i].Range.Start.Line != locations[j].Range.Start.Line {
+			return locations[i].Range.Start.Line < locations[j].Range.Start.Line
+		}
+
+		return locations[i].Range.Start.Character < locations[j].Range.Start.Character
+	})
+}
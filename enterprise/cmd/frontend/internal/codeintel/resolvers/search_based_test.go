@@ -0,0 +1,212 @@
+package resolvers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/cmd/searcher/protocol"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/lsifstore"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+const testSearchBasedContents = `package main
+
+func main() {
+	fmt.Println(greeting)
+}
+`
+
+func TestSearchBasedDefinitions(t *testing.T) {
+	mockGitserverClient := NewMockGitserverClient()
+	mockGitserverClient.RawContentsFunc.SetDefaultReturn([]byte(testSearchBasedContents), nil)
+	mockSymbolsClient := NewMockSymbolsClient()
+	mockSymbolsClient.SearchFunc.SetDefaultReturn(&[]result.Symbol{
+		{Name: "greeting", Path: "strings.go", Line: 8},
+		{Name: "greeting", Path: "main.go", Line: 12},
+	}, nil)
+
+	resolver := NewSearchBasedQueryResolver(
+		mockGitserverClient,
+		mockSymbolsClient,
+		NewMockSearcherClient(),
+		&types.Repo{ID: 42, Name: "github.com/test/test"},
+		"deadbeef",
+		"main.go",
+		newOperations(&observation.TestContext),
+	)
+	locations, err := resolver.Definitions(context.Background(), 3, 15)
+	if err != nil {
+		t.Fatalf("unexpected error querying definitions: %s", err)
+	}
+
+	expectedLocations := []SearchBasedLocation{
+		{Path: "main.go", Range: testSearchBasedRange(11, 0, 8), Confidence: SearchBasedConfidenceMedium},
+		{Path: "strings.go", Range: testSearchBasedRange(7, 0, 8), Confidence: SearchBasedConfidenceMedium},
+	}
+	if diff := cmp.Diff(expectedLocations, locations); diff != "" {
+		t.Errorf("unexpected locations (-want +got):\n%s", diff)
+	}
+
+	if history := mockSymbolsClient.SearchFunc.History(); len(history) != 1 {
+		t.Errorf("unexpected number of symbol requests. want=%d have=%d", 1, len(history))
+	} else {
+		expectedArgs := search.SymbolsParameters{
+			Repo:            "github.com/test/test",
+			CommitID:        "deadbeef",
+			Query:           "^greeting$",
+			IsRegExp:        true,
+			IsCaseSensitive: true,
+			First:           SearchBasedDefinitionsLimit,
+		}
+		if diff := cmp.Diff(expectedArgs, history[0].Arg1); diff != "" {
+			t.Errorf("unexpected symbols parameters (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestSearchBasedDefinitionsNoIdentifier(t *testing.T) {
+	mockGitserverClient := NewMockGitserverClient()
+	mockGitserverClient.RawContentsFunc.SetDefaultReturn([]byte(testSearchBasedContents), nil)
+	mockSymbolsClient := NewMockSymbolsClient()
+
+	resolver := NewSearchBasedQueryResolver(
+		mockGitserverClient,
+		mockSymbolsClient,
+		NewMockSearcherClient(),
+		&types.Repo{ID: 42, Name: "github.com/test/test"},
+		"deadbeef",
+		"main.go",
+		newOperations(&observation.TestContext),
+	)
+	locations, err := resolver.Definitions(context.Background(), 1, 0)
+	if err != nil {
+		t.Fatalf("unexpected error querying definitions: %s", err)
+	}
+	if len(locations) != 0 {
+		t.Errorf("unexpected locations. want=%d have=%d", 0, len(locations))
+	}
+
+	if history := mockSymbolsClient.SearchFunc.History(); len(history) != 0 {
+		t.Errorf("unexpected number of symbol requests. want=%d have=%d", 0, len(history))
+	}
+}
+
+func TestSearchBasedReferences(t *testing.T) {
+	mockGitserverClient := NewMockGitserverClient()
+	mockGitserverClient.RawContentsFunc.SetDefaultReturn([]byte(testSearchBasedContents), nil)
+	mockSymbolsClient := NewMockSymbolsClient()
+	mockSymbolsClient.SearchFunc.SetDefaultReturn(&[]result.Symbol{
+		{Name: "greeting", Path: "main.go", Line: 12},
+	}, nil)
+	mockSearcherClient := NewMockSearcherClient()
+	mockSearcherClient.SearchFunc.SetDefaultHook(func(ctx context.Context, repo api.RepoName, repoID api.RepoID, commit api.CommitID, p *search.TextPatternInfo, onMatches func([]*protocol.FileMatch)) (bool, error) {
+		onMatches([]*protocol.FileMatch{
+			{Path: "README.md", LineMatches: []protocol.LineMatch{{LineNumber: 2, OffsetAndLengths: [][2]int{{4, 8}}}}},
+			{Path: "main.go", LineMatches: []protocol.LineMatch{
+				{LineNumber: 3, OffsetAndLengths: [][2]int{{13, 8}}},
+				{LineNumber: 11, OffsetAndLengths: [][2]int{{6, 8}}},
+			}},
+			{Path: "util.go", LineMatches: []protocol.LineMatch{{LineNumber: 5, OffsetAndLengths: [][2]int{{1, 8}, {20, 8}}}}},
+		})
+		return false, nil
+	})
+
+	resolver := NewSearchBasedQueryResolver(
+		mockGitserverClient,
+		mockSymbolsClient,
+		mockSearcherClient,
+		&types.Repo{ID: 42, Name: "github.com/test/test"},
+		"deadbeef",
+		"main.go",
+		newOperations(&observation.TestContext),
+	)
+	locations, limitHit, err := resolver.References(context.Background(), 3, 14, 4)
+	if err != nil {
+		t.Fatalf("unexpected error querying references: %s", err)
+	}
+	if !limitHit {
+		t.Errorf("expected limit to be hit")
+	}
+
+	expectedLocations := []SearchBasedLocation{
+		{Path: "main.go", Range: testSearchBasedRange(3, 13, 21), Confidence: SearchBasedConfidenceHigh},
+		{Path: "main.go", Range: testSearchBasedRange(11, 6, 14), Confidence: SearchBasedConfidenceHigh},
+		{Path: "util.go", Range: testSearchBasedRange(5, 1, 9), Confidence: SearchBasedConfidenceMedium},
+		{Path: "util.go", Range: testSearchBasedRange(5, 20, 28), Confidence: SearchBasedConfidenceMedium},
+	}
+	if diff := cmp.Diff(expectedLocations, locations); diff != "" {
+		t.Errorf("unexpected locations (-want +got):\n%s", diff)
+	}
+
+	if history := mockSearcherClient.SearchFunc.History(); len(history) != 1 {
+		t.Errorf("unexpected number of searcher requests. want=%d have=%d", 1, len(history))
+	} else {
+		expectedPatternInfo := &search.TextPatternInfo{
+			Pattern:               "greeting",
+			IsWordMatch:           true,
+			IsCaseSensitive:       true,
+			FileMatchLimit:        4,
+			PatternMatchesContent: true,
+		}
+		if diff := cmp.Diff(expectedPatternInfo, history[0].Arg4); diff != "" {
+			t.Errorf("unexpected pattern info (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestIdentifierAt(t *testing.T) {
+	testCases := []struct {
+		line      string
+		character int
+		expected  string
+	}{
+		{line: "\tfmt.Println(greeting)", character: 13, expected: "greeting"},
+		{line: "\tfmt.Println(greeting)", character: 16, expected: "greeting"},
+		{line: "\tfmt.Println(greeting)", character: 21, expected: "greeting"},
+		{line: "\tfmt.Println(greeting)", character: 1, expected: "fmt"},
+		{line: "\tfmt.Println(greeting)", character: 0, expected: ""},
+		{line: "\tfmt.Println(greeting)", character: 22, expected: ""},
+		{line: "x := 1234", character: 6, expected: ""},
+		{line: "grüße := snake_case", character: 2, expected: "grüße"},
+		{line: "grüße := snake_case", character: 14, expected: "snake_case"},
+	}
+
+	for _, testCase := range testCases {
+		if identifier := identifierAt([]rune(testCase.line), testCase.character); identifier != testCase.expected {
+			t.Errorf("unexpected identifier for %q at %d. want=%q have=%q", testCase.line, testCase.character, testCase.expected, identifier)
+		}
+	}
+}
+
+func TestSearchBasedConfidence(t *testing.T) {
+	testCases := []struct {
+		path      string
+		ambiguous bool
+		expected  string
+	}{
+		{path: "cmd/main.go", ambiguous: false, expected: SearchBasedConfidenceHigh},
+		{path: "cmd/main.go", ambiguous: true, expected: SearchBasedConfidenceMedium},
+		{path: "internal/util.go", ambiguous: false, expected: SearchBasedConfidenceMedium},
+		{path: "internal/util.go", ambiguous: true, expected: SearchBasedConfidenceMedium},
+		{path: "web/main.ts", ambiguous: false, expected: SearchBasedConfidenceLow},
+	}
+
+	for _, testCase := range testCases {
+		if confidence := searchBasedConfidence("cmd/main.go", testCase.path, testCase.ambiguous); confidence != testCase.expected {
+			t.Errorf("unexpected confidence for %q (ambiguous=%v). want=%s have=%s", testCase.path, testCase.ambiguous, testCase.expected, confidence)
+		}
+	}
+}
+
+func testSearchBasedRange(line, startCharacter, endCharacter int) lsifstore.Range {
+	return lsifstore.Range{
+		Start: lsifstore.Position{Line: line, Character: startCharacter},
+		End:   lsifstore.Position{Line: line, Character: endCharacter},
+	}
+}
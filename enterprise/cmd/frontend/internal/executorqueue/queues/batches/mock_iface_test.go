@@ -29,6 +29,10 @@ type MockBatchesStore struct {
 	// function object controlling the behavior of the method
 	// ListBatchSpecExecutionCacheEntries.
 	ListBatchSpecExecutionCacheEntriesFunc *BatchesStoreListBatchSpecExecutionCacheEntriesFunc
+	// MarkUsedBatchSpecExecutionCacheEntriesFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// MarkUsedBatchSpecExecutionCacheEntries.
+	MarkUsedBatchSpecExecutionCacheEntriesFunc *BatchesStoreMarkUsedBatchSpecExecutionCacheEntriesFunc
 	// SetBatchSpecWorkspaceExecutionJobAccessTokenFunc is an instance of a
 	// mock function object controlling the behavior of the method
 	// SetBatchSpecWorkspaceExecutionJobAccessToken.
@@ -59,6 +63,11 @@ func NewMockBatchesStore() *MockBatchesStore {
 				return nil, nil
 			},
 		},
+		MarkUsedBatchSpecExecutionCacheEntriesFunc: &BatchesStoreMarkUsedBatchSpecExecutionCacheEntriesFunc{
+			defaultHook: func(context.Context, []int64) error {
+				return nil
+			},
+		},
 		SetBatchSpecWorkspaceExecutionJobAccessTokenFunc: &BatchesStoreSetBatchSpecWorkspaceExecutionJobAccessTokenFunc{
 			defaultHook: func(context.Context, int64, int64) error {
 				return nil
@@ -91,6 +100,11 @@ func NewStrictMockBatchesStore() *MockBatchesStore {
 				panic("unexpected invocation of MockBatchesStore.ListBatchSpecExecutionCacheEntries")
 			},
 		},
+		MarkUsedBatchSpecExecutionCacheEntriesFunc: &BatchesStoreMarkUsedBatchSpecExecutionCacheEntriesFunc{
+			defaultHook: func(context.Context, []int64) error {
+				panic("unexpected invocation of MockBatchesStore.MarkUsedBatchSpecExecutionCacheEntries")
+			},
+		},
 		SetBatchSpecWorkspaceExecutionJobAccessTokenFunc: &BatchesStoreSetBatchSpecWorkspaceExecutionJobAccessTokenFunc{
 			defaultHook: func(context.Context, int64, int64) error {
 				panic("unexpected invocation of MockBatchesStore.SetBatchSpecWorkspaceExecutionJobAccessToken")
@@ -116,6 +130,9 @@ func NewMockBatchesStoreFrom(i BatchesStore) *MockBatchesStore {
 		ListBatchSpecExecutionCacheEntriesFunc: &BatchesStoreListBatchSpecExecutionCacheEntriesFunc{
 			defaultHook: i.ListBatchSpecExecutionCacheEntries,
 		},
+		MarkUsedBatchSpecExecutionCacheEntriesFunc: &BatchesStoreMarkUsedBatchSpecExecutionCacheEntriesFunc{
+			defaultHook: i.MarkUsedBatchSpecExecutionCacheEntries,
+		},
 		SetBatchSpecWorkspaceExecutionJobAccessTokenFunc: &BatchesStoreSetBatchSpecWorkspaceExecutionJobAccessTokenFunc{
 			defaultHook: i.SetBatchSpecWorkspaceExecutionJobAccessToken,
 		},
@@ -556,6 +573,118 @@ func (c BatchesStoreListBatchSpecExecutionCacheEntriesFuncCall) Results() []inte
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// BatchesStoreMarkUsedBatchSpecExecutionCacheEntriesFunc describes the
+// behavior when the MarkUsedBatchSpecExecutionCacheEntries method of the
+// parent MockBatchesStore instance is invoked.
+type BatchesStoreMarkUsedBatchSpecExecutionCacheEntriesFunc struct {
+	defaultHook func(context.Context, []int64) error
+	hooks       []func(context.Context, []int64) error
+	history     []BatchesStoreMarkUsedBatchSpecExecutionCacheEntriesFuncCall
+	mutex       sync.Mutex
+}
+
+// MarkUsedBatchSpecExecutionCacheEntries delegates to the next hook
+// function in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockBatchesStore) MarkUsedBatchSpecExecutionCacheEntries(v0 context.Context, v1 []int64) error {
+	r0 := m.MarkUsedBatchSpecExecutionCacheEntriesFunc.nextHook()(v0, v1)
+	m.MarkUsedBatchSpecExecutionCacheEntriesFunc.appendCall(BatchesStoreMarkUsedBatchSpecExecutionCacheEntriesFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// MarkUsedBatchSpecExecutionCacheEntries method of the parent
+// MockBatchesStore instance is invoked and the hook queue is empty.
+func (f *BatchesStoreMarkUsedBatchSpecExecutionCacheEntriesFunc) SetDefaultHook(hook func(context.Context, []int64) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// MarkUsedBatchSpecExecutionCacheEntries method of the parent
+// MockBatchesStore instance invokes the hook at the front of the queue and
+// discards it. After the queue is empty, the default hook function is
+// invoked for any future action.
+func (f *BatchesStoreMarkUsedBatchSpecExecutionCacheEntriesFunc) PushHook(hook func(context.Context, []int64) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *BatchesStoreMarkUsedBatchSpecExecutionCacheEntriesFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, []int64) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *BatchesStoreMarkUsedBatchSpecExecutionCacheEntriesFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, []int64) error {
+		return r0
+	})
+}
+
+func (f *BatchesStoreMarkUsedBatchSpecExecutionCacheEntriesFunc) nextHook() func(context.Context, []int64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *BatchesStoreMarkUsedBatchSpecExecutionCacheEntriesFunc) appendCall(r0 BatchesStoreMarkUsedBatchSpecExecutionCacheEntriesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// BatchesStoreMarkUsedBatchSpecExecutionCacheEntriesFuncCall objects
+// describing the invocations of this function.
+func (f *BatchesStoreMarkUsedBatchSpecExecutionCacheEntriesFunc) History() []BatchesStoreMarkUsedBatchSpecExecutionCacheEntriesFuncCall {
+	f.mutex.Lock()
+	history := make([]BatchesStoreMarkUsedBatchSpecExecutionCacheEntriesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// BatchesStoreMarkUsedBatchSpecExecutionCacheEntriesFuncCall is an object
+// that describes an invocation of method
+// MarkUsedBatchSpecExecutionCacheEntries on an instance of
+// MockBatchesStore.
+type BatchesStoreMarkUsedBatchSpecExecutionCacheEntriesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 []int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c BatchesStoreMarkUsedBatchSpecExecutionCacheEntriesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c BatchesStoreMarkUsedBatchSpecExecutionCacheEntriesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // BatchesStoreSetBatchSpecWorkspaceExecutionJobAccessTokenFunc describes
 // the behavior when the SetBatchSpecWorkspaceExecutionJobAccessToken method
 // of the parent MockBatchesStore instance is invoked.
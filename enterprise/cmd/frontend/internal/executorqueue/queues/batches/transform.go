@@ -25,6 +25,7 @@ type BatchesStore interface {
 	GetBatchSpec(context.Context, store.GetBatchSpecOpts) (*btypes.BatchSpec, error)
 	SetBatchSpecWorkspaceExecutionJobAccessToken(ctx context.Context, jobID, tokenID int64) error
 	ListBatchSpecExecutionCacheEntries(ctx context.Context, opts store.ListBatchSpecExecutionCacheEntriesOpts) ([]*btypes.BatchSpecExecutionCacheEntry, error)
+	MarkUsedBatchSpecExecutionCacheEntries(ctx context.Context, ids []int64) error
 
 	DatabaseDB() database.DB
 }
@@ -104,7 +105,9 @@ func transformRecord(ctx context.Context, s BatchesStore, job *btypes.BatchSpecW
 
 	if !batchSpec.NoCache {
 		// We start at the back so that we can find the _last_ cached step,
-		// then restart execution on the following step.
+		// then restart execution on the following step. Since step cache keys
+		// only depend on the steps up to and including that step, this also
+		// finds the unchanged prefix of steps after the spec has been edited.
 		taskKey := cache.KeyForWorkspace(
 			&template.BatchChangeAttributes{
 				Name:        batchSpec.Spec.Name,
@@ -139,6 +142,11 @@ func transformRecord(ctx context.Context, s BatchesStore, job *btypes.BatchSpecW
 				continue
 			}
 
+			// Mark the entry as used, so it isn't evicted from the cache.
+			if err := s.MarkUsedBatchSpecExecutionCacheEntries(ctx, []int64{entries[0].ID}); err != nil {
+				return apiclient.Job{}, err
+			}
+
 			// Add file to virtualMachineFiles.
 			files[rawKey+`.json`] = entries[0].Value
 			// And break after. src-cli only needs the most recent cache entry.
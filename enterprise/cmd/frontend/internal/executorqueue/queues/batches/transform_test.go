@@ -65,7 +65,7 @@ func TestTransformRecord(t *testing.T) {
 		BatchSpecWorkspaceID: workspace.ID,
 	}
 
-	entry := &btypes.BatchSpecExecutionCacheEntry{Value: "cachevalue"}
+	entry := &btypes.BatchSpecExecutionCacheEntry{ID: 1, Value: "cachevalue"}
 
 	store := NewMockBatchesStore()
 	store.GetBatchSpecFunc.SetDefaultReturn(batchSpec, nil)
@@ -111,7 +111,7 @@ func TestTransformRecord(t *testing.T) {
 			ID: int(workspaceExecutionJob.ID),
 			VirtualMachineFiles: map[string]string{
 				"input.json":                         string(marshaledInput),
-				"eKWXvCxV2S3H8it3CVL-Iw-step-1.json": "cachevalue",
+				"17lF2QVLUz8P0EuoHYdl6w-step-1.json": "cachevalue",
 			},
 			CliSteps: []apiclient.CliStep{
 				{
@@ -136,6 +136,12 @@ func TestTransformRecord(t *testing.T) {
 		if storedAccessToken != accessTokenID {
 			t.Errorf("wrong access token ID set on execution job: %d", storedAccessToken)
 		}
+
+		if calls := store.MarkUsedBatchSpecExecutionCacheEntriesFunc.History(); len(calls) != 1 {
+			t.Errorf("unexpected number of calls to MarkUsedBatchSpecExecutionCacheEntries. want=%d have=%d", 1, len(calls))
+		} else if diff := cmp.Diff([]int64{entry.ID}, calls[0].Arg1); diff != "" {
+			t.Errorf("unexpected cache entries marked as used (-want +got):\n%s", diff)
+		}
 	})
 
 	t.Run("with cache disabled", func(t *testing.T) {
@@ -14,6 +14,7 @@ type Config struct {
 	WorkerPollInterval time.Duration
 	WorkerConcurrency  int
 	WorkerBudget       int64
+	ValidationMaxSize  int64
 }
 
 func (c *Config) Load() {
@@ -24,4 +25,5 @@ func (c *Config) Load() {
 	c.WorkerPollInterval = c.GetInterval("PRECISE_CODE_INTEL_WORKER_POLL_INTERVAL", "1s", "Interval between queries to the upload queue.")
 	c.WorkerConcurrency = c.GetInt("PRECISE_CODE_INTEL_WORKER_CONCURRENCY", "1", "The maximum number of indexes that can be processed concurrently.")
 	c.WorkerBudget = int64(c.GetInt("PRECISE_CODE_INTEL_WORKER_BUDGET", "0", "The amount of compressed input data (in bytes) a worker can process concurrently. Zero acts as an infinite budget."))
+	c.ValidationMaxSize = int64(c.GetInt("PRECISE_CODE_INTEL_WORKER_VALIDATION_MAX_SIZE", "10485760", "The maximum size of compressed input data (in bytes) that is validated while processing. Validated uploads count twice against the worker budget. Zero disables the limit."))
 }
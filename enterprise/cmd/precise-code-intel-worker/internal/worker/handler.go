@@ -29,14 +29,15 @@ import (
 )
 
 type handler struct {
-	dbStore         DBStore
-	workerStore     dbworkerstore.Store
-	lsifStore       LSIFStore
-	uploadStore     uploadstore.Store
-	gitserverClient GitserverClient
-	handleOp        *observation.Operation
-	budgetRemaining int64
-	enableBudget    bool
+	dbStore           DBStore
+	workerStore       dbworkerstore.Store
+	lsifStore         LSIFStore
+	uploadStore       uploadstore.Store
+	gitserverClient   GitserverClient
+	handleOp          *observation.Operation
+	budgetRemaining   int64
+	enableBudget      bool
+	validationMaxSize int64
 }
 
 var (
@@ -78,7 +79,16 @@ func (h *handler) PreDequeue(ctx context.Context) (bool, interface{}, error) {
 		return false, nil, nil
 	}
 
-	return true, []*sqlf.Query{sqlf.Sprintf("(upload_size IS NULL OR upload_size <= %s)", budgetRemaining)}, nil
+	// Uploads that are validated while they're processed take up twice their size (see getSize).
+	if h.validationMaxSize <= 0 {
+		return true, []*sqlf.Query{sqlf.Sprintf("(upload_size IS NULL OR upload_size * 2 <= %s)", budgetRemaining)}, nil
+	}
+	return true, []*sqlf.Query{sqlf.Sprintf(
+		"(upload_size IS NULL OR upload_size * 2 <= %s OR (upload_size > %s AND upload_size <= %s))",
+		budgetRemaining,
+		h.validationMaxSize,
+		budgetRemaining,
+	)}, nil
 }
 
 func (h *handler) PreHandle(ctx context.Context, record workerutil.Record) {
@@ -90,7 +100,13 @@ func (h *handler) PostHandle(ctx context.Context, record workerutil.Record) {
 }
 
 func (h *handler) getSize(record workerutil.Record) int64 {
-	if size := record.(store.Upload).UploadSize; size != nil {
+	upload := record.(store.Upload)
+	if size := upload.UploadSize; size != nil {
+		// The validator keeps its own copy of the upload's data in memory while the upload is
+		// correlated, so validated uploads count twice against the budget.
+		if h.validatesUpload(upload) {
+			return 2 * *size
+		}
 		return *size
 	}
 
@@ -126,8 +142,34 @@ func (h *handler) handle(ctx context.Context, upload store.Upload, traceLog obse
 		return directoryChildren, nil
 	}
 
+	// Record problems with the raw index while correlating it. Malformed uploads will likely fail
+	// to correlate below, so these findings are written independently of the processing result.
+	var findings []store.UploadValidationFinding
+	validated := false
+	defer func() {
+		if !validated {
+			return
+		}
+		if findingsErr := h.updateValidationFindings(ctx, upload, findings, traceLog); findingsErr != nil && err == nil {
+			err = findingsErr
+		}
+	}()
+
 	return false, withUploadData(ctx, h.uploadStore, upload.ID, traceLog, func(r io.Reader) (err error) {
+		var validation *uploadValidation
+		if h.validatesUpload(upload) {
+			validation = validateWhileReading(r)
+			r = validation
+		} else {
+			traceLog(log.Bool("skippedValidation", true))
+		}
+
 		groupedBundleData, err := conversion.Correlate(ctx, r, upload.Root, getChildren)
+		if validation != nil {
+			if findings, validated = validation.Finish(); !validated {
+				traceLog(log.Bool("skippedValidation", true))
+			}
+		}
 		if err != nil {
 			return errors.Wrap(err, "conversion.Correlate")
 		}
@@ -256,6 +298,20 @@ func withUploadData(ctx context.Context, uploadStore uploadstore.Store, id int,
 
 	traceLog(log.String("uploadFilename", uploadFilename))
 
+	if err := readUploadData(ctx, uploadStore, uploadFilename, fn); err != nil {
+		return err
+	}
+
+	if err := uploadStore.Delete(ctx, uploadFilename); err != nil {
+		log15.Warn("Failed to delete upload file", "err", err, "filename", uploadFilename)
+	}
+
+	return nil
+}
+
+// readUploadData will invoke the given function with a reader of the decompressed content of
+// the given file in the upload store.
+func readUploadData(ctx context.Context, uploadStore uploadstore.Store, uploadFilename string, fn func(r io.Reader) error) error {
 	// Pull raw uploaded data from bucket
 	rc, err := uploadStore.Get(ctx, uploadFilename)
 	if err != nil {
@@ -269,15 +325,7 @@ func withUploadData(ctx context.Context, uploadStore uploadstore.Store, id int,
 	}
 	defer rc.Close()
 
-	if err := fn(rc); err != nil {
-		return err
-	}
-
-	if err := uploadStore.Delete(ctx, uploadFilename); err != nil {
-		log15.Warn("Failed to delete upload file", "err", err, "filename", uploadFilename)
-	}
-
-	return nil
+	return fn(rc)
 }
 
 // writeData transactionally writes the given grouped bundle data into the given LSIF store.
@@ -1,9 +1,11 @@
 package worker
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"os"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -11,6 +13,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/google/go-cmp/cmp"
 	"github.com/opentracing/opentracing-go/log"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
@@ -19,6 +22,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/bloomfilter"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol/scip"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
 )
 
@@ -156,6 +160,33 @@ func TestHandle(t *testing.T) {
 	if len(mockUploadStore.DeleteFunc.History()) != 1 {
 		t.Errorf("unexpected number of Delete calls. want=%d have=%d", 1, len(mockUploadStore.DeleteFunc.History()))
 	}
+
+	// The upload is validated while it's correlated, so it's only downloaded once.
+	if len(mockUploadStore.GetFunc.History()) != 1 {
+		t.Errorf("unexpected number of Get calls. want=%d have=%d", 1, len(mockUploadStore.GetFunc.History()))
+	}
+
+	expectedFindings := []dbstore.UploadValidationFinding{
+		{Kind: "UNRESOLVED_MONIKER", Message: "export moniker 21 has no package information", LineNumbers: []int{21}},
+		{Kind: "UNRESOLVED_MONIKER", Message: "import moniker 20 has no package information", LineNumbers: []int{20}},
+	}
+	if len(mockDBStore.UpdateUploadValidationFindingsFunc.History()) != 1 {
+		t.Errorf("unexpected number of UpdateUploadValidationFindings calls. want=%d have=%d", 1, len(mockDBStore.UpdateUploadValidationFindingsFunc.History()))
+	} else if mockDBStore.UpdateUploadValidationFindingsFunc.History()[0].Arg1 != 42 {
+		t.Errorf("unexpected value for upload id. want=%d have=%d", 42, mockDBStore.UpdateUploadValidationFindingsFunc.History()[0].Arg1)
+	} else {
+		var findings []dbstore.UploadValidationFinding
+		for _, finding := range mockDBStore.UpdateUploadValidationFindingsFunc.History()[0].Arg2 {
+			if finding.Kind == "UNRESOLVED_MONIKER" {
+				findings = append(findings, finding)
+			}
+		}
+		sort.Slice(findings, func(i, j int) bool { return findings[i].Message < findings[j].Message })
+
+		if diff := cmp.Diff(expectedFindings, findings); diff != "" {
+			t.Errorf("unexpected validation findings (-want +got):\n%s", diff)
+		}
+	}
 }
 
 func TestHandleError(t *testing.T) {
@@ -216,6 +247,29 @@ func TestHandleError(t *testing.T) {
 	if len(mockUploadStore.DeleteFunc.History()) != 0 {
 		t.Errorf("unexpected number of Delete calls. want=%d have=%d", 0, len(mockUploadStore.DeleteFunc.History()))
 	}
+
+	if len(mockDBStore.UpdateUploadValidationFindingsFunc.History()) != 1 {
+		t.Errorf("unexpected number of UpdateUploadValidationFindings calls. want=%d have=%d", 1, len(mockDBStore.UpdateUploadValidationFindingsFunc.History()))
+	}
+}
+
+func TestGetSize(t *testing.T) {
+	size := func(n int64) *int64 { return &n }
+	handler := &handler{validationMaxSize: 100}
+
+	for _, tc := range []struct {
+		uploadSize *int64
+		want       int64
+	}{
+		{uploadSize: nil, want: 0},
+		{uploadSize: size(50), want: 100},
+		{uploadSize: size(100), want: 200},
+		{uploadSize: size(150), want: 150},
+	} {
+		if have := handler.getSize(dbstore.Upload{UploadSize: tc.uploadSize}); have != tc.want {
+			t.Errorf("unexpected size. want=%d have=%d", tc.want, have)
+		}
+	}
 }
 
 func TestHandleCloneInProgress(t *testing.T) {
@@ -294,3 +348,45 @@ func setupRepoMocks(t *testing.T) {
 		return "", nil
 	}
 }
+
+func TestValidateUploadData(t *testing.T) {
+	input := strings.Join([]string{
+		`{"id": "01", "type": "vertex", "label": "metaData", "version": "0.4.3", "projectRoot": "file:///test/"}`,
+		`{"id": "02", "type": "vertex", "label": "document", "uri": "file:///test/foo.go"}`,
+		`{"id": "03", "type": "vertex", "label": "range", "start": {"line": 1, "character": 2}, "end": {"line": 1, "character": 4}}`,
+		`{"id": "04", "type": "vertex", "label": "range", "start": {"line": 2, "character": 2}, "end": {"line": 2, "character": 4}}`,
+		`{"id": "05", "type": "edge", "label": "contains", "outV": "02", "inVs": ["03"]}`,
+	}, "\n")
+
+	findings, validated := validateUploadData(strings.NewReader(input))
+	if !validated {
+		t.Fatalf("expected upload to be validated")
+	}
+	sort.Slice(findings, func(i, j int) bool { return findings[i].Kind < findings[j].Kind })
+
+	expectedFindings := []dbstore.UploadValidationFinding{
+		{Kind: "MISSING_CONTAINS_EDGE", Message: "range 4 not owned by any document", LineNumbers: []int{4}},
+		{Kind: "UNREACHABLE_VERTEX", Message: "vertex 4 unreachable from any range", LineNumbers: []int{4}},
+	}
+	if diff := cmp.Diff(expectedFindings, findings); diff != "" {
+		t.Errorf("unexpected validation findings (-want +got):\n%s", diff)
+	}
+}
+
+func TestValidateUploadDataProtobuf(t *testing.T) {
+	input, err := proto.Marshal(&scip.Index{
+		Metadata:  &scip.Metadata{ToolInfo: &scip.ToolInfo{Name: "scip-go"}, ProjectRoot: "file:///test/"},
+		Documents: []*scip.Document{{RelativePath: "foo.go"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error marshalling index: %s", err)
+	}
+
+	findings, validated := validateUploadData(bytes.NewReader(input))
+	if validated {
+		t.Errorf("expected protobuf-encoded upload to skip validation")
+	}
+	if len(findings) != 0 {
+		t.Errorf("unexpected validation findings. want=%d have=%d", 0, len(findings))
+	}
+}
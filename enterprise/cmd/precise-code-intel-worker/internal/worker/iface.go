@@ -28,6 +28,7 @@ type DBStore interface {
 	DeleteOverlappingDumps(ctx context.Context, repositoryID int, commit, root, indexer string) error
 	InsertDependencySyncingJob(ctx context.Context, uploadID int) (jobID int, err error)
 	UpdateCommitedAt(ctx context.Context, dumpID int, committedAt time.Time) error
+	UpdateUploadValidationFindings(ctx context.Context, uploadID int, findings []dbstore.UploadValidationFinding) error
 }
 
 type DBStoreShim struct {
@@ -53,6 +53,10 @@ type MockDBStore struct {
 	// UpdateReferenceCountsFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateReferenceCounts.
 	UpdateReferenceCountsFunc *DBStoreUpdateReferenceCountsFunc
+	// UpdateUploadValidationFindingsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// UpdateUploadValidationFindings.
+	UpdateUploadValidationFindingsFunc *DBStoreUpdateUploadValidationFindingsFunc
 	// WithFunc is an instance of a mock function object controlling the
 	// behavior of the method With.
 	WithFunc *DBStoreWithFunc
@@ -117,6 +121,11 @@ func NewMockDBStore() *MockDBStore {
 				return 0, nil
 			},
 		},
+		UpdateUploadValidationFindingsFunc: &DBStoreUpdateUploadValidationFindingsFunc{
+			defaultHook: func(context.Context, int, []dbstore.UploadValidationFinding) error {
+				return nil
+			},
+		},
 		WithFunc: &DBStoreWithFunc{
 			defaultHook: func(basestore.ShareableStore) DBStore {
 				return nil
@@ -184,6 +193,11 @@ func NewStrictMockDBStore() *MockDBStore {
 				panic("unexpected invocation of MockDBStore.UpdateReferenceCounts")
 			},
 		},
+		UpdateUploadValidationFindingsFunc: &DBStoreUpdateUploadValidationFindingsFunc{
+			defaultHook: func(context.Context, int, []dbstore.UploadValidationFinding) error {
+				panic("unexpected invocation of MockDBStore.UpdateUploadValidationFindings")
+			},
+		},
 		WithFunc: &DBStoreWithFunc{
 			defaultHook: func(basestore.ShareableStore) DBStore {
 				panic("unexpected invocation of MockDBStore.With")
@@ -229,6 +243,9 @@ func NewMockDBStoreFrom(i DBStore) *MockDBStore {
 		UpdateReferenceCountsFunc: &DBStoreUpdateReferenceCountsFunc{
 			defaultHook: i.UpdateReferenceCounts,
 		},
+		UpdateUploadValidationFindingsFunc: &DBStoreUpdateUploadValidationFindingsFunc{
+			defaultHook: i.UpdateUploadValidationFindings,
+		},
 		WithFunc: &DBStoreWithFunc{
 			defaultHook: i.With,
 		},
@@ -1427,6 +1444,119 @@ func (c DBStoreUpdateReferenceCountsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// DBStoreUpdateUploadValidationFindingsFunc describes the behavior when the
+// UpdateUploadValidationFindings method of the parent MockDBStore instance
+// is invoked.
+type DBStoreUpdateUploadValidationFindingsFunc struct {
+	defaultHook func(context.Context, int, []dbstore.UploadValidationFinding) error
+	hooks       []func(context.Context, int, []dbstore.UploadValidationFinding) error
+	history     []DBStoreUpdateUploadValidationFindingsFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateUploadValidationFindings delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockDBStore) UpdateUploadValidationFindings(v0 context.Context, v1 int, v2 []dbstore.UploadValidationFinding) error {
+	r0 := m.UpdateUploadValidationFindingsFunc.nextHook()(v0, v1, v2)
+	m.UpdateUploadValidationFindingsFunc.appendCall(DBStoreUpdateUploadValidationFindingsFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// UpdateUploadValidationFindings method of the parent MockDBStore instance
+// is invoked and the hook queue is empty.
+func (f *DBStoreUpdateUploadValidationFindingsFunc) SetDefaultHook(hook func(context.Context, int, []dbstore.UploadValidationFinding) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateUploadValidationFindings method of the parent MockDBStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *DBStoreUpdateUploadValidationFindingsFunc) PushHook(hook func(context.Context, int, []dbstore.UploadValidationFinding) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *DBStoreUpdateUploadValidationFindingsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int, []dbstore.UploadValidationFinding) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *DBStoreUpdateUploadValidationFindingsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int, []dbstore.UploadValidationFinding) error {
+		return r0
+	})
+}
+
+func (f *DBStoreUpdateUploadValidationFindingsFunc) nextHook() func(context.Context, int, []dbstore.UploadValidationFinding) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *DBStoreUpdateUploadValidationFindingsFunc) appendCall(r0 DBStoreUpdateUploadValidationFindingsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// DBStoreUpdateUploadValidationFindingsFuncCall objects describing the
+// invocations of this function.
+func (f *DBStoreUpdateUploadValidationFindingsFunc) History() []DBStoreUpdateUploadValidationFindingsFuncCall {
+	f.mutex.Lock()
+	history := make([]DBStoreUpdateUploadValidationFindingsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// DBStoreUpdateUploadValidationFindingsFuncCall is an object that describes
+// an invocation of method UpdateUploadValidationFindings on an instance of
+// MockDBStore.
+type DBStoreUpdateUploadValidationFindingsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []dbstore.UploadValidationFinding
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c DBStoreUpdateUploadValidationFindingsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c DBStoreUpdateUploadValidationFindingsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // DBStoreWithFunc describes the behavior when the With method of the parent
 // MockDBStore instance is invoked.
 type DBStoreWithFunc struct {
@@ -0,0 +1,130 @@
+package worker
+
+import (
+	"context"
+	"io"
+
+	"github.com/cockroachdb/errors"
+	"github.com/opentracing/opentracing-go/log"
+
+	store "github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol/reader"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/validation"
+)
+
+// MaxValidationFindings is the maximum number of validation findings recorded for a single upload.
+// Indexes with a systemic problem can produce a finding for nearly every element.
+const MaxValidationFindings = 1000
+
+// validatesUpload returns true if the given upload is small enough to be validated while it's
+// being processed.
+func (h *handler) validatesUpload(upload store.Upload) bool {
+	return h.validationMaxSize <= 0 || upload.UploadSize == nil || *upload.UploadSize <= h.validationMaxSize
+}
+
+// uploadValidation validates the raw data of an upload while it's being read by the correlator,
+// so the upload is only downloaded once.
+type uploadValidation struct {
+	r       io.Reader
+	pw      *io.PipeWriter
+	results chan validationResult
+}
+
+type validationResult struct {
+	findings  []store.UploadValidationFinding
+	validated bool
+}
+
+// validateWhileReading returns a reader that yields the content of r and feeds it to the LSIF
+// validator as it's read. Finish must be called to receive the validation findings.
+func validateWhileReading(r io.Reader) *uploadValidation {
+	pr, pw := io.Pipe()
+	results := make(chan validationResult, 1)
+
+	go func() {
+		findings, validated := validateUploadData(pr)
+		results <- validationResult{findings: findings, validated: validated}
+		// The validator may stop reading early, the rest of the content is discarded so the
+		// correlator isn't blocked on the pipe.
+		_, _ = io.Copy(io.Discard, pr)
+	}()
+
+	return &uploadValidation{
+		r:       io.TeeReader(r, pw),
+		pw:      pw,
+		results: results,
+	}
+}
+
+// Read reads from the underlying reader.
+func (v *uploadValidation) Read(p []byte) (int, error) {
+	return v.r.Read(p)
+}
+
+// Finish reads the content the correlator left unread, so that the validator sees the
+// entire upload, and returns the validation findings. The returned flag is false if the format
+// of the upload can't be validated, in which case there are no findings.
+func (v *uploadValidation) Finish() ([]store.UploadValidationFinding, bool) {
+	_, err := io.Copy(io.Discard, v.r)
+	_ = v.pw.CloseWithError(err)
+	result := <-v.results
+	return result.findings, result.validated
+}
+
+// updateValidationFindings replaces the validation findings of the given upload.
+func (h *handler) updateValidationFindings(ctx context.Context, upload store.Upload, findings []store.UploadValidationFinding, traceLog observation.TraceLogger) error {
+	traceLog(log.Int("numValidationFindings", len(findings)))
+
+	if err := h.dbStore.UpdateUploadValidationFindings(ctx, upload.ID, findings); err != nil {
+		return errors.Wrap(err, "store.UpdateUploadValidationFindings")
+	}
+
+	return nil
+}
+
+// validateUploadData validates the given newline-delimited LSIF content and returns at most
+// MaxValidationFindings findings. An error reading the content is reported as an additional
+// finding.
+//
+// The validator only understands LSIF, so protobuf-encoded indexes are skipped and this function
+// returns false. Their structure is still checked by the correlator, which fails the upload if
+// the index is malformed.
+func validateUploadData(r io.Reader) ([]store.UploadValidationFinding, bool) {
+	ctx := validation.NewValidationContext()
+	validator := &validation.Validator{Context: ctx, UploadChecks: true}
+
+	format, r, err := reader.DetectFormat(r)
+	if err == nil && format == reader.FormatProtobuf {
+		return nil, false
+	}
+
+	if err := validator.Validate(r); err != nil {
+		ctx.AddError("failed to read index: %s", err)
+	}
+
+	findings := make([]store.UploadValidationFinding, 0, len(ctx.Errors))
+	for _, err := range ctx.Errors {
+		if len(findings) >= MaxValidationFindings {
+			break
+		}
+
+		kind := err.Kind
+		if kind == "" {
+			kind = validation.KindOther
+		}
+
+		lineNumbers := make([]int, 0, len(err.RelevantLines))
+		for _, lineContext := range err.RelevantLines {
+			lineNumbers = append(lineNumbers, lineContext.Index)
+		}
+
+		findings = append(findings, store.UploadValidationFinding{
+			Kind:        kind,
+			Message:     err.Message,
+			LineNumbers: lineNumbers,
+		})
+	}
+
+	return findings, true
+}
@@ -28,6 +28,7 @@ func NewWorker(
 	pollInterval time.Duration,
 	numProcessorRoutines int,
 	budgetMax int64,
+	validationMaxSize int64,
 	workerMetrics workerutil.WorkerMetrics,
 ) *workerutil.Worker {
 	rootContext := actor.WithActor(context.Background(), &actor.Actor{Internal: true})
@@ -47,14 +48,15 @@ func NewWorker(
 	})
 
 	handler := &handler{
-		dbStore:         dbStore,
-		workerStore:     workerStore,
-		lsifStore:       lsifStore,
-		uploadStore:     uploadStore,
-		gitserverClient: gitserverClient,
-		enableBudget:    budgetMax > 0,
-		budgetRemaining: budgetMax,
-		handleOp:        op,
+		dbStore:           dbStore,
+		workerStore:       workerStore,
+		lsifStore:         lsifStore,
+		uploadStore:       uploadStore,
+		gitserverClient:   gitserverClient,
+		enableBudget:      budgetMax > 0,
+		budgetRemaining:   budgetMax,
+		validationMaxSize: validationMaxSize,
+		handleOp:          op,
 	}
 
 	return dbworker.NewWorker(rootContext, workerStore, handler, workerutil.WorkerOptions{
@@ -106,6 +106,7 @@ func main() {
 		config.WorkerPollInterval,
 		config.WorkerConcurrency,
 		config.WorkerBudget,
+		config.ValidationMaxSize,
 		makeWorkerMetrics(observationContext),
 	)
 
@@ -116,8 +117,14 @@ func main() {
 		Handler:      httpserver.NewHandler(nil),
 	})
 
+	routines := []goroutine.BackgroundRoutine{worker, server}
+	if uploadstore.NeedsJanitor(config.UploadStoreConfig) {
+		// Uploads stored on local disk are not expired by a bucket lifecycle policy
+		routines = append(routines, uploadstore.NewJanitor(uploadStore, config.UploadStoreConfig.Local.JanitorInterval))
+	}
+
 	// Go!
-	goroutine.MonitorBackgroundRoutines(context.Background(), worker, server)
+	goroutine.MonitorBackgroundRoutines(context.Background(), routines...)
 }
 
 func mustInitializeDB() *sql.DB {
@@ -263,7 +263,7 @@ func (e *uploadExpirer) isUploadProtectedByPolicy(
 		for _, commit := range commits {
 			if policyMatches, ok := commitMap[commit]; ok {
 				for _, policyMatch := range policyMatches {
-					if policyMatch.PolicyDuration == nil || now.Sub(upload.UploadedAt) < *policyMatch.PolicyDuration {
+					if policies.ProtectsUpload(policyMatch, upload.UploadedAt, now) {
 						return true, nil
 					}
 				}
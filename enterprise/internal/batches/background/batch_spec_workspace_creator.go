@@ -12,7 +12,9 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
 	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
 	"github.com/sourcegraph/sourcegraph/lib/batches/execution"
@@ -176,7 +178,11 @@ func (r *batchSpecWorkspaceCreator) process(
 			return err
 		}
 
-		rawSpecs, err := cache.ChangesetSpecsFromCache(spec.Spec, workspace.repo, executionResult)
+		repo := workspace.repo
+		readFile := func(path string) ([]byte, error) {
+			return git.ReadFile(ctx, api.RepoName(repo.Name), api.CommitID(repo.BaseRev), path, 0)
+		}
+		rawSpecs, err := cache.ChangesetSpecsFromCacheWithReadFile(spec.Spec, repo, executionResult, readFile)
 		if err != nil {
 			return err
 		}
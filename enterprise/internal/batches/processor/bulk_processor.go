@@ -16,6 +16,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
 )
 
 // unknownJobTypeErr is returned when a ChangesetJob record is of an unknown type
@@ -101,6 +102,8 @@ func (b *bulkProcessor) Process(ctx context.Context, job *btypes.ChangesetJob) (
 		return b.closeChangeset(ctx, job)
 	case btypes.ChangesetJobTypePublish:
 		return b.publishChangeset(ctx, job)
+	case btypes.ChangesetJobTypeRefresh:
+		return b.refreshChangeset(ctx, job)
 
 	default:
 		return &unknownJobTypeErr{jobType: string(job.JobType)}
@@ -266,3 +269,67 @@ func (b *bulkProcessor) publishChangeset(ctx context.Context, job *btypes.Change
 
 	return nil
 }
+
+// refreshChangeset re-executes the workspace that produced the changeset's
+// current spec against the latest commit of its base branch. Once the
+// execution has completed, the changeset is updated with the resulting spec
+// and enqueued, so that the reconciler pushes the new changes.
+func (b *bulkProcessor) refreshChangeset(ctx context.Context, job *btypes.ChangesetJob) (err error) {
+	// We can't refresh changesets we didn't create.
+	if b.ch.CurrentSpecID == 0 {
+		return errcode.MakeNonRetryable(errors.New("cannot refresh an imported changeset"))
+	}
+
+	spec, err := b.tx.GetChangesetSpecByID(ctx, b.ch.CurrentSpecID)
+	if err != nil {
+		return errors.Wrapf(err, "getting changeset spec for changeset %d", b.ch.ID)
+	}
+
+	baseRev, err := git.ResolveRevision(ctx, b.repo.Name, spec.Spec.BaseRef, git.ResolveRevisionOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "resolving base ref %q", spec.Spec.BaseRef)
+	}
+	if string(baseRev) == spec.Spec.BaseRev {
+		log15.Debug("changeset is up to date with its base branch", "changeset", b.ch.ID)
+		return nil
+	}
+
+	workspaces, _, err := b.tx.ListBatchSpecWorkspaces(ctx, store.ListBatchSpecWorkspacesOpts{ChangesetSpecID: spec.ID})
+	if err != nil {
+		return errors.Wrap(err, "loading batch spec workspace")
+	}
+	if len(workspaces) == 0 {
+		return errcode.MakeNonRetryable(errors.New("changeset was not created by a server-side execution; re-run the batch spec to update it"))
+	}
+	workspace := workspaces[0]
+
+	jobs, err := b.tx.ListBatchSpecWorkspaceExecutionJobs(ctx, store.ListBatchSpecWorkspaceExecutionJobsOpts{
+		BatchSpecWorkspaceIDs: []int64{workspace.ID},
+	})
+	if err != nil {
+		return errors.Wrap(err, "loading batch spec workspace execution jobs")
+	}
+	jobIDs := make([]int64, len(jobs))
+	for i, j := range jobs {
+		if !j.State.Retryable() {
+			return errcode.MakeNonRetryable(errors.Newf("workspace of changeset %d is already being executed", b.ch.ID))
+		}
+		jobIDs[i] = j.ID
+	}
+
+	if len(jobIDs) > 0 {
+		if err := b.tx.DeleteBatchSpecWorkspaceExecutionJobs(ctx, jobIDs); err != nil {
+			return errors.Wrap(err, "deleting batch spec workspace execution jobs")
+		}
+	}
+
+	if err := b.tx.RefreshBatchSpecWorkspace(ctx, workspace.ID, string(baseRev), b.ch.ID); err != nil {
+		return errors.Wrap(err, "updating batch spec workspace")
+	}
+
+	if err := b.tx.CreateBatchSpecWorkspaceExecutionJobsForWorkspaces(ctx, []int64{workspace.ID}); err != nil {
+		return errors.Wrap(err, "creating batch spec workspace execution job")
+	}
+
+	return nil
+}
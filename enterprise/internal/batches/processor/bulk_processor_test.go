@@ -10,11 +10,14 @@ import (
 	ct "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/testing"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
 )
 
 func TestBulkProcessor(t *testing.T) {
@@ -357,4 +360,122 @@ func TestBulkProcessor(t *testing.T) {
 			}
 		})
 	})
+
+	t.Run("Refresh job", func(t *testing.T) {
+		t.Cleanup(git.ResetMocks)
+
+		refreshSpec := ct.CreateChangesetSpec(t, ctx, bstore, ct.TestSpecOpts{
+			User:      user.ID,
+			Repo:      repo.ID,
+			BatchSpec: batchSpec.ID,
+			HeadRef:   "refs/heads/refresh",
+			BaseRef:   "refs/heads/main",
+			BaseRev:   "base-rev",
+			Published: true,
+		})
+		refreshChangeset := ct.CreateChangeset(t, ctx, bstore, ct.TestChangesetOpts{
+			Repo:                repo.ID,
+			BatchChanges:        []types.BatchChangeAssoc{{BatchChangeID: batchChange.ID}},
+			OwnedByBatchChange:  batchChange.ID,
+			Metadata:            &github.PullRequest{HeadRefOid: "head-rev"},
+			ExternalServiceType: extsvc.TypeGitHub,
+			ExternalState:       btypes.ChangesetExternalStateOpen,
+			PublicationState:    btypes.ChangesetPublicationStatePublished,
+			CurrentSpec:         refreshSpec.ID,
+		})
+
+		job := &types.ChangesetJob{
+			JobType:       types.ChangesetJobTypeRefresh,
+			BatchChangeID: batchChange.ID,
+			ChangesetID:   refreshChangeset.ID,
+			UserID:        user.ID,
+			Payload:       &types.ChangesetJobRefreshPayload{},
+		}
+
+		bp := &bulkProcessor{
+			tx:      bstore,
+			sourcer: sources.NewFakeSourcer(nil, &sources.FakeChangesetSource{}),
+		}
+
+		t.Run("base branch unchanged", func(t *testing.T) {
+			git.Mocks.ResolveRevision = func(spec string, opt git.ResolveRevisionOptions) (api.CommitID, error) {
+				return "base-rev", nil
+			}
+
+			if err := bp.Process(ctx, job); err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		t.Run("not executed server-side", func(t *testing.T) {
+			git.Mocks.ResolveRevision = func(spec string, opt git.ResolveRevisionOptions) (api.CommitID, error) {
+				return "new-base-rev", nil
+			}
+
+			err := bp.Process(ctx, job)
+			if err == nil {
+				t.Fatal("unexpected nil error")
+			}
+			if !errcode.IsNonRetryable(err) {
+				t.Fatalf("error is retryable: %v", err)
+			}
+		})
+
+		t.Run("success", func(t *testing.T) {
+			git.Mocks.ResolveRevision = func(spec string, opt git.ResolveRevisionOptions) (api.CommitID, error) {
+				return "new-base-rev", nil
+			}
+
+			workspace := &btypes.BatchSpecWorkspace{
+				BatchSpecID:       batchSpec.ID,
+				ChangesetSpecIDs:  []int64{refreshSpec.ID},
+				RepoID:            repo.ID,
+				Branch:            "refs/heads/main",
+				Commit:            "base-rev",
+				Steps:             []batcheslib.Step{{Run: "echo 1", Container: "alpine"}},
+				CachedResultFound: true,
+			}
+			if err := bstore.CreateBatchSpecWorkspace(ctx, workspace); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := bp.Process(ctx, job); err != nil {
+				t.Fatal(err)
+			}
+
+			have, err := bstore.GetBatchSpecWorkspace(ctx, store.GetBatchSpecWorkspaceOpts{ID: workspace.ID})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if have.Commit != "new-base-rev" {
+				t.Errorf("wrong commit. want=%q, have=%q", "new-base-rev", have.Commit)
+			}
+			if have.RefreshChangesetID != refreshChangeset.ID {
+				t.Errorf("wrong refresh changeset. want=%d, have=%d", refreshChangeset.ID, have.RefreshChangesetID)
+			}
+			if have.CachedResultFound {
+				t.Error("cached result still marked as found")
+			}
+
+			jobs, err := bstore.ListBatchSpecWorkspaceExecutionJobs(ctx, store.ListBatchSpecWorkspaceExecutionJobsOpts{
+				BatchSpecWorkspaceIDs: []int64{workspace.ID},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(jobs) != 1 || jobs[0].State != btypes.BatchSpecWorkspaceExecutionJobStateQueued {
+				t.Fatalf("expected one queued execution job, have %+v", jobs)
+			}
+
+			// The workspace is being executed, so it can't be refreshed again
+			// until the execution has finished.
+			err = bp.Process(ctx, job)
+			if err == nil {
+				t.Fatal("unexpected nil error")
+			}
+			if !errcode.IsNonRetryable(err) {
+				t.Fatalf("error is retryable: %v", err)
+			}
+		})
+	})
 }
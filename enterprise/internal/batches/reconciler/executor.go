@@ -49,6 +49,10 @@ type executor struct {
 	cssErr  error
 	cssOnce sync.Once
 
+	fork     *types.Repo
+	forkErr  error
+	forkOnce sync.Once
+
 	repo *types.Repo
 }
 
@@ -149,7 +153,19 @@ func (e *executor) pushChangesetPatch(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
-	pushConf, err := css.GitserverPushConfig(ctx, e.tx.ExternalServices(), e.repo)
+
+	// If the changeset spec asks for it, the commit is pushed to a fork of the
+	// repository instead of the repository itself.
+	pushRepo := e.repo
+	fork, err := e.forkRepo(ctx)
+	if err != nil {
+		return err
+	}
+	if fork != nil {
+		pushRepo = fork
+	}
+
+	pushConf, err := css.GitserverPushConfig(ctx, e.tx.ExternalServices(), pushRepo)
 	if err != nil {
 		return err
 	}
@@ -167,10 +183,19 @@ func (e *executor) publishChangeset(ctx context.Context, asDraft bool) (err erro
 		Body:      e.spec.Spec.Body,
 		BaseRef:   e.spec.Spec.BaseRef,
 		HeadRef:   e.spec.Spec.HeadRef,
+		Reviewers: e.spec.Spec.Reviewers,
+		Labels:    e.spec.Spec.Labels,
 		Repo:      e.repo,
 		Changeset: e.ch,
 	}
 
+	// If the head ref was pushed to a fork, the changeset is opened from there.
+	fork, err := e.forkRepo(ctx)
+	if err != nil {
+		return err
+	}
+	cs.RemoteRepo = fork
+
 	// Depending on the changeset, we may want to add to the body (for example,
 	// to add a backlink to Sourcegraph).
 	if err := decorateChangesetBody(ctx, e.tx, database.NamespacesWith(e.tx), cs); err != nil {
@@ -266,6 +291,8 @@ func (e *executor) updateChangeset(ctx context.Context) (err error) {
 		Body:      e.spec.Spec.Body,
 		BaseRef:   e.spec.Spec.BaseRef,
 		HeadRef:   e.spec.Spec.HeadRef,
+		Reviewers: e.spec.Spec.Reviewers,
+		Labels:    e.spec.Spec.Labels,
 		Repo:      e.repo,
 		Changeset: e.ch,
 	}
@@ -358,6 +385,8 @@ func (e *executor) undraftChangeset(ctx context.Context) (err error) {
 		Body:      e.spec.Spec.Body,
 		BaseRef:   e.spec.Spec.BaseRef,
 		HeadRef:   e.spec.Spec.HeadRef,
+		Reviewers: e.spec.Spec.Reviewers,
+		Labels:    e.spec.Spec.Labels,
 		Repo:      e.repo,
 		Changeset: e.ch,
 	}
@@ -382,6 +411,44 @@ func (e *executor) changesetSource(ctx context.Context) (sources.ChangesetSource
 	return e.css, e.cssErr
 }
 
+// forkRepo returns the fork of the changeset's repository that the changeset
+// is pushed to and opened from, and records its namespace on the changeset. It
+// returns nil if the changeset spec doesn't ask for a fork.
+func (e *executor) forkRepo(ctx context.Context) (*types.Repo, error) {
+	e.forkOnce.Do(func() {
+		if e.spec == nil || !e.spec.Spec.Fork {
+			e.ch.ExternalForkNamespace = ""
+			return
+		}
+
+		css, err := e.changesetSource(ctx)
+		if err != nil {
+			e.forkErr = err
+			return
+		}
+		fss, err := sources.ToForkableChangesetSource(css)
+		if err != nil {
+			e.forkErr = errForkNotSupported{externalServiceType: e.repo.ExternalRepo.ServiceType}
+			return
+		}
+
+		fork, err := fss.GetUserFork(ctx, e.repo)
+		if err != nil {
+			e.forkErr = errors.Wrap(err, "getting user fork")
+			return
+		}
+		namespace, err := sources.ForkNamespace(fork)
+		if err != nil {
+			e.forkErr = err
+			return
+		}
+
+		e.fork = fork
+		e.ch.ExternalForkNamespace = namespace
+	})
+	return e.fork, e.forkErr
+}
+
 func loadChangesetSource(ctx context.Context, s *store.Store, sourcer sources.Sourcer, ch *btypes.Changeset, repo *types.Repo) (sources.ChangesetSource, error) {
 	// This is a changeset source using the external service config for authentication,
 	// based on our heuristic in the sources package.
@@ -633,3 +700,14 @@ func (e errNoPushCredentials) Error() string {
 }
 
 func (e errNoPushCredentials) NonRetryable() bool { return true }
+
+// errForkNotSupported is returned if the changeset spec asks for the changeset
+// to be pushed to a fork, but the code host of the repository doesn't support
+// that.
+type errForkNotSupported struct{ externalServiceType string }
+
+func (e errForkNotSupported) Error() string {
+	return fmt.Sprintf("pushing changesets to a fork is not supported for code host type %s", e.externalServiceType)
+}
+
+func (e errForkNotSupported) NonRetryable() bool { return true }
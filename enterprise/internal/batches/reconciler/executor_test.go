@@ -22,6 +22,7 @@ import (
 	et "github.com/sourcegraph/sourcegraph/internal/encryption/testing"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	gitprotocol "github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/repoupdater/protocol"
@@ -61,6 +62,12 @@ func TestExecutor_ExecutePlan(t *testing.T) {
 	draftGithubPR := buildGithubPR(clock(), btypes.ChangesetExternalStateDraft)
 	closedGitHubPR := buildGithubPR(clock(), btypes.ChangesetExternalStateClosed)
 
+	forkRepo := *repo
+	forkRepo.Metadata = &github.Repository{
+		NameWithOwner: "fork-user/" + string(repo.Name),
+		URL:           "https://github.com/fork-user/" + string(repo.Name),
+	}
+
 	notFoundErr := sources.ChangesetNotFoundError{
 		Changeset: &sources.Changeset{
 			Changeset: &btypes.Changeset{ExternalID: "100000"},
@@ -70,6 +77,7 @@ func TestExecutor_ExecutePlan(t *testing.T) {
 	type testCase struct {
 		changeset      ct.TestChangesetOpts
 		hasCurrentSpec bool
+		forkSpec       bool
 		plan           *Plan
 
 		sourcerMetadata interface{}
@@ -86,6 +94,7 @@ func TestExecutor_ExecutePlan(t *testing.T) {
 		wantReopenOnCodeHost      bool
 
 		wantGitserverCommit bool
+		wantForkPush        bool
 
 		wantChangeset       ct.ChangesetAssertions
 		wantNonRetryableErr bool
@@ -159,6 +168,34 @@ func TestExecutor_ExecutePlan(t *testing.T) {
 				DiffStat:         state.DiffStat,
 			},
 		},
+		"push and publish to fork": {
+			hasCurrentSpec: true,
+			forkSpec:       true,
+			changeset: ct.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStateUnpublished,
+			},
+			plan: &Plan{
+				Ops: Operations{
+					btypes.ReconcilerOperationPush,
+					btypes.ReconcilerOperationPublish,
+				},
+			},
+
+			wantCreateOnCodeHost: true,
+			wantGitserverCommit:  true,
+			wantForkPush:         true,
+
+			wantChangeset: ct.ChangesetAssertions{
+				PublicationState:      btypes.ChangesetPublicationStatePublished,
+				ExternalID:            githubPR.ID,
+				ExternalBranch:        githubHeadRef,
+				ExternalForkNamespace: "fork-user",
+				ExternalState:         btypes.ChangesetExternalStateOpen,
+				Title:                 githubPR.Title,
+				Body:                  githubPR.Body,
+				DiffStat:              state.DiffStat,
+			},
+		},
 		"retry push and publish": {
 			// This test case makes sure that everything works when the code host says
 			// that the changeset already exists.
@@ -452,6 +489,7 @@ func TestExecutor_ExecutePlan(t *testing.T) {
 				specOpts.User = admin.ID
 				specOpts.Repo = repo.ID
 				specOpts.BatchSpec = batchSpec.ID
+				specOpts.Fork = tc.forkSpec
 				changesetSpec = ct.CreateChangesetSpec(t, ctx, cstore, specOpts)
 			}
 
@@ -483,6 +521,7 @@ func TestExecutor_ExecutePlan(t *testing.T) {
 				Svc:             extSvc,
 				Err:             tc.sourcerErr,
 				ChangesetExists: tc.alreadyExists,
+				UserFork:        &forkRepo,
 			}
 
 			if tc.sourcerMetadata != nil {
@@ -523,6 +562,19 @@ func TestExecutor_ExecutePlan(t *testing.T) {
 				t.Fatalf("wrong CreateCommitFromPatch call. wantCalled=%t, wasCalled=%t", want, have)
 			}
 
+			if have, want := fakeSource.GetUserForkCalled, tc.wantForkPush; have != want {
+				t.Fatalf("wrong GetUserFork call. wantCalled=%t, wasCalled=%t", want, have)
+			}
+
+			if tc.wantForkPush {
+				if have, want := gitClient.CreateCommitFromPatchReq.Push.RemoteURL, "github.com/fork-user/"; !strings.Contains(have, want) {
+					t.Fatalf("commit not pushed to fork. want remote URL containing %q, have %q", want, have)
+				}
+				if have, want := fakeSource.CreatedChangesets[0].RemoteRepo, &forkRepo; have != want {
+					t.Fatalf("changeset not created from fork. want=%+v, have=%+v", want, have)
+				}
+			}
+
 			if have, want := fakeSource.CreateDraftChangesetCalled, tc.wantCreateDraftOnCodeHost; have != want {
 				t.Fatalf("wrong CreateDraftChangeset call. wantCalled=%t, wasCalled=%t", want, have)
 			}
@@ -2,6 +2,7 @@ package reconciler
 
 import (
 	"fmt"
+	"reflect"
 	"sort"
 	"strings"
 
@@ -279,6 +280,12 @@ func compareChangesetSpecs(previous, current *btypes.ChangesetSpec, uiPublicatio
 	if previous.Spec.BaseRef != current.Spec.BaseRef {
 		delta.BaseRefChanged = true
 	}
+	if !reflect.DeepEqual(previous.Spec.Reviewers, current.Spec.Reviewers) {
+		delta.ReviewersChanged = true
+	}
+	if !reflect.DeepEqual(previous.Spec.Labels, current.Spec.Labels) {
+		delta.LabelsChanged = true
+	}
 
 	// If was set to "draft" and now "true", need to undraft the changeset.
 	// We currently ignore going from "true" to "draft".
@@ -348,6 +355,8 @@ type ChangesetSpecDelta struct {
 	BodyChanged          bool
 	Undraft              bool
 	BaseRefChanged       bool
+	ReviewersChanged     bool
+	LabelsChanged        bool
 	DiffChanged          bool
 	CommitMessageChanged bool
 	AuthorNameChanged    bool
@@ -361,7 +370,7 @@ func (d *ChangesetSpecDelta) NeedCommitUpdate() bool {
 }
 
 func (d *ChangesetSpecDelta) NeedCodeHostUpdate() bool {
-	return d.TitleChanged || d.BodyChanged || d.BaseRefChanged
+	return d.TitleChanged || d.BodyChanged || d.BaseRefChanged || d.ReviewersChanged || d.LabelsChanged
 }
 
 func (d *ChangesetSpecDelta) AttributesChanged() bool {
@@ -206,6 +206,24 @@ func TestDetermineReconcilerPlan(t *testing.T) {
 			},
 			wantOperations: Operations{btypes.ReconcilerOperationUpdate},
 		},
+		{
+			name:         "reviewers changed on published changeset",
+			previousSpec: &ct.TestSpecOpts{Published: true, Reviewers: []string{"alice"}},
+			currentSpec:  &ct.TestSpecOpts{Published: true, Reviewers: []string{"alice", "bob"}},
+			changeset: ct.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+			},
+			wantOperations: Operations{btypes.ReconcilerOperationUpdate},
+		},
+		{
+			name:         "labels changed on published changeset",
+			previousSpec: &ct.TestSpecOpts{Published: true},
+			currentSpec:  &ct.TestSpecOpts{Published: true, Labels: []string{"batch-change"}},
+			changeset: ct.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+			},
+			wantOperations: Operations{btypes.ReconcilerOperationUpdate},
+		},
 		{
 			name:         "commit diff changed on published changeset",
 			previousSpec: &ct.TestSpecOpts{Published: true, CommitDiff: "testDiff"},
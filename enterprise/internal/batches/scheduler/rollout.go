@@ -0,0 +1,145 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database/locker"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+)
+
+const rolloutAdvancerInterval = 1 * time.Minute
+
+// NewRolloutAdvancer returns a background routine that opens the next wave of
+// the staged rollouts of batch changes once the changesets in the previous
+// waves have been published and none of them has failing checks.
+//
+// Changesets in waves that haven't been opened yet are kept in the scheduled
+// state, and are only enqueued by the Scheduler once their wave is opened.
+func NewRolloutAdvancer(ctx context.Context, bstore *store.Store) goroutine.BackgroundRoutine {
+	return goroutine.NewPeriodicGoroutine(
+		ctx,
+		rolloutAdvancerInterval,
+		goroutine.NewHandlerWithErrorMessage("advance batch change rollouts", func(ctx context.Context) error {
+			return advanceRollouts(ctx, bstore)
+		}),
+	)
+}
+
+func advanceRollouts(ctx context.Context, s *store.Store) error {
+	batchChanges, _, err := s.ListBatchChanges(ctx, store.ListBatchChangesOpts{OnlyPendingRollout: true})
+	if err != nil {
+		return errors.Wrap(err, "listing batch changes with pending rollouts")
+	}
+
+	var errs *multierror.Error
+	for _, bc := range batchChanges {
+		if err := advanceRollout(ctx, s, bc.ID); err != nil {
+			errs = multierror.Append(errs, errors.Wrapf(err, "advancing rollout of batch change %d", bc.ID))
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+func advanceRollout(ctx context.Context, s *store.Store, batchChangeID int64) (err error) {
+	tx, err := s.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	// Don't interfere with a batch spec that is being applied right now; we'll
+	// try again on the next run.
+	l := locker.NewWithDB(nil, "batches_apply").With(tx)
+	locked, err := l.LockInTransaction(ctx, int32(batchChangeID), false)
+	if err != nil || !locked {
+		return err
+	}
+
+	batchChange, err := tx.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: batchChangeID})
+	if err != nil {
+		return errors.Wrap(err, "loading batch change")
+	}
+	if batchChange.Closed() || batchChange.RolloutWave == 0 {
+		return nil
+	}
+
+	batchSpec, err := tx.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: batchChange.BatchSpecID})
+	if err != nil {
+		return errors.Wrap(err, "loading batch spec")
+	}
+
+	stats, err := tx.GetRolloutWaveStats(ctx, batchChange.ID, batchChange.RolloutWave)
+	if err != nil {
+		return errors.Wrap(err, "getting rollout wave stats")
+	}
+
+	changed, err := updateRolloutState(batchChange, batchSpec.Spec.Rollout, stats, tx.Clock()())
+	if err != nil || !changed {
+		return err
+	}
+
+	return tx.UpdateBatchChange(ctx, batchChange)
+}
+
+// updateRolloutState updates the rollout state of the batch change based on
+// the stats of the changesets in the waves that have been opened already. The
+// next wave is opened once these changesets have been published without
+// failures, and the delay of the next wave has passed.
+//
+// It returns true if the state of the batch change changed.
+func updateRolloutState(bc *btypes.BatchChange, waves []batcheslib.RolloutWave, stats btypes.RolloutWaveStats, now time.Time) (bool, error) {
+	wave, completedAt, reason := bc.RolloutWave, bc.RolloutWaveCompletedAt, bc.RolloutPausedReason
+
+	switch {
+	case stats.Failed > 0:
+		bc.RolloutPausedReason = fmt.Sprintf("%d of the changesets in %s failed to publish", stats.Failed, openedWaves(bc.RolloutWave))
+		bc.RolloutWaveCompletedAt = time.Time{}
+
+	case stats.FailingChecks > 0:
+		bc.RolloutPausedReason = fmt.Sprintf("%d of the changesets in %s have failing checks", stats.FailingChecks, openedWaves(bc.RolloutWave))
+		bc.RolloutWaveCompletedAt = time.Time{}
+
+	case stats.Pending > 0:
+		bc.RolloutPausedReason = ""
+		bc.RolloutWaveCompletedAt = time.Time{}
+
+	default:
+		bc.RolloutPausedReason = ""
+		if bc.RolloutWaveCompletedAt.IsZero() {
+			bc.RolloutWaveCompletedAt = now
+		}
+
+		// Waves are numbered starting at 1, so the next wave is at index
+		// RolloutWave. The implicit final wave has no delay.
+		var delay time.Duration
+		if int(bc.RolloutWave) < len(waves) {
+			var err error
+			if delay, err = waves[bc.RolloutWave].Delay(); err != nil {
+				return false, errors.Wrapf(err, "rollout wave %d", bc.RolloutWave+1)
+			}
+		}
+
+		if !now.Before(bc.RolloutWaveCompletedAt.Add(delay)) {
+			bc.RolloutWave++
+			bc.RolloutWaveCompletedAt = time.Time{}
+		}
+	}
+
+	changed := bc.RolloutWave != wave || !bc.RolloutWaveCompletedAt.Equal(completedAt) || bc.RolloutPausedReason != reason
+	return changed, nil
+}
+
+func openedWaves(wave int32) string {
+	if wave == 1 {
+		return "rollout wave 1"
+	}
+	return fmt.Sprintf("rollout waves 1-%d", wave)
+}
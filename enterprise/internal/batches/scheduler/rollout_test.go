@@ -0,0 +1,101 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+)
+
+func TestUpdateRolloutState(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC)
+	waves := []batcheslib.RolloutWave{
+		{Match: "repo:^core/"},
+		{Percent: 10, After: "1h"},
+	}
+
+	tests := []struct {
+		name    string
+		bc      btypes.BatchChange
+		stats   btypes.RolloutWaveStats
+		want    btypes.BatchChange
+		changed bool
+	}{
+		{
+			name:    "pending changesets",
+			bc:      btypes.BatchChange{RolloutWave: 1},
+			stats:   btypes.RolloutWaveStats{Pending: 2},
+			want:    btypes.BatchChange{RolloutWave: 1},
+			changed: false,
+		},
+		{
+			name:  "failing checks",
+			bc:    btypes.BatchChange{RolloutWave: 1, RolloutWaveCompletedAt: now.Add(-1 * time.Minute)},
+			stats: btypes.RolloutWaveStats{FailingChecks: 1},
+			want: btypes.BatchChange{
+				RolloutWave:         1,
+				RolloutPausedReason: "1 of the changesets in rollout wave 1 have failing checks",
+			},
+			changed: true,
+		},
+		{
+			name:  "failed to publish",
+			bc:    btypes.BatchChange{RolloutWave: 2},
+			stats: btypes.RolloutWaveStats{Failed: 3, FailingChecks: 1},
+			want: btypes.BatchChange{
+				RolloutWave:         2,
+				RolloutPausedReason: "3 of the changesets in rollout waves 1-2 failed to publish",
+			},
+			changed: true,
+		},
+		{
+			name:    "completed, waiting for delay",
+			bc:      btypes.BatchChange{RolloutWave: 1, RolloutPausedReason: "checks failed"},
+			want:    btypes.BatchChange{RolloutWave: 1, RolloutWaveCompletedAt: now},
+			changed: true,
+		},
+		{
+			name:    "delay not passed",
+			bc:      btypes.BatchChange{RolloutWave: 1, RolloutWaveCompletedAt: now.Add(-59 * time.Minute)},
+			want:    btypes.BatchChange{RolloutWave: 1, RolloutWaveCompletedAt: now.Add(-59 * time.Minute)},
+			changed: false,
+		},
+		{
+			name:    "delay passed",
+			bc:      btypes.BatchChange{RolloutWave: 1, RolloutWaveCompletedAt: now.Add(-1 * time.Hour)},
+			want:    btypes.BatchChange{RolloutWave: 2},
+			changed: true,
+		},
+		{
+			name:    "final wave has no delay",
+			bc:      btypes.BatchChange{RolloutWave: 2},
+			want:    btypes.BatchChange{RolloutWave: 3},
+			changed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bc := tt.bc
+			changed, err := updateRolloutState(&bc, waves, tt.stats, now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if changed != tt.changed {
+				t.Errorf("wrong changed. want=%t, have=%t", tt.changed, changed)
+			}
+			if bc.RolloutWave != tt.want.RolloutWave {
+				t.Errorf("wrong wave. want=%d, have=%d", tt.want.RolloutWave, bc.RolloutWave)
+			}
+			if !bc.RolloutWaveCompletedAt.Equal(tt.want.RolloutWaveCompletedAt) {
+				t.Errorf("wrong completed at. want=%s, have=%s", tt.want.RolloutWaveCompletedAt, bc.RolloutWaveCompletedAt)
+			}
+			if bc.RolloutPausedReason != tt.want.RolloutPausedReason {
+				t.Errorf("wrong paused reason. want=%q, have=%q", tt.want.RolloutPausedReason, bc.RolloutPausedReason)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/cockroachdb/errors"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/database/locker"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
 )
 
 // ErrApplyClosedBatchChange is returned by ApplyBatchChange when the batch change
@@ -155,6 +158,11 @@ func (s *Service) ApplyBatchChange(
 		return nil, err
 	}
 
+	// Assign the changesets to the waves of the staged rollout, if any.
+	if err := assignRolloutWaves(batchChange, batchSpec.Spec.Rollout, mappings, changesets); err != nil {
+		return nil, err
+	}
+
 	// Upsert all changesets.
 	for _, changeset := range changesets {
 		if state := opts.PublicationStates.get(changeset.CurrentSpecID); state != nil {
@@ -197,5 +205,71 @@ func (s *Service) ReconcileBatchChange(
 	batchChange.LastApplierID = a.UID
 	batchChange.LastAppliedAt = s.clock()
 	batchChange.Description = batchSpec.Spec.Description
+	if len(batchSpec.Spec.Rollout) == 0 {
+		batchChange.RolloutWave = 0
+		batchChange.RolloutWaveCompletedAt = time.Time{}
+		batchChange.RolloutPausedReason = ""
+	} else if batchChange.RolloutWave == 0 {
+		// A staged rollout starts with the first wave. If the batch change
+		// is already being rolled out, we continue where we left off.
+		batchChange.RolloutWave = 1
+	}
 	return batchChange, previousSpecID, nil
 }
+
+// assignRolloutWaves assigns the changesets created by the batch change for
+// the changeset specs in the given mappings to the waves of the staged
+// rollout. Changesets that were assigned a wave by an earlier apply keep it,
+// so only new changesets are distributed across the waves. Unpublished
+// changesets in waves that haven't been opened yet are scheduled, so that the
+// scheduler only enqueues them once their wave opens.
+func assignRolloutWaves(batchChange *btypes.BatchChange, waves []batcheslib.RolloutWave, mappings btypes.RewirerMappings, changesets []*btypes.Changeset) error {
+	repoNames := make(map[int64]string, len(mappings))
+	for _, m := range mappings {
+		if m.ChangesetSpec != nil && m.Repo != nil {
+			repoNames[m.ChangesetSpec.ID] = string(m.Repo.Name)
+		}
+	}
+
+	var owned []*btypes.Changeset
+	for _, c := range changesets {
+		if c.OwnedByBatchChangeID != batchChange.ID {
+			continue
+		}
+		if _, ok := repoNames[c.CurrentSpecID]; ok {
+			owned = append(owned, c)
+		}
+	}
+
+	// Percentage waves take the first changesets, so we need a stable order.
+	sort.Slice(owned, func(i, j int) bool {
+		ni, nj := repoNames[owned[i].CurrentSpecID], repoNames[owned[j].CurrentSpecID]
+		if ni != nj {
+			return ni < nj
+		}
+		return owned[i].CurrentSpecID < owned[j].CurrentSpecID
+	})
+
+	names := make([]string, len(owned))
+	existing := make([]int32, len(owned))
+	for i, c := range owned {
+		names[i] = repoNames[c.CurrentSpecID]
+		existing[i] = c.RolloutWave
+	}
+
+	assigned, err := batcheslib.AssignRolloutWaves(waves, names, existing)
+	if err != nil {
+		return err
+	}
+
+	for i, c := range owned {
+		c.RolloutWave = assigned[i]
+
+		if batchChange.RolloutWave > 0 && c.RolloutWave > batchChange.RolloutWave &&
+			!c.Published() && c.ReconcilerState == btypes.ReconcilerStateQueued {
+			c.ReconcilerState = btypes.ReconcilerStateScheduled
+		}
+	}
+
+	return nil
+}
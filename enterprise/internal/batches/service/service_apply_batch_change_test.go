@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -14,10 +15,13 @@ import (
 	ct "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/testing"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/timeutil"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
 )
 
 func TestServiceApplyBatchChange(t *testing.T) {
@@ -1196,3 +1200,96 @@ func applyAndListChangesets(ctx context.Context, t *testing.T, svc *Service, bat
 
 	return batchChange, changesets
 }
+
+func TestAssignRolloutWaves(t *testing.T) {
+	batchChange := &btypes.BatchChange{ID: 1, RolloutWave: 1}
+	waves := []batcheslib.RolloutWave{{Match: "repo:^core/"}, {Percent: 50}}
+
+	var (
+		mappings   btypes.RewirerMappings
+		changesets []*btypes.Changeset
+	)
+	for i, name := range []string{"tools/b", "core/a", "tools/a", "docs/a"} {
+		spec := &btypes.ChangesetSpec{ID: int64(i + 1)}
+		mappings = append(mappings, &btypes.RewirerMapping{
+			ChangesetSpec: spec,
+			Repo:          &types.Repo{Name: api.RepoName(name)},
+		})
+		changesets = append(changesets, &btypes.Changeset{
+			OwnedByBatchChangeID: batchChange.ID,
+			CurrentSpecID:        spec.ID,
+			PublicationState:     btypes.ChangesetPublicationStateUnpublished,
+			ReconcilerState:      btypes.ReconcilerStateQueued,
+		})
+	}
+
+	// Published changesets are updated right away, even if their wave hasn't
+	// been opened yet.
+	changesets[0].PublicationState = btypes.ChangesetPublicationStatePublished
+
+	// Imported changesets aren't part of the rollout.
+	imported := &btypes.Changeset{ReconcilerState: btypes.ReconcilerStateQueued}
+	changesets = append(changesets, imported)
+
+	if err := assignRolloutWaves(batchChange, waves, mappings, changesets); err != nil {
+		t.Fatal(err)
+	}
+
+	type result struct {
+		Wave  int32
+		State btypes.ReconcilerState
+	}
+	var have []result
+	for _, c := range changesets {
+		have = append(have, result{c.RolloutWave, c.ReconcilerState})
+	}
+	want := []result{
+		{3, btypes.ReconcilerStateQueued},
+		{1, btypes.ReconcilerStateQueued},
+		{2, btypes.ReconcilerStateScheduled},
+		{2, btypes.ReconcilerStateScheduled},
+		{0, btypes.ReconcilerStateQueued},
+	}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Errorf("wrong waves (-want +have):\n%s", diff)
+	}
+}
+
+func TestAssignRolloutWavesKeepsExistingWaves(t *testing.T) {
+	batchChange := &btypes.BatchChange{ID: 1, RolloutWave: 1}
+	// The waves changed since the changesets were assigned: every changeset
+	// would be in the first wave if it were assigned from scratch.
+	waves := []batcheslib.RolloutWave{{Percent: 100}}
+
+	var (
+		mappings   btypes.RewirerMappings
+		changesets []*btypes.Changeset
+	)
+	for i, wave := range []int32{2, 1, 2, 0} {
+		spec := &btypes.ChangesetSpec{ID: int64(i + 1)}
+		mappings = append(mappings, &btypes.RewirerMapping{
+			ChangesetSpec: spec,
+			Repo:          &types.Repo{Name: api.RepoName("repo-" + strconv.Itoa(i))},
+		})
+		changesets = append(changesets, &btypes.Changeset{
+			OwnedByBatchChangeID: batchChange.ID,
+			CurrentSpecID:        spec.ID,
+			PublicationState:     btypes.ChangesetPublicationStateUnpublished,
+			ReconcilerState:      btypes.ReconcilerStateQueued,
+			RolloutWave:          wave,
+		})
+	}
+
+	if err := assignRolloutWaves(batchChange, waves, mappings, changesets); err != nil {
+		t.Fatal(err)
+	}
+
+	var have []int32
+	for _, c := range changesets {
+		have = append(have, c.RolloutWave)
+	}
+	// Only the new changeset is assigned a wave.
+	if diff := cmp.Diff([]int32{2, 1, 2, 1}, have); diff != "" {
+		t.Errorf("wrong waves (-want +have):\n%s", diff)
+	}
+}
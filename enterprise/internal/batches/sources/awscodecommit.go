@@ -0,0 +1,252 @@
+package sources
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/config"
+	awscredentials "github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/cockroachdb/errors"
+	"golang.org/x/net/http2"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/awscodecommit"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// AWSCodeCommitSource is a ChangesetSource for AWS CodeCommit.
+//
+// The CodeCommit API is always accessed with the AWS access key of the
+// external service, since CodeCommit has no concept of per-user API tokens.
+// The authenticator only holds the HTTPS Git credentials used to push
+// commits, which is why changesets are always opened by the IAM user of the
+// external service.
+type AWSCodeCommitSource struct {
+	client *awscodecommit.Client
+	au     auth.Authenticator
+}
+
+// NewAWSCodeCommitSource returns a new AWSCodeCommitSource from the given external service.
+func NewAWSCodeCommitSource(svc *types.ExternalService, cf *httpcli.Factory) (*AWSCodeCommitSource, error) {
+	var c schema.AWSCodeCommitConnection
+	if err := jsonc.Unmarshal(svc.Config, &c); err != nil {
+		return nil, errors.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	return newAWSCodeCommitSource(&c, cf)
+}
+
+func newAWSCodeCommitSource(c *schema.AWSCodeCommitConnection, cf *httpcli.Factory) (*AWSCodeCommitSource, error) {
+	if cf == nil {
+		cf = httpcli.ExternalClientFactory
+	}
+
+	cli, err := cf.Doer(func(c *http.Client) error {
+		tr := awshttp.NewBuildableClient().GetTransport()
+		if err := http2.ConfigureTransport(tr); err != nil {
+			return err
+		}
+		c.Transport = tr
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	awsConfig, err := config.LoadDefaultConfig(context.Background(),
+		config.WithRegion(c.Region),
+		config.WithCredentialsProvider(
+			awscredentials.StaticCredentialsProvider{
+				Value: aws.Credentials{
+					AccessKeyID:     c.AccessKeyID,
+					SecretAccessKey: c.SecretAccessKey,
+					Source:          "sourcegraph-site-configuration",
+				},
+			},
+		),
+		config.WithHTTPClient(cli),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AWSCodeCommitSource{
+		client: awscodecommit.NewClient(awsConfig),
+		au:     &auth.BasicAuth{Username: c.GitCredentials.Username, Password: c.GitCredentials.Password},
+	}, nil
+}
+
+func (s AWSCodeCommitSource) GitserverPushConfig(ctx context.Context, store database.ExternalServiceStore, repo *types.Repo) (*protocol.PushConfig, error) {
+	return gitserverPushConfig(ctx, store, repo, s.au)
+}
+
+// WithAuthenticator returns a copy of the source that pushes with the given
+// authenticator. AWS CodeCommit only supports HTTPS Git credentials, which are
+// a username and password combination.
+func (s AWSCodeCommitSource) WithAuthenticator(a auth.Authenticator) (ChangesetSource, error) {
+	switch a.(type) {
+	case *auth.BasicAuth, *auth.BasicAuthWithSSH:
+	default:
+		return nil, newUnsupportedAuthenticatorError("AWSCodeCommitSource", a)
+	}
+
+	return &AWSCodeCommitSource{
+		client: s.client,
+		au:     a,
+	}, nil
+}
+
+// ValidateAuthenticator is a noop for AWS CodeCommit: Git credentials can
+// only be used to push and pull, so there is no API to validate them with.
+func (s AWSCodeCommitSource) ValidateAuthenticator(ctx context.Context) error {
+	return nil
+}
+
+// CreateChangeset creates the given *Changeset in the code host. CodeCommit
+// allows several open pull requests for the same branches, so we look for an
+// existing one first.
+func (s AWSCodeCommitSource) CreateChangeset(ctx context.Context, c *Changeset) (bool, error) {
+	if err := checkReviewersAndLabels(c, "AWS CodeCommit", false, false); err != nil {
+		return false, err
+	}
+
+	repo := c.Repo.Metadata.(*awscodecommit.Repository)
+	headRef := git.EnsureRefPrefix(c.HeadRef)
+	baseRef := git.EnsureRefPrefix(c.BaseRef)
+
+	exists := true
+	pr, err := s.client.FindOpenPullRequest(ctx, repo.Name, headRef, baseRef)
+	if err != nil {
+		return false, errors.Wrap(err, "looking for existing pull request")
+	}
+	if pr == nil {
+		exists = false
+		pr, err = s.client.CreatePullRequest(ctx, awscodecommit.CreatePullRequestInput{
+			RepositoryName:       repo.Name,
+			Title:                c.Title,
+			Description:          c.Body,
+			SourceReference:      headRef,
+			DestinationReference: baseRef,
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if err := s.setMetadata(ctx, c, pr); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+// CloseChangeset closes the given *Changeset on the code host and updates the
+// Metadata column in the *batches.Changeset to the newly closed pull request.
+func (s AWSCodeCommitSource) CloseChangeset(ctx context.Context, c *Changeset) error {
+	pr, ok := c.Changeset.Metadata.(*awscodecommit.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not an AWS CodeCommit pull request")
+	}
+
+	closed, err := s.client.UpdatePullRequestStatus(ctx, pr.ID, awscodecommit.PullRequestStatusClosed)
+	if err != nil {
+		return err
+	}
+
+	return s.setMetadata(ctx, c, closed)
+}
+
+// LoadChangeset loads the latest state of the given Changeset from the codehost.
+func (s AWSCodeCommitSource) LoadChangeset(ctx context.Context, cs *Changeset) error {
+	pr, err := s.client.GetPullRequest(ctx, cs.ExternalID)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return ChangesetNotFoundError{Changeset: cs}
+		}
+		return err
+	}
+
+	return s.setMetadata(ctx, cs, pr)
+}
+
+func (s AWSCodeCommitSource) UpdateChangeset(ctx context.Context, c *Changeset) error {
+	pr, ok := c.Changeset.Metadata.(*awscodecommit.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not an AWS CodeCommit pull request")
+	}
+	if err := checkReviewersAndLabels(c, "AWS CodeCommit", false, false); err != nil {
+		return err
+	}
+
+	// CodeCommit doesn't support changing the destination branch of a pull
+	// request, so only the title and description are updated.
+	updated, err := s.client.UpdatePullRequest(ctx, pr.ID, c.Title, c.Body)
+	if err != nil {
+		return err
+	}
+
+	return s.setMetadata(ctx, c, updated)
+}
+
+// ReopenChangeset reopens the *Changeset on the code host and updates the
+// Metadata column in the *batches.Changeset.
+func (s AWSCodeCommitSource) ReopenChangeset(ctx context.Context, c *Changeset) error {
+	pr, ok := c.Changeset.Metadata.(*awscodecommit.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not an AWS CodeCommit pull request")
+	}
+
+	reopened, err := s.client.UpdatePullRequestStatus(ctx, pr.ID, awscodecommit.PullRequestStatusOpen)
+	if err != nil {
+		return err
+	}
+
+	return s.setMetadata(ctx, c, reopened)
+}
+
+// CreateComment posts a comment on the Changeset.
+func (s AWSCodeCommitSource) CreateComment(ctx context.Context, c *Changeset, text string) error {
+	pr, ok := c.Changeset.Metadata.(*awscodecommit.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not an AWS CodeCommit pull request")
+	}
+
+	return s.client.CreatePullRequestComment(ctx, pr, text)
+}
+
+// MergeChangeset merges a Changeset on the code host, if in a mergeable state.
+// If squash is true, a squash merge is performed, otherwise a three-way merge
+// creates a merge commit.
+func (s AWSCodeCommitSource) MergeChangeset(ctx context.Context, c *Changeset, squash bool) error {
+	pr, ok := c.Changeset.Metadata.(*awscodecommit.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not an AWS CodeCommit pull request")
+	}
+
+	merged, err := s.client.MergePullRequest(ctx, pr, squash)
+	if err != nil {
+		if errors.Is(err, awscodecommit.ErrNotMergeable) {
+			return &ChangesetNotMergeableError{ErrorMsg: err.Error()}
+		}
+		return err
+	}
+
+	return s.setMetadata(ctx, c, merged)
+}
+
+func (s AWSCodeCommitSource) setMetadata(ctx context.Context, c *Changeset, pr *awscodecommit.PullRequest) error {
+	if err := s.client.LoadPullRequestApprovals(ctx, pr); err != nil {
+		return errors.Wrap(err, "loading pull request approvals")
+	}
+	if err := c.Changeset.SetMetadata(pr); err != nil {
+		return errors.Wrap(err, "setting changeset metadata")
+	}
+	return nil
+}
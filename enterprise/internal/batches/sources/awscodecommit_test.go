@@ -0,0 +1,351 @@
+package sources
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/awscodecommit"
+	"github.com/sourcegraph/sourcegraph/internal/testutil"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// The test fixtures and golden files were generated against the "test"
+// repository of the AWS CodeCommit test account in us-west-1.
+var awsCodeCommitTestRepo = &types.Repo{
+	Metadata: &awscodecommit.Repository{
+		ARN:       "arn:aws:codecommit:us-west-1:185007729374:test",
+		AccountID: "185007729374",
+		ID:        "b4455554-4f1c-4a10-a60f-a02dcbd2cdd3",
+		Name:      "test",
+	},
+}
+
+func newAWSCodeCommitTestSource(t *testing.T, name string) (*AWSCodeCommitSource, func(testing.TB)) {
+	t.Helper()
+
+	cf, save := newClientFactory(t, name)
+
+	svc := &types.ExternalService{
+		Kind: extsvc.KindAWSCodeCommit,
+		Config: marshalJSON(t, &schema.AWSCodeCommitConnection{
+			AccessKeyID:     getAWSEnv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: getAWSEnv("AWS_SECRET_ACCESS_KEY"),
+			Region:          "us-west-1",
+			GitCredentials: schema.AWSCodeCommitGitCredentials{
+				Username: getAWSEnv("AWS_CODECOMMIT_USERNAME"),
+				Password: getAWSEnv("AWS_CODECOMMIT_PASSWORD"),
+			},
+		}),
+	}
+
+	src, err := NewAWSCodeCommitSource(svc, cf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return src, save
+}
+
+func getAWSEnv(envVar string) string {
+	s := os.Getenv(envVar)
+	if s == "" {
+		s = fmt.Sprintf("BOGUS-%s", envVar)
+	}
+	return s
+}
+
+func TestAWSCodeCommitSource_LoadChangeset(t *testing.T) {
+	testCases := []struct {
+		name string
+		cs   *Changeset
+		err  string
+	}{
+		{
+			name: "found",
+			cs:   &Changeset{Repo: awsCodeCommitTestRepo, Changeset: &btypes.Changeset{ExternalID: "42"}},
+		},
+		{
+			name: "not-found",
+			cs:   &Changeset{Repo: awsCodeCommitTestRepo, Changeset: &btypes.Changeset{ExternalID: "999"}},
+			err:  `Changeset with external ID 999 not found`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		tc.name = "AWSCodeCommitSource_LoadChangeset_" + tc.name
+
+		t.Run(tc.name, func(t *testing.T) {
+			src, save := newAWSCodeCommitTestSource(t, tc.name)
+			defer save(t)
+
+			if tc.err == "" {
+				tc.err = "<nil>"
+			}
+
+			err := src.LoadChangeset(context.Background(), tc.cs)
+			if have, want := fmt.Sprint(err), tc.err; have != want {
+				t.Errorf("error:\nhave: %q\nwant: %q", have, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			testutil.AssertGolden(
+				t,
+				"testdata/golden/"+tc.name,
+				update(tc.name),
+				tc.cs.Changeset.Metadata.(*awscodecommit.PullRequest),
+			)
+		})
+	}
+}
+
+func TestAWSCodeCommitSource_CreateChangeset(t *testing.T) {
+	testCases := []struct {
+		name   string
+		exists bool
+	}{
+		{name: "success", exists: false},
+		{name: "already-exists", exists: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		tc.name = "AWSCodeCommitSource_CreateChangeset_" + tc.name
+
+		t.Run(tc.name, func(t *testing.T) {
+			src, save := newAWSCodeCommitTestSource(t, tc.name)
+			defer save(t)
+
+			cs := &Changeset{
+				Title:     "This is a test PR",
+				Body:      "This is the body of a test PR",
+				BaseRef:   "refs/heads/master",
+				HeadRef:   "refs/heads/test-pr-acc-1",
+				Repo:      awsCodeCommitTestRepo,
+				Changeset: &btypes.Changeset{},
+			}
+
+			exists, err := src.CreateChangeset(context.Background(), cs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if exists != tc.exists {
+				t.Errorf("unexpected exists value: have=%t want=%t", exists, tc.exists)
+			}
+
+			if have, want := cs.Changeset.ExternalID, "43"; have != want {
+				t.Errorf("wrong external ID: have=%q want=%q", have, want)
+			}
+			if have, want := cs.Changeset.ExternalBranch, "refs/heads/test-pr-acc-1"; have != want {
+				t.Errorf("wrong external branch: have=%q want=%q", have, want)
+			}
+
+			testutil.AssertGolden(t, "testdata/golden/"+tc.name, update(tc.name), cs.Changeset.Metadata)
+		})
+	}
+}
+
+func TestAWSCodeCommitSource_CloseChangeset(t *testing.T) {
+	name := "AWSCodeCommitSource_CloseChangeset_success"
+	src, save := newAWSCodeCommitTestSource(t, name)
+	defer save(t)
+
+	cs := &Changeset{
+		Repo:      awsCodeCommitTestRepo,
+		Changeset: &btypes.Changeset{Metadata: &awscodecommit.PullRequest{ID: "43"}},
+	}
+
+	if err := src.CloseChangeset(context.Background(), cs); err != nil {
+		t.Fatal(err)
+	}
+
+	pr := cs.Changeset.Metadata.(*awscodecommit.PullRequest)
+	if have, want := pr.Status, awscodecommit.PullRequestStatusClosed; have != want {
+		t.Errorf("wrong status: have=%q want=%q", have, want)
+	}
+
+	testutil.AssertGolden(t, "testdata/golden/"+name, update(name), pr)
+}
+
+func TestAWSCodeCommitSource_ReopenChangeset(t *testing.T) {
+	name := "AWSCodeCommitSource_ReopenChangeset_success"
+	src, save := newAWSCodeCommitTestSource(t, name)
+	defer save(t)
+
+	cs := &Changeset{
+		Repo:      awsCodeCommitTestRepo,
+		Changeset: &btypes.Changeset{Metadata: &awscodecommit.PullRequest{ID: "43"}},
+	}
+
+	if err := src.ReopenChangeset(context.Background(), cs); err != nil {
+		t.Fatal(err)
+	}
+
+	pr := cs.Changeset.Metadata.(*awscodecommit.PullRequest)
+	if have, want := pr.Status, awscodecommit.PullRequestStatusOpen; have != want {
+		t.Errorf("wrong status: have=%q want=%q", have, want)
+	}
+
+	testutil.AssertGolden(t, "testdata/golden/"+name, update(name), pr)
+}
+
+func TestAWSCodeCommitSource_UpdateChangeset(t *testing.T) {
+	name := "AWSCodeCommitSource_UpdateChangeset_success"
+	src, save := newAWSCodeCommitTestSource(t, name)
+	defer save(t)
+
+	cs := &Changeset{
+		Title:     "This is a new title",
+		Body:      "This is a new body",
+		BaseRef:   "refs/heads/master",
+		HeadRef:   "refs/heads/test-pr-acc-1",
+		Repo:      awsCodeCommitTestRepo,
+		Changeset: &btypes.Changeset{Metadata: &awscodecommit.PullRequest{ID: "43"}},
+	}
+
+	if err := src.UpdateChangeset(context.Background(), cs); err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertGolden(t, "testdata/golden/"+name, update(name), cs.Changeset.Metadata)
+}
+
+func TestAWSCodeCommitSource_CreateComment(t *testing.T) {
+	name := "AWSCodeCommitSource_CreateComment_success"
+	src, save := newAWSCodeCommitTestSource(t, name)
+	defer save(t)
+
+	cs := &Changeset{
+		Repo: awsCodeCommitTestRepo,
+		Changeset: &btypes.Changeset{Metadata: &awscodecommit.PullRequest{
+			ID: "43",
+			Target: awscodecommit.PullRequestTarget{
+				RepositoryName:    "test",
+				SourceCommit:      "8f2b6a1d9e7c5b3a1f0e2d4c6b8a9f7e5d3c1b0a",
+				DestinationCommit: "c3e2c7e6a3d5e9b0f4a1d2c3b4a5968778695a4b",
+			},
+		}},
+	}
+
+	if err := src.CreateComment(context.Background(), cs, "test-comment"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAWSCodeCommitSource_MergeChangeset(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		name := "AWSCodeCommitSource_MergeChangeset_success"
+		src, save := newAWSCodeCommitTestSource(t, name)
+		defer save(t)
+
+		cs := &Changeset{
+			Repo: awsCodeCommitTestRepo,
+			Changeset: &btypes.Changeset{Metadata: &awscodecommit.PullRequest{
+				ID:     "43",
+				Target: awscodecommit.PullRequestTarget{RepositoryName: "test", SourceCommit: "8f2b6a1d9e7c5b3a1f0e2d4c6b8a9f7e5d3c1b0a"},
+			}},
+		}
+
+		if err := src.MergeChangeset(context.Background(), cs, true); err != nil {
+			t.Fatal(err)
+		}
+
+		pr := cs.Changeset.Metadata.(*awscodecommit.PullRequest)
+		if !pr.Target.IsMerged {
+			t.Error("pull request is not merged")
+		}
+
+		testutil.AssertGolden(t, "testdata/golden/"+name, update(name), pr)
+	})
+
+	t.Run("not-mergeable", func(t *testing.T) {
+		name := "AWSCodeCommitSource_MergeChangeset_not-mergeable"
+		src, save := newAWSCodeCommitTestSource(t, name)
+		defer save(t)
+
+		cs := &Changeset{
+			Repo: awsCodeCommitTestRepo,
+			Changeset: &btypes.Changeset{Metadata: &awscodecommit.PullRequest{
+				ID:     "42",
+				Target: awscodecommit.PullRequestTarget{RepositoryName: "test", SourceCommit: "8f2b6a1d9e7c5b3a1f0e2d4c6b8a9f7e5d3c1b0a"},
+			}},
+		}
+
+		err := src.MergeChangeset(context.Background(), cs, false)
+		if !errors.HasType(err, &ChangesetNotMergeableError{}) {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+	})
+}
+
+func TestAWSCodeCommitSource_WithAuthenticator(t *testing.T) {
+	svc := &types.ExternalService{
+		Kind: extsvc.KindAWSCodeCommit,
+		Config: marshalJSON(t, &schema.AWSCodeCommitConnection{
+			AccessKeyID:     "access-key-id",
+			SecretAccessKey: "secret-access-key",
+			Region:          "us-west-1",
+			GitCredentials: schema.AWSCodeCommitGitCredentials{
+				Username: "user",
+				Password: "password",
+			},
+		}),
+	}
+
+	accSrc, err := NewAWSCodeCommitSource(svc, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("supported", func(t *testing.T) {
+		for name, tc := range map[string]auth.Authenticator{
+			"BasicAuth":        &auth.BasicAuth{},
+			"BasicAuthWithSSH": &auth.BasicAuthWithSSH{},
+		} {
+			t.Run(name, func(t *testing.T) {
+				src, err := accSrc.WithAuthenticator(tc)
+				if err != nil {
+					t.Errorf("unexpected non-nil error: %v", err)
+				}
+
+				if as, ok := src.(*AWSCodeCommitSource); !ok {
+					t.Error("cannot coerce Source into AWSCodeCommitSource")
+				} else if as == nil {
+					t.Error("unexpected nil Source")
+				} else if as.au != tc {
+					t.Errorf("incorrect authenticator: have=%v want=%v", as.au, tc)
+				}
+			})
+		}
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		for name, tc := range map[string]auth.Authenticator{
+			"nil":              nil,
+			"OAuthBearerToken": &auth.OAuthBearerToken{},
+			"OAuthClient":      &auth.OAuthClient{},
+		} {
+			t.Run(name, func(t *testing.T) {
+				src, err := accSrc.WithAuthenticator(tc)
+				if err == nil {
+					t.Error("unexpected nil error")
+				} else if !errors.HasType(err, UnsupportedAuthenticatorError{}) {
+					t.Errorf("unexpected error of type %T: %v", err, err)
+				}
+				if src != nil {
+					t.Errorf("expected non-nil Source: %v", src)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,265 @@
+package sources
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+type BitbucketCloudSource struct {
+	client *bitbucketcloud.Client
+	au     auth.Authenticator
+}
+
+// NewBitbucketCloudSource returns a new BitbucketCloudSource from the given external service.
+func NewBitbucketCloudSource(svc *types.ExternalService, cf *httpcli.Factory) (*BitbucketCloudSource, error) {
+	var c schema.BitbucketCloudConnection
+	if err := jsonc.Unmarshal(svc.Config, &c); err != nil {
+		return nil, errors.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	return newBitbucketCloudSource(&c, cf)
+}
+
+func newBitbucketCloudSource(c *schema.BitbucketCloudConnection, cf *httpcli.Factory) (*BitbucketCloudSource, error) {
+	if c.ApiURL == "" {
+		c.ApiURL = "https://api.bitbucket.org"
+	}
+	apiURL, err := url.Parse(c.ApiURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing Bitbucket Cloud API URL")
+	}
+	apiURL = extsvc.NormalizeBaseURL(apiURL)
+
+	if cf == nil {
+		cf = httpcli.ExternalClientFactory
+	}
+
+	cli, err := cf.Doer()
+	if err != nil {
+		return nil, err
+	}
+
+	client := bitbucketcloud.NewClient(apiURL, cli)
+	client.Username = c.Username
+	client.AppPassword = c.AppPassword
+
+	return &BitbucketCloudSource{
+		client: client,
+		au:     &auth.BasicAuth{Username: c.Username, Password: c.AppPassword},
+	}, nil
+}
+
+func (s BitbucketCloudSource) GitserverPushConfig(ctx context.Context, store database.ExternalServiceStore, repo *types.Repo) (*protocol.PushConfig, error) {
+	return gitserverPushConfig(ctx, store, repo, s.au)
+}
+
+// WithAuthenticator returns a copy of the source that uses the given
+// authenticator. Bitbucket Cloud only supports a username and app password
+// combination.
+func (s BitbucketCloudSource) WithAuthenticator(a auth.Authenticator) (ChangesetSource, error) {
+	var username, password string
+	switch av := a.(type) {
+	case *auth.BasicAuth:
+		username, password = av.Username, av.Password
+	case *auth.BasicAuthWithSSH:
+		username, password = av.Username, av.Password
+	default:
+		return nil, newUnsupportedAuthenticatorError("BitbucketCloudSource", a)
+	}
+
+	return &BitbucketCloudSource{
+		client: s.client.WithCredentials(username, password),
+		au:     a,
+	}, nil
+}
+
+func (s BitbucketCloudSource) ValidateAuthenticator(ctx context.Context) error {
+	_, err := s.client.CurrentUser(ctx)
+	return err
+}
+
+// CreateChangeset creates the given *Changeset in the code host.
+func (s BitbucketCloudSource) CreateChangeset(ctx context.Context, c *Changeset) (bool, error) {
+	if err := checkReviewersAndLabels(c, "Bitbucket Cloud", false, false); err != nil {
+		return false, err
+	}
+
+	repo := c.Repo.Metadata.(*bitbucketcloud.Repo)
+	input := pullRequestInput(c)
+
+	// Bitbucket Cloud silently updates an existing pull request for the same
+	// branches instead of returning an error, so we have to look for one
+	// first to tell whether the pull request already existed.
+	existing, err := s.client.FindOpenPullRequest(ctx, repo, input.SourceBranch, input.DestinationBranch)
+	if err != nil {
+		return false, err
+	}
+
+	pr, err := s.client.CreatePullRequest(ctx, repo, input)
+	if err != nil {
+		return false, err
+	}
+
+	if err := s.loadPullRequestData(ctx, repo, pr); err != nil {
+		return false, errors.Wrap(err, "loading extra metadata")
+	}
+	if err := c.SetMetadata(pr); err != nil {
+		return false, errors.Wrap(err, "setting changeset metadata")
+	}
+
+	return existing != nil, nil
+}
+
+// CloseChangeset declines the given *Changeset on the code host and updates
+// the Metadata column in the *batches.Changeset to the newly closed pull
+// request.
+func (s BitbucketCloudSource) CloseChangeset(ctx context.Context, c *Changeset) error {
+	pr, ok := c.Changeset.Metadata.(*bitbucketcloud.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not a Bitbucket Cloud pull request")
+	}
+	repo := c.Repo.Metadata.(*bitbucketcloud.Repo)
+
+	declined, err := s.client.DeclinePullRequest(ctx, repo, pr.ID)
+	if err != nil {
+		return err
+	}
+
+	return s.setMetadata(ctx, repo, c, declined)
+}
+
+// LoadChangeset loads the latest state of the given Changeset from the codehost.
+func (s BitbucketCloudSource) LoadChangeset(ctx context.Context, cs *Changeset) error {
+	repo := cs.Repo.Metadata.(*bitbucketcloud.Repo)
+	number, err := strconv.ParseInt(cs.ExternalID, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "converting external ID")
+	}
+
+	pr, err := s.client.GetPullRequest(ctx, repo, number)
+	if err != nil {
+		if bitbucketcloud.IsNotFound(err) {
+			return ChangesetNotFoundError{Changeset: cs}
+		}
+		return err
+	}
+
+	return s.setMetadata(ctx, repo, cs, pr)
+}
+
+func (s BitbucketCloudSource) UpdateChangeset(ctx context.Context, c *Changeset) error {
+	pr, ok := c.Changeset.Metadata.(*bitbucketcloud.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not a Bitbucket Cloud pull request")
+	}
+	if err := checkReviewersAndLabels(c, "Bitbucket Cloud", false, false); err != nil {
+		return err
+	}
+	repo := c.Repo.Metadata.(*bitbucketcloud.Repo)
+
+	updated, err := s.client.UpdatePullRequest(ctx, repo, pr.ID, pullRequestInput(c))
+	if err != nil {
+		return err
+	}
+
+	return s.setMetadata(ctx, repo, c, updated)
+}
+
+// ReopenChangeset reopens the *Changeset on the code host and updates the
+// Metadata column in the *batches.Changeset.
+//
+// Bitbucket Cloud cannot reopen a declined pull request, so a new pull request
+// with the same branches is opened instead, which changes the external ID of
+// the changeset. If the pull request is still open, this updates it.
+func (s BitbucketCloudSource) ReopenChangeset(ctx context.Context, c *Changeset) error {
+	if err := checkReviewersAndLabels(c, "Bitbucket Cloud", false, false); err != nil {
+		return err
+	}
+
+	repo := c.Repo.Metadata.(*bitbucketcloud.Repo)
+
+	pr, err := s.client.CreatePullRequest(ctx, repo, pullRequestInput(c))
+	if err != nil {
+		return err
+	}
+
+	return s.setMetadata(ctx, repo, c, pr)
+}
+
+// CreateComment posts a comment on the Changeset.
+func (s BitbucketCloudSource) CreateComment(ctx context.Context, c *Changeset, text string) error {
+	pr, ok := c.Changeset.Metadata.(*bitbucketcloud.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not a Bitbucket Cloud pull request")
+	}
+	repo := c.Repo.Metadata.(*bitbucketcloud.Repo)
+
+	_, err := s.client.CreatePullRequestComment(ctx, repo, pr.ID, text)
+	return err
+}
+
+// MergeChangeset merges a Changeset on the code host, if in a mergeable state.
+// If squash is true, a squash merge is performed, otherwise the default merge
+// strategy of the repository is used.
+func (s BitbucketCloudSource) MergeChangeset(ctx context.Context, c *Changeset, squash bool) error {
+	pr, ok := c.Changeset.Metadata.(*bitbucketcloud.PullRequest)
+	if !ok {
+		return errors.New("Changeset is not a Bitbucket Cloud pull request")
+	}
+	repo := c.Repo.Metadata.(*bitbucketcloud.Repo)
+
+	var opts bitbucketcloud.MergePullRequestOpts
+	if squash {
+		strategy := bitbucketcloud.MergeStrategySquash
+		opts.MergeStrategy = &strategy
+	}
+
+	merged, err := s.client.MergePullRequest(ctx, repo, pr.ID, opts)
+	if err != nil {
+		if errors.Is(err, bitbucketcloud.ErrNotMergeable) {
+			return &ChangesetNotMergeableError{ErrorMsg: err.Error()}
+		}
+		return err
+	}
+
+	return s.setMetadata(ctx, repo, c, merged)
+}
+
+func (s BitbucketCloudSource) setMetadata(ctx context.Context, repo *bitbucketcloud.Repo, c *Changeset, pr *bitbucketcloud.PullRequest) error {
+	if err := s.loadPullRequestData(ctx, repo, pr); err != nil {
+		return errors.Wrap(err, "loading pull request data")
+	}
+	if err := c.Changeset.SetMetadata(pr); err != nil {
+		return errors.Wrap(err, "setting changeset metadata")
+	}
+	return nil
+}
+
+func (s BitbucketCloudSource) loadPullRequestData(ctx context.Context, repo *bitbucketcloud.Repo, pr *bitbucketcloud.PullRequest) error {
+	if err := s.client.LoadPullRequestStatuses(ctx, repo, pr); err != nil {
+		return errors.Wrap(err, "loading pr statuses")
+	}
+	return nil
+}
+
+func pullRequestInput(c *Changeset) bitbucketcloud.PullRequestInput {
+	return bitbucketcloud.PullRequestInput{
+		Title:             c.Title,
+		Description:       c.Body,
+		SourceBranch:      git.AbbreviateRef(c.HeadRef),
+		DestinationBranch: git.AbbreviateRef(c.BaseRef),
+	}
+}
@@ -0,0 +1,350 @@
+package sources
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/testutil"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// The test fixtures and golden files were generated against the sglocal/mux
+// repository on bitbucket.org.
+var bitbucketCloudTestRepo = &types.Repo{
+	Metadata: &bitbucketcloud.Repo{
+		Slug:     "mux",
+		FullName: "sglocal/mux",
+		UUID:     "{e1e75436-05e6-4c38-8543-9c36ec26fad1}",
+	},
+}
+
+func newBitbucketCloudTestSource(t *testing.T, name string) (*BitbucketCloudSource, func(testing.TB)) {
+	t.Helper()
+
+	cf, save := newClientFactory(t, name)
+
+	svc := &types.ExternalService{
+		Kind: extsvc.KindBitbucketCloud,
+		Config: marshalJSON(t, &schema.BitbucketCloudConnection{
+			Url:         "https://bitbucket.org",
+			Username:    os.Getenv("BITBUCKET_CLOUD_USERNAME"),
+			AppPassword: os.Getenv("BITBUCKET_CLOUD_APP_PASSWORD"),
+		}),
+	}
+
+	src, err := NewBitbucketCloudSource(svc, cf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return src, save
+}
+
+func TestBitbucketCloudSource_LoadChangeset(t *testing.T) {
+	testCases := []struct {
+		name string
+		cs   *Changeset
+		err  string
+	}{
+		{
+			name: "found",
+			cs:   &Changeset{Repo: bitbucketCloudTestRepo, Changeset: &btypes.Changeset{ExternalID: "2"}},
+		},
+		{
+			name: "not-found",
+			cs:   &Changeset{Repo: bitbucketCloudTestRepo, Changeset: &btypes.Changeset{ExternalID: "999"}},
+			err:  `Changeset with external ID 999 not found`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		tc.name = "BitbucketCloudSource_LoadChangeset_" + tc.name
+
+		t.Run(tc.name, func(t *testing.T) {
+			src, save := newBitbucketCloudTestSource(t, tc.name)
+			defer save(t)
+
+			if tc.err == "" {
+				tc.err = "<nil>"
+			}
+
+			err := src.LoadChangeset(context.Background(), tc.cs)
+			if have, want := fmt.Sprint(err), tc.err; have != want {
+				t.Errorf("error:\nhave: %q\nwant: %q", have, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			testutil.AssertGolden(
+				t,
+				"testdata/golden/"+tc.name,
+				update(tc.name),
+				tc.cs.Changeset.Metadata.(*bitbucketcloud.PullRequest),
+			)
+		})
+	}
+}
+
+func TestBitbucketCloudSource_CreateChangeset(t *testing.T) {
+	testCases := []struct {
+		name   string
+		exists bool
+	}{
+		{name: "success", exists: false},
+		{name: "exists", exists: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		tc.name = "BitbucketCloudSource_CreateChangeset_" + tc.name
+
+		t.Run(tc.name, func(t *testing.T) {
+			src, save := newBitbucketCloudTestSource(t, tc.name)
+			defer save(t)
+
+			cs := &Changeset{
+				Title:     "This is a test PR",
+				Body:      "This is the body of a test PR",
+				BaseRef:   "refs/heads/master",
+				HeadRef:   "refs/heads/test-pr-bbc-1",
+				Repo:      bitbucketCloudTestRepo,
+				Changeset: &btypes.Changeset{},
+			}
+
+			exists, err := src.CreateChangeset(context.Background(), cs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if exists != tc.exists {
+				t.Errorf("unexpected exists value: have=%v want=%v", exists, tc.exists)
+			}
+
+			pr := cs.Changeset.Metadata.(*bitbucketcloud.PullRequest)
+			if have, want := cs.Changeset.ExternalID, "3"; have != want {
+				t.Errorf("wrong external ID: have=%q want=%q", have, want)
+			}
+			if have, want := cs.Changeset.ExternalBranch, "refs/heads/test-pr-bbc-1"; have != want {
+				t.Errorf("wrong external branch: have=%q want=%q", have, want)
+			}
+
+			testutil.AssertGolden(t, "testdata/golden/"+tc.name, update(tc.name), pr)
+		})
+	}
+}
+
+func TestBitbucketCloudSource_CloseChangeset(t *testing.T) {
+	name := "BitbucketCloudSource_CloseChangeset_success"
+	src, save := newBitbucketCloudTestSource(t, name)
+	defer save(t)
+
+	cs := &Changeset{
+		Repo:      bitbucketCloudTestRepo,
+		Changeset: &btypes.Changeset{Metadata: &bitbucketcloud.PullRequest{ID: 3}},
+	}
+
+	if err := src.CloseChangeset(context.Background(), cs); err != nil {
+		t.Fatal(err)
+	}
+
+	pr := cs.Changeset.Metadata.(*bitbucketcloud.PullRequest)
+	if have, want := pr.State, bitbucketcloud.PullRequestStateDeclined; have != want {
+		t.Errorf("wrong state: have=%q want=%q", have, want)
+	}
+
+	testutil.AssertGolden(t, "testdata/golden/"+name, update(name), pr)
+}
+
+func TestBitbucketCloudSource_ReopenChangeset(t *testing.T) {
+	name := "BitbucketCloudSource_ReopenChangeset_success"
+	src, save := newBitbucketCloudTestSource(t, name)
+	defer save(t)
+
+	cs := &Changeset{
+		Title:     "This is a test PR",
+		Body:      "This is the body of a test PR",
+		BaseRef:   "refs/heads/master",
+		HeadRef:   "refs/heads/test-pr-bbc-1",
+		Repo:      bitbucketCloudTestRepo,
+		Changeset: &btypes.Changeset{ExternalID: "3", Metadata: &bitbucketcloud.PullRequest{ID: 3}},
+	}
+
+	if err := src.ReopenChangeset(context.Background(), cs); err != nil {
+		t.Fatal(err)
+	}
+
+	// Declined pull requests can't be reopened, so a new one is created.
+	if have, want := cs.Changeset.ExternalID, "4"; have != want {
+		t.Errorf("wrong external ID: have=%q want=%q", have, want)
+	}
+
+	testutil.AssertGolden(t, "testdata/golden/"+name, update(name), cs.Changeset.Metadata)
+}
+
+func TestBitbucketCloudSource_UpdateChangeset(t *testing.T) {
+	name := "BitbucketCloudSource_UpdateChangeset_success"
+	src, save := newBitbucketCloudTestSource(t, name)
+	defer save(t)
+
+	cs := &Changeset{
+		Title:     "This is a new title",
+		Body:      "This is a new body",
+		BaseRef:   "refs/heads/master",
+		HeadRef:   "refs/heads/test-pr-bbc-1",
+		Repo:      bitbucketCloudTestRepo,
+		Changeset: &btypes.Changeset{Metadata: &bitbucketcloud.PullRequest{ID: 4}},
+	}
+
+	if err := src.UpdateChangeset(context.Background(), cs); err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertGolden(t, "testdata/golden/"+name, update(name), cs.Changeset.Metadata)
+}
+
+func TestBitbucketCloudSource_UnsupportedAttributes(t *testing.T) {
+	// The attributes are checked before the code host is contacted, so no
+	// client is needed.
+	src := BitbucketCloudSource{}
+	ctx := context.Background()
+
+	cs := &Changeset{
+		Repo:      bitbucketCloudTestRepo,
+		Reviewers: []string{"alice"},
+		Changeset: &btypes.Changeset{Metadata: &bitbucketcloud.PullRequest{ID: 4}},
+	}
+	want := UnsupportedChangesetAttributeError{CodeHost: "Bitbucket Cloud", Attribute: "reviewers"}
+
+	if _, err := src.CreateChangeset(ctx, cs); err != want {
+		t.Errorf("wrong CreateChangeset error: have=%v want=%v", err, want)
+	}
+	if err := src.UpdateChangeset(ctx, cs); err != want {
+		t.Errorf("wrong UpdateChangeset error: have=%v want=%v", err, want)
+	}
+	if err := src.ReopenChangeset(ctx, cs); err != want {
+		t.Errorf("wrong ReopenChangeset error: have=%v want=%v", err, want)
+	}
+}
+
+func TestBitbucketCloudSource_CreateComment(t *testing.T) {
+	name := "BitbucketCloudSource_CreateComment_success"
+	src, save := newBitbucketCloudTestSource(t, name)
+	defer save(t)
+
+	cs := &Changeset{
+		Repo:      bitbucketCloudTestRepo,
+		Changeset: &btypes.Changeset{Metadata: &bitbucketcloud.PullRequest{ID: 4}},
+	}
+
+	if err := src.CreateComment(context.Background(), cs, "test-comment"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBitbucketCloudSource_MergeChangeset(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		name := "BitbucketCloudSource_MergeChangeset_success"
+		src, save := newBitbucketCloudTestSource(t, name)
+		defer save(t)
+
+		cs := &Changeset{
+			Repo:      bitbucketCloudTestRepo,
+			Changeset: &btypes.Changeset{Metadata: &bitbucketcloud.PullRequest{ID: 4}},
+		}
+
+		if err := src.MergeChangeset(context.Background(), cs, true); err != nil {
+			t.Fatal(err)
+		}
+
+		pr := cs.Changeset.Metadata.(*bitbucketcloud.PullRequest)
+		if have, want := pr.State, bitbucketcloud.PullRequestStateMerged; have != want {
+			t.Errorf("wrong state: have=%q want=%q", have, want)
+		}
+
+		testutil.AssertGolden(t, "testdata/golden/"+name, update(name), pr)
+	})
+
+	t.Run("not-mergeable", func(t *testing.T) {
+		name := "BitbucketCloudSource_MergeChangeset_not-mergeable"
+		src, save := newBitbucketCloudTestSource(t, name)
+		defer save(t)
+
+		cs := &Changeset{
+			Repo:      bitbucketCloudTestRepo,
+			Changeset: &btypes.Changeset{Metadata: &bitbucketcloud.PullRequest{ID: 2}},
+		}
+
+		err := src.MergeChangeset(context.Background(), cs, false)
+		if !errors.HasType(err, &ChangesetNotMergeableError{}) {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+	})
+}
+
+func TestBitbucketCloudSource_WithAuthenticator(t *testing.T) {
+	svc := &types.ExternalService{
+		Kind: extsvc.KindBitbucketCloud,
+		Config: marshalJSON(t, &schema.BitbucketCloudConnection{
+			Url:         "https://bitbucket.org",
+			Username:    "user",
+			AppPassword: "password",
+		}),
+	}
+
+	bbcSrc, err := NewBitbucketCloudSource(svc, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("supported", func(t *testing.T) {
+		for name, tc := range map[string]auth.Authenticator{
+			"BasicAuth":        &auth.BasicAuth{},
+			"BasicAuthWithSSH": &auth.BasicAuthWithSSH{},
+		} {
+			t.Run(name, func(t *testing.T) {
+				src, err := bbcSrc.WithAuthenticator(tc)
+				if err != nil {
+					t.Errorf("unexpected non-nil error: %v", err)
+				}
+
+				if bs, ok := src.(*BitbucketCloudSource); !ok {
+					t.Error("cannot coerce Source into BitbucketCloudSource")
+				} else if bs == nil {
+					t.Error("unexpected nil Source")
+				} else if bs.au != tc {
+					t.Errorf("incorrect authenticator: have=%v want=%v", bs.au, tc)
+				}
+			})
+		}
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		for name, tc := range map[string]auth.Authenticator{
+			"nil":              nil,
+			"OAuthBearerToken": &auth.OAuthBearerToken{},
+			"OAuthClient":      &auth.OAuthClient{},
+		} {
+			t.Run(name, func(t *testing.T) {
+				src, err := bbcSrc.WithAuthenticator(tc)
+				if err == nil {
+					t.Error("unexpected nil error")
+				} else if !errors.HasType(err, UnsupportedAuthenticatorError{}) {
+					t.Errorf("unexpected error of type %T: %v", err, err)
+				}
+				if src != nil {
+					t.Errorf("expected non-nil Source: %v", src)
+				}
+			})
+		}
+	})
+}
@@ -101,9 +101,16 @@ func (s BitbucketServerSource) ValidateAuthenticator(ctx context.Context) error
 func (s BitbucketServerSource) CreateChangeset(ctx context.Context, c *Changeset) (bool, error) {
 	var exists bool
 
+	if err := checkReviewersAndLabels(c, "Bitbucket Server", true, false); err != nil {
+		return exists, err
+	}
+
 	repo := c.Repo.Metadata.(*bitbucketserver.Repo)
 
 	pr := &bitbucketserver.PullRequest{Title: c.Title, Description: c.Body}
+	for _, r := range c.Reviewers {
+		pr.Reviewers = append(pr.Reviewers, bitbucketserver.Reviewer{User: &bitbucketserver.User{Name: r}})
+	}
 
 	pr.ToRef.Repository.Slug = repo.Slug
 	pr.ToRef.Repository.ID = repo.ID
@@ -210,12 +217,35 @@ func (s BitbucketServerSource) UpdateChangeset(ctx context.Context, c *Changeset
 		return errors.New("Changeset is not a Bitbucket Server pull request")
 	}
 
+	if err := checkReviewersAndLabels(c, "Bitbucket Server", true, false); err != nil {
+		return err
+	}
+
 	update := &bitbucketserver.UpdatePullRequestInput{
 		PullRequestID: strconv.Itoa(pr.ID),
 		Title:         c.Title,
 		Description:   c.Body,
 		Version:       pr.Version,
 	}
+
+	// Bitbucket Server replaces the reviewers of a pull request when it's
+	// updated, so the requested reviewers are added to the current ones.
+	seen := make(map[string]struct{}, len(pr.Reviewers)+len(c.Reviewers))
+	addReviewer := func(name string) {
+		if _, ok := seen[name]; ok || name == "" {
+			return
+		}
+		seen[name] = struct{}{}
+		update.Reviewers = append(update.Reviewers, bitbucketserver.UpdatePullRequestReviewer{Name: name})
+	}
+	for _, r := range pr.Reviewers {
+		if r.User != nil {
+			addReviewer(r.User.Name)
+		}
+	}
+	for _, name := range c.Reviewers {
+		addReviewer(name)
+	}
 	update.ToRef.ID = c.BaseRef
 	update.ToRef.Repository.Slug = pr.ToRef.Repository.Slug
 	update.ToRef.Repository.Project.Key = pr.ToRef.Repository.Project.Key
@@ -3,10 +3,15 @@ package sources
 import (
 	"context"
 	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
 
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
@@ -37,6 +42,17 @@ type DraftChangesetSource interface {
 	UndraftChangeset(context.Context, *Changeset) error
 }
 
+// A ForkableChangesetSource can push the changes of a changeset to a fork of
+// the target repository, and open a changeset from the fork.
+type ForkableChangesetSource interface {
+	ChangesetSource
+
+	// GetUserFork returns a repo pointing to the fork of the given repository
+	// in the namespace of the currently authenticated user. If the fork
+	// doesn't exist yet, it is created.
+	GetUserFork(ctx context.Context, targetRepo *types.Repo) (*types.Repo, error)
+}
+
 // A ChangesetSource can load the latest state of a list of Changesets.
 type ChangesetSource interface {
 	// GitserverPushConfig returns an authenticated push config used for pushing
@@ -90,6 +106,33 @@ func (e ChangesetNotMergeableError) Error() string {
 
 func (e ChangesetNotMergeableError) NonRetryable() bool { return true }
 
+// UnsupportedChangesetAttributeError is returned by CreateChangeset and
+// UpdateChangeset if the changeset requests an attribute, such as reviewers or
+// labels, that the code host doesn't support.
+type UnsupportedChangesetAttributeError struct {
+	CodeHost  string
+	Attribute string
+}
+
+func (e UnsupportedChangesetAttributeError) Error() string {
+	return fmt.Sprintf("%s changesets don't support %s", e.CodeHost, e.Attribute)
+}
+
+func (e UnsupportedChangesetAttributeError) NonRetryable() bool { return true }
+
+// checkReviewersAndLabels returns an UnsupportedChangesetAttributeError if the
+// changeset requests reviewers or labels and the code host doesn't support
+// them.
+func checkReviewersAndLabels(c *Changeset, codeHost string, supportsReviewers, supportsLabels bool) error {
+	if len(c.Reviewers) > 0 && !supportsReviewers {
+		return UnsupportedChangesetAttributeError{CodeHost: codeHost, Attribute: "reviewers"}
+	}
+	if len(c.Labels) > 0 && !supportsLabels {
+		return UnsupportedChangesetAttributeError{CodeHost: codeHost, Attribute: "labels"}
+	}
+	return nil
+}
+
 // A Changeset of an existing Repo.
 type Changeset struct {
 	Title   string
@@ -97,8 +140,53 @@ type Changeset struct {
 	HeadRef string
 	BaseRef string
 
+	// Reviewers are the usernames of the users to request reviews from, and
+	// Labels the labels to add to the changeset. Sources of code hosts that
+	// don't support them return an UnsupportedChangesetAttributeError.
+	Reviewers []string
+	Labels    []string
+
 	*btypes.Changeset
 	*types.Repo
+
+	// RemoteRepo is the repository the head ref was pushed to. If it's nil,
+	// the head ref was pushed to Repo itself, otherwise it's a fork of Repo.
+	RemoteRepo *types.Repo
+}
+
+// copyRepoAsFork returns a shallow copy of the given repository, with its
+// code host metadata replaced by the metadata of the given fork.
+//
+// Forks are generally not synced to Sourcegraph, so the returned repo keeps
+// the ID, name and sources of the original repository. It must only be used to
+// build the push config for the fork and to open changesets from it.
+func copyRepoAsFork(repo *types.Repo, fork interface{}) *types.Repo {
+	forkRepo := *repo
+	forkRepo.Metadata = fork
+	return &forkRepo
+}
+
+// ForkNamespace returns the namespace the given fork repository, as returned
+// by ForkableChangesetSource.GetUserFork, lives in.
+func ForkNamespace(fork *types.Repo) (string, error) {
+	switch m := fork.Metadata.(type) {
+	case *github.Repository:
+		owner, _, err := github.SplitRepositoryNameWithOwner(m.NameWithOwner)
+		if err != nil {
+			return "", errors.Wrap(err, "getting fork owner")
+		}
+		return owner, nil
+
+	case *gitlab.Project:
+		i := strings.LastIndex(m.PathWithNamespace, "/")
+		if i < 0 {
+			return "", errors.Errorf("invalid project path %q", m.PathWithNamespace)
+		}
+		return m.PathWithNamespace[:i], nil
+
+	default:
+		return "", errors.Errorf("forks are not supported for repo metadata of type %T", fork.Metadata)
+	}
 }
 
 // IsOutdated returns true when the attributes of the nested
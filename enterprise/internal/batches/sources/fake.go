@@ -51,6 +51,7 @@ type FakeChangesetSource struct {
 	AuthenticatedUsernameCalled bool
 	ValidateAuthenticatorCalled bool
 	MergeChangesetCalled        bool
+	GetUserForkCalled           bool
 
 	// The Changeset.HeadRef to be expected in CreateChangeset/UpdateChangeset calls.
 	WantHeadRef string
@@ -93,10 +94,14 @@ type FakeChangesetSource struct {
 
 	// Username is the username returned by AuthenticatedUsername
 	Username string
+
+	// UserFork is the repo returned by GetUserFork.
+	UserFork *types.Repo
 }
 
 var _ ChangesetSource = &FakeChangesetSource{}
 var _ DraftChangesetSource = &FakeChangesetSource{}
+var _ ForkableChangesetSource = &FakeChangesetSource{}
 
 func (s *FakeChangesetSource) CreateDraftChangeset(ctx context.Context, c *Changeset) (bool, error) {
 	s.CreateDraftChangesetCalled = true
@@ -283,3 +288,17 @@ func (s *FakeChangesetSource) MergeChangeset(ctx context.Context, c *Changeset,
 	s.MergeChangesetCalled = true
 	return s.Err
 }
+
+func (s *FakeChangesetSource) GetUserFork(ctx context.Context, targetRepo *types.Repo) (*types.Repo, error) {
+	s.GetUserForkCalled = true
+
+	if s.Err != nil {
+		return nil, s.Err
+	}
+
+	if s.UserFork == nil {
+		return nil, errors.New("no user fork configured")
+	}
+
+	return s.UserFork, nil
+}
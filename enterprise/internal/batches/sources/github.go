@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -21,10 +22,13 @@ import (
 )
 
 type GithubSource struct {
-	client *github.V4Client
-	au     auth.Authenticator
+	client   *github.V4Client
+	v3Client *github.V3Client
+	au       auth.Authenticator
 }
 
+var _ ForkableChangesetSource = &GithubSource{}
+
 func NewGithubSource(svc *types.ExternalService, cf *httpcli.Factory) (*GithubSource, error) {
 	var c schema.GitHubConnection
 	if err := jsonc.Unmarshal(svc.Config, &c); err != nil {
@@ -67,8 +71,9 @@ func newGithubSource(c *schema.GitHubConnection, cf *httpcli.Factory, au auth.Au
 	}
 
 	return &GithubSource{
-		au:     authr,
-		client: github.NewV4Client(apiURL, authr, cli),
+		au:       authr,
+		client:   github.NewV4Client(apiURL, authr, cli),
+		v3Client: github.NewV3Client(apiURL, authr, cli),
 	}, nil
 }
 
@@ -89,6 +94,7 @@ func (s GithubSource) WithAuthenticator(a auth.Authenticator) (ChangesetSource,
 	sc := s
 	sc.au = a
 	sc.client = sc.client.WithAuthenticator(a)
+	sc.v3Client = sc.v3Client.WithAuthenticator(a)
 
 	return &sc, nil
 }
@@ -98,27 +104,69 @@ func (s GithubSource) ValidateAuthenticator(ctx context.Context) error {
 	return err
 }
 
+// GetUserFork returns a repo pointing to the fork of the given repository in
+// the namespace of the authenticated user. The fork is created if it doesn't
+// exist yet.
+func (s GithubSource) GetUserFork(ctx context.Context, targetRepo *types.Repo) (*types.Repo, error) {
+	repo, ok := targetRepo.Metadata.(*github.Repository)
+	if !ok {
+		return nil, errors.New("target repo is not a GitHub repo")
+	}
+
+	owner, name, err := github.SplitRepositoryNameWithOwner(repo.NameWithOwner)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting repo owner and name")
+	}
+
+	// GitHub returns the existing fork if the repository has already been
+	// forked into the user's namespace.
+	fork, err := s.v3Client.Fork(ctx, owner, name, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "forking repository")
+	}
+
+	return copyRepoAsFork(targetRepo, fork), nil
+}
+
 // CreateChangeset creates the given changeset on the code host.
 func (s GithubSource) CreateChangeset(ctx context.Context, c *Changeset) (bool, error) {
-	input := buildCreatePullRequestInput(c)
+	input, err := buildCreatePullRequestInput(c)
+	if err != nil {
+		return false, err
+	}
 	return s.createChangeset(ctx, c, input)
 }
 
 // CreateDraftChangeset creates the given changeset on the code host in draft mode.
 func (s GithubSource) CreateDraftChangeset(ctx context.Context, c *Changeset) (bool, error) {
-	input := buildCreatePullRequestInput(c)
+	input, err := buildCreatePullRequestInput(c)
+	if err != nil {
+		return false, err
+	}
 	input.Draft = true
 	return s.createChangeset(ctx, c, input)
 }
 
-func buildCreatePullRequestInput(c *Changeset) *github.CreatePullRequestInput {
+func buildCreatePullRequestInput(c *Changeset) (*github.CreatePullRequestInput, error) {
+	headRef := git.AbbreviateRef(c.HeadRef)
+
+	// Cross-repository pull requests need the head ref to be namespaced with
+	// the owner of the fork.
+	if c.RemoteRepo != nil {
+		namespace, err := ForkNamespace(c.RemoteRepo)
+		if err != nil {
+			return nil, err
+		}
+		headRef = namespace + ":" + headRef
+	}
+
 	return &github.CreatePullRequestInput{
 		RepositoryID: c.Repo.Metadata.(*github.Repository).ID,
 		Title:        c.Title,
 		Body:         c.Body,
-		HeadRefName:  git.AbbreviateRef(c.HeadRef),
+		HeadRefName:  headRef,
 		BaseRefName:  git.AbbreviateRef(c.BaseRef),
-	}
+	}, nil
 }
 
 func (s GithubSource) createChangeset(ctx context.Context, c *Changeset, prInput *github.CreatePullRequestInput) (bool, error) {
@@ -133,13 +181,17 @@ func (s GithubSource) createChangeset(ctx context.Context, c *Changeset, prInput
 		if err != nil {
 			return exists, errors.Wrap(err, "getting repo owner and name")
 		}
-		pr, err = s.client.GetOpenPullRequestByRefs(ctx, owner, name, c.BaseRef, c.HeadRef)
+		pr, err = s.client.GetOpenPullRequestByRefs(ctx, owner, name, c.BaseRef, prInput.HeadRefName)
 		if err != nil {
 			return exists, errors.Wrap(err, "fetching existing PR")
 		}
 		exists = true
 	}
 
+	if err := s.requestReviewersAndAddLabels(ctx, c, pr); err != nil {
+		return exists, err
+	}
+
 	if err := c.SetMetadata(pr); err != nil {
 		return false, errors.Wrap(err, "setting changeset metadata")
 	}
@@ -147,6 +199,80 @@ func (s GithubSource) createChangeset(ctx context.Context, c *Changeset, prInput
 	return exists, nil
 }
 
+// requestReviewersAndAddLabels requests reviews from the reviewers of the
+// changeset and adds its labels to the given pull request. Reviewers that
+// already reviewed the pull request or were already requested are skipped, so
+// that we don't ask them again every time the changeset is updated.
+func (s GithubSource) requestReviewersAndAddLabels(ctx context.Context, c *Changeset, pr *github.PullRequest) error {
+	reviewers := missingGitHubReviewers(pr, c.Reviewers)
+	labels := missingGitHubLabels(pr, c.Labels)
+	if len(reviewers) == 0 && len(labels) == 0 {
+		return nil
+	}
+
+	owner, name, err := github.SplitRepositoryNameWithOwner(c.Repo.Metadata.(*github.Repository).NameWithOwner)
+	if err != nil {
+		return errors.Wrap(err, "getting repo owner and name")
+	}
+
+	if len(reviewers) > 0 {
+		if err := s.v3Client.RequestReviewers(ctx, owner, name, pr.Number, reviewers); err != nil {
+			return errors.Wrap(err, "requesting reviewers")
+		}
+	}
+	if len(labels) > 0 {
+		if err := s.v3Client.AddLabels(ctx, owner, name, pr.Number, labels); err != nil {
+			return errors.Wrap(err, "adding labels")
+		}
+	}
+	return nil
+}
+
+func missingGitHubReviewers(pr *github.PullRequest, reviewers []string) []string {
+	existing := make(map[string]struct{})
+	for _, item := range pr.TimelineItems {
+		switch e := item.Item.(type) {
+		case *github.PullRequestReview:
+			existing[strings.ToLower(e.Author.Login)] = struct{}{}
+		case *github.ReviewRequestedEvent:
+			if e.RequestedReviewer.Login != "" {
+				existing[strings.ToLower(e.RequestedReviewer.Login)] = struct{}{}
+			}
+			if e.RequestedTeam.Name != "" {
+				existing[strings.ToLower(e.RequestedTeam.Name)] = struct{}{}
+			}
+		}
+	}
+
+	var missing []string
+	for _, r := range reviewers {
+		// Teams are given as org/team, but only their name is in the timeline.
+		key := r
+		if i := strings.Index(r, "/"); i >= 0 {
+			key = r[i+1:]
+		}
+		if _, ok := existing[strings.ToLower(key)]; !ok {
+			missing = append(missing, r)
+		}
+	}
+	return missing
+}
+
+func missingGitHubLabels(pr *github.PullRequest, labels []string) []string {
+	existing := make(map[string]struct{}, len(pr.Labels.Nodes))
+	for _, l := range pr.Labels.Nodes {
+		existing[strings.ToLower(l.Name)] = struct{}{}
+	}
+
+	var missing []string
+	for _, l := range labels {
+		if _, ok := existing[strings.ToLower(l)]; !ok {
+			missing = append(missing, l)
+		}
+	}
+	return missing
+}
+
 // CloseChangeset closes the given *Changeset on the code host and updates the
 // Metadata column in the *batches.Changeset to the newly closed pull request.
 func (s GithubSource) CloseChangeset(ctx context.Context, c *Changeset) error {
@@ -223,6 +349,10 @@ func (s GithubSource) UpdateChangeset(ctx context.Context, c *Changeset) error {
 		return err
 	}
 
+	if err := s.requestReviewersAndAddLabels(ctx, c, updated); err != nil {
+		return err
+	}
+
 	return c.Changeset.SetMetadata(updated)
 }
 
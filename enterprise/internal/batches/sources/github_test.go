@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/cockroachdb/errors"
+	"github.com/google/go-cmp/cmp"
 	"github.com/inconshreveable/log15"
 
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
@@ -498,3 +499,68 @@ func TestGithubSource_WithAuthenticator(t *testing.T) {
 		}
 	})
 }
+
+func TestGithubSource_buildCreatePullRequestInput(t *testing.T) {
+	repo := &types.Repo{
+		Metadata: &github.Repository{
+			ID:            "MDEwOlJlcG9zaXRvcnkyMjExNDc1MTM=",
+			NameWithOwner: "sourcegraph/automation-testing",
+		},
+	}
+
+	t.Run("same repository", func(t *testing.T) {
+		input, err := buildCreatePullRequestInput(&Changeset{
+			Repo:    repo,
+			HeadRef: "refs/heads/always-open-pr",
+			BaseRef: "refs/heads/master",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have, want := input.HeadRefName, "always-open-pr"; have != want {
+			t.Errorf("unexpected head ref: have %q; want %q", have, want)
+		}
+	})
+
+	t.Run("fork", func(t *testing.T) {
+		input, err := buildCreatePullRequestInput(&Changeset{
+			Repo: repo,
+			RemoteRepo: copyRepoAsFork(repo, &github.Repository{
+				ID:            "fork",
+				NameWithOwner: "user/automation-testing",
+			}),
+			HeadRef: "refs/heads/always-open-pr",
+			BaseRef: "refs/heads/master",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have, want := input.HeadRefName, "user:always-open-pr"; have != want {
+			t.Errorf("unexpected head ref: have %q; want %q", have, want)
+		}
+		if have, want := input.RepositoryID, "MDEwOlJlcG9zaXRvcnkyMjExNDc1MTM="; have != want {
+			t.Errorf("pull request not opened against the target repository: have %q; want %q", have, want)
+		}
+	})
+}
+
+func TestGithubSource_missingReviewersAndLabels(t *testing.T) {
+	pr := &github.PullRequest{
+		TimelineItems: []github.TimelineItem{
+			{Type: "PullRequestReview", Item: &github.PullRequestReview{Author: github.Actor{Login: "alice"}}},
+			{Type: "ReviewRequestedEvent", Item: &github.ReviewRequestedEvent{RequestedReviewer: github.Actor{Login: "Bob"}}},
+			{Type: "ReviewRequestedEvent", Item: &github.ReviewRequestedEvent{RequestedTeam: github.Team{Name: "backend"}}},
+		},
+	}
+	pr.Labels.Nodes = []github.Label{{Name: "Batch-Change"}}
+
+	reviewers := missingGitHubReviewers(pr, []string{"alice", "bob", "carol", "org/backend", "org/frontend"})
+	if diff := cmp.Diff([]string{"carol", "org/frontend"}, reviewers); diff != "" {
+		t.Errorf("unexpected reviewers (-want +got):\n%s", diff)
+	}
+
+	labels := missingGitHubLabels(pr, []string{"batch-change", "automated"})
+	if diff := cmp.Diff([]string{"automated"}, labels); diff != "" {
+		t.Errorf("unexpected labels (-want +got):\n%s", diff)
+	}
+}
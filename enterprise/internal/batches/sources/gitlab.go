@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 
@@ -26,6 +27,7 @@ type GitLabSource struct {
 
 var _ ChangesetSource = &GitLabSource{}
 var _ DraftChangesetSource = &GitLabSource{}
+var _ ForkableChangesetSource = &GitLabSource{}
 
 // NewGitLabSource returns a new GitLabSource from the given external service.
 func NewGitLabSource(svc *types.ExternalService, cf *httpcli.Factory) (*GitLabSource, error) {
@@ -102,6 +104,47 @@ func (s GitLabSource) ValidateAuthenticator(ctx context.Context) error {
 	return s.client.ValidateToken(ctx)
 }
 
+// GetUserFork returns a repo pointing to the fork of the given project in the
+// namespace of the authenticated user. The fork is created if it doesn't exist
+// yet.
+func (s *GitLabSource) GetUserFork(ctx context.Context, targetRepo *types.Repo) (*types.Repo, error) {
+	project, ok := targetRepo.Metadata.(*gitlab.Project)
+	if !ok {
+		return nil, errors.New("target repo is not a GitLab project")
+	}
+
+	user, err := s.client.GetUser(ctx, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "getting the authenticated user")
+	}
+
+	// GitLab doesn't return the existing fork when forking a project twice,
+	// so we have to look for it first. The fork may have been renamed, so we
+	// ask the API for the forks in the user's namespace rather than guessing
+	// its path.
+	forks, err := s.client.ListOwnedForks(ctx, project)
+	if err != nil {
+		return nil, errors.Wrap(err, "looking for an existing fork")
+	}
+
+	var fork *gitlab.Project
+	for _, f := range forks {
+		if strings.HasPrefix(f.PathWithNamespace, user.Username+"/") {
+			fork = f
+			break
+		}
+	}
+
+	if fork == nil {
+		fork, err = s.client.ForkProject(ctx, project, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "forking project")
+		}
+	}
+
+	return copyRepoAsFork(targetRepo, fork), nil
+}
+
 // CreateChangeset creates a GitLab merge request. If it already exists,
 // *Changeset will be populated and the return value will be true.
 func (s *GitLabSource) CreateChangeset(ctx context.Context, c *Changeset) (bool, error) {
@@ -110,12 +153,29 @@ func (s *GitLabSource) CreateChangeset(ctx context.Context, c *Changeset) (bool,
 	source := git.AbbreviateRef(c.HeadRef)
 	target := git.AbbreviateRef(c.BaseRef)
 
-	mr, err := s.client.CreateMergeRequest(ctx, project, gitlab.CreateMergeRequestOpts{
+	reviewerIDs, err := s.reviewerIDs(ctx, c.Reviewers)
+	if err != nil {
+		return exists, err
+	}
+
+	opts := gitlab.CreateMergeRequestOpts{
 		SourceBranch: source,
 		TargetBranch: target,
 		Title:        c.Title,
 		Description:  c.Body,
-	})
+		Labels:       strings.Join(c.Labels, ","),
+		ReviewerIDs:  reviewerIDs,
+	}
+
+	// Merge requests from a fork are opened on the fork, targeting the
+	// original project.
+	sourceProject := project
+	if c.RemoteRepo != nil {
+		sourceProject = c.RemoteRepo.Metadata.(*gitlab.Project)
+		opts.TargetProjectID = project.ID
+	}
+
+	mr, err := s.client.CreateMergeRequest(ctx, sourceProject, opts)
 	if err != nil {
 		if err == gitlab.ErrMergeRequestAlreadyExists {
 			exists = true
@@ -140,6 +200,40 @@ func (s *GitLabSource) CreateChangeset(ctx context.Context, c *Changeset) (bool,
 	return exists, nil
 }
 
+// reviewerIDs returns the IDs of the users with the given usernames. Reviewers
+// that aren't GitLab users, such as groups listed as code owners, are skipped.
+func (s *GitLabSource) reviewerIDs(ctx context.Context, usernames []string) ([]int32, error) {
+	var ids []int32
+	for _, username := range usernames {
+		if strings.Contains(username, "/") {
+			continue
+		}
+		user, err := s.client.GetUserByUsername(ctx, username)
+		if err != nil {
+			return nil, errors.Wrapf(err, "looking up reviewer %q", username)
+		}
+		if user != nil {
+			ids = append(ids, user.ID)
+		}
+	}
+	return ids, nil
+}
+
+func missingGitLabReviewers(mr *gitlab.MergeRequest, reviewers []string) []string {
+	existing := make(map[string]struct{}, len(mr.Reviewers))
+	for _, r := range mr.Reviewers {
+		existing[strings.ToLower(r.Username)] = struct{}{}
+	}
+
+	var missing []string
+	for _, r := range reviewers {
+		if _, ok := existing[strings.ToLower(r)]; !ok {
+			missing = append(missing, r)
+		}
+	}
+	return missing
+}
+
 // CreateDraftChangeset creates a GitLab merge request. If it already exists,
 // *Changeset will be populated and the return value will be true.
 func (s *GitLabSource) CreateDraftChangeset(ctx context.Context, c *Changeset) (bool, error) {
@@ -399,11 +493,29 @@ func (s *GitLabSource) UpdateChangeset(ctx context.Context, c *Changeset) error
 		title = gitlab.SetWIP(c.Title)
 	}
 
-	updated, err := s.client.UpdateMergeRequest(ctx, project, mr, gitlab.UpdateMergeRequestOpts{
+	opts := gitlab.UpdateMergeRequestOpts{
 		Title:        title,
 		Description:  c.Body,
 		TargetBranch: git.AbbreviateRef(c.BaseRef),
-	})
+		AddLabels:    strings.Join(c.Labels, ","),
+	}
+
+	// GitLab replaces the reviewers of a merge request on update, so we need
+	// to send the current ones along with the ones that are missing.
+	if missing := missingGitLabReviewers(mr, c.Reviewers); len(missing) > 0 {
+		ids, err := s.reviewerIDs(ctx, missing)
+		if err != nil {
+			return err
+		}
+		if len(ids) > 0 {
+			for _, r := range mr.Reviewers {
+				opts.ReviewerIDs = append(opts.ReviewerIDs, r.ID)
+			}
+			opts.ReviewerIDs = append(opts.ReviewerIDs, ids...)
+		}
+	}
+
+	updated, err := s.client.UpdateMergeRequest(ctx, project, mr, opts)
 	if err != nil {
 		return errors.Wrap(err, "updating GitLab merge request")
 	}
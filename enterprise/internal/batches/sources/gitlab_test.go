@@ -122,6 +122,68 @@ func TestGitLabSource_ChangesetSource(t *testing.T) {
 		})
 	})
 
+	t.Run("CreateChangeset from fork", func(t *testing.T) {
+		p := newGitLabChangesetSourceTestProvider(t)
+		project := p.changeset.Repo.Metadata.(*gitlab.Project)
+		project.ID = 1
+		fork := &gitlab.Project{ProjectCommon: gitlab.ProjectCommon{ID: 2, PathWithNamespace: "user/repo"}}
+		p.changeset.RemoteRepo = &types.Repo{Metadata: fork}
+
+		gitlab.MockCreateMergeRequest = func(client *gitlab.Client, ctx context.Context, have *gitlab.Project, opts gitlab.CreateMergeRequestOpts) (*gitlab.MergeRequest, error) {
+			if have != fork {
+				t.Errorf("merge request not created on fork: have %+v; want %+v", have, fork)
+			}
+			if want := project.ID; opts.TargetProjectID != want {
+				t.Errorf("unexpected TargetProjectID: have %d; want %d", opts.TargetProjectID, want)
+			}
+			return p.mr, nil
+		}
+		p.mockGetMergeRequestNotes(p.mr.IID, nil, 20, nil)
+		p.mockGetMergeRequestResourceStateEvents(p.mr.IID, nil, 20, nil)
+		p.mockGetMergeRequestPipelines(p.mr.IID, nil, 20, nil)
+
+		exists, err := p.source.CreateChangeset(p.ctx, p.changeset)
+		if exists {
+			t.Errorf("unexpected exists value: %v", exists)
+		}
+		if err != nil {
+			t.Errorf("unexpected non-nil err: %+v", err)
+		}
+
+		if p.changeset.Changeset.Metadata != p.mr {
+			t.Errorf("unexpected metadata: have %+v; want %+v", p.changeset.Changeset.Metadata, p.mr)
+		}
+	})
+
+	t.Run("CreateChangeset with reviewers and labels", func(t *testing.T) {
+		p := newGitLabChangesetSourceTestProvider(t)
+		p.changeset.Reviewers = []string{"alice", "ghost", "org/team"}
+		p.changeset.Labels = []string{"batch-change", "automated"}
+
+		gitlab.MockListUsers = func(c *gitlab.Client, ctx context.Context, urlStr string) ([]*gitlab.User, *string, error) {
+			if urlStr == "users?username=alice" {
+				return []*gitlab.User{{ID: 1, Username: "alice"}}, nil, nil
+			}
+			return nil, nil, nil
+		}
+		gitlab.MockCreateMergeRequest = func(client *gitlab.Client, ctx context.Context, project *gitlab.Project, opts gitlab.CreateMergeRequestOpts) (*gitlab.MergeRequest, error) {
+			if want := "batch-change,automated"; opts.Labels != want {
+				t.Errorf("unexpected Labels: have %q; want %q", opts.Labels, want)
+			}
+			if diff := cmp.Diff([]int32{1}, opts.ReviewerIDs); diff != "" {
+				t.Errorf("unexpected ReviewerIDs (-want +got):\n%s", diff)
+			}
+			return p.mr, nil
+		}
+		p.mockGetMergeRequestNotes(p.mr.IID, nil, 20, nil)
+		p.mockGetMergeRequestResourceStateEvents(p.mr.IID, nil, 20, nil)
+		p.mockGetMergeRequestPipelines(p.mr.IID, nil, 20, nil)
+
+		if _, err := p.source.CreateChangeset(p.ctx, p.changeset); err != nil {
+			t.Errorf("unexpected non-nil err: %+v", err)
+		}
+	})
+
 	t.Run("CloseChangeset", func(t *testing.T) {
 		t.Run("invalid metadata", func(t *testing.T) {
 			defer func() { _ = recover() }()
@@ -750,6 +812,102 @@ func TestGitLabSource_ChangesetSource(t *testing.T) {
 	})
 }
 
+func TestGitLabSource_GetUserFork(t *testing.T) {
+	target := &gitlab.Project{ProjectCommon: gitlab.ProjectCommon{ID: 1, PathWithNamespace: "org/repo"}}
+	targetRepo := &types.Repo{Name: "gitlab.com/org/repo", Metadata: target}
+
+	mockUser := func(t *testing.T) {
+		gitlab.MockGetUser = func(c *gitlab.Client, ctx context.Context, id string) (*gitlab.User, error) {
+			if id != "" {
+				t.Errorf("unexpected user ID: %q", id)
+			}
+			return &gitlab.User{Username: "user"}, nil
+		}
+	}
+
+	t.Run("fork exists", func(t *testing.T) {
+		p := newGitLabChangesetSourceTestProvider(t)
+		mockUser(t)
+
+		fork := &gitlab.Project{
+			ProjectCommon:     gitlab.ProjectCommon{ID: 3, PathWithNamespace: "user/renamed-repo"},
+			ForkedFromProject: &target.ProjectCommon,
+		}
+		gitlab.MockListOwnedForks = func(c *gitlab.Client, ctx context.Context, project *gitlab.Project) ([]*gitlab.Project, error) {
+			if project != target {
+				t.Errorf("unexpected project: have %+v; want %+v", project, target)
+			}
+			return []*gitlab.Project{
+				{ProjectCommon: gitlab.ProjectCommon{ID: 2, PathWithNamespace: "group/repo"}},
+				fork,
+			}, nil
+		}
+		gitlab.MockForkProject = func(c *gitlab.Client, ctx context.Context, project *gitlab.Project, namespace *string) (*gitlab.Project, error) {
+			t.Fatal("unexpected call to ForkProject")
+			return nil, nil
+		}
+
+		repo, err := p.source.GetUserFork(p.ctx, targetRepo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if repo.Metadata != fork {
+			t.Errorf("unexpected fork metadata: have %+v; want %+v", repo.Metadata, fork)
+		}
+		if repo.Name != targetRepo.Name {
+			t.Errorf("unexpected fork name: have %q; want %q", repo.Name, targetRepo.Name)
+		}
+		if targetRepo.Metadata != target {
+			t.Error("target repo was modified")
+		}
+	})
+
+	t.Run("fork is created", func(t *testing.T) {
+		p := newGitLabChangesetSourceTestProvider(t)
+		mockUser(t)
+
+		fork := &gitlab.Project{
+			ProjectCommon:     gitlab.ProjectCommon{ID: 2, PathWithNamespace: "user/repo"},
+			ForkedFromProject: &target.ProjectCommon,
+		}
+		gitlab.MockListOwnedForks = func(c *gitlab.Client, ctx context.Context, project *gitlab.Project) ([]*gitlab.Project, error) {
+			return []*gitlab.Project{
+				{ProjectCommon: gitlab.ProjectCommon{ID: 3, PathWithNamespace: "group/repo"}},
+			}, nil
+		}
+		gitlab.MockForkProject = func(c *gitlab.Client, ctx context.Context, project *gitlab.Project, namespace *string) (*gitlab.Project, error) {
+			if project != target {
+				t.Errorf("unexpected project: have %+v; want %+v", project, target)
+			}
+			if namespace != nil {
+				t.Errorf("unexpected namespace: %q", *namespace)
+			}
+			return fork, nil
+		}
+
+		repo, err := p.source.GetUserFork(p.ctx, targetRepo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if repo.Metadata != fork {
+			t.Errorf("unexpected fork metadata: have %+v; want %+v", repo.Metadata, fork)
+		}
+	})
+
+	t.Run("listing forks fails", func(t *testing.T) {
+		p := newGitLabChangesetSourceTestProvider(t)
+		mockUser(t)
+
+		gitlab.MockListOwnedForks = func(c *gitlab.Client, ctx context.Context, project *gitlab.Project) ([]*gitlab.Project, error) {
+			return nil, errors.New("boom")
+		}
+
+		if _, err := p.source.GetUserFork(p.ctx, targetRepo); err == nil {
+			t.Error("unexpected nil error")
+		}
+	})
+}
+
 func TestReadNotesUntilSeen(t *testing.T) {
 	commonNotes := []*gitlab.Note{
 		{ID: 1, System: true},
@@ -1015,6 +1173,11 @@ func (p *gitLabChangesetSourceTestProvider) unmock() {
 	gitlab.MockGetOpenMergeRequestByRefs = nil
 	gitlab.MockUpdateMergeRequest = nil
 	gitlab.MockCreateMergeRequestNote = nil
+	gitlab.MockGetUser = nil
+	gitlab.MockListUsers = nil
+	gitlab.MockGetProject = nil
+	gitlab.MockForkProject = nil
+	gitlab.MockListOwnedForks = nil
 }
 
 // panicDoer provides a httpcli.Doer implementation that panics if any attempt
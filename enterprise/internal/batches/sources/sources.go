@@ -191,6 +191,16 @@ func ToDraftChangesetSource(css ChangesetSource) (DraftChangesetSource, error) {
 	return draftCss, nil
 }
 
+// ToForkableChangesetSource returns a ForkableChangesetSource, if the
+// underlying source supports it. Returns an error if not.
+func ToForkableChangesetSource(css ChangesetSource) (ForkableChangesetSource, error) {
+	forkCss, ok := css.(ForkableChangesetSource)
+	if !ok {
+		return nil, errors.New("changeset source doesn't implement ForkableChangesetSource")
+	}
+	return forkCss, nil
+}
+
 // WithAuthenticatorForUser authenticates the given ChangesetSource with a credential
 // usable by the given user with userID. User credentials are preferred, with a
 // fallback to site credentials. If none of these exist, ErrMissingCredentials
@@ -261,6 +271,14 @@ func loadExternalService(ctx context.Context, s database.ExternalServiceStore, o
 			if cfg.Token != "" {
 				return e, nil
 			}
+		case *schema.BitbucketCloudConnection:
+			if cfg.AppPassword != "" {
+				return e, nil
+			}
+		case *schema.AWSCodeCommitConnection:
+			if cfg.AccessKeyID != "" {
+				return e, nil
+			}
 		case *schema.GitLabConnection:
 			if cfg.Token != "" {
 				return e, nil
@@ -282,6 +300,10 @@ func buildChangesetSource(store SourcerStore, cf *httpcli.Factory, externalServi
 		return NewGitLabSource(externalService, cf)
 	case extsvc.KindBitbucketServer:
 		return NewBitbucketServerSource(externalService, cf)
+	case extsvc.KindBitbucketCloud:
+		return NewBitbucketCloudSource(externalService, cf)
+	case extsvc.KindAWSCodeCommit:
+		return NewAWSCodeCommitSource(externalService, cf)
 	default:
 		return nil, errors.Errorf("unsupported external service type %q", extsvc.KindToType(externalService.Kind))
 	}
@@ -334,6 +356,12 @@ func setOAuthTokenAuth(u *vcs.URL, extSvcType, token string) error {
 	case extsvc.TypeBitbucketServer:
 		return errors.New("require username/token to push commits to BitbucketServer")
 
+	case extsvc.TypeBitbucketCloud:
+		return errors.New("require username/app password to push commits to BitbucketCloud")
+
+	case extsvc.TypeAWSCodeCommit:
+		return errors.New("require Git credentials to push commits to AWS CodeCommit")
+
 	default:
 		panic(fmt.Sprintf("setOAuthTokenAuth: invalid external service type %q", extSvcType))
 	}
@@ -347,7 +375,7 @@ func setBasicAuth(u *vcs.URL, extSvcType, username, password string) error {
 	case extsvc.TypeGitHub, extsvc.TypeGitLab:
 		return errors.New("need token to push commits to " + extSvcType)
 
-	case extsvc.TypeBitbucketServer:
+	case extsvc.TypeBitbucketServer, extsvc.TypeBitbucketCloud, extsvc.TypeAWSCodeCommit:
 		u.User = url.UserPassword(username, password)
 
 	default:
@@ -540,3 +540,38 @@ func TestGitserverPushConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckReviewersAndLabels(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cs                *Changeset
+		supportsReviewers bool
+		supportsLabels    bool
+		want              error
+	}{
+		"none requested": {
+			cs: &Changeset{},
+		},
+		"supported": {
+			cs:                &Changeset{Reviewers: []string{"alice"}, Labels: []string{"bug"}},
+			supportsReviewers: true,
+			supportsLabels:    true,
+		},
+		"reviewers unsupported": {
+			cs:             &Changeset{Reviewers: []string{"alice"}},
+			supportsLabels: true,
+			want:           UnsupportedChangesetAttributeError{CodeHost: "Code host", Attribute: "reviewers"},
+		},
+		"labels unsupported": {
+			cs:                &Changeset{Reviewers: []string{"alice"}, Labels: []string{"bug"}},
+			supportsReviewers: true,
+			want:              UnsupportedChangesetAttributeError{CodeHost: "Code host", Attribute: "labels"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := checkReviewersAndLabels(tc.cs, "Code host", tc.supportsReviewers, tc.supportsLabels)
+			if have, want := err, tc.want; have != want {
+				t.Errorf("wrong error: have=%v want=%v", have, want)
+			}
+		})
+	}
+}
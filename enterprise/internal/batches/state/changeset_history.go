@@ -53,9 +53,11 @@ var RequiredEventTypesForHistory = []btypes.ChangesetEventKind{
 	btypes.ChangesetEventKindGitHubConvertToDraft,
 	btypes.ChangesetEventKindGitHubClosed,
 	btypes.ChangesetEventKindBitbucketServerDeclined,
+	btypes.ChangesetEventKindBitbucketCloudDeclined,
 	btypes.ChangesetEventKindGitLabClosed,
 	btypes.ChangesetEventKindGitHubMerged,
 	btypes.ChangesetEventKindBitbucketServerMerged,
+	btypes.ChangesetEventKindBitbucketCloudMerged,
 	btypes.ChangesetEventKindGitLabMerged,
 	btypes.ChangesetEventKindGitHubReopened,
 	btypes.ChangesetEventKindBitbucketServerReopened,
@@ -63,9 +65,13 @@ var RequiredEventTypesForHistory = []btypes.ChangesetEventKind{
 	btypes.ChangesetEventKindGitHubReviewed,
 	btypes.ChangesetEventKindBitbucketServerApproved,
 	btypes.ChangesetEventKindBitbucketServerReviewed,
+	btypes.ChangesetEventKindBitbucketCloudApproved,
+	btypes.ChangesetEventKindBitbucketCloudChangesRequestCreated,
 	btypes.ChangesetEventKindGitLabApproved,
 	btypes.ChangesetEventKindBitbucketServerUnapproved,
 	btypes.ChangesetEventKindBitbucketServerDismissed,
+	btypes.ChangesetEventKindBitbucketCloudUnapproved,
+	btypes.ChangesetEventKindBitbucketCloudChangesRequestRemoved,
 	btypes.ChangesetEventKindGitLabUnapproved,
 }
 
@@ -121,6 +127,7 @@ func computeHistory(ch *btypes.Changeset, ce ChangesetEvents) (changesetHistory,
 		switch e.Kind {
 		case btypes.ChangesetEventKindGitHubClosed,
 			btypes.ChangesetEventKindBitbucketServerDeclined,
+			btypes.ChangesetEventKindBitbucketCloudDeclined,
 			btypes.ChangesetEventKindGitLabClosed:
 			// Merged is a final state. We can ignore everything after.
 			if currentExtState != btypes.ChangesetExternalStateMerged {
@@ -130,6 +137,7 @@ func computeHistory(ch *btypes.Changeset, ce ChangesetEvents) (changesetHistory,
 
 		case btypes.ChangesetEventKindGitHubMerged,
 			btypes.ChangesetEventKindBitbucketServerMerged,
+			btypes.ChangesetEventKindBitbucketCloudMerged,
 			btypes.ChangesetEventKindGitLabMerged:
 			currentExtState = btypes.ChangesetExternalStateMerged
 			pushStates(et)
@@ -175,6 +183,8 @@ func computeHistory(ch *btypes.Changeset, ce ChangesetEvents) (changesetHistory,
 		case btypes.ChangesetEventKindGitHubReviewed,
 			btypes.ChangesetEventKindBitbucketServerApproved,
 			btypes.ChangesetEventKindBitbucketServerReviewed,
+			btypes.ChangesetEventKindBitbucketCloudApproved,
+			btypes.ChangesetEventKindBitbucketCloudChangesRequestCreated,
 			btypes.ChangesetEventKindGitLabApproved:
 
 			s, err := e.ReviewState()
@@ -217,6 +227,8 @@ func computeHistory(ch *btypes.Changeset, ce ChangesetEvents) (changesetHistory,
 
 		case btypes.ChangesetEventKindBitbucketServerUnapproved,
 			btypes.ChangesetEventKindBitbucketServerDismissed,
+			btypes.ChangesetEventKindBitbucketCloudUnapproved,
+			btypes.ChangesetEventKindBitbucketCloudChangesRequestRemoved,
 			btypes.ChangesetEventKindGitLabUnapproved:
 			author := e.ReviewAuthor()
 			// If the user has been deleted, skip their reviews, as they don't count towards the final state anymore.
@@ -16,6 +16,8 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/awscodecommit"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
@@ -103,6 +105,9 @@ func computeCheckState(c *btypes.Changeset, events ChangesetEvents) btypes.Chang
 	case *bitbucketserver.PullRequest:
 		return computeBitbucketBuildStatus(c.UpdatedAt, m, events)
 
+	case *bitbucketcloud.PullRequest:
+		return computeBitbucketCloudBuildStatus(c.UpdatedAt, m, events)
+
 	case *gitlab.MergeRequest:
 		return computeGitLabCheckState(c.UpdatedAt, m, events)
 	}
@@ -197,6 +202,55 @@ func parseBitbucketBuildState(s string) btypes.ChangesetCheckState {
 	}
 }
 
+// parseBitbucketCloudBuildState is like parseBitbucketBuildState, but also
+// handles builds that were stopped, which only Bitbucket Cloud reports.
+func parseBitbucketCloudBuildState(s string) btypes.ChangesetCheckState {
+	if s == "STOPPED" {
+		return btypes.ChangesetCheckStateFailed
+	}
+	return parseBitbucketBuildState(s)
+}
+
+func computeBitbucketCloudBuildStatus(lastSynced time.Time, pr *bitbucketcloud.PullRequest, events []*btypes.ChangesetEvent) btypes.ChangesetCheckState {
+	// The commit hash of the pull request is abbreviated, while the hashes of
+	// commit statuses are complete.
+	var headCommit string
+	if pr.Source.Commit != nil {
+		headCommit = pr.Source.Commit.Hash
+	}
+	isHeadCommit := func(s *bitbucketcloud.CommitStatus) bool {
+		return headCommit != "" && strings.HasPrefix(s.Commit.Hash, headCommit)
+	}
+
+	stateMap := make(map[string]btypes.ChangesetCheckState)
+
+	// States from last sync
+	for _, status := range pr.Statuses {
+		if status.Commit.Hash != "" && !isHeadCommit(status) {
+			continue
+		}
+		stateMap[status.StatusKey] = parseBitbucketCloudBuildState(string(status.State))
+	}
+
+	// Add any events we've received since our last sync
+	for _, e := range events {
+		switch m := e.Metadata.(type) {
+		case *bitbucketcloud.CommitStatus:
+			if !isHeadCommit(m) || m.UpdatedOn.Before(lastSynced) {
+				continue
+			}
+			stateMap[m.StatusKey] = parseBitbucketCloudBuildState(string(m.State))
+		}
+	}
+
+	states := make([]btypes.ChangesetCheckState, 0, len(stateMap))
+	for _, v := range stateMap {
+		states = append(states, v)
+	}
+
+	return combineCheckStates(states)
+}
+
 func computeGitHubCheckState(lastSynced time.Time, pr *github.PullRequest, events []*btypes.ChangesetEvent) btypes.ChangesetCheckState {
 	// We should only consider the latest commit. This could be from a sync or a webhook that
 	// has occurred later
@@ -435,6 +489,32 @@ func computeSingleChangesetExternalState(c *btypes.Changeset) (s btypes.Changese
 		} else {
 			s = btypes.ChangesetExternalState(m.State)
 		}
+	case *bitbucketcloud.PullRequest:
+		switch m.State {
+		case bitbucketcloud.PullRequestStateOpen:
+			s = btypes.ChangesetExternalStateOpen
+		case bitbucketcloud.PullRequestStateMerged:
+			s = btypes.ChangesetExternalStateMerged
+		case bitbucketcloud.PullRequestStateDeclined, bitbucketcloud.PullRequestStateSuperseded:
+			s = btypes.ChangesetExternalStateClosed
+		default:
+			return "", errors.Errorf("unknown Bitbucket Cloud pull request state: %s", m.State)
+		}
+	case *awscodecommit.PullRequest:
+		// Merged pull requests are closed as well, so we need to check
+		// whether the pull request has been merged first.
+		if m.Target.IsMerged {
+			s = btypes.ChangesetExternalStateMerged
+			break
+		}
+		switch m.Status {
+		case awscodecommit.PullRequestStatusOpen:
+			s = btypes.ChangesetExternalStateOpen
+		case awscodecommit.PullRequestStatusClosed:
+			s = btypes.ChangesetExternalStateClosed
+		default:
+			return "", errors.Errorf("unknown AWS CodeCommit pull request status: %s", m.Status)
+		}
 	case *gitlab.MergeRequest:
 		switch m.State {
 		case gitlab.MergeRequestStateClosed, gitlab.MergeRequestStateLocked:
@@ -486,6 +566,36 @@ func computeSingleChangesetReviewState(c *btypes.Changeset) (s btypes.ChangesetR
 			}
 		}
 
+	case *bitbucketcloud.PullRequest:
+		for _, p := range m.Participants {
+			switch p.State {
+			case bitbucketcloud.ParticipantStateApproved:
+				states[btypes.ChangesetReviewStateApproved] = true
+			case bitbucketcloud.ParticipantStateChangesRequested:
+				states[btypes.ChangesetReviewStateChangesRequested] = true
+			default:
+				if p.Role == bitbucketcloud.ParticipantRoleReviewer {
+					states[btypes.ChangesetReviewStatePending] = true
+				}
+			}
+		}
+
+	case *awscodecommit.PullRequest:
+		// CodeCommit has no concept of requesting changes: reviewers can only
+		// approve a revision, and the approval rules decide whether that's
+		// enough. Without approval rules, a single approval is sufficient.
+		if m.Evaluation != nil && (m.Evaluation.Approved || m.Evaluation.Overridden) && len(m.ApprovalRules) > 0 {
+			return btypes.ChangesetReviewStateApproved, nil
+		}
+		if len(m.ApprovalRules) == 0 {
+			for _, a := range m.Approvals {
+				if a.State == awscodecommit.ApprovalStateApprove {
+					return btypes.ChangesetReviewStateApproved, nil
+				}
+			}
+		}
+		return btypes.ChangesetReviewStatePending, nil
+
 	case *gitlab.MergeRequest:
 		// GitLab has an elaborate approvers workflow, but this doesn't map
 		// terribly closely to the GitHub/Bitbucket workflow: most notably,
@@ -9,6 +9,7 @@ import (
 
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/awscodecommit"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
@@ -224,6 +225,13 @@ func TestComputeBitbucketBuildStatus(t *testing.T) {
 			},
 			want: btypes.ChangesetCheckStateFailed,
 		},
+		{
+			name: "single stopped",
+			events: []*btypes.ChangesetEvent{
+				statusEvent(1, "ctx1", "STOPPED"),
+			},
+			want: btypes.ChangesetCheckStateUnknown,
+		},
 		{
 			name: "pending + error",
 			events: []*btypes.ChangesetEvent{
@@ -535,6 +543,36 @@ func TestComputeReviewState(t *testing.T) {
 			},
 			want: btypes.ChangesetReviewStateChangesRequested,
 		},
+		{
+			name:      "awscodecommit - no approval rules, approved",
+			changeset: awsCodeCommitChangeset(daysAgo(0), awscodecommit.PullRequestStatusOpen, false, nil, awscodecommit.ApprovalStateApprove),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetReviewStateApproved,
+		},
+		{
+			name:      "awscodecommit - no approval rules, revoked",
+			changeset: awsCodeCommitChangeset(daysAgo(0), awscodecommit.PullRequestStatusOpen, false, nil, awscodecommit.ApprovalStateRevoke),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetReviewStatePending,
+		},
+		{
+			name:      "awscodecommit - approval rules satisfied",
+			changeset: awsCodeCommitChangeset(daysAgo(0), awscodecommit.PullRequestStatusOpen, false, &awscodecommit.Evaluation{Approved: true}),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetReviewStateApproved,
+		},
+		{
+			name:      "awscodecommit - approval rules overridden",
+			changeset: awsCodeCommitChangeset(daysAgo(0), awscodecommit.PullRequestStatusOpen, false, &awscodecommit.Evaluation{Overridden: true}),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetReviewStateApproved,
+		},
+		{
+			name:      "awscodecommit - approval rules not satisfied",
+			changeset: awsCodeCommitChangeset(daysAgo(0), awscodecommit.PullRequestStatusOpen, false, &awscodecommit.Evaluation{}, awscodecommit.ApprovalStateApprove),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetReviewStatePending,
+		},
 	}
 
 	for i, tc := range tests {
@@ -715,6 +753,24 @@ func TestComputeExternalState(t *testing.T) {
 			},
 			want: btypes.ChangesetExternalStateDraft,
 		},
+		{
+			name:      "awscodecommit - no events, open",
+			changeset: awsCodeCommitChangeset(daysAgo(0), awscodecommit.PullRequestStatusOpen, false, nil),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetExternalStateOpen,
+		},
+		{
+			name:      "awscodecommit - no events, closed",
+			changeset: awsCodeCommitChangeset(daysAgo(0), awscodecommit.PullRequestStatusClosed, false, nil),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetExternalStateClosed,
+		},
+		{
+			name:      "awscodecommit - no events, merged",
+			changeset: awsCodeCommitChangeset(daysAgo(0), awscodecommit.PullRequestStatusClosed, true, nil),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetExternalStateMerged,
+		},
 	}
 
 	for i, tc := range tests {
@@ -865,6 +921,25 @@ func gitLabChangeset(updatedAt time.Time, state gitlab.MergeRequestState, notes
 	}
 }
 
+func awsCodeCommitChangeset(updatedAt time.Time, status awscodecommit.PullRequestStatus, merged bool, evaluation *awscodecommit.Evaluation, approvals ...awscodecommit.ApprovalState) *btypes.Changeset {
+	pr := &awscodecommit.PullRequest{
+		Status:     status,
+		Target:     awscodecommit.PullRequestTarget{IsMerged: merged},
+		Evaluation: evaluation,
+	}
+	if evaluation != nil {
+		pr.ApprovalRules = []awscodecommit.ApprovalRule{{Name: "Require one approval"}}
+	}
+	for _, a := range approvals {
+		pr.Approvals = append(pr.Approvals, awscodecommit.Approval{State: a})
+	}
+	return &btypes.Changeset{
+		ExternalServiceType: extsvc.TypeAWSCodeCommit,
+		UpdatedAt:           updatedAt,
+		Metadata:            pr,
+	}
+}
+
 func setDeletedAt(c *btypes.Changeset, deletedAt time.Time) *btypes.Changeset {
 	c.ExternalDeletedAt = deletedAt
 	return c
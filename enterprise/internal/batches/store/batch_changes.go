@@ -31,6 +31,9 @@ var batchChangeColumns = []*sqlf.Query{
 	sqlf.Sprintf("batch_changes.updated_at"),
 	sqlf.Sprintf("batch_changes.closed_at"),
 	sqlf.Sprintf("batch_changes.batch_spec_id"),
+	sqlf.Sprintf("batch_changes.rollout_wave"),
+	sqlf.Sprintf("batch_changes.rollout_wave_completed_at"),
+	sqlf.Sprintf("batch_changes.rollout_paused_reason"),
 }
 
 // batchChangeInsertColumns is the list of batch changes columns that are
@@ -48,6 +51,9 @@ var batchChangeInsertColumns = []*sqlf.Query{
 	sqlf.Sprintf("updated_at"),
 	sqlf.Sprintf("closed_at"),
 	sqlf.Sprintf("batch_spec_id"),
+	sqlf.Sprintf("rollout_wave"),
+	sqlf.Sprintf("rollout_wave_completed_at"),
+	sqlf.Sprintf("rollout_paused_reason"),
 }
 
 // CreateBatchChange creates the given batch change.
@@ -65,7 +71,7 @@ func (s *Store) CreateBatchChange(ctx context.Context, c *btypes.BatchChange) (e
 var createBatchChangeQueryFmtstr = `
 -- source: enterprise/internal/batches/store.go:CreateBatchChange
 INSERT INTO batch_changes (%s)
-VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
+VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
 RETURNING %s
 `
 
@@ -92,6 +98,9 @@ func (s *Store) createBatchChangeQuery(c *btypes.BatchChange) *sqlf.Query {
 		c.UpdatedAt,
 		nullTimeColumn(c.ClosedAt),
 		c.BatchSpecID,
+		c.RolloutWave,
+		nullTimeColumn(c.RolloutWaveCompletedAt),
+		nullStringColumn(c.RolloutPausedReason),
 		sqlf.Join(batchChangeColumns, ", "),
 	)
 }
@@ -111,7 +120,7 @@ func (s *Store) UpdateBatchChange(ctx context.Context, c *btypes.BatchChange) (e
 var updateBatchChangeQueryFmtstr = `
 -- source: enterprise/internal/batches/store.go:UpdateBatchChange
 UPDATE batch_changes
-SET (%s) = (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
+SET (%s) = (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
 WHERE id = %s
 RETURNING %s
 `
@@ -133,6 +142,9 @@ func (s *Store) updateBatchChangeQuery(c *btypes.BatchChange) *sqlf.Query {
 		c.UpdatedAt,
 		nullTimeColumn(c.ClosedAt),
 		c.BatchSpecID,
+		c.RolloutWave,
+		nullTimeColumn(c.RolloutWaveCompletedAt),
+		nullStringColumn(c.RolloutPausedReason),
 		c.ID,
 		sqlf.Join(batchChangeColumns, ", "),
 	)
@@ -424,6 +436,10 @@ type ListBatchChangesOpts struct {
 	NamespaceOrgID  int32
 
 	RepoID api.RepoID
+
+	// OnlyPendingRollout only returns open batch changes whose staged rollout
+	// has changesets in rollout waves that haven't been opened yet.
+	OnlyPendingRollout bool
 }
 
 // ListBatchChanges lists batch changes with the given filters.
@@ -514,6 +530,15 @@ func listBatchChangesQuery(opts *ListBatchChangesOpts, repoAuthzConds *sqlf.Quer
 		)`, opts.RepoID, repoAuthzConds))
 	}
 
+	if opts.OnlyPendingRollout {
+		preds = append(preds, sqlf.Sprintf(`batch_changes.closed_at IS NULL AND batch_changes.rollout_wave > 0 AND EXISTS(
+			SELECT 1 FROM changesets
+			WHERE
+				changesets.owned_by_batch_change_id = batch_changes.id AND
+				changesets.rollout_wave > batch_changes.rollout_wave
+		)`))
+	}
+
 	if len(preds) == 0 {
 		preds = append(preds, sqlf.Sprintf("TRUE"))
 	}
@@ -540,5 +565,8 @@ func scanBatchChange(c *btypes.BatchChange, s dbutil.Scanner) error {
 		&c.UpdatedAt,
 		&dbutil.NullTime{Time: &c.ClosedAt},
 		&c.BatchSpecID,
+		&c.RolloutWave,
+		&dbutil.NullTime{Time: &c.RolloutWaveCompletedAt},
+		&dbutil.NullString{S: &c.RolloutPausedReason},
 	)
 }
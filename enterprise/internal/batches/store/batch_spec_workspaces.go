@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"sort"
+	"strconv"
 
 	"github.com/cockroachdb/errors"
 	"github.com/keegancsmith/sqlf"
@@ -36,6 +37,7 @@ var batchSpecWorkspaceInsertColumns = []string{
 	"ignored",
 	"skipped",
 	"cached_result_found",
+	"refresh_changeset_id",
 
 	"created_at",
 	"updated_at",
@@ -60,6 +62,7 @@ var BatchSpecWorkspaceColums = SQLColumns{
 	"batch_spec_workspaces.ignored",
 	"batch_spec_workspaces.skipped",
 	"batch_spec_workspaces.cached_result_found",
+	"batch_spec_workspaces.refresh_changeset_id",
 
 	"batch_spec_workspaces.created_at",
 	"batch_spec_workspaces.updated_at",
@@ -120,6 +123,7 @@ func (s *Store) CreateBatchSpecWorkspace(ctx context.Context, ws ...*btypes.Batc
 				wj.Ignored,
 				wj.Skipped,
 				wj.CachedResultFound,
+				nullInt64Column(wj.RefreshChangesetID),
 				wj.CreatedAt,
 				wj.UpdatedAt,
 			); err != nil {
@@ -202,6 +206,10 @@ type ListBatchSpecWorkspacesOpts struct {
 	Cursor      int64
 	BatchSpecID int64
 	IDs         []int64
+
+	// ChangesetSpecID limits the results to the workspace that produced the
+	// given changeset spec.
+	ChangesetSpecID int64
 }
 
 func (opts ListBatchSpecWorkspacesOpts) SQLConds(forCount bool) *sqlf.Query {
@@ -217,6 +225,10 @@ func (opts ListBatchSpecWorkspacesOpts) SQLConds(forCount bool) *sqlf.Query {
 		preds = append(preds, sqlf.Sprintf("batch_spec_workspaces.batch_spec_id = %d", opts.BatchSpecID))
 	}
 
+	if opts.ChangesetSpecID != 0 {
+		preds = append(preds, sqlf.Sprintf("batch_spec_workspaces.changeset_spec_ids ? %s", strconv.FormatInt(opts.ChangesetSpecID, 10)))
+	}
+
 	if !forCount && opts.Cursor > 0 {
 		preds = append(preds, sqlf.Sprintf("batch_spec_workspaces.id >= %s", opts.Cursor))
 	}
@@ -322,6 +334,34 @@ func (s *Store) MarkSkippedBatchSpecWorkspaces(ctx context.Context, batchSpecID
 	return s.Exec(ctx, q)
 }
 
+const refreshBatchSpecWorkspaceQueryFmtstr = `
+-- source: enterprise/internal/batches/store/batch_spec_workspaces.go:RefreshBatchSpecWorkspace
+UPDATE
+	batch_spec_workspaces
+SET
+	commit = %s,
+	refresh_changeset_id = %s,
+	cached_result_found = FALSE,
+	skipped = FALSE,
+	updated_at = %s
+WHERE
+	id = %s
+`
+
+// RefreshBatchSpecWorkspace points the workspace at the given commit of its
+// branch, so that it can be executed again, and records the changeset that is
+// updated with the result of that execution. A changesetID of 0 clears it.
+func (s *Store) RefreshBatchSpecWorkspace(ctx context.Context, id int64, commit string, changesetID int64) (err error) {
+	ctx, endObservation := s.operations.refreshBatchSpecWorkspace.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("ID", int(id)),
+		log.Int("changesetID", int(changesetID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(refreshBatchSpecWorkspaceQueryFmtstr, commit, nullInt64Column(changesetID), s.now(), id)
+	return s.Exec(ctx, q)
+}
+
 func scanBatchSpecWorkspace(wj *btypes.BatchSpecWorkspace, s dbutil.Scanner) error {
 	var steps json.RawMessage
 
@@ -340,6 +380,7 @@ func scanBatchSpecWorkspace(wj *btypes.BatchSpecWorkspace, s dbutil.Scanner) err
 		&wj.Ignored,
 		&wj.Skipped,
 		&wj.CachedResultFound,
+		&dbutil.NullInt64{N: &wj.RefreshChangesetID},
 		&wj.CreatedAt,
 		&wj.UpdatedAt,
 	); err != nil {
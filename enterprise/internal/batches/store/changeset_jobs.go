@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 	"github.com/keegancsmith/sqlf"
@@ -122,12 +123,17 @@ func (s *Store) CreateChangesetJob(ctx context.Context, cs ...*btypes.ChangesetJ
 // GetChangesetJobOpts captures the query options needed for getting a ChangesetJob
 type GetChangesetJobOpts struct {
 	ID int64
+
+	ChangesetID int64
+	JobType     btypes.ChangesetJobType
 }
 
-// GetChangesetJob gets a ChangesetJob matching the given options.
+// GetChangesetJob gets a ChangesetJob matching the given options. If more than
+// one job matches, the most recently created one is returned.
 func (s *Store) GetChangesetJob(ctx context.Context, opts GetChangesetJobOpts) (job *btypes.ChangesetJob, err error) {
 	ctx, endObservation := s.operations.getChangesetJob.With(ctx, &err, observation.Args{LogFields: []log.Field{
 		log.Int("ID", int(opts.ID)),
+		log.Int("ChangesetID", int(opts.ChangesetID)),
 	}})
 	defer endObservation(1, observation.Args{})
 
@@ -153,13 +159,25 @@ SELECT %s FROM changeset_jobs
 INNER JOIN changesets ON changesets.id = changeset_jobs.changeset_id
 INNER JOIN repo ON repo.id = changesets.repo_id
 WHERE %s
+ORDER BY changeset_jobs.id DESC
 LIMIT 1
 `
 
 func getChangesetJobQuery(opts *GetChangesetJobOpts) *sqlf.Query {
 	preds := []*sqlf.Query{
 		sqlf.Sprintf("repo.deleted_at IS NULL"),
-		sqlf.Sprintf("changeset_jobs.id = %s", opts.ID),
+	}
+
+	if opts.ID != 0 {
+		preds = append(preds, sqlf.Sprintf("changeset_jobs.id = %s", opts.ID))
+	}
+
+	if opts.ChangesetID != 0 {
+		preds = append(preds, sqlf.Sprintf("changeset_jobs.changeset_id = %s", opts.ChangesetID))
+	}
+
+	if opts.JobType != "" {
+		preds = append(preds, sqlf.Sprintf("changeset_jobs.job_type = %s", opts.JobType))
 	}
 
 	return sqlf.Sprintf(
@@ -170,7 +188,10 @@ func getChangesetJobQuery(opts *GetChangesetJobOpts) *sqlf.Query {
 }
 
 func scanChangesetJob(c *btypes.ChangesetJob, s dbutil.Scanner) error {
-	var raw json.RawMessage
+	var (
+		raw   json.RawMessage
+		state string
+	)
 	if err := s.Scan(
 		&c.ID,
 		&c.BulkGroup,
@@ -179,7 +200,7 @@ func scanChangesetJob(c *btypes.ChangesetJob, s dbutil.Scanner) error {
 		&c.ChangesetID,
 		&c.JobType,
 		&raw,
-		&c.State,
+		&state,
 		&dbutil.NullString{S: c.FailureMessage},
 		&dbutil.NullTime{Time: &c.StartedAt},
 		&dbutil.NullTime{Time: &c.FinishedAt},
@@ -191,6 +212,8 @@ func scanChangesetJob(c *btypes.ChangesetJob, s dbutil.Scanner) error {
 	); err != nil {
 		return err
 	}
+	c.State = btypes.ChangesetJobState(strings.ToUpper(state))
+
 	switch c.JobType {
 	case btypes.ChangesetJobTypeComment:
 		c.Payload = new(btypes.ChangesetJobCommentPayload)
@@ -204,6 +227,8 @@ func scanChangesetJob(c *btypes.ChangesetJob, s dbutil.Scanner) error {
 		c.Payload = new(btypes.ChangesetJobClosePayload)
 	case btypes.ChangesetJobTypePublish:
 		c.Payload = new(btypes.ChangesetJobPublishPayload)
+	case btypes.ChangesetJobTypeRefresh:
+		c.Payload = new(btypes.ChangesetJobRefreshPayload)
 	default:
 		return errors.Errorf("unknown job type %q", c.JobType)
 	}
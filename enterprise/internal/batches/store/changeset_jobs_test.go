@@ -96,6 +96,29 @@ func testStoreChangesetJobs(t *testing.T, ctx context.Context, s *Store, clock c
 			})
 		}
 
+		t.Run("ByChangesetAndJobType", func(t *testing.T) {
+			have, err := s.GetChangesetJob(ctx, GetChangesetJobOpts{
+				ChangesetID: changeset.ID,
+				JobType:     btypes.ChangesetJobTypeComment,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// The most recently created job should be returned.
+			if diff := cmp.Diff(have, jobs[1]); diff != "" {
+				t.Fatal(diff)
+			}
+
+			_, err = s.GetChangesetJob(ctx, GetChangesetJobOpts{
+				ChangesetID: changeset.ID,
+				JobType:     btypes.ChangesetJobTypeMerge,
+			})
+			if err != ErrNoResults {
+				t.Fatalf("have err %v, want %v", err, ErrNoResults)
+			}
+		})
+
 		t.Run("NoResults", func(t *testing.T) {
 			opts := GetChangesetJobOpts{ID: 0xdeadbeef}
 
@@ -22,6 +22,8 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/awscodecommit"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
@@ -41,6 +43,7 @@ var changesetColumns = []*sqlf.Query{
 	sqlf.Sprintf("changesets.external_id"),
 	sqlf.Sprintf("changesets.external_service_type"),
 	sqlf.Sprintf("changesets.external_branch"),
+	sqlf.Sprintf("changesets.external_fork_namespace"),
 	sqlf.Sprintf("changesets.external_deleted_at"),
 	sqlf.Sprintf("changesets.external_updated_at"),
 	sqlf.Sprintf("changesets.external_state"),
@@ -64,6 +67,8 @@ var changesetColumns = []*sqlf.Query{
 	sqlf.Sprintf("changesets.num_failures"),
 	sqlf.Sprintf("changesets.closing"),
 	sqlf.Sprintf("changesets.syncer_error"),
+	sqlf.Sprintf("changesets.auto_merge_blocked_reason"),
+	sqlf.Sprintf("changesets.rollout_wave"),
 }
 
 // changesetInsertColumns is the list of changeset columns that are modified in
@@ -77,6 +82,7 @@ var changesetInsertColumns = []*sqlf.Query{
 	sqlf.Sprintf("external_id"),
 	sqlf.Sprintf("external_service_type"),
 	sqlf.Sprintf("external_branch"),
+	sqlf.Sprintf("external_fork_namespace"),
 	sqlf.Sprintf("external_deleted_at"),
 	sqlf.Sprintf("external_updated_at"),
 	sqlf.Sprintf("external_state"),
@@ -100,6 +106,8 @@ var changesetInsertColumns = []*sqlf.Query{
 	sqlf.Sprintf("num_failures"),
 	sqlf.Sprintf("closing"),
 	sqlf.Sprintf("syncer_error"),
+	sqlf.Sprintf("auto_merge_blocked_reason"),
+	sqlf.Sprintf("rollout_wave"),
 	// We additionally store the result of changeset.Title() in a column, so
 	// the business logic for determining it is in one place and the field is
 	// indexable for searching.
@@ -121,6 +129,7 @@ var changesetCodeHostStateInsertColumns = []*sqlf.Query{
 	sqlf.Sprintf("diff_stat_deleted"),
 	sqlf.Sprintf("sync_state"),
 	sqlf.Sprintf("syncer_error"),
+	sqlf.Sprintf("auto_merge_blocked_reason"),
 	// We additionally store the result of changeset.Title() in a column, so
 	// the business logic for determining it is in one place and the field is
 	// indexable for searching.
@@ -158,6 +167,7 @@ func (s *Store) changesetWriteQuery(q string, includeID bool, c *btypes.Changese
 		nullStringColumn(c.ExternalID),
 		c.ExternalServiceType,
 		nullStringColumn(c.ExternalBranch),
+		nullStringColumn(c.ExternalForkNamespace),
 		nullTimeColumn(c.ExternalDeletedAt),
 		nullTimeColumn(c.ExternalUpdatedAt),
 		nullStringColumn(string(c.ExternalState)),
@@ -181,6 +191,8 @@ func (s *Store) changesetWriteQuery(q string, includeID bool, c *btypes.Changese
 		c.NumFailures,
 		c.Closing,
 		c.SyncErrorMessage,
+		nullStringColumn(c.AutoMergeBlockedReason),
+		c.RolloutWave,
 		nullStringColumn(title),
 	}
 
@@ -225,7 +237,7 @@ func (s *Store) CreateChangeset(ctx context.Context, c *btypes.Changeset) (err e
 var createChangesetQueryFmtstr = `
 -- source: enterprise/internal/batches/store.go:CreateChangeset
 INSERT INTO changesets (%s)
-VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
+VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
 RETURNING %s
 `
 
@@ -462,6 +474,7 @@ func scanChangesetSyncData(h *btypes.ChangesetSyncData, s dbutil.Scanner) error
 		&dbutil.NullTime{Time: &h.LatestEvent},
 		&dbutil.NullTime{Time: &h.ExternalUpdatedAt},
 		&h.RepoExternalServiceID,
+		&h.ExternalServiceType,
 	)
 }
 
@@ -471,7 +484,8 @@ SELECT changesets.id,
 	changesets.updated_at,
 	max(ce.updated_at) AS latest_event,
 	changesets.external_updated_at,
-	r.external_service_id
+	r.external_service_id,
+	changesets.external_service_type
 FROM changesets
 LEFT JOIN changeset_events ce ON changesets.id = ce.changeset_id
 JOIN batch_changes ON changesets.batch_change_ids ? batch_changes.id::TEXT
@@ -519,6 +533,10 @@ type ListChangesetsOpts struct {
 	TextSearch           []search.TextSearchTerm
 	EnforceAuthz         bool
 	RepoID               api.RepoID
+	// BitbucketCloudCommit only returns Bitbucket Cloud pull requests whose
+	// source commit is the given commit. The pull request metadata only
+	// contains abbreviated hashes, so this matches by prefix.
+	BitbucketCloudCommit string
 }
 
 // ListChangesets lists Changesets with the given filters.
@@ -608,6 +626,13 @@ func listChangesetsQuery(opts *ListChangesetsOpts, authzConds *sqlf.Query) *sqlf
 	if opts.RepoID != 0 {
 		preds = append(preds, sqlf.Sprintf("repo.id = %s", opts.RepoID))
 	}
+	if opts.BitbucketCloudCommit != "" {
+		preds = append(preds, sqlf.Sprintf(
+			"changesets.external_service_type = %s AND %s LIKE (changesets.metadata->'source'->'commit'->>'hash') || '%%'",
+			extsvc.TypeBitbucketCloud,
+			opts.BitbucketCloudCommit,
+		))
+	}
 
 	join := sqlf.Sprintf("")
 	if len(opts.TextSearch) != 0 {
@@ -713,7 +738,7 @@ func (s *Store) UpdateChangeset(ctx context.Context, cs *btypes.Changeset) (err
 var updateChangesetQueryFmtstr = `
 -- source: enterprise/internal/batches/store_changesets.go:UpdateChangeset
 UPDATE changesets
-SET (%s) = (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
+SET (%s) = (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
 WHERE id = %s
 RETURNING
   %s
@@ -826,6 +851,7 @@ func updateChangesetCodeHostStateQuery(c *btypes.Changeset) (*sqlf.Query, error)
 		c.DiffStatDeleted,
 		syncState,
 		c.SyncErrorMessage,
+		nullStringColumn(c.AutoMergeBlockedReason),
 		nullStringColumn(title),
 		c.ID,
 		sqlf.Join(changesetColumns, ", "),
@@ -837,7 +863,7 @@ func updateChangesetCodeHostStateQuery(c *btypes.Changeset) (*sqlf.Query, error)
 var updateChangesetCodeHostStateQueryFmtstr = `
 -- source: enterprise/internal/batches/store/changesets.go:UpdateChangesetCodeHostState
 UPDATE changesets
-SET (%s) = (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
+SET (%s) = (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
 WHERE id = %s
 RETURNING
   %s
@@ -1126,6 +1152,7 @@ func scanChangeset(t *btypes.Changeset, s dbutil.Scanner) error {
 		&dbutil.NullString{S: &t.ExternalID},
 		&t.ExternalServiceType,
 		&dbutil.NullString{S: &t.ExternalBranch},
+		&dbutil.NullString{S: &t.ExternalForkNamespace},
 		&dbutil.NullTime{Time: &t.ExternalDeletedAt},
 		&dbutil.NullTime{Time: &t.ExternalUpdatedAt},
 		&dbutil.NullString{S: &externalState},
@@ -1149,6 +1176,8 @@ func scanChangeset(t *btypes.Changeset, s dbutil.Scanner) error {
 		&t.NumFailures,
 		&t.Closing,
 		&dbutil.NullString{S: &syncErrorMessage},
+		&dbutil.NullString{S: &t.AutoMergeBlockedReason},
+		&t.RolloutWave,
 	)
 	if err != nil {
 		return errors.Wrap(err, "scanning changeset")
@@ -1170,6 +1199,10 @@ func scanChangeset(t *btypes.Changeset, s dbutil.Scanner) error {
 		t.Metadata = new(github.PullRequest)
 	case extsvc.TypeBitbucketServer:
 		t.Metadata = new(bitbucketserver.PullRequest)
+	case extsvc.TypeBitbucketCloud:
+		t.Metadata = new(bitbucketcloud.PullRequest)
+	case extsvc.TypeAWSCodeCommit:
+		t.Metadata = new(awscodecommit.PullRequest)
 	case extsvc.TypeGitLab:
 		t.Metadata = new(gitlab.MergeRequest)
 	default:
@@ -1281,6 +1314,7 @@ func (s *Store) EnqueueNextScheduledChangeset(ctx context.Context) (ch *btypes.C
 	q := sqlf.Sprintf(
 		enqueueNextScheduledChangesetFmtstr,
 		btypes.ReconcilerStateScheduled.ToDB(),
+		rolloutWaveNotOpen(),
 		btypes.ReconcilerStateQueued.ToDB(),
 		sqlf.Join(changesetColumns, ","),
 	)
@@ -1305,7 +1339,9 @@ const enqueueNextScheduledChangesetFmtstr = `
 WITH c AS (
 	SELECT *
 	FROM changesets
-	WHERE reconciler_state = %s
+	WHERE
+		reconciler_state = %s AND
+		NOT %s
 	ORDER BY updated_at ASC
 	LIMIT 1
 )
@@ -1325,6 +1361,7 @@ func (s *Store) GetChangesetPlaceInSchedulerQueue(ctx context.Context, id int64)
 	q := sqlf.Sprintf(
 		getChangesetPlaceInSchedulerQueueFmtstr,
 		btypes.ReconcilerStateScheduled.ToDB(),
+		rolloutWaveNotOpen(),
 		id,
 	)
 
@@ -1351,12 +1388,68 @@ FROM (
 	FROM
 		changesets
 	WHERE
-		reconciler_state = %s
+		reconciler_state = %s AND
+		NOT %s
 	) t
 WHERE
 	id = %d
 `
 
+// GetRolloutWaveStats returns statistics on the changesets owned by the given
+// batch change in the rollout waves up to and including the given wave.
+func (s *Store) GetRolloutWaveStats(ctx context.Context, batchChangeID int64, wave int32) (stats btypes.RolloutWaveStats, err error) {
+	ctx, endObservation := s.operations.getRolloutWaveStats.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("batchChangeID", int(batchChangeID)),
+		log.Int("wave", int(wave)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		getRolloutWaveStatsFmtstr,
+		sqlf.Join([]*sqlf.Query{
+			sqlf.Sprintf("%s", btypes.ReconcilerStateScheduled.ToDB()),
+			sqlf.Sprintf("%s", btypes.ReconcilerStateQueued.ToDB()),
+			sqlf.Sprintf("%s", btypes.ReconcilerStateProcessing.ToDB()),
+			sqlf.Sprintf("%s", btypes.ReconcilerStateErrored.ToDB()),
+		}, ","),
+		btypes.ReconcilerStateFailed.ToDB(),
+		btypes.ChangesetExternalStateOpen,
+		btypes.ChangesetExternalStateDraft,
+		btypes.ChangesetCheckStateFailed,
+		batchChangeID,
+		wave,
+	)
+
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return sc.Scan(&stats.Pending, &stats.Failed, &stats.FailingChecks)
+	})
+	return stats, err
+}
+
+const getRolloutWaveStatsFmtstr = `
+-- source: enterprise/internal/batches/store/changesets.go:GetRolloutWaveStats
+SELECT
+	COUNT(*) FILTER (WHERE reconciler_state IN (%s)) AS pending,
+	COUNT(*) FILTER (WHERE reconciler_state = %s) AS failed,
+	COUNT(*) FILTER (WHERE external_state IN (%s, %s) AND external_check_state = %s) AS failing_checks
+FROM changesets
+WHERE
+	owned_by_batch_change_id = %s AND
+	rollout_wave BETWEEN 1 AND %s
+`
+
+// rolloutWaveNotOpen returns a predicate that matches changesets in a rollout
+// wave of the batch change that owns them that hasn't been opened yet.
+func rolloutWaveNotOpen() *sqlf.Query {
+	return sqlf.Sprintf(`EXISTS (
+		SELECT 1 FROM batch_changes
+		WHERE
+			batch_changes.id = changesets.owned_by_batch_change_id AND
+			batch_changes.rollout_wave > 0 AND
+			changesets.rollout_wave > batch_changes.rollout_wave
+	)`)
+}
+
 func archivedInBatchChange(batchChangeID string) *sqlf.Query {
 	return sqlf.Sprintf(
 		"(COALESCE((batch_change_ids->%s->>'isArchived')::bool, false) OR COALESCE((batch_change_ids->%s->>'archive')::bool, false))",
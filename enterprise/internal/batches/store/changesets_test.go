@@ -1589,6 +1589,7 @@ func testStoreListChangesetSyncData(t *testing.T, ctx context.Context, s *Store,
 				LatestEvent:           clock.Now(),
 				ExternalUpdatedAt:     clock.Now(),
 				RepoExternalServiceID: "https://github.com/",
+				ExternalServiceType:   extsvc.TypeGitHub,
 			},
 			{
 				ChangesetID:           changesets[1].ID,
@@ -1596,6 +1597,7 @@ func testStoreListChangesetSyncData(t *testing.T, ctx context.Context, s *Store,
 				LatestEvent:           clock.Now(),
 				ExternalUpdatedAt:     clock.Now(),
 				RepoExternalServiceID: "https://github.com/",
+				ExternalServiceType:   extsvc.TypeGitHub,
 			},
 			{
 				// No events
@@ -1603,6 +1605,7 @@ func testStoreListChangesetSyncData(t *testing.T, ctx context.Context, s *Store,
 				UpdatedAt:             clock.Now(),
 				ExternalUpdatedAt:     clock.Now(),
 				RepoExternalServiceID: "https://gitlab.com/",
+				ExternalServiceType:   extsvc.TypeGitLab,
 			},
 		}
 		if diff := cmp.Diff(want, hs); diff != "" {
@@ -1621,6 +1624,7 @@ func testStoreListChangesetSyncData(t *testing.T, ctx context.Context, s *Store,
 				UpdatedAt:             clock.Now(),
 				ExternalUpdatedAt:     clock.Now(),
 				RepoExternalServiceID: "https://gitlab.com/",
+				ExternalServiceType:   extsvc.TypeGitLab,
 			},
 		}
 		if diff := cmp.Diff(want, hs); diff != "" {
@@ -1640,6 +1644,7 @@ func testStoreListChangesetSyncData(t *testing.T, ctx context.Context, s *Store,
 				LatestEvent:           clock.Now(),
 				ExternalUpdatedAt:     clock.Now(),
 				RepoExternalServiceID: "https://github.com/",
+				ExternalServiceType:   extsvc.TypeGitHub,
 			},
 		}
 		if diff := cmp.Diff(want, hs); diff != "" {
@@ -2150,6 +2155,67 @@ func testStoreChangesetScheduling(t *testing.T, ctx context.Context, s *Store, c
 			t.Errorf("unexpected error: %v", err)
 		}
 	}
+
+	t.Run("rollout waves", func(t *testing.T) {
+		user := ct.CreateTestUser(t, s.DB(), false)
+		batchSpec := ct.CreateBatchSpec(t, ctx, s, "rollout", user.ID)
+		batchChange := ct.BuildBatchChange(s, "rollout", user.ID, batchSpec.ID)
+		batchChange.RolloutWave = 1
+		if err := s.CreateBatchChange(ctx, batchChange); err != nil {
+			t.Fatal(err)
+		}
+
+		inWave := func(cs *btypes.Changeset, wave int32) {
+			cs.OwnedByBatchChangeID = batchChange.ID
+			cs.RolloutWave = wave
+			if err := s.UpdateChangeset(ctx, cs); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		published := createChangeset("published", time.Now(), btypes.ReconcilerStateCompleted)
+		published.PublicationState = btypes.ChangesetPublicationStatePublished
+		published.ExternalState = btypes.ChangesetExternalStateOpen
+		published.ExternalCheckState = btypes.ChangesetCheckStateFailed
+		inWave(published, 1)
+		pending := createChangeset("pending", time.Now(), btypes.ReconcilerStateScheduled)
+		inWave(pending, 1)
+		gated := createChangeset("gated", time.Now().Add(-1*time.Minute), btypes.ReconcilerStateScheduled)
+		inWave(gated, 2)
+
+		stats, err := s.GetRolloutWaveStats(ctx, batchChange.ID, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := (btypes.RolloutWaveStats{Pending: 1, FailingChecks: 1}); stats != want {
+			t.Errorf("unexpected stats: have=%+v want=%+v", stats, want)
+		}
+
+		// The changeset in the second wave must not be enqueued before the
+		// second wave is opened, even though it has been scheduled earlier.
+		if _, err := s.GetChangesetPlaceInSchedulerQueue(ctx, gated.ID); err != ErrNoResults {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if have, err := s.EnqueueNextScheduledChangeset(ctx); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		} else if have.ID != pending.ID {
+			t.Errorf("unexpected changeset: have=%v want=%v", have, pending)
+		}
+		if _, err := s.EnqueueNextScheduledChangeset(ctx); err != ErrNoResults {
+			t.Errorf("unexpected error: have=%v want=%v", err, ErrNoResults)
+		}
+
+		batchChange.RolloutWave = 2
+		if err := s.UpdateBatchChange(ctx, batchChange); err != nil {
+			t.Fatal(err)
+		}
+
+		if have, err := s.EnqueueNextScheduledChangeset(ctx); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		} else if have.ID != gated.ID {
+			t.Errorf("unexpected changeset: have=%v want=%v", have, gated)
+		}
+	})
 }
 
 func TestCancelQueuedBatchChangeChangesets(t *testing.T) {
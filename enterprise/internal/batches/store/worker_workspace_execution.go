@@ -13,11 +13,14 @@ import (
 	"github.com/keegancsmith/sqlf"
 	"github.com/lib/pq"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/global"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
 	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
 	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
@@ -227,6 +230,7 @@ func (s *batchSpecWorkspaceExecutionWorkerStore) MarkComplete(ctx context.Contex
 	}
 
 	changesetSpecIDs := []int64{}
+	changesetSpecs := []*btypes.ChangesetSpec{}
 	for _, entry := range executionResults {
 		// Store the cache entry.
 		if err := tx.CreateBatchSpecExecutionCacheEntry(ctx, entry); err != nil {
@@ -239,7 +243,7 @@ func (s *batchSpecWorkspaceExecutionWorkerStore) MarkComplete(ctx context.Contex
 			return rollbackAndMarkFailed(err, fmt.Sprintf("failed to parse cache entry: %s", err))
 		}
 
-		rawSpecs, err := cache.ChangesetSpecsFromCache(
+		rawSpecs, err := cache.ChangesetSpecsFromCacheWithReadFile(
 			batchSpec.Spec,
 			batcheslib.Repository{
 				ID:          string(relay.MarshalID("Repository", repo.ID)),
@@ -249,6 +253,9 @@ func (s *batchSpecWorkspaceExecutionWorkerStore) MarkComplete(ctx context.Contex
 				FileMatches: workspace.FileMatches,
 			},
 			executionResult,
+			func(path string) ([]byte, error) {
+				return git.ReadFile(ctx, repo.Name, api.CommitID(workspace.Commit), path, 0)
+			},
 		)
 		if err != nil {
 			return rollbackAndMarkFailed(err, fmt.Sprintf("failed to build changeset specs from cache: %s", err))
@@ -274,6 +281,13 @@ func (s *batchSpecWorkspaceExecutionWorkerStore) MarkComplete(ctx context.Contex
 			for _, spec := range specs {
 				changesetSpecIDs = append(changesetSpecIDs, spec.ID)
 			}
+			changesetSpecs = append(changesetSpecs, specs...)
+		}
+	}
+
+	if workspace.RefreshChangesetID != 0 {
+		if err := refreshChangeset(ctx, tx, workspace, changesetSpecs); err != nil {
+			return rollbackAndMarkFailed(err, fmt.Sprintf("failed to refresh changeset: %s", err))
 		}
 	}
 
@@ -290,6 +304,41 @@ func (s *batchSpecWorkspaceExecutionWorkerStore) MarkComplete(ctx context.Contex
 	return ok, tx.Done(err)
 }
 
+// refreshChangeset updates the changeset that the workspace was re-executed
+// for with the changeset spec for the same branch among the given specs, and
+// enqueues it, so that the reconciler pushes the new changes.
+func refreshChangeset(ctx context.Context, tx *Store, workspace *btypes.BatchSpecWorkspace, specs []*btypes.ChangesetSpec) error {
+	ch, err := tx.GetChangeset(ctx, GetChangesetOpts{ID: workspace.RefreshChangesetID})
+	if err != nil {
+		return errors.Wrap(err, "loading changeset")
+	}
+
+	currentSpec, err := tx.GetChangesetSpecByID(ctx, ch.CurrentSpecID)
+	if err != nil {
+		return errors.Wrap(err, "loading changeset spec")
+	}
+
+	var refreshedSpec *btypes.ChangesetSpec
+	for _, spec := range specs {
+		if spec.Spec.HeadRef == currentSpec.Spec.HeadRef {
+			refreshedSpec = spec
+			break
+		}
+	}
+	if refreshedSpec == nil {
+		return errors.Newf("execution produced no changes for branch %q", currentSpec.Spec.HeadRef)
+	}
+
+	ch.PreviousSpecID = ch.CurrentSpecID
+	ch.CurrentSpecID = refreshedSpec.ID
+	ch.ResetReconcilerState(global.DefaultReconcilerEnqueueState())
+	if err := tx.UpdateChangeset(ctx, ch); err != nil {
+		return errors.Wrap(err, "updating changeset")
+	}
+
+	return tx.RefreshBatchSpecWorkspace(ctx, workspace.ID, workspace.Commit, 0)
+}
+
 func (s *batchSpecWorkspaceExecutionWorkerStore) setChangesetSpecIDs(ctx context.Context, batchSpecWorkspaceID int64, changesetSpecIDs []int64) error {
 	if len(changesetSpecIDs) > 0 {
 		// Set the batch_spec_id on the changeset_specs that were created.
@@ -186,6 +186,65 @@ stdout: {"operation":"CACHE_AFTER_STEP_RESULT","timestamp":"2021-11-04T12:43:19.
 		assertJobState(t, btypes.BatchSpecWorkspaceExecutionJobStateCompleted)
 	})
 
+	t.Run("refresh", func(t *testing.T) {
+		specs, _, err := s.ListChangesetSpecs(ctx, ListChangesetSpecsOpts{BatchSpecID: batchSpec.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		previousSpec := specs[len(specs)-1]
+
+		changeset := ct.CreateChangeset(t, ctx, s, ct.TestChangesetOpts{
+			Repo:            repo.ID,
+			CurrentSpec:     previousSpec.ID,
+			ReconcilerState: btypes.ReconcilerStateCompleted,
+		})
+		if err := s.RefreshBatchSpecWorkspace(ctx, workspace.ID, "new-base-rev", changeset.ID); err != nil {
+			t.Fatal(err)
+		}
+
+		setProcessing(t)
+
+		ok, err := executionStore.MarkComplete(context.Background(), int(job.ID), opts)
+		if !ok || err != nil {
+			t.Fatalf("MarkComplete failed. ok=%t, err=%s", ok, err)
+		}
+
+		assertJobState(t, btypes.BatchSpecWorkspaceExecutionJobStateCompleted)
+
+		reloadedWorkspace, err := s.GetBatchSpecWorkspace(ctx, GetBatchSpecWorkspaceOpts{ID: workspace.ID})
+		if err != nil {
+			t.Fatalf("failed to reload workspace: %s", err)
+		}
+		if reloadedWorkspace.RefreshChangesetID != 0 {
+			t.Fatalf("workspace still refreshes changeset %d", reloadedWorkspace.RefreshChangesetID)
+		}
+		if len(reloadedWorkspace.ChangesetSpecIDs) != 1 {
+			t.Fatalf("wrong changeset spec IDs on workspace: %v", reloadedWorkspace.ChangesetSpecIDs)
+		}
+
+		reloadedChangeset, err := s.GetChangeset(ctx, GetChangesetOpts{ID: changeset.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have, want := reloadedChangeset.CurrentSpecID, reloadedWorkspace.ChangesetSpecIDs[0]; have != want {
+			t.Fatalf("wrong current spec: have=%d want=%d", have, want)
+		}
+		if have, want := reloadedChangeset.PreviousSpecID, previousSpec.ID; have != want {
+			t.Fatalf("wrong previous spec: have=%d want=%d", have, want)
+		}
+		if have, want := reloadedChangeset.ReconcilerState, btypes.ReconcilerStateQueued; have != want {
+			t.Fatalf("wrong reconciler state: have=%s want=%s", have, want)
+		}
+
+		currentSpec, err := s.GetChangesetSpecByID(ctx, reloadedChangeset.CurrentSpecID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have, want := currentSpec.Spec.BaseRev, "new-base-rev"; have != want {
+			t.Fatalf("wrong base rev: have=%q want=%q", have, want)
+		}
+	})
+
 	t.Run("token set but deletion fails", func(t *testing.T) {
 		setProcessing(t)
 		tokenID := attachAccessToken(t)
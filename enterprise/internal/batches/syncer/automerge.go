@@ -0,0 +1,165 @@
+package syncer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types/scheduler/window"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+)
+
+// EvaluateAutoMerge evaluates the auto-merge policy of the batch change that
+// owns the given changeset and enqueues a merge job if the changeset can be
+// merged. Like in SyncChangeset, the caller is responsible for persisting the
+// updated c.AutoMergeBlockedReason.
+func EvaluateAutoMerge(ctx context.Context, tx *store.Store, c *btypes.Changeset) error {
+	mergeJob, err := evaluateAutoMerge(ctx, tx, c)
+	if err != nil || mergeJob == nil {
+		return err
+	}
+	return tx.CreateChangesetJob(ctx, mergeJob)
+}
+
+// evaluateAutoMerge evaluates the auto-merge policy of the batch change that
+// owns the given changeset, if any. It records why the changeset can't be
+// merged yet in c.AutoMergeBlockedReason, and returns a merge job to be
+// enqueued for the bulk processor if it can.
+//
+// The returned job is nil if no policy applies, the changeset can't be merged
+// yet, or a merge job is already enqueued or has completed.
+func evaluateAutoMerge(ctx context.Context, tx *store.Store, c *btypes.Changeset) (*btypes.ChangesetJob, error) {
+	c.AutoMergeBlockedReason = ""
+
+	// Only changesets that were created by a batch change are merged
+	// automatically; imported changesets are left alone.
+	if c.OwnedByBatchChangeID == 0 {
+		return nil, nil
+	}
+
+	batchChange, err := tx.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: c.OwnedByBatchChangeID})
+	if err != nil {
+		if err == store.ErrNoResults {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "loading batch change")
+	}
+	if batchChange.Closed() {
+		return nil, nil
+	}
+
+	batchSpec, err := tx.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: batchChange.BatchSpecID})
+	if err != nil {
+		return nil, errors.Wrap(err, "loading batch spec")
+	}
+	policy := batchSpec.Spec.AutoMerge
+	if policy == nil {
+		return nil, nil
+	}
+
+	if c.AutoMergeBlockedReason = autoMergeBlockedReason(policy, c, tx.Clock()()); c.AutoMergeBlockedReason != "" {
+		return nil, nil
+	}
+	if c.ExternalState != btypes.ChangesetExternalStateOpen {
+		// Merged and closed changesets are left alone.
+		return nil, nil
+	}
+
+	// Check whether we already tried to merge the changeset.
+	job, err := tx.GetChangesetJob(ctx, store.GetChangesetJobOpts{
+		ChangesetID: c.ID,
+		JobType:     btypes.ChangesetJobTypeMerge,
+	})
+	if err != nil && err != store.ErrNoResults {
+		return nil, errors.Wrap(err, "loading merge job")
+	}
+	if job != nil {
+		var retry bool
+		if retry, c.AutoMergeBlockedReason = retryMerge(job, c); !retry {
+			return nil, nil
+		}
+	}
+
+	bulkGroup, err := store.RandomID()
+	if err != nil {
+		return nil, errors.Wrap(err, "creating bulk group")
+	}
+
+	return &btypes.ChangesetJob{
+		BulkGroup:     bulkGroup,
+		BatchChangeID: batchChange.ID,
+		UserID:        batchChange.LastApplierID,
+		ChangesetID:   c.ID,
+		JobType:       btypes.ChangesetJobTypeMerge,
+		Payload:       &btypes.ChangesetJobMergePayload{Squash: policy.Squash()},
+		State:         btypes.ChangesetJobStateQueued,
+	}, nil
+}
+
+// retryMerge returns whether the changeset should be merged again, given the
+// previous merge job for it. If it shouldn't, the returned reason explains why
+// the changeset isn't merged yet, if there is anything to explain.
+func retryMerge(job *btypes.ChangesetJob, c *btypes.Changeset) (bool, string) {
+	switch job.State {
+	case btypes.ChangesetJobStateQueued, btypes.ChangesetJobStateProcessing, btypes.ChangesetJobStateErrored:
+		// The changeset is going to be merged.
+		return false, ""
+
+	case btypes.ChangesetJobStateCompleted:
+		// The changeset was merged, but the code host state we have may not
+		// reflect that yet. Only merge again if the changeset was updated on
+		// the code host since, for example because it was reopened.
+		return c.ExternalUpdatedAt.After(job.FinishedAt), ""
+
+	case btypes.ChangesetJobStateFailed:
+		// Don't try again until the changeset has been updated on the code
+		// host, since the merge would most likely fail again.
+		if !c.ExternalUpdatedAt.After(job.FinishedAt) {
+			msg := "unknown error"
+			if job.FailureMessage != nil {
+				msg = *job.FailureMessage
+			}
+			return false, fmt.Sprintf("merging failed: %s", msg)
+		}
+	}
+
+	return true, ""
+}
+
+// autoMergeBlockedReason returns why the given changeset can't be merged
+// according to the policy at the given time. It returns an empty string if the
+// changeset can be merged, or if merging doesn't apply to it at all, because
+// it is already merged or closed.
+func autoMergeBlockedReason(policy *batcheslib.AutoMerge, c *btypes.Changeset, now time.Time) string {
+	switch c.ExternalState {
+	case btypes.ChangesetExternalStateOpen:
+	case btypes.ChangesetExternalStateDraft:
+		return "changeset is a draft"
+	default:
+		return ""
+	}
+
+	var reasons []string
+	if policy.RequiredReviewState() == batcheslib.AutoMergeReviewStateApproved && c.ExternalReviewState != btypes.ChangesetReviewStateApproved {
+		reasons = append(reasons, fmt.Sprintf("changeset is not approved (review state is %s)", c.ExternalReviewState))
+	}
+	if policy.RequiredCheckState() == batcheslib.AutoMergeCheckStatePassed && c.ExternalCheckState != btypes.ChangesetCheckStatePassed {
+		reasons = append(reasons, fmt.Sprintf("checks have not passed (check state is %s)", c.ExternalCheckState))
+	}
+
+	if policy.Window != nil {
+		w, err := window.NewUnlimitedWindow(policy.Window.Days, policy.Window.Start, policy.Window.End)
+		if err != nil {
+			reasons = append(reasons, fmt.Sprintf("invalid merge window: %s", err))
+		} else if !w.IsOpen(now.UTC()) {
+			reasons = append(reasons, fmt.Sprintf("outside of the merge window, which opens next at %s", w.NextOpenAfter(now.UTC()).Format(time.RFC3339)))
+		}
+	}
+
+	return strings.Join(reasons, "; ")
+}
@@ -0,0 +1,141 @@
+package syncer
+
+import (
+	"testing"
+	"time"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+)
+
+func TestAutoMergeBlockedReason(t *testing.T) {
+	t.Parallel()
+
+	// A Wednesday.
+	now := time.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	mergeable := func() *btypes.Changeset {
+		return &btypes.Changeset{
+			ExternalState:       btypes.ChangesetExternalStateOpen,
+			ExternalReviewState: btypes.ChangesetReviewStateApproved,
+			ExternalCheckState:  btypes.ChangesetCheckStatePassed,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		policy    *batcheslib.AutoMerge
+		changeset func(c *btypes.Changeset)
+		want      string
+	}{
+		{
+			name:   "mergeable with defaults",
+			policy: &batcheslib.AutoMerge{},
+			want:   "",
+		},
+		{
+			name:      "merged changeset",
+			policy:    &batcheslib.AutoMerge{},
+			changeset: func(c *btypes.Changeset) { c.ExternalState = btypes.ChangesetExternalStateMerged },
+			want:      "",
+		},
+		{
+			name:      "draft changeset",
+			policy:    &batcheslib.AutoMerge{},
+			changeset: func(c *btypes.Changeset) { c.ExternalState = btypes.ChangesetExternalStateDraft },
+			want:      "changeset is a draft",
+		},
+		{
+			name:   "not approved and checks pending",
+			policy: &batcheslib.AutoMerge{},
+			changeset: func(c *btypes.Changeset) {
+				c.ExternalReviewState = btypes.ChangesetReviewStatePending
+				c.ExternalCheckState = btypes.ChangesetCheckStatePending
+			},
+			want: "changeset is not approved (review state is PENDING); checks have not passed (check state is PENDING)",
+		},
+		{
+			name: "any review and check state",
+			policy: &batcheslib.AutoMerge{
+				ReviewState: batcheslib.AutoMergeReviewStateAny,
+				CheckState:  batcheslib.AutoMergeCheckStateAny,
+			},
+			changeset: func(c *btypes.Changeset) {
+				c.ExternalReviewState = btypes.ChangesetReviewStateChangesRequested
+				c.ExternalCheckState = btypes.ChangesetCheckStateUnknown
+			},
+			want: "",
+		},
+		{
+			name: "inside merge window",
+			policy: &batcheslib.AutoMerge{
+				Window: &batcheslib.AutoMergeWindow{Days: []string{"wednesday"}, Start: "09:00", End: "17:00"},
+			},
+			want: "",
+		},
+		{
+			name: "outside merge window",
+			policy: &batcheslib.AutoMerge{
+				Window: &batcheslib.AutoMergeWindow{Days: []string{"friday"}, Start: "09:00", End: "17:00"},
+			},
+			want: "outside of the merge window, which opens next at 2021-09-03T09:00:00Z",
+		},
+		{
+			name: "invalid merge window",
+			policy: &batcheslib.AutoMerge{
+				Window: &batcheslib.AutoMergeWindow{Start: "17:00", End: "09:00"},
+			},
+			want: "invalid merge window: 1 error occurred:\n\t* end time must be after the start time\n\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := mergeable()
+			if tt.changeset != nil {
+				tt.changeset(c)
+			}
+
+			if have := autoMergeBlockedReason(tt.policy, c, now); have != tt.want {
+				t.Errorf("wrong reason. want=%q, have=%q", tt.want, have)
+			}
+		})
+	}
+}
+
+func TestRetryMerge(t *testing.T) {
+	t.Parallel()
+
+	finishedAt := time.Date(2021, 9, 1, 12, 0, 0, 0, time.UTC)
+	failureMessage := "merge conflict"
+
+	tests := []struct {
+		name       string
+		state      btypes.ChangesetJobState
+		updatedAt  time.Time
+		wantRetry  bool
+		wantReason string
+	}{
+		{name: "queued", state: btypes.ChangesetJobStateQueued, updatedAt: finishedAt.Add(time.Hour)},
+		{name: "errored", state: btypes.ChangesetJobStateErrored, updatedAt: finishedAt.Add(time.Hour)},
+		{name: "completed, not synced since", state: btypes.ChangesetJobStateCompleted, updatedAt: finishedAt.Add(-time.Minute)},
+		{name: "completed, updated since", state: btypes.ChangesetJobStateCompleted, updatedAt: finishedAt.Add(time.Hour), wantRetry: true},
+		{name: "failed, not updated since", state: btypes.ChangesetJobStateFailed, updatedAt: finishedAt.Add(-time.Minute), wantReason: "merging failed: merge conflict"},
+		{name: "failed, updated since", state: btypes.ChangesetJobStateFailed, updatedAt: finishedAt.Add(time.Hour), wantRetry: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			job := &btypes.ChangesetJob{State: tc.state, FinishedAt: finishedAt, FailureMessage: &failureMessage}
+			c := &btypes.Changeset{ExternalUpdatedAt: tc.updatedAt}
+
+			retry, reason := retryMerge(job, c)
+			if retry != tc.wantRetry {
+				t.Errorf("unexpected retry. want=%v have=%v", tc.wantRetry, retry)
+			}
+			if reason != tc.wantReason {
+				t.Errorf("unexpected reason. want=%q have=%q", tc.wantReason, reason)
+			}
+		})
+	}
+}
@@ -4,13 +4,25 @@ import (
 	"time"
 
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 )
 
 var (
 	minSyncDelay = 2 * time.Minute
 	maxSyncDelay = 8 * time.Hour
+
+	// maxPollingSyncDelay is the maximum delay between syncs for changesets
+	// on code hosts that don't support webhooks, so that we pick up changes
+	// in a timely manner by polling alone.
+	maxPollingSyncDelay = 30 * time.Minute
 )
 
+// webhooklessExternalServiceTypes are the code hosts that can't notify us
+// about changes to changesets through webhooks.
+var webhooklessExternalServiceTypes = map[string]struct{}{
+	extsvc.TypeAWSCodeCommit: {},
+}
+
 // NextSync computes the time we want the next sync to happen.
 func NextSync(clock func() time.Time, h *btypes.ChangesetSyncData) time.Time {
 	lastSync := h.UpdatedAt
@@ -45,6 +57,9 @@ func NextSync(clock func() time.Time, h *btypes.ChangesetSyncData) time.Time {
 	if diff > maxSyncDelay {
 		diff = maxSyncDelay
 	}
+	if _, ok := webhooklessExternalServiceTypes[h.ExternalServiceType]; ok && diff > maxPollingSyncDelay {
+		diff = maxPollingSyncDelay
+	}
 	if diff < minSyncDelay {
 		diff = minSyncDelay
 	}
@@ -7,6 +7,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 )
 
 func TestNextSync(t *testing.T) {
@@ -51,6 +52,15 @@ func TestNextSync(t *testing.T) {
 			},
 			want: clock().Add(maxSyncDelay),
 		},
+		{
+			name: "Diff max is capped for code hosts without webhooks",
+			h: &btypes.ChangesetSyncData{
+				UpdatedAt:           clock(),
+				ExternalUpdatedAt:   clock().Add(-2 * time.Hour),
+				ExternalServiceType: extsvc.TypeAWSCodeCommit,
+			},
+			want: clock().Add(maxPollingSyncDelay),
+		},
 		{
 			name: "Diff min is capped",
 			h: &btypes.ChangesetSyncData{
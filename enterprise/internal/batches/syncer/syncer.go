@@ -486,12 +486,27 @@ func SyncChangeset(ctx context.Context, syncStore SyncStore, source sources.Chan
 	// Reset syncer error message state.
 	c.SyncErrorMessage = nil
 
+	// Now that the changeset is up to date, check whether it should be merged
+	// automatically.
+	mergeJob, err := evaluateAutoMerge(ctx, tx, c)
+	if err != nil {
+		return errors.Wrap(err, "evaluating auto-merge policy")
+	}
+
 	err = tx.UpdateChangesetCodeHostState(ctx, c)
 	if err != nil {
 		return err
 	}
 
-	return tx.UpsertChangesetEvents(ctx, events...)
+	if err := tx.UpsertChangesetEvents(ctx, events...); err != nil {
+		return err
+	}
+
+	if mergeJob != nil {
+		return tx.CreateChangesetJob(ctx, mergeJob)
+	}
+
+	return nil
 }
 
 func loadChangesetSource(ctx context.Context, cf *httpcli.Factory, syncStore SyncStore, repo *types.Repo) (sources.ChangesetSource, error) {
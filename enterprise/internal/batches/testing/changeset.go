@@ -131,18 +131,19 @@ func BuildChangeset(opts TestChangesetOpts) *btypes.Changeset {
 }
 
 type ChangesetAssertions struct {
-	Repo               api.RepoID
-	CurrentSpec        int64
-	PreviousSpec       int64
-	OwnedByBatchChange int64
-	ReconcilerState    btypes.ReconcilerState
-	PublicationState   btypes.ChangesetPublicationState
-	UiPublicationState *btypes.ChangesetUiPublicationState
-	ExternalState      btypes.ChangesetExternalState
-	ExternalID         string
-	ExternalBranch     string
-	DiffStat           *diff.Stat
-	Closing            bool
+	Repo                  api.RepoID
+	CurrentSpec           int64
+	PreviousSpec          int64
+	OwnedByBatchChange    int64
+	ReconcilerState       btypes.ReconcilerState
+	PublicationState      btypes.ChangesetPublicationState
+	UiPublicationState    *btypes.ChangesetUiPublicationState
+	ExternalState         btypes.ChangesetExternalState
+	ExternalID            string
+	ExternalBranch        string
+	ExternalForkNamespace string
+	DiffStat              *diff.Stat
+	Closing               bool
 
 	Title string
 	Body  string
@@ -206,6 +207,10 @@ func AssertChangeset(t *testing.T, c *btypes.Changeset, a ChangesetAssertions) {
 		t.Fatalf("changeset ExternalBranch wrong. want=%s, have=%s", want, have)
 	}
 
+	if have, want := c.ExternalForkNamespace, a.ExternalForkNamespace; have != want {
+		t.Fatalf("changeset ExternalForkNamespace wrong. want=%s, have=%s", want, have)
+	}
+
 	if want, have := a.FailureMessage, c.FailureMessage; want == nil && have != nil {
 		t.Fatalf("expected no failure message, but have=%q", *have)
 	}
@@ -40,6 +40,13 @@ type TestSpecOpts struct {
 
 	BaseRev string
 	BaseRef string
+
+	// If this is set, the changesetSpec asks for the head ref to be pushed to
+	// a fork.
+	Fork bool
+
+	Reviewers []string
+	Labels    []string
 }
 
 var TestChangsetSpecDiffStat = &diff.Stat{Added: 10, Changed: 5, Deleted: 2}
@@ -66,6 +73,9 @@ func BuildChangesetSpec(t *testing.T, opts TestSpecOpts) *btypes.ChangesetSpec {
 			ExternalID: opts.ExternalID,
 			HeadRef:    opts.HeadRef,
 			Published:  published,
+			Fork:       opts.Fork,
+			Reviewers:  opts.Reviewers,
+			Labels:     opts.Labels,
 
 			Title: opts.Title,
 			Body:  opts.Body,
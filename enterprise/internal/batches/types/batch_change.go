@@ -28,6 +28,15 @@ type BatchChange struct {
 
 	ClosedAt time.Time
 
+	// RolloutWave is the last rollout wave that has been opened, if the batch
+	// spec declares a staged rollout; 0 otherwise.
+	RolloutWave int32
+	// RolloutWaveCompletedAt is the time at which all changesets in the
+	// opened rollout waves were published without failures.
+	RolloutWaveCompletedAt time.Time
+	// RolloutPausedReason explains why the next rollout wave isn't opened.
+	RolloutPausedReason string
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -5,7 +5,7 @@ import (
 	"time"
 )
 
-const CurrentCacheVersion = 2
+const CurrentCacheVersion = 3
 
 type BatchSpecExecutionCacheEntry struct {
 	ID int64
@@ -27,6 +27,11 @@ type BatchSpecWorkspace struct {
 	Skipped           bool
 	CachedResultFound bool
 
+	// RefreshChangesetID is the ID of the changeset that is updated with the
+	// changeset spec produced by the next execution of the workspace. It is
+	// set when a changeset is refreshed against a new base revision.
+	RefreshChangesetID int64
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -11,6 +11,8 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/awscodecommit"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
@@ -249,16 +251,20 @@ type Changeset struct {
 	ExternalID          string
 	ExternalServiceType string
 	// ExternalBranch should always be prefixed with refs/heads/. Call git.EnsureRefPrefix before setting this value.
-	ExternalBranch      string
-	ExternalDeletedAt   time.Time
-	ExternalUpdatedAt   time.Time
-	ExternalState       ChangesetExternalState
-	ExternalReviewState ChangesetReviewState
-	ExternalCheckState  ChangesetCheckState
-	DiffStatAdded       *int32
-	DiffStatChanged     *int32
-	DiffStatDeleted     *int32
-	SyncState           ChangesetSyncState
+	ExternalBranch string
+	// ExternalForkNamespace is the namespace (user or organisation) of the
+	// fork the changeset's head ref was pushed to. It's empty if the changeset
+	// wasn't pushed to a fork.
+	ExternalForkNamespace string
+	ExternalDeletedAt     time.Time
+	ExternalUpdatedAt     time.Time
+	ExternalState         ChangesetExternalState
+	ExternalReviewState   ChangesetReviewState
+	ExternalCheckState    ChangesetCheckState
+	DiffStatAdded         *int32
+	DiffStatChanged       *int32
+	DiffStatDeleted       *int32
+	SyncState             ChangesetSyncState
 
 	// The batch change that "owns" this changeset: it can create/close
 	// it on code host. If this is 0, it is imported/tracked by a batch change.
@@ -281,6 +287,17 @@ type Changeset struct {
 	NumFailures      int64
 	SyncErrorMessage *string
 
+	// AutoMergeBlockedReason explains why the changeset hasn't been merged
+	// yet by the auto-merge policy of the batch change that owns it. It's
+	// empty if no policy applies, or if the changeset has been enqueued to be
+	// merged.
+	AutoMergeBlockedReason string
+
+	// RolloutWave is the rollout wave of the batch change that owns the
+	// changeset in which the changeset is published. It's 0 if the batch
+	// change doesn't use a staged rollout.
+	RolloutWave int32
+
 	// Closing is set to true (along with the ReocncilerState) when the
 	// reconciler should close the changeset.
 	Closing bool
@@ -373,6 +390,18 @@ func (c *Changeset) SetMetadata(meta interface{}) error {
 		c.ExternalServiceType = extsvc.TypeBitbucketServer
 		c.ExternalBranch = git.EnsureRefPrefix(pr.FromRef.ID)
 		c.ExternalUpdatedAt = unixMilliToTime(int64(pr.UpdatedDate))
+	case *bitbucketcloud.PullRequest:
+		c.Metadata = pr
+		c.ExternalID = strconv.FormatInt(pr.ID, 10)
+		c.ExternalServiceType = extsvc.TypeBitbucketCloud
+		c.ExternalBranch = git.EnsureRefPrefix(pr.Source.Branch.Name)
+		c.ExternalUpdatedAt = pr.UpdatedOn
+	case *awscodecommit.PullRequest:
+		c.Metadata = pr
+		c.ExternalID = pr.ID
+		c.ExternalServiceType = extsvc.TypeAWSCodeCommit
+		c.ExternalBranch = git.EnsureRefPrefix(pr.Target.SourceReference)
+		c.ExternalUpdatedAt = pr.LastActivityDate
 	case *gitlab.MergeRequest:
 		c.Metadata = pr
 		c.ExternalID = strconv.FormatInt(int64(pr.IID), 10)
@@ -395,6 +424,45 @@ func (c *Changeset) RemoveBatchChangeID(id int64) {
 	}
 }
 
+// State returns the state of the changeset as it's shown to users, which
+// combines the reconciler, publication and external states.
+func (c *Changeset) State() (ChangesetState, error) {
+	// Note that there's an inverse version of this function in
+	// getRewirerMappingCurrentState(): if one changes, so should the other.
+
+	switch c.ReconcilerState {
+	case ReconcilerStateErrored:
+		return ChangesetStateRetrying, nil
+	case ReconcilerStateFailed:
+		return ChangesetStateFailed, nil
+	case ReconcilerStateScheduled:
+		return ChangesetStateScheduled, nil
+	default:
+		if c.ReconcilerState != ReconcilerStateCompleted {
+			return ChangesetStateProcessing, nil
+		}
+	}
+
+	if c.PublicationState == ChangesetPublicationStateUnpublished {
+		return ChangesetStateUnpublished, nil
+	}
+
+	switch c.ExternalState {
+	case ChangesetExternalStateDraft:
+		return ChangesetStateDraft, nil
+	case ChangesetExternalStateOpen:
+		return ChangesetStateOpen, nil
+	case ChangesetExternalStateClosed:
+		return ChangesetStateClosed, nil
+	case ChangesetExternalStateMerged:
+		return ChangesetStateMerged, nil
+	case ChangesetExternalStateDeleted:
+		return ChangesetStateDeleted, nil
+	default:
+		return "", errors.Errorf("invalid ExternalState %q for state calculation", c.ExternalState)
+	}
+}
+
 // Title of the Changeset.
 func (c *Changeset) Title() (string, error) {
 	switch m := c.Metadata.(type) {
@@ -402,6 +470,10 @@ func (c *Changeset) Title() (string, error) {
 		return m.Title, nil
 	case *bitbucketserver.PullRequest:
 		return m.Title, nil
+	case *bitbucketcloud.PullRequest:
+		return m.Title, nil
+	case *awscodecommit.PullRequest:
+		return m.Title, nil
 	case *gitlab.MergeRequest:
 		return m.Title, nil
 	default:
@@ -419,6 +491,10 @@ func (c *Changeset) AuthorName() (string, error) {
 			return "", nil
 		}
 		return m.Author.User.Name, nil
+	case *bitbucketcloud.PullRequest:
+		return m.Author.Nickname, nil
+	case *awscodecommit.PullRequest:
+		return m.AuthorName(), nil
 	case *gitlab.MergeRequest:
 		return m.Author.Username, nil
 	default:
@@ -442,6 +518,12 @@ func (c *Changeset) AuthorEmail() (string, error) {
 			return "", nil
 		}
 		return m.Author.User.EmailAddress, nil
+	case *bitbucketcloud.PullRequest:
+		// Bitbucket Cloud doesn't expose the email addresses of accounts.
+		return "", nil
+	case *awscodecommit.PullRequest:
+		// Pull request authors are IAM identities, which have no email.
+		return "", nil
 	case *gitlab.MergeRequest:
 		return m.Author.Email, nil
 	default:
@@ -458,6 +540,10 @@ func (c *Changeset) ExternalCreatedAt() time.Time {
 		return m.CreatedAt
 	case *bitbucketserver.PullRequest:
 		return unixMilliToTime(int64(m.CreatedDate))
+	case *bitbucketcloud.PullRequest:
+		return m.CreatedOn
+	case *awscodecommit.PullRequest:
+		return m.CreationDate
 	case *gitlab.MergeRequest:
 		return m.CreatedAt.Time
 	default:
@@ -472,6 +558,10 @@ func (c *Changeset) Body() (string, error) {
 		return m.Body, nil
 	case *bitbucketserver.PullRequest:
 		return m.Description, nil
+	case *bitbucketcloud.PullRequest:
+		return m.Summary.Raw, nil
+	case *awscodecommit.PullRequest:
+		return m.Description, nil
 	case *gitlab.MergeRequest:
 		return m.Description, nil
 	default:
@@ -509,6 +599,10 @@ func (c *Changeset) URL() (s string, err error) {
 		}
 		selfLink := m.Links.Self[0]
 		return selfLink.Href, nil
+	case *bitbucketcloud.PullRequest:
+		return m.Links.HTML.Href, nil
+	case *awscodecommit.PullRequest:
+		return m.URL, nil
 	case *gitlab.MergeRequest:
 		return m.WebURL, nil
 	default:
@@ -600,6 +694,17 @@ func (c *Changeset) Events() (events []*ChangesetEvent, err error) {
 			}
 		}
 
+	case *bitbucketcloud.PullRequest:
+		events = make([]*ChangesetEvent, 0, len(m.Statuses))
+		for _, s := range m.Statuses {
+			appendEvent(&ChangesetEvent{
+				ChangesetID: c.ID,
+				Key:         s.Key(),
+				Kind:        ChangesetEventKindBitbucketCloudCommitStatus,
+				Metadata:    s,
+			})
+		}
+
 	case *gitlab.MergeRequest:
 		events = make([]*ChangesetEvent, 0, len(m.Notes)+len(m.ResourceStateEvents)+len(m.Pipelines))
 		var kind ChangesetEventKind
@@ -656,6 +761,13 @@ func (c *Changeset) HeadRefOid() (string, error) {
 		return m.HeadRefOid, nil
 	case *bitbucketserver.PullRequest:
 		return "", nil
+	case *bitbucketcloud.PullRequest:
+		if m.Source.Commit == nil {
+			return "", nil
+		}
+		return m.Source.Commit.Hash, nil
+	case *awscodecommit.PullRequest:
+		return m.Target.SourceCommit, nil
 	case *gitlab.MergeRequest:
 		return m.DiffRefs.HeadSHA, nil
 	default:
@@ -671,6 +783,10 @@ func (c *Changeset) HeadRef() (string, error) {
 		return "refs/heads/" + m.HeadRefName, nil
 	case *bitbucketserver.PullRequest:
 		return m.FromRef.ID, nil
+	case *bitbucketcloud.PullRequest:
+		return "refs/heads/" + m.Source.Branch.Name, nil
+	case *awscodecommit.PullRequest:
+		return git.EnsureRefPrefix(m.Target.SourceReference), nil
 	case *gitlab.MergeRequest:
 		return "refs/heads/" + m.SourceBranch, nil
 	default:
@@ -687,6 +803,13 @@ func (c *Changeset) BaseRefOid() (string, error) {
 		return m.BaseRefOid, nil
 	case *bitbucketserver.PullRequest:
 		return "", nil
+	case *bitbucketcloud.PullRequest:
+		if m.Destination.Commit == nil {
+			return "", nil
+		}
+		return m.Destination.Commit.Hash, nil
+	case *awscodecommit.PullRequest:
+		return m.Target.DestinationCommit, nil
 	case *gitlab.MergeRequest:
 		return m.DiffRefs.BaseSHA, nil
 	default:
@@ -702,6 +825,10 @@ func (c *Changeset) BaseRef() (string, error) {
 		return "refs/heads/" + m.BaseRefName, nil
 	case *bitbucketserver.PullRequest:
 		return m.ToRef.ID, nil
+	case *bitbucketcloud.PullRequest:
+		return "refs/heads/" + m.Destination.Branch.Name, nil
+	case *awscodecommit.PullRequest:
+		return git.EnsureRefPrefix(m.Target.DestinationReference), nil
 	case *gitlab.MergeRequest:
 		return "refs/heads/" + m.TargetBranch, nil
 	default:
@@ -913,6 +1040,17 @@ type ChangesetsStats struct {
 	Archived   int32
 }
 
+// RolloutWaveStats holds stats information on the changesets in the rollout
+// waves of a batch change that have been opened.
+type RolloutWaveStats struct {
+	// Pending is the number of changesets that haven't been reconciled yet.
+	Pending int32
+	// Failed is the number of changesets that failed to be reconciled.
+	Failed int32
+	// FailingChecks is the number of open changesets whose checks failed.
+	FailingChecks int32
+}
+
 // ChangesetEventKindFor returns the ChangesetEventKind for the given
 // specific code host event.
 func ChangesetEventKindFor(e interface{}) (ChangesetEventKind, error) {
@@ -964,6 +1102,24 @@ func ChangesetEventKindFor(e interface{}) (ChangesetEventKind, error) {
 		return ChangesetEventKind("bitbucketserver:participant_status:" + strings.ToLower(string(e.Action))), nil
 	case *bitbucketserver.CommitStatus:
 		return ChangesetEventKindBitbucketServerCommitStatus, nil
+	case *bitbucketcloud.PullRequestApprovedEvent:
+		return ChangesetEventKindBitbucketCloudApproved, nil
+	case *bitbucketcloud.PullRequestUnapprovedEvent:
+		return ChangesetEventKindBitbucketCloudUnapproved, nil
+	case *bitbucketcloud.PullRequestChangesRequestCreatedEvent:
+		return ChangesetEventKindBitbucketCloudChangesRequestCreated, nil
+	case *bitbucketcloud.PullRequestChangesRequestRemovedEvent:
+		return ChangesetEventKindBitbucketCloudChangesRequestRemoved, nil
+	case *bitbucketcloud.PullRequestCommentCreatedEvent:
+		return ChangesetEventKindBitbucketCloudCommented, nil
+	case *bitbucketcloud.PullRequestFulfilledEvent:
+		return ChangesetEventKindBitbucketCloudMerged, nil
+	case *bitbucketcloud.PullRequestRejectedEvent:
+		return ChangesetEventKindBitbucketCloudDeclined, nil
+	case *bitbucketcloud.PullRequestUpdatedEvent:
+		return ChangesetEventKindBitbucketCloudUpdated, nil
+	case *bitbucketcloud.CommitStatus:
+		return ChangesetEventKindBitbucketCloudCommitStatus, nil
 	case *gitlab.Pipeline:
 		return ChangesetEventKindGitLabPipeline, nil
 	case *gitlab.ReviewApprovedEvent:
@@ -999,6 +1155,27 @@ func NewChangesetEventMetadata(k ChangesetEventKind) (interface{}, error) {
 		default:
 			return new(bitbucketserver.Activity), nil
 		}
+	case strings.HasPrefix(string(k), "bitbucketcloud"):
+		switch k {
+		case ChangesetEventKindBitbucketCloudApproved:
+			return new(bitbucketcloud.PullRequestApprovedEvent), nil
+		case ChangesetEventKindBitbucketCloudUnapproved:
+			return new(bitbucketcloud.PullRequestUnapprovedEvent), nil
+		case ChangesetEventKindBitbucketCloudChangesRequestCreated:
+			return new(bitbucketcloud.PullRequestChangesRequestCreatedEvent), nil
+		case ChangesetEventKindBitbucketCloudChangesRequestRemoved:
+			return new(bitbucketcloud.PullRequestChangesRequestRemovedEvent), nil
+		case ChangesetEventKindBitbucketCloudCommented:
+			return new(bitbucketcloud.PullRequestCommentCreatedEvent), nil
+		case ChangesetEventKindBitbucketCloudMerged:
+			return new(bitbucketcloud.PullRequestFulfilledEvent), nil
+		case ChangesetEventKindBitbucketCloudDeclined:
+			return new(bitbucketcloud.PullRequestRejectedEvent), nil
+		case ChangesetEventKindBitbucketCloudUpdated:
+			return new(bitbucketcloud.PullRequestUpdatedEvent), nil
+		case ChangesetEventKindBitbucketCloudCommitStatus:
+			return new(bitbucketcloud.CommitStatus), nil
+		}
 	case strings.HasPrefix(string(k), "github"):
 		switch k {
 		case ChangesetEventKindGitHubAssigned:
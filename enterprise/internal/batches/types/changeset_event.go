@@ -9,6 +9,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/inconshreveable/log15"
 
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
@@ -69,6 +70,16 @@ const (
 	// clearly convey that it only occurs when a request for changes has been dismissed.
 	ChangesetEventKindBitbucketServerDismissed ChangesetEventKind = "bitbucketserver:participant_status:unapproved"
 
+	ChangesetEventKindBitbucketCloudApproved              ChangesetEventKind = "bitbucketcloud:approved"
+	ChangesetEventKindBitbucketCloudUnapproved            ChangesetEventKind = "bitbucketcloud:unapproved"
+	ChangesetEventKindBitbucketCloudChangesRequestCreated ChangesetEventKind = "bitbucketcloud:changes_request_created"
+	ChangesetEventKindBitbucketCloudChangesRequestRemoved ChangesetEventKind = "bitbucketcloud:changes_request_removed"
+	ChangesetEventKindBitbucketCloudCommented             ChangesetEventKind = "bitbucketcloud:commented"
+	ChangesetEventKindBitbucketCloudMerged                ChangesetEventKind = "bitbucketcloud:merged"
+	ChangesetEventKindBitbucketCloudDeclined              ChangesetEventKind = "bitbucketcloud:declined"
+	ChangesetEventKindBitbucketCloudUpdated               ChangesetEventKind = "bitbucketcloud:updated"
+	ChangesetEventKindBitbucketCloudCommitStatus          ChangesetEventKind = "bitbucketcloud:commit_status"
+
 	ChangesetEventKindGitLabApproved             ChangesetEventKind = "gitlab:approved"
 	ChangesetEventKindGitLabClosed               ChangesetEventKind = "gitlab:closed"
 	ChangesetEventKindGitLabMerged               ChangesetEventKind = "gitlab:merged"
@@ -115,6 +126,18 @@ func (e *ChangesetEvent) ReviewAuthor() string {
 	case *bitbucketserver.ParticipantStatusEvent:
 		return meta.User.Name
 
+	case *bitbucketcloud.PullRequestApprovedEvent:
+		return meta.Approval.User.Nickname
+
+	case *bitbucketcloud.PullRequestUnapprovedEvent:
+		return meta.Approval.User.Nickname
+
+	case *bitbucketcloud.PullRequestChangesRequestCreatedEvent:
+		return meta.ChangesRequest.User.Nickname
+
+	case *bitbucketcloud.PullRequestChangesRequestRemovedEvent:
+		return meta.ChangesRequest.User.Nickname
+
 	case *gitlab.ReviewApprovedEvent:
 		return meta.Author.Username
 
@@ -130,9 +153,13 @@ func (e *ChangesetEvent) ReviewAuthor() string {
 func (e *ChangesetEvent) ReviewState() (ChangesetReviewState, error) {
 	switch e.Kind {
 	case ChangesetEventKindBitbucketServerApproved,
+		ChangesetEventKindBitbucketCloudApproved,
 		ChangesetEventKindGitLabApproved:
 		return ChangesetReviewStateApproved, nil
 
+	case ChangesetEventKindBitbucketCloudChangesRequestCreated:
+		return ChangesetReviewStateChangesRequested, nil
+
 	// BitbucketServer's "REVIEWED" activity is created when someone clicks
 	// the "Needs work" button in the UI, which is why we map it to "Changes Requested"
 	case ChangesetEventKindBitbucketServerReviewed:
@@ -155,6 +182,8 @@ func (e *ChangesetEvent) ReviewState() (ChangesetReviewState, error) {
 	case ChangesetEventKindGitHubReviewDismissed,
 		ChangesetEventKindBitbucketServerUnapproved,
 		ChangesetEventKindBitbucketServerDismissed,
+		ChangesetEventKindBitbucketCloudUnapproved,
+		ChangesetEventKindBitbucketCloudChangesRequestRemoved,
 		ChangesetEventKindGitLabUnapproved:
 		return ChangesetReviewStateDismissed, nil
 
@@ -221,6 +250,24 @@ func (e *ChangesetEvent) Timestamp() time.Time {
 		t = unixMilliToTime(int64(ev.CreatedDate))
 	case *bitbucketserver.CommitStatus:
 		t = unixMilliToTime(ev.Status.DateAdded)
+	case *bitbucketcloud.PullRequestApprovedEvent:
+		t = ev.Approval.Date
+	case *bitbucketcloud.PullRequestUnapprovedEvent:
+		t = ev.Approval.Date
+	case *bitbucketcloud.PullRequestChangesRequestCreatedEvent:
+		t = ev.ChangesRequest.Date
+	case *bitbucketcloud.PullRequestChangesRequestRemovedEvent:
+		t = ev.ChangesRequest.Date
+	case *bitbucketcloud.PullRequestCommentCreatedEvent:
+		t = ev.Comment.CreatedOn
+	case *bitbucketcloud.PullRequestFulfilledEvent:
+		t = ev.PullRequest.UpdatedOn
+	case *bitbucketcloud.PullRequestRejectedEvent:
+		t = ev.PullRequest.UpdatedOn
+	case *bitbucketcloud.PullRequestUpdatedEvent:
+		t = ev.PullRequest.UpdatedOn
+	case *bitbucketcloud.CommitStatus:
+		t = ev.UpdatedOn
 	case *gitlab.ReviewApprovedEvent:
 		t = ev.CreatedAt.Time
 	case *gitlab.ReviewUnapprovedEvent:
@@ -602,6 +649,27 @@ func (e *ChangesetEvent) Update(o *ChangesetEvent) error {
 		// We always get the full event, so safe to replace it
 		*e = *o
 
+	// Bitbucket Cloud webhooks always contain the full event, so we can
+	// safely replace the existing metadata.
+	case *bitbucketcloud.PullRequestApprovedEvent:
+		*e = *o.Metadata.(*bitbucketcloud.PullRequestApprovedEvent)
+	case *bitbucketcloud.PullRequestUnapprovedEvent:
+		*e = *o.Metadata.(*bitbucketcloud.PullRequestUnapprovedEvent)
+	case *bitbucketcloud.PullRequestChangesRequestCreatedEvent:
+		*e = *o.Metadata.(*bitbucketcloud.PullRequestChangesRequestCreatedEvent)
+	case *bitbucketcloud.PullRequestChangesRequestRemovedEvent:
+		*e = *o.Metadata.(*bitbucketcloud.PullRequestChangesRequestRemovedEvent)
+	case *bitbucketcloud.PullRequestCommentCreatedEvent:
+		*e = *o.Metadata.(*bitbucketcloud.PullRequestCommentCreatedEvent)
+	case *bitbucketcloud.PullRequestFulfilledEvent:
+		*e = *o.Metadata.(*bitbucketcloud.PullRequestFulfilledEvent)
+	case *bitbucketcloud.PullRequestRejectedEvent:
+		*e = *o.Metadata.(*bitbucketcloud.PullRequestRejectedEvent)
+	case *bitbucketcloud.PullRequestUpdatedEvent:
+		*e = *o.Metadata.(*bitbucketcloud.PullRequestUpdatedEvent)
+	case *bitbucketcloud.CommitStatus:
+		*e = *o.Metadata.(*bitbucketcloud.CommitStatus)
+
 	case *github.CheckRun:
 		o := o.Metadata.(*github.CheckRun)
 		if e.Status == "" {
@@ -47,6 +47,7 @@ var (
 	ChangesetJobTypeMerge     ChangesetJobType = "merge"
 	ChangesetJobTypeClose     ChangesetJobType = "close"
 	ChangesetJobTypePublish   ChangesetJobType = "publish"
+	ChangesetJobTypeRefresh   ChangesetJobType = "refresh"
 )
 
 type ChangesetJobCommentPayload struct {
@@ -67,6 +68,8 @@ type ChangesetJobPublishPayload struct {
 	Draft bool `json:"draft"`
 }
 
+type ChangesetJobRefreshPayload struct{}
+
 // ChangesetJob describes a one-time action to be taken on a changeset.
 type ChangesetJob struct {
 	ID int64
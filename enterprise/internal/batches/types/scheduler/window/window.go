@@ -19,6 +19,22 @@ type Window struct {
 	rate  rate
 }
 
+// NewUnlimitedWindow constructs a Window that is open on the given days between
+// the given start and end times, without a rate limit. It uses the same format
+// as the rollout windows in the site configuration.
+func NewUnlimitedWindow(days []string, start, end string) (*Window, error) {
+	w, err := parseWindow(&schema.BatchChangeRolloutWindow{
+		Rate:  "unlimited",
+		Days:  days,
+		Start: start,
+		End:   end,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
 func (w *Window) covers(when timeOfDay) bool {
 	if w.start == nil || w.end == nil {
 		return true
@@ -375,3 +375,28 @@ func TestParseWindow(t *testing.T) {
 		}
 	})
 }
+
+func TestNewUnlimitedWindow(t *testing.T) {
+	t.Run("error", func(t *testing.T) {
+		if _, err := NewUnlimitedWindow(nil, "01:00", ""); err == nil {
+			t.Error("unexpected nil error")
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		have, err := NewUnlimitedWindow([]string{"friday"}, "09:00", "17:30")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		want := &Window{
+			days:  newWeekdaySet(time.Friday),
+			rate:  rate{n: -1},
+			start: timeOfDayPtr(9, 0),
+			end:   timeOfDayPtr(17, 30),
+		}
+		if diff := cmp.Diff(have, want, cmpOptions); diff != "" {
+			t.Errorf("unexpected window (-have +want):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,53 @@
+package commitgraph
+
+// FindPath returns the commits on a shortest path from the given descendant commit to the given
+// ancestor commit, inclusive, by following the parent edges of the given graph (a mapping from a
+// commit to its parents). If the ancestor is not reachable from the descendant within the graph,
+// a false-valued flag is returned.
+func FindPath(graph map[string][]string, descendant, ancestor string) ([]string, bool) {
+	if descendant == ancestor {
+		return []string{descendant}, true
+	}
+
+	// Breadth-first search from the descendant towards its ancestors. Each visited commit is
+	// mapped to the commit from which it was first reached so the path can be reconstructed
+	// once the ancestor is found.
+	children := map[string]string{descendant: ""}
+	frontier := []string{descendant}
+
+	for len(frontier) > 0 {
+		commit := frontier[0]
+		frontier = frontier[1:]
+
+		for _, parent := range graph[commit] {
+			if _, ok := children[parent]; ok {
+				continue
+			}
+			children[parent] = commit
+
+			if parent == ancestor {
+				return reconstructPath(children, descendant, ancestor), true
+			}
+
+			frontier = append(frontier, parent)
+		}
+	}
+
+	return nil, false
+}
+
+// reconstructPath returns the path from the given descendant to the given ancestor by following
+// the given child links backwards from the ancestor.
+func reconstructPath(children map[string]string, descendant, ancestor string) []string {
+	path := []string{ancestor}
+	for commit := ancestor; commit != descendant; {
+		commit = children[commit]
+		path = append(path, commit)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path
+}
@@ -0,0 +1,56 @@
+package commitgraph
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/gitserver"
+)
+
+func TestFindPath(t *testing.T) {
+	// testGraph has the following layout:
+	//
+	//       +--- b ---------+
+	//       |               |
+	// a ----+               +-- f -- g
+	//       |               |
+	//       +-- c -- d -- e-+
+	//
+	// NOTE: The input to ParseCommitGraph must match the order and format
+	// of `git log --topo-sort`.
+	testGraph := gitserver.ParseCommitGraph([]string{
+		"g f",
+		"f b e",
+		"e d",
+		"d c",
+		"c a",
+		"b a",
+		"a",
+	}).Graph()
+
+	testCases := []struct {
+		descendant    string
+		ancestor      string
+		expectedPath  []string
+		expectedFound bool
+	}{
+		{"g", "a", []string{"g", "f", "b", "a"}, true},
+		{"g", "d", []string{"g", "f", "e", "d"}, true},
+		{"e", "c", []string{"e", "d", "c"}, true},
+		{"f", "f", []string{"f"}, true},
+		{"c", "e", nil, false},
+		{"b", "c", nil, false},
+		{"g", "x", nil, false},
+	}
+
+	for _, testCase := range testCases {
+		path, found := FindPath(testGraph, testCase.descendant, testCase.ancestor)
+		if found != testCase.expectedFound {
+			t.Errorf("unexpected found flag for %s..%s. want=%v have=%v", testCase.ancestor, testCase.descendant, testCase.expectedFound, found)
+		}
+		if diff := cmp.Diff(testCase.expectedPath, path); diff != "" {
+			t.Errorf("unexpected path for %s..%s (-want +got):\n%s", testCase.ancestor, testCase.descendant, diff)
+		}
+	}
+}
@@ -28,6 +28,12 @@ type PolicyMatch struct {
 	PolicyDuration *time.Duration
 }
 
+// ProtectsUpload returns true if the given policy match protects an upload that was uploaded at the given
+// time (and is visible from the matching commit) from data retention expiry.
+func ProtectsUpload(policyMatch PolicyMatch, uploadedAt, now time.Time) bool {
+	return policyMatch.PolicyDuration == nil || now.Sub(uploadedAt) < *policyMatch.PolicyDuration
+}
+
 func NewMatcher(
 	gitserverClient GitserverClient,
 	extractor Extractor,
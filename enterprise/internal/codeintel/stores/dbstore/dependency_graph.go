@@ -0,0 +1,260 @@
+package dbstore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+// PackageDependent is a repository that depends on a package provided by the subject of a dependency
+// graph query, paired with the version of the package on which it depends.
+type PackageDependent struct {
+	RepositoryID   int
+	RepositoryName string
+	Package        precise.Package
+}
+
+// scanPackageDependents scans a slice of package dependents from the return value of `*Store.query`.
+func scanPackageDependents(rows *sql.Rows, queryErr error) (_ []PackageDependent, err error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var dependents []PackageDependent
+	for rows.Next() {
+		var dependent PackageDependent
+		if err := rows.Scan(
+			&dependent.RepositoryID,
+			&dependent.RepositoryName,
+			&dependent.Package.Scheme,
+			&dependent.Package.Name,
+			&dependent.Package.Version,
+		); err != nil {
+			return nil, err
+		}
+
+		dependents = append(dependents, dependent)
+	}
+
+	return dependents, nil
+}
+
+// scanPackages scans a slice of packages from the return value of `*Store.query`.
+func scanPackages(rows *sql.Rows, queryErr error) (_ []precise.Package, err error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var packages []precise.Package
+	for rows.Next() {
+		var pkg precise.Package
+		if err := rows.Scan(&pkg.Scheme, &pkg.Name, &pkg.Version); err != nil {
+			return nil, err
+		}
+
+		packages = append(packages, pkg)
+	}
+
+	return packages, nil
+}
+
+// DependencyGraphOptions selects the subject of a dependency graph query. Only uploads visible at the
+// tip of the default branch of their repository contribute to the dependency graph.
+type DependencyGraphOptions struct {
+	// RepositoryID indicates that the subject is the repository with the given identifier. When
+	// equal to zero, the subject is the package described by Scheme, Name, and Version.
+	RepositoryID int
+
+	// Scheme is the moniker scheme of the subject package.
+	Scheme string
+
+	// Name is the name of the subject package.
+	Name string
+
+	// Version is the version of the subject package. An empty version matches all versions.
+	Version string
+
+	// Limit indicates the number of results to take from the result set.
+	Limit int
+
+	// Offset indicates the number of results to skip in the result set.
+	Offset int
+}
+
+// GetPackageDependents returns the repositories that depend on the subject of the given options. If the
+// subject is a repository, its dependents are the other repositories that depend on any package provided
+// by the repository. Each result pairs a repository with a version of a package on which it depends.
+func (s *Store) GetPackageDependents(ctx context.Context, opts DependencyGraphOptions) (_ []PackageDependent, totalCount int, err error) {
+	ctx, traceLog, endObservation := s.operations.getPackageDependents.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("repositoryID", opts.RepositoryID),
+		log.String("scheme", opts.Scheme),
+		log.String("name", opts.Name),
+		log.String("version", opts.Version),
+		log.Int("limit", opts.Limit),
+		log.Int("offset", opts.Offset),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	authzConds, err := database.AuthzQueryConds(ctx, s.Store.Handle().DB())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	conds := []*sqlf.Query{authzConds}
+	if opts.RepositoryID != 0 {
+		conds = append(conds,
+			sqlf.Sprintf("(r.scheme, r.name, r.version) IN (%s)", sqlf.Sprintf(repositoryPackagesQuery, opts.RepositoryID)),
+			sqlf.Sprintf("uvt.repository_id != %s", opts.RepositoryID),
+		)
+	} else {
+		conds = append(conds, makePackageConditions("r", opts)...)
+	}
+
+	tx, err := s.transact(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	totalCount, _, err = basestore.ScanFirstInt(tx.Query(ctx, sqlf.Sprintf(getPackageDependentsCountQuery, sqlf.Join(conds, " AND "))))
+	if err != nil {
+		return nil, 0, err
+	}
+	traceLog(log.Int("totalCount", totalCount))
+
+	dependents, err := scanPackageDependents(tx.Query(ctx, sqlf.Sprintf(getPackageDependentsQuery, sqlf.Join(conds, " AND "), opts.Limit, opts.Offset)))
+	if err != nil {
+		return nil, 0, err
+	}
+	traceLog(log.Int("numDependents", len(dependents)))
+
+	return dependents, totalCount, nil
+}
+
+// repositoryPackagesQuery selects the packages provided by the uploads visible at the tip of the
+// default branch of a repository.
+const repositoryPackagesQuery = `
+SELECT p.scheme, p.name, p.version
+FROM lsif_packages p
+JOIN lsif_uploads_visible_at_tip uvt ON uvt.upload_id = p.dump_id
+WHERE uvt.repository_id = %s AND uvt.is_default_branch
+`
+
+const getPackageDependentsBaseQuery = `
+FROM lsif_references r
+JOIN lsif_uploads_visible_at_tip uvt ON uvt.upload_id = r.dump_id
+JOIN repo ON repo.id = uvt.repository_id
+WHERE uvt.is_default_branch AND repo.deleted_at IS NULL AND %s
+`
+
+const getPackageDependentsCountQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/dependency_graph.go:GetPackageDependents
+SELECT COUNT(*) FROM (
+	SELECT DISTINCT repo.id, r.scheme, r.name, r.version
+	` + getPackageDependentsBaseQuery + `
+) s
+`
+
+const getPackageDependentsQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/dependency_graph.go:GetPackageDependents
+SELECT DISTINCT repo.id, repo.name, r.scheme, r.name, r.version
+` + getPackageDependentsBaseQuery + `
+ORDER BY repo.name, r.scheme, r.name, r.version
+LIMIT %s OFFSET %s
+`
+
+// GetPackageDependencies returns the packages on which the subject of the given options depends. If the
+// subject is a package, its dependencies are the packages referenced by the uploads providing it.
+func (s *Store) GetPackageDependencies(ctx context.Context, opts DependencyGraphOptions) (_ []precise.Package, totalCount int, err error) {
+	ctx, traceLog, endObservation := s.operations.getPackageDependencies.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("repositoryID", opts.RepositoryID),
+		log.String("scheme", opts.Scheme),
+		log.String("name", opts.Name),
+		log.String("version", opts.Version),
+		log.Int("limit", opts.Limit),
+		log.Int("offset", opts.Offset),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	authzConds, err := database.AuthzQueryConds(ctx, s.Store.Handle().DB())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	conds := []*sqlf.Query{authzConds}
+	if opts.RepositoryID != 0 {
+		conds = append(conds, sqlf.Sprintf("uvt.repository_id = %s", opts.RepositoryID))
+	} else {
+		packageConds := makePackageConditions("p", opts)
+		conds = append(conds, sqlf.Sprintf("EXISTS (SELECT 1 FROM lsif_packages p WHERE p.dump_id = uvt.upload_id AND %s)", sqlf.Join(packageConds, " AND ")))
+	}
+
+	tx, err := s.transact(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	totalCount, _, err = basestore.ScanFirstInt(tx.Query(ctx, sqlf.Sprintf(getPackageDependenciesCountQuery, sqlf.Join(conds, " AND "))))
+	if err != nil {
+		return nil, 0, err
+	}
+	traceLog(log.Int("totalCount", totalCount))
+
+	packages, err := scanPackages(tx.Query(ctx, sqlf.Sprintf(getPackageDependenciesQuery, sqlf.Join(conds, " AND "), opts.Limit, opts.Offset)))
+	if err != nil {
+		return nil, 0, err
+	}
+	traceLog(log.Int("numPackages", len(packages)))
+
+	return packages, totalCount, nil
+}
+
+const getPackageDependenciesBaseQuery = `
+FROM lsif_references r
+WHERE r.dump_id IN (
+	SELECT uvt.upload_id
+	FROM lsif_uploads_visible_at_tip uvt
+	JOIN repo ON repo.id = uvt.repository_id
+	WHERE uvt.is_default_branch AND repo.deleted_at IS NULL AND %s
+)
+`
+
+const getPackageDependenciesCountQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/dependency_graph.go:GetPackageDependencies
+SELECT COUNT(*) FROM (
+	SELECT DISTINCT r.scheme, r.name, r.version
+	` + getPackageDependenciesBaseQuery + `
+) s
+`
+
+const getPackageDependenciesQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/dependency_graph.go:GetPackageDependencies
+SELECT DISTINCT r.scheme, r.name, r.version
+` + getPackageDependenciesBaseQuery + `
+ORDER BY r.scheme, r.name, r.version
+LIMIT %s OFFSET %s
+`
+
+// makePackageConditions returns the conditions matching the subject package of the given options against
+// the scheme, name, and version columns of the table with the given alias.
+func makePackageConditions(alias string, opts DependencyGraphOptions) []*sqlf.Query {
+	conds := []*sqlf.Query{
+		sqlf.Sprintf(alias+".scheme = %s", opts.Scheme),
+		sqlf.Sprintf(alias+".name = %s", opts.Name),
+	}
+	if opts.Version != "" {
+		conds = append(conds, sqlf.Sprintf(alias+".version = %s", opts.Version))
+	}
+
+	return conds
+}
@@ -0,0 +1,139 @@
+package dbstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/stores/shared"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+func TestGetPackageDependentsAndDependencies(t *testing.T) {
+	db := dbtest.NewDB(t)
+	store := testStore(db)
+
+	// Repository 50 provides leftpad, which repositories 51 and 52 depend on at different versions.
+	// Repository 51 also depends on rightpad. Repository 53 depends on leftpad only from an upload
+	// that is not visible at the tip of its default branch.
+	insertUploads(t, db,
+		Upload{ID: 1, RepositoryID: 50, RepositoryName: "github.com/test/leftpad"},
+		Upload{ID: 2, RepositoryID: 50, RepositoryName: "github.com/test/leftpad"},
+		Upload{ID: 3, RepositoryID: 51, RepositoryName: "github.com/test/app"},
+		Upload{ID: 4, RepositoryID: 52, RepositoryName: "github.com/test/cli"},
+		Upload{ID: 5, RepositoryID: 53, RepositoryName: "github.com/test/old"},
+	)
+	insertVisibleAtTip(t, db, 50, 1, 2)
+	insertVisibleAtTip(t, db, 51, 3)
+	insertVisibleAtTip(t, db, 52, 4)
+	insertVisibleAtTipNonDefaultBranch(t, db, 53, 5)
+
+	insertPackages(t, store, []shared.Package{
+		{DumpID: 1, Scheme: "npm", Name: "leftpad", Version: "1.0.0"},
+		{DumpID: 2, Scheme: "npm", Name: "leftpad", Version: "2.0.0"},
+	})
+	insertPackageReferences(t, store, []shared.PackageReference{
+		{Package: shared.Package{DumpID: 1, Scheme: "npm", Name: "padding", Version: "0.1.0"}},
+		{Package: shared.Package{DumpID: 3, Scheme: "npm", Name: "leftpad", Version: "1.0.0"}},
+		{Package: shared.Package{DumpID: 3, Scheme: "npm", Name: "rightpad", Version: "3.0.0"}},
+		{Package: shared.Package{DumpID: 4, Scheme: "npm", Name: "leftpad", Version: "2.0.0"}},
+		{Package: shared.Package{DumpID: 5, Scheme: "npm", Name: "leftpad", Version: "2.0.0"}},
+	})
+
+	leftpad1 := precise.Package{Scheme: "npm", Name: "leftpad", Version: "1.0.0"}
+	leftpad2 := precise.Package{Scheme: "npm", Name: "leftpad", Version: "2.0.0"}
+	padding := precise.Package{Scheme: "npm", Name: "padding", Version: "0.1.0"}
+	rightpad := precise.Package{Scheme: "npm", Name: "rightpad", Version: "3.0.0"}
+
+	dependentTestCases := []struct {
+		opts               DependencyGraphOptions
+		expectedTotalCount int
+		expectedDependents []PackageDependent
+	}{
+		{
+			opts:               DependencyGraphOptions{Scheme: "npm", Name: "leftpad"},
+			expectedTotalCount: 2,
+			expectedDependents: []PackageDependent{
+				{RepositoryID: 51, RepositoryName: "github.com/test/app", Package: leftpad1},
+				{RepositoryID: 52, RepositoryName: "github.com/test/cli", Package: leftpad2},
+			},
+		},
+		{
+			opts:               DependencyGraphOptions{Scheme: "npm", Name: "leftpad", Version: "2.0.0"},
+			expectedTotalCount: 1,
+			expectedDependents: []PackageDependent{
+				{RepositoryID: 52, RepositoryName: "github.com/test/cli", Package: leftpad2},
+			},
+		},
+		{
+			opts:               DependencyGraphOptions{RepositoryID: 50, Limit: 1, Offset: 1},
+			expectedTotalCount: 2,
+			expectedDependents: []PackageDependent{
+				{RepositoryID: 52, RepositoryName: "github.com/test/cli", Package: leftpad2},
+			},
+		},
+		{
+			opts:               DependencyGraphOptions{RepositoryID: 51},
+			expectedTotalCount: 0,
+		},
+	}
+
+	for _, testCase := range dependentTestCases {
+		if testCase.opts.Limit == 0 {
+			testCase.opts.Limit = 10
+		}
+
+		dependents, totalCount, err := store.GetPackageDependents(context.Background(), testCase.opts)
+		if err != nil {
+			t.Fatalf("unexpected error getting package dependents: %s", err)
+		}
+		if totalCount != testCase.expectedTotalCount {
+			t.Errorf("unexpected total count for %+v. want=%d have=%d", testCase.opts, testCase.expectedTotalCount, totalCount)
+		}
+		if diff := cmp.Diff(testCase.expectedDependents, dependents); diff != "" {
+			t.Errorf("unexpected dependents for %+v (-want +got):\n%s", testCase.opts, diff)
+		}
+	}
+
+	dependencyTestCases := []struct {
+		opts                 DependencyGraphOptions
+		expectedTotalCount   int
+		expectedDependencies []precise.Package
+	}{
+		{
+			opts:                 DependencyGraphOptions{RepositoryID: 51},
+			expectedTotalCount:   2,
+			expectedDependencies: []precise.Package{leftpad1, rightpad},
+		},
+		{
+			opts:                 DependencyGraphOptions{Scheme: "npm", Name: "leftpad"},
+			expectedTotalCount:   1,
+			expectedDependencies: []precise.Package{padding},
+		},
+		{
+			opts:               DependencyGraphOptions{Scheme: "npm", Name: "leftpad", Version: "2.0.0"},
+			expectedTotalCount: 0,
+		},
+		{
+			opts:               DependencyGraphOptions{RepositoryID: 53},
+			expectedTotalCount: 0,
+		},
+	}
+
+	for _, testCase := range dependencyTestCases {
+		testCase.opts.Limit = 10
+
+		dependencies, totalCount, err := store.GetPackageDependencies(context.Background(), testCase.opts)
+		if err != nil {
+			t.Fatalf("unexpected error getting package dependencies: %s", err)
+		}
+		if totalCount != testCase.expectedTotalCount {
+			t.Errorf("unexpected total count for %+v. want=%d have=%d", testCase.opts, testCase.expectedTotalCount, totalCount)
+		}
+		if diff := cmp.Diff(testCase.expectedDependencies, dependencies); diff != "" {
+			t.Errorf("unexpected dependencies for %+v (-want +got):\n%s", testCase.opts, diff)
+		}
+	}
+}
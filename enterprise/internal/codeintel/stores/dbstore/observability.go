@@ -35,9 +35,12 @@ type operations struct {
 	getIndexes                                  *observation.Operation
 	getIndexesByIDs                             *observation.Operation
 	getOldestCommitDate                         *observation.Operation
+	getPackageDependencies                      *observation.Operation
+	getPackageDependents                        *observation.Operation
 	getUploadByID                               *observation.Operation
 	getUploads                                  *observation.Operation
 	getUploadsByIDs                             *observation.Operation
+	getUploadValidationFindings                 *observation.Operation
 	hardDeleteUploadByID                        *observation.Operation
 	hasCommit                                   *observation.Operation
 	hasRepository                               *observation.Operation
@@ -77,6 +80,7 @@ type operations struct {
 	updateReposMatchingPatterns                 *observation.Operation
 	updateSourcedCommits                        *observation.Operation
 	updateUploadRetention                       *observation.Operation
+	updateUploadValidationFindings              *observation.Operation
 
 	persistNearestUploads      *observation.Operation
 	persistNearestUploadsLinks *observation.Operation
@@ -130,9 +134,12 @@ func newOperations(observationContext *observation.Context, metrics *metrics.RED
 		getIndexes:                          op("GetIndexes"),
 		getIndexesByIDs:                     op("GetIndexesByIDs"),
 		getOldestCommitDate:                 op("GetOldestCommitDate"),
+		getPackageDependencies:              op("GetPackageDependencies"),
+		getPackageDependents:                op("GetPackageDependents"),
 		getUploadByID:                       op("GetUploadByID"),
 		getUploads:                          op("GetUploads"),
 		getUploadsByIDs:                     op("GetUploadsByIDs"),
+		getUploadValidationFindings:         op("GetUploadValidationFindings"),
 		hardDeleteUploadByID:                op("HardDeleteUploadByID"),
 		hasCommit:                           op("HasCommit"),
 		hasRepository:                       op("HasRepository"),
@@ -173,6 +180,7 @@ func newOperations(observationContext *observation.Context, metrics *metrics.RED
 		updateReposMatchingPatterns:            op("UpdateReposMatchingPatterns"),
 		updateSourcedCommits:                   op("UpdateSourcedCommits"),
 		updateUploadRetention:                  op("UpdateUploadRetention"),
+		updateUploadValidationFindings:         op("UpdateUploadValidationFindings"),
 
 		persistNearestUploads:      subOp("persistNearestUploads"),
 		persistNearestUploadsLinks: subOp("persistNearestUploadsLinks"),
@@ -0,0 +1,125 @@
+package dbstore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/batch"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// UploadValidationFinding is a problem detected while validating the raw LSIF data of an upload.
+type UploadValidationFinding struct {
+	Kind        string
+	Message     string
+	LineNumbers []int
+}
+
+// scanUploadValidationFindings scans a slice of validation findings from the return value of `*Store.query`.
+func scanUploadValidationFindings(rows *sql.Rows, queryErr error) (_ []UploadValidationFinding, err error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var findings []UploadValidationFinding
+	for rows.Next() {
+		var finding UploadValidationFinding
+		var lineNumbers []int64
+		if err := rows.Scan(
+			&finding.Kind,
+			&finding.Message,
+			pq.Array(&lineNumbers),
+		); err != nil {
+			return nil, err
+		}
+
+		for _, lineNumber := range lineNumbers {
+			finding.LineNumbers = append(finding.LineNumbers, int(lineNumber))
+		}
+
+		findings = append(findings, finding)
+	}
+
+	return findings, nil
+}
+
+// GetUploadValidationFindings returns the validation findings recorded for the given upload.
+func (s *Store) GetUploadValidationFindings(ctx context.Context, uploadID int) (_ []UploadValidationFinding, err error) {
+	ctx, endObservation := s.operations.getUploadValidationFindings.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("uploadID", uploadID),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return scanUploadValidationFindings(s.Store.Query(ctx, sqlf.Sprintf(getUploadValidationFindingsQuery, uploadID)))
+}
+
+const getUploadValidationFindingsQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/validation_findings.go:GetUploadValidationFindings
+SELECT kind, message, line_numbers
+FROM lsif_upload_validation_findings
+WHERE upload_id = %s
+ORDER BY id
+`
+
+// UpdateUploadValidationFindings replaces the validation findings recorded for the given upload.
+func (s *Store) UpdateUploadValidationFindings(ctx context.Context, uploadID int, findings []UploadValidationFinding) (err error) {
+	ctx, endObservation := s.operations.updateUploadValidationFindings.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("uploadID", uploadID),
+		log.Int("numFindings", len(findings)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	tx, err := s.transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	// Clear the findings of a previous processing attempt of the same upload
+	if err := tx.Exec(ctx, sqlf.Sprintf(updateUploadValidationFindingsDeleteQuery, uploadID)); err != nil {
+		return err
+	}
+
+	if len(findings) == 0 {
+		return nil
+	}
+
+	return batch.InsertValues(
+		ctx,
+		tx.Handle().DB(),
+		"lsif_upload_validation_findings",
+		batch.MaxNumPostgresParameters,
+		[]string{"upload_id", "kind", "message", "line_numbers"},
+		loadUploadValidationFindingsChannel(uploadID, findings),
+	)
+}
+
+const updateUploadValidationFindingsDeleteQuery = `
+-- source: enterprise/internal/codeintel/stores/dbstore/validation_findings.go:UpdateUploadValidationFindings
+DELETE FROM lsif_upload_validation_findings WHERE upload_id = %s
+`
+
+func loadUploadValidationFindingsChannel(uploadID int, findings []UploadValidationFinding) <-chan []interface{} {
+	ch := make(chan []interface{}, len(findings))
+
+	go func() {
+		defer close(ch)
+
+		for _, f := range findings {
+			lineNumbers := f.LineNumbers
+			if lineNumbers == nil {
+				lineNumbers = []int{}
+			}
+
+			ch <- []interface{}{uploadID, f.Kind, f.Message, pq.Array(lineNumbers)}
+		}
+	}()
+
+	return ch
+}
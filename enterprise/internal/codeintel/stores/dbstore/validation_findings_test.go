@@ -0,0 +1,50 @@
+package dbstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+)
+
+func TestUpdateUploadValidationFindings(t *testing.T) {
+	db := dbtest.NewDB(t)
+	store := testStore(db)
+
+	// for foreign key relation
+	insertUploads(t, db, Upload{ID: 42}, Upload{ID: 43})
+
+	if err := store.UpdateUploadValidationFindings(context.Background(), 42, []UploadValidationFinding{
+		{Kind: "OTHER", Message: "stale finding", LineNumbers: []int{1}},
+	}); err != nil {
+		t.Fatalf("unexpected error updating validation findings: %s", err)
+	}
+
+	findings := []UploadValidationFinding{
+		{Kind: "MISSING_CONTAINS_EDGE", Message: "range 4 not owned by any document", LineNumbers: []int{4}},
+		{Kind: "DANGLING_RANGE", Message: "vertex 5 should be owned by document 2", LineNumbers: []int{12, 5}},
+		{Kind: "OTHER", Message: "failed to read index: unexpected EOF"},
+	}
+	if err := store.UpdateUploadValidationFindings(context.Background(), 42, findings); err != nil {
+		t.Fatalf("unexpected error updating validation findings: %s", err)
+	}
+	if err := store.UpdateUploadValidationFindings(context.Background(), 43, []UploadValidationFinding{
+		{Kind: "UNRESOLVED_MONIKER", Message: "import moniker 20 has no package information", LineNumbers: []int{20}},
+	}); err != nil {
+		t.Fatalf("unexpected error updating validation findings: %s", err)
+	}
+
+	actualFindings, err := store.GetUploadValidationFindings(context.Background(), 42)
+	if err != nil {
+		t.Fatalf("unexpected error getting validation findings: %s", err)
+	}
+
+	// Scanned empty arrays are returned as nil slices
+	findings[2].LineNumbers = nil
+
+	if diff := cmp.Diff(findings, actualFindings); diff != "" {
+		t.Errorf("unexpected validation findings (-want +got):\n%s", diff)
+	}
+}
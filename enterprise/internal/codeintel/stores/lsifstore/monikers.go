@@ -85,26 +85,7 @@ func (s *Store) BulkMonikerResults(ctx context.Context, tableName string, upload
 	}})
 	defer endObservation(1, observation.Args{})
 
-	if len(uploadIDs) == 0 || len(monikers) == 0 {
-		return nil, 0, nil
-	}
-
-	idQueries := make([]*sqlf.Query, 0, len(uploadIDs))
-	for _, id := range uploadIDs {
-		idQueries = append(idQueries, sqlf.Sprintf("%s", id))
-	}
-
-	monikerQueries := make([]*sqlf.Query, 0, len(monikers))
-	for _, arg := range monikers {
-		monikerQueries = append(monikerQueries, sqlf.Sprintf("(%s, %s)", arg.Scheme, arg.Identifier))
-	}
-
-	locationData, err := s.scanQualifiedMonikerLocations(s.Store.Query(ctx, sqlf.Sprintf(
-		bulkMonikerResultsQuery,
-		sqlf.Sprintf(fmt.Sprintf("lsif_data_%s", tableName)),
-		sqlf.Join(idQueries, ", "),
-		sqlf.Join(monikerQueries, ", "),
-	)))
+	locationData, err := s.queryQualifiedMonikerLocations(ctx, tableName, uploadIDs, monikers)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -148,8 +129,56 @@ outer:
 	return locations, totalCount, nil
 }
 
+// BulkMonikerLocations returns the locations (within one of the given uploads) with an attached moniker
+// whose scheme+identifier matches one of the given monikers. Unlike BulkMonikerResults, the locations
+// are not paginated and remain grouped by the upload and moniker they were found under, so that a
+// caller resolving many monikers at once can attribute each location to the moniker that matched it.
+func (s *Store) BulkMonikerLocations(ctx context.Context, tableName string, uploadIDs []int, monikers []precise.MonikerData) (_ []QualifiedMonikerLocations, err error) {
+	ctx, traceLog, endObservation := s.operations.bulkMonikerLocations.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("tableName", tableName),
+		log.Int("numUploadIDs", len(uploadIDs)),
+		log.String("uploadIDs", intsToString(uploadIDs)),
+		log.Int("numMonikers", len(monikers)),
+		log.String("monikers", monikersToString(monikers)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	locationData, err := s.queryQualifiedMonikerLocations(ctx, tableName, uploadIDs, monikers)
+	if err != nil {
+		return nil, err
+	}
+	traceLog(log.Int("numMonikerLocations", len(locationData)))
+
+	return locationData, nil
+}
+
+// queryQualifiedMonikerLocations returns the location data attached to the given monikers within
+// the given uploads, ordered by upload, scheme, and identifier.
+func (s *Store) queryQualifiedMonikerLocations(ctx context.Context, tableName string, uploadIDs []int, monikers []precise.MonikerData) ([]QualifiedMonikerLocations, error) {
+	if len(uploadIDs) == 0 || len(monikers) == 0 {
+		return nil, nil
+	}
+
+	idQueries := make([]*sqlf.Query, 0, len(uploadIDs))
+	for _, id := range uploadIDs {
+		idQueries = append(idQueries, sqlf.Sprintf("%s", id))
+	}
+
+	monikerQueries := make([]*sqlf.Query, 0, len(monikers))
+	for _, arg := range monikers {
+		monikerQueries = append(monikerQueries, sqlf.Sprintf("(%s, %s)", arg.Scheme, arg.Identifier))
+	}
+
+	return s.scanQualifiedMonikerLocations(s.Store.Query(ctx, sqlf.Sprintf(
+		bulkMonikerResultsQuery,
+		sqlf.Sprintf(fmt.Sprintf("lsif_data_%s", tableName)),
+		sqlf.Join(idQueries, ", "),
+		sqlf.Join(monikerQueries, ", "),
+	)))
+}
+
 const bulkMonikerResultsQuery = `
--- source: enterprise/internal/codeintel/stores/lsifstore/monikers.go:BulkMonikerResults
+-- source: enterprise/internal/codeintel/stores/lsifstore/monikers.go:queryQualifiedMonikerLocations
 SELECT dump_id, scheme, identifier, data FROM %s WHERE dump_id IN (%s) AND (scheme, identifier) IN (%s) ORDER BY (dump_id, scheme, identifier)
 `
 
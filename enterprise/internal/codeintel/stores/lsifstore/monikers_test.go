@@ -110,3 +110,46 @@ func TestDatabaseBulkMonikerResults(t *testing.T) {
 		})
 	}
 }
+
+func TestDatabaseBulkMonikerLocations(t *testing.T) {
+	store := populateTestStore(t)
+
+	edgeMoniker := precise.MonikerData{Scheme: "gomod", Identifier: "github.com/sourcegraph/lsif-go/protocol:Edge"}
+	markdownMoniker := precise.MonikerData{Scheme: "gomod", Identifier: "github.com/slimsag/godocmd:ToMarkdown"}
+
+	monikerLocations, err := store.BulkMonikerLocations(context.Background(), "references", []int{testBundleID}, []precise.MonikerData{edgeMoniker, markdownMoniker})
+	if err != nil {
+		t.Fatalf("unexpected error querying moniker locations: %s", err)
+	}
+
+	actual := map[string][]Location{}
+	for _, monikerLocation := range monikerLocations {
+		if monikerLocation.DumpID != testBundleID || monikerLocation.Scheme != "gomod" {
+			t.Errorf("unexpected moniker locations: %v", monikerLocation)
+		}
+
+		for _, row := range monikerLocation.Locations {
+			actual[monikerLocation.Identifier] = append(actual[monikerLocation.Identifier], Location{
+				DumpID: monikerLocation.DumpID,
+				Path:   row.URI,
+				Range:  newRange(row.StartLine, row.StartCharacter, row.EndLine, row.EndCharacter),
+			})
+		}
+	}
+
+	expected := map[string][]Location{
+		edgeMoniker.Identifier: {
+			{DumpID: testBundleID, Path: "protocol/protocol.go", Range: newRange(410, 5, 410, 9)},
+			{DumpID: testBundleID, Path: "protocol/protocol.go", Range: newRange(440, 1, 440, 5)},
+			{DumpID: testBundleID, Path: "protocol/protocol.go", Range: newRange(448, 8, 448, 12)},
+			{DumpID: testBundleID, Path: "protocol/protocol.go", Range: newRange(462, 1, 462, 5)},
+			{DumpID: testBundleID, Path: "protocol/protocol.go", Range: newRange(470, 8, 470, 12)},
+		},
+		markdownMoniker.Identifier: {
+			{DumpID: testBundleID, Path: "internal/index/helper.go", Range: newRange(78, 6, 78, 16)},
+		},
+	}
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("unexpected moniker locations (-want +got):\n%s", diff)
+	}
+}
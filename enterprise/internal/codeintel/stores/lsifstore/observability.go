@@ -8,6 +8,7 @@ import (
 )
 
 type operations struct {
+	bulkMonikerLocations            *observation.Operation
 	bulkMonikerResults              *observation.Operation
 	clear                           *observation.Operation
 	definitions                     *observation.Operation
@@ -23,6 +24,7 @@ type operations struct {
 	documentationReferences         *observation.Operation
 	documentationSearchRepoNameIDs  *observation.Operation
 	documentationSearch             *observation.Operation
+	enclosingSymbols                *observation.Operation
 	exists                          *observation.Operation
 	hover                           *observation.Operation
 	implementations                 *observation.Operation
@@ -32,6 +34,8 @@ type operations struct {
 	ranges                          *observation.Operation
 	references                      *observation.Operation
 	stencil                         *observation.Operation
+	symbols                         *observation.Operation
+	typeDefinitions                 *observation.Operation
 	writeDefinitions                *observation.Operation
 	writeDocumentationMappings      *observation.Operation
 	writeDocumentationPages         *observation.Operation
@@ -74,6 +78,7 @@ func newOperations(observationContext *observation.Context) *operations {
 	}
 
 	return &operations{
+		bulkMonikerLocations:            op("BulkMonikerLocations"),
 		bulkMonikerResults:              op("BulkMonikerResults"),
 		clear:                           op("Clear"),
 		definitions:                     op("Definitions"),
@@ -89,6 +94,7 @@ func newOperations(observationContext *observation.Context) *operations {
 		documentationReferences:         op("DocumentationReferences"),
 		documentationSearchRepoNameIDs:  op("DocumentationSearchRepoNameIDs"),
 		documentationSearch:             op("DocumentationSearch"),
+		enclosingSymbols:                op("EnclosingSymbols"),
 		exists:                          op("Exists"),
 		hover:                           op("Hover"),
 		implementations:                 op("Implementations"),
@@ -98,6 +104,8 @@ func newOperations(observationContext *observation.Context) *operations {
 		ranges:                          op("Ranges"),
 		references:                      op("References"),
 		stencil:                         op("Stencil"),
+		symbols:                         op("Symbols"),
+		typeDefinitions:                 op("TypeDefinitions"),
 		writeDefinitions:                op("WriteDefinitions"),
 		writeDocumentationMappings:      op("WriteDocumentationMappings"),
 		writeDocumentationPages:         op("WriteDocumentationPages"),
@@ -0,0 +1,120 @@
+package lsifstore
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+// Symbols returns the symbols defined at each of the given ranges within a single document. The
+// returned slice is parallel to the given ranges and contains a nil symbol for each range at which
+// no symbol (with known extent) is defined.
+func (s *Store) Symbols(ctx context.Context, bundleID int, path string, ranges []Range) (_ []*Symbol, err error) {
+	ctx, traceLog, endObservation := s.operations.symbols.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("bundleID", bundleID),
+		log.String("path", path),
+		log.Int("numRanges", len(ranges)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	documentData, exists, err := s.scanFirstDocumentData(s.Store.Query(ctx, sqlf.Sprintf(rangesDocumentQuery, bundleID, path)))
+	if err != nil || !exists {
+		return make([]*Symbol, len(ranges)), err
+	}
+
+	traceLog(log.Int("numRanges", len(documentData.Document.Ranges)))
+
+	symbols := make([]*Symbol, 0, len(ranges))
+	for _, r := range ranges {
+		symbols = append(symbols, symbolAt(documentData.Document, r.Start))
+	}
+
+	return symbols, nil
+}
+
+// EnclosingSymbols returns the innermost callable symbol (function, method, or constructor) whose body
+// encloses each of the given ranges within a single document. The returned slice is parallel to the
+// given ranges and contains a nil symbol for each range that is not enclosed by a callable symbol.
+func (s *Store) EnclosingSymbols(ctx context.Context, bundleID int, path string, ranges []Range) (_ []*Symbol, err error) {
+	ctx, traceLog, endObservation := s.operations.enclosingSymbols.WithAndLogger(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("bundleID", bundleID),
+		log.String("path", path),
+		log.Int("numRanges", len(ranges)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	documentData, exists, err := s.scanFirstDocumentData(s.Store.Query(ctx, sqlf.Sprintf(rangesDocumentQuery, bundleID, path)))
+	if err != nil || !exists {
+		return make([]*Symbol, len(ranges)), err
+	}
+
+	traceLog(log.Int("numRanges", len(documentData.Document.Ranges)))
+
+	symbols := make([]*Symbol, 0, len(ranges))
+	for _, r := range ranges {
+		symbols = append(symbols, enclosingCallableSymbol(documentData.Document, r))
+	}
+
+	return symbols, nil
+}
+
+// symbolAt returns the symbol defined at the range of the given document that contains the given
+// position. If multiple such ranges define a symbol, the innermost range is used.
+func symbolAt(document precise.DocumentData, position Position) *Symbol {
+	for _, r := range precise.FindRanges(document.Ranges, position.Line, position.Character) {
+		if r.Symbol != nil {
+			return newSymbol(r)
+		}
+	}
+
+	return nil
+}
+
+// enclosingCallableSymbol returns the innermost callable symbol of the given document whose full range
+// encloses the given range. If the given range is itself the name of a symbol defined in the document,
+// no symbol is returned, so that declarations aren't reported as calls from the enclosing function.
+func enclosingCallableSymbol(document precise.DocumentData, r Range) *Symbol {
+	var enclosing *Symbol
+	for _, rangeData := range document.Ranges {
+		if rangeData.Symbol == nil {
+			continue
+		}
+
+		symbol := newSymbol(rangeData)
+		if symbol.Range == r {
+			return nil
+		}
+		if !symbol.Callable() || !rangeContainsRange(symbol.FullRange, r) {
+			continue
+		}
+
+		if enclosing == nil || rangeContainsRange(enclosing.FullRange, symbol.FullRange) {
+			enclosing = symbol
+		}
+	}
+
+	return enclosing
+}
+
+func newSymbol(r precise.RangeData) *Symbol {
+	return &Symbol{
+		Name:      r.Symbol.Text,
+		Kind:      r.Symbol.Kind,
+		Range:     newRange(r.StartLine, r.StartCharacter, r.EndLine, r.EndCharacter),
+		FullRange: newRange(r.Symbol.FullStartLine, r.Symbol.FullStartCharacter, r.Symbol.FullEndLine, r.Symbol.FullEndCharacter),
+	}
+}
+
+// rangeContainsRange returns true if the outer range encloses the inner range.
+func rangeContainsRange(outer, inner Range) bool {
+	return !positionBefore(inner.Start, outer.Start) && !positionBefore(outer.End, inner.End)
+}
+
+// positionBefore returns true if the position a occurs before position b.
+func positionBefore(a, b Position) bool {
+	return a.Line < b.Line || (a.Line == b.Line && a.Character < b.Character)
+}
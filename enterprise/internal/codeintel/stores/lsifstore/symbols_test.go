@@ -0,0 +1,76 @@
+package lsifstore
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
+
+func TestSymbolAt(t *testing.T) {
+	document := testSymbolsDocument()
+
+	expected := &Symbol{
+		Name:      "inner",
+		Kind:      protocol.Function,
+		Range:     newRange(5, 6, 5, 11),
+		FullRange: newRange(5, 1, 7, 2),
+	}
+	if diff := cmp.Diff(expected, symbolAt(document, Position{Line: 5, Character: 8})); diff != "" {
+		t.Errorf("unexpected symbol (-want +got):\n%s", diff)
+	}
+
+	if symbol := symbolAt(document, Position{Line: 6, Character: 3}); symbol != nil {
+		t.Errorf("unexpected symbol: %v", symbol)
+	}
+}
+
+func TestEnclosingCallableSymbol(t *testing.T) {
+	document := testSymbolsDocument()
+
+	testCases := []struct {
+		r        Range
+		expected string
+	}{
+		{r: newRange(2, 2, 2, 5), expected: "outer"}, // body of outer
+		{r: newRange(6, 2, 6, 7), expected: "inner"}, // body of nested function
+		{r: newRange(5, 6, 5, 11), expected: ""},     // declaration of nested function
+		{r: newRange(1, 5, 1, 10), expected: ""},     // declaration of outer
+		{r: newRange(12, 0, 12, 3), expected: ""},    // outside of any function
+		{r: newRange(10, 2, 10, 4), expected: ""},    // body of a non-callable symbol
+	}
+
+	for _, testCase := range testCases {
+		name := ""
+		if symbol := enclosingCallableSymbol(document, testCase.r); symbol != nil {
+			name = symbol.Name
+		}
+
+		if name != testCase.expected {
+			t.Errorf("unexpected enclosing symbol for %v. want=%q have=%q", testCase.r, testCase.expected, name)
+		}
+	}
+}
+
+func testSymbolsDocument() precise.DocumentData {
+	return precise.DocumentData{
+		Ranges: map[precise.ID]precise.RangeData{
+			"1": {
+				StartLine: 1, StartCharacter: 5, EndLine: 1, EndCharacter: 10,
+				Symbol: &precise.SymbolData{Text: "outer", Kind: protocol.Function, FullStartLine: 1, FullEndLine: 8, FullEndCharacter: 1},
+			},
+			"2": {StartLine: 2, StartCharacter: 2, EndLine: 2, EndCharacter: 5},
+			"3": {
+				StartLine: 5, StartCharacter: 6, EndLine: 5, EndCharacter: 11,
+				Symbol: &precise.SymbolData{Text: "inner", Kind: protocol.Function, FullStartLine: 5, FullStartCharacter: 1, FullEndLine: 7, FullEndCharacter: 2},
+			},
+			"4": {StartLine: 6, StartCharacter: 2, EndLine: 6, EndCharacter: 7},
+			"5": {
+				StartLine: 9, StartCharacter: 5, EndLine: 9, EndCharacter: 8,
+				Symbol: &precise.SymbolData{Text: "T", Kind: protocol.Struct, FullStartLine: 9, FullEndLine: 11, FullEndCharacter: 1},
+			},
+		},
+	}
+}
@@ -1,6 +1,9 @@
 package lsifstore
 
-import "github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+import (
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
+)
 
 // Location is an LSP-like location scoped to a dump.
 type Location struct {
@@ -38,3 +41,17 @@ type CodeIntelligenceRange struct {
 	HoverText           string
 	DocumentationPathID string
 }
+
+// Symbol describes a symbol defined in a document. This data is only available for uploads
+// produced by indexers which emit definition tags.
+type Symbol struct {
+	Name      string
+	Kind      protocol.SymbolKind
+	Range     Range // the range of the symbol's name
+	FullRange Range // the range of the entire symbol, e.g. including the body of a function
+}
+
+// Callable returns true if the symbol is a function, method, or constructor.
+func (s Symbol) Callable() bool {
+	return s.Kind == protocol.Function || s.Kind == protocol.Method || s.Kind == protocol.Constructor
+}
@@ -190,6 +190,37 @@ func TestConfigGCS(t *testing.T) {
 	}
 }
 
+func TestConfigLocal(t *testing.T) {
+	env := map[string]string{
+		"PRECISE_CODE_INTEL_UPLOAD_BACKEND":                "Local",
+		"PRECISE_CODE_INTEL_UPLOAD_BUCKET":                 "lsif-uploads",
+		"PRECISE_CODE_INTEL_UPLOAD_TTL":                    "8h",
+		"PRECISE_CODE_INTEL_UPLOAD_LOCAL_DIR":              "/data",
+		"PRECISE_CODE_INTEL_UPLOAD_LOCAL_JANITOR_INTERVAL": "5m",
+	}
+
+	config := Config{}
+	config.SetMockGetter(mapGetter(env))
+	config.Load()
+
+	if err := config.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %s", err)
+	}
+
+	if config.TTL != 8*time.Hour {
+		t.Errorf("unexpected value for Local.TTL. want=%v have=%v", 8*time.Hour, config.TTL)
+	}
+	if config.Local.Dir != "/data" {
+		t.Errorf("unexpected value for Local.Dir. want=%s have=%s", "/data", config.Local.Dir)
+	}
+	if config.Local.JanitorInterval != 5*time.Minute {
+		t.Errorf("unexpected value for Local.JanitorInterval. want=%v have=%v", 5*time.Minute, config.Local.JanitorInterval)
+	}
+	if !NeedsJanitor(&config) {
+		t.Errorf("expected local store to need a janitor")
+	}
+}
+
 func mapGetter(env map[string]string) func(name, defaultValue, description string) string {
 	return func(name, defaultValue, description string) string {
 		if v, ok := env[name]; ok {
@@ -0,0 +1,36 @@
+package uploadstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+)
+
+// expirer is implemented by stores that remove expired objects themselves instead of relying
+// on the lifecycle configuration of a managed bucket.
+type expirer interface {
+	expire(ctx context.Context) error
+}
+
+// NeedsJanitor returns true if the store created from the given configuration does not expire
+// objects on its own and requires a janitor created by NewJanitor.
+func NeedsJanitor(config *Config) bool {
+	return config.Backend == "local"
+}
+
+// NewJanitor returns a background routine that periodically removes the objects of the given
+// store that are older than the configured TTL. This is a no-op for stores backed by a managed
+// blob store.
+func NewJanitor(store Store, interval time.Duration) goroutine.BackgroundRoutine {
+	return goroutine.NewPeriodicGoroutine(context.Background(), interval, goroutine.NewHandlerWithErrorMessage(
+		"expire upload store objects",
+		func(ctx context.Context) error {
+			if expirer, ok := store.(expirer); ok {
+				return expirer.expire(ctx)
+			}
+
+			return nil
+		},
+	))
+}
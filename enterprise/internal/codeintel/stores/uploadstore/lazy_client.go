@@ -54,6 +54,19 @@ func (s *lazyStore) Delete(ctx context.Context, key string) error {
 	return s.store.Delete(ctx, key)
 }
 
+func (s *lazyStore) expire(ctx context.Context) error {
+	expirer, ok := s.store.(expirer)
+	if !ok {
+		return nil
+	}
+
+	if err := s.initOnce(ctx); err != nil {
+		return err
+	}
+
+	return expirer.expire(ctx)
+}
+
 // initOnce serializes access to the underlying store's Init method. If the
 // Init method completes successfully, all future calls to this function will
 // no-op.
@@ -0,0 +1,298 @@
+package uploadstore
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+type localStore struct {
+	root       string
+	ttl        time.Duration
+	operations *operations
+}
+
+var _ Store = &localStore{}
+
+type LocalConfig struct {
+	Dir             string
+	JanitorInterval time.Duration
+}
+
+func (c *LocalConfig) load(parent *env.BaseConfig) {
+	c.Dir = parent.Get("PRECISE_CODE_INTEL_UPLOAD_LOCAL_DIR", "/var/opt/sourcegraph", "The directory containing the bucket directory for LSIF uploads.")
+	c.JanitorInterval = parent.GetInterval("PRECISE_CODE_INTEL_UPLOAD_LOCAL_JANITOR_INTERVAL", "1h", "The interval at which expired uploads are removed from disk.")
+}
+
+// newLocalFromConfig creates a new store backed by a directory on the local filesystem.
+func newLocalFromConfig(ctx context.Context, config *Config, operations *operations) (Store, error) {
+	return newLocal(filepath.Join(config.Local.Dir, config.Bucket), config.TTL, operations), nil
+}
+
+func newLocal(root string, ttl time.Duration, operations *operations) *localStore {
+	return &localStore{
+		root:       root,
+		ttl:        ttl,
+		operations: operations,
+	}
+}
+
+// localTempFilePrefix is the prefix of files that are still being written. Objects are written
+// to a temporary file in the root directory and renamed once complete, so that a partial object
+// is never visible to readers.
+const localTempFilePrefix = ".tmp-"
+
+func (s *localStore) Init(ctx context.Context) error {
+	if err := os.MkdirAll(s.root, os.ModePerm); err != nil {
+		return errors.Wrap(err, "failed to create upload directory")
+	}
+
+	return nil
+}
+
+func (s *localStore) Get(ctx context.Context, key string) (_ io.ReadCloser, err error) {
+	ctx, endObservation := s.operations.get.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return openGzipFile(path)
+}
+
+func (s *localStore) Upload(ctx context.Context, key string, r io.Reader) (_ int64, err error) {
+	ctx, endObservation := s.operations.upload.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	path, err := s.path(key)
+	if err != nil {
+		return 0, err
+	}
+
+	cr := &countingReader{r: r}
+
+	if err := s.writeAtomically(path, func(w io.Writer) error {
+		_, err := io.Copy(w, cr)
+		return err
+	}); err != nil {
+		return 0, errors.Wrap(err, "failed to upload object")
+	}
+
+	return int64(cr.n), nil
+}
+
+func (s *localStore) Compose(ctx context.Context, destination string, sources ...string) (_ int64, err error) {
+	ctx, endObservation := s.operations.compose.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("destination", destination),
+		log.String("sources", strings.Join(sources, ", ")),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	path, err := s.path(destination)
+	if err != nil {
+		return 0, err
+	}
+
+	sourcePaths := make([]string, 0, len(sources))
+	for _, source := range sources {
+		sourcePath, err := s.path(source)
+		if err != nil {
+			return 0, err
+		}
+
+		sourcePaths = append(sourcePaths, sourcePath)
+	}
+
+	var n int64
+	if err := s.writeAtomically(path, func(w io.Writer) error {
+		for _, sourcePath := range sourcePaths {
+			m, err := copyGzipFile(w, sourcePath)
+			if err != nil {
+				return err
+			}
+
+			n += m
+		}
+
+		return nil
+	}); err != nil {
+		return 0, errors.Wrap(err, "failed to compose object")
+	}
+
+	// Delete sources on success
+	for _, sourcePath := range sourcePaths {
+		if err := removeIfExists(sourcePath); err != nil {
+			log15.Error("Failed to delete source object", "error", err)
+		}
+	}
+
+	return n, nil
+}
+
+func (s *localStore) Delete(ctx context.Context, key string) (err error) {
+	ctx, endObservation := s.operations.delete.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	return errors.Wrap(removeIfExists(path), "failed to delete object")
+}
+
+// expire removes all objects (and abandoned temporary files) that were last written before
+// the store's TTL.
+func (s *localStore) expire(ctx context.Context) (err error) {
+	ctx, endObservation := s.operations.expire.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	entries, err := os.ReadDir(s.root)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return errors.Wrap(err, "failed to read upload directory")
+	}
+
+	expiredBefore := time.Now().Add(-s.ttl)
+
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+
+			return errors.Wrap(err, "failed to stat object")
+		}
+		if !info.ModTime().Before(expiredBefore) {
+			continue
+		}
+
+		if err := removeIfExists(filepath.Join(s.root, entry.Name())); err != nil {
+			return errors.Wrap(err, "failed to delete expired object")
+		}
+	}
+
+	return nil
+}
+
+// path returns the path of the file holding the object with the given key. Keys are flat
+// names within the root directory.
+func (s *localStore) path(key string) (string, error) {
+	if key == "" || strings.ContainsAny(key, `/\`) || key == "." || key == ".." || strings.HasPrefix(key, localTempFilePrefix) {
+		return "", errors.Errorf("illegal object key %q", key)
+	}
+
+	return filepath.Join(s.root, key), nil
+}
+
+// writeAtomically invokes the given function with a writer that compresses its input into a
+// temporary file. The temporary file is moved to the given path only if the function succeeds.
+func (s *localStore) writeAtomically(path string, fn func(w io.Writer) error) (err error) {
+	f, err := os.CreateTemp(s.root, localTempFilePrefix+"*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+		}
+	}()
+
+	gzipWriter := gzip.NewWriter(f)
+	if err := fn(gzipWriter); err != nil {
+		return err
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+// openGzipFile returns a reader of the decompressed content of the file at the given path.
+func openGzipFile(path string) (io.ReadCloser, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get object")
+	}
+
+	gzipReader, err := gzip.NewReader(f)
+	if err != nil {
+		_ = f.Close()
+		return nil, errors.Wrap(err, "failed to read object")
+	}
+
+	return &gzipFileReader{Reader: gzipReader, file: f}, nil
+}
+
+// copyGzipFile writes the decompressed content of the file at the given path into the given writer.
+func copyGzipFile(w io.Writer, path string) (int64, error) {
+	rc, err := openGzipFile(path)
+	if err != nil {
+		return 0, err
+	}
+	defer rc.Close()
+
+	return io.Copy(w, rc)
+}
+
+func removeIfExists(path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// gzipFileReader closes both a gzip reader and its underlying file.
+type gzipFileReader struct {
+	*gzip.Reader
+	file *os.File
+}
+
+func (r *gzipFileReader) Close() error {
+	err := r.Reader.Close()
+	if closeErr := r.file.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
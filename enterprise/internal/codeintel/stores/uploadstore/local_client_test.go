@@ -0,0 +1,208 @@
+package uploadstore
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestLocalUploadGet(t *testing.T) {
+	client := testLocalClient(t, time.Hour)
+
+	size, err := client.Upload(context.Background(), "test-key", bytes.NewReader([]byte("TEST PAYLOAD")))
+	if err != nil {
+		t.Fatalf("unexpected error uploading object: %s", err)
+	}
+	if size != 12 {
+		t.Errorf("unexpected size. want=%d have=%d", 12, size)
+	}
+
+	if contents := readLocalObject(t, client, "test-key"); contents != "TEST PAYLOAD" {
+		t.Errorf("unexpected contents. want=%s have=%s", "TEST PAYLOAD", contents)
+	}
+
+	// Objects are stored compressed
+	raw, err := os.ReadFile(filepath.Join(client.root, "test-key"))
+	if err != nil {
+		t.Fatalf("unexpected error reading object file: %s", err)
+	}
+	if !bytes.HasPrefix(raw, []byte{0x1f, 0x8b}) {
+		t.Errorf("expected object file to be gzip-compressed")
+	}
+}
+
+func TestLocalGetMissing(t *testing.T) {
+	client := testLocalClient(t, time.Hour)
+
+	if _, err := client.Get(context.Background(), "test-key"); err == nil {
+		t.Fatalf("expected error reading missing object")
+	}
+}
+
+func TestLocalUploadFailure(t *testing.T) {
+	client := testLocalClient(t, time.Hour)
+
+	if _, err := client.Upload(context.Background(), "test-key", io.MultiReader(
+		bytes.NewReader([]byte("TEST")),
+		&errorReader{},
+	)); err == nil {
+		t.Fatalf("expected error uploading object")
+	}
+
+	// Neither the object nor the temporary file should remain
+	entries, err := os.ReadDir(client.root)
+	if err != nil {
+		t.Fatalf("unexpected error reading directory: %s", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("unexpected files after failed upload. want=%d have=%d", 0, len(entries))
+	}
+}
+
+func TestLocalIllegalKey(t *testing.T) {
+	client := testLocalClient(t, time.Hour)
+
+	for _, key := range []string{"", ".", "..", "../test-key", "dir/test-key", localTempFilePrefix + "test-key"} {
+		if _, err := client.Upload(context.Background(), key, bytes.NewReader(nil)); err == nil {
+			t.Errorf("expected error uploading object with key %q", key)
+		}
+	}
+}
+
+func TestLocalCompose(t *testing.T) {
+	client := testLocalClient(t, time.Hour)
+
+	for key, contents := range map[string]string{"test-src1": "TEST ", "test-src2": "PAY", "test-src3": "LOAD"} {
+		if _, err := client.Upload(context.Background(), key, bytes.NewReader([]byte(contents))); err != nil {
+			t.Fatalf("unexpected error uploading object: %s", err)
+		}
+	}
+
+	size, err := client.Compose(context.Background(), "test-key", "test-src1", "test-src2", "test-src3")
+	if err != nil {
+		t.Fatalf("unexpected error composing objects: %s", err)
+	}
+	if size != 12 {
+		t.Errorf("unexpected size. want=%d have=%d", 12, size)
+	}
+
+	if contents := readLocalObject(t, client, "test-key"); contents != "TEST PAYLOAD" {
+		t.Errorf("unexpected contents. want=%s have=%s", "TEST PAYLOAD", contents)
+	}
+
+	for _, key := range []string{"test-src1", "test-src2", "test-src3"} {
+		if _, err := os.Stat(filepath.Join(client.root, key)); !os.IsNotExist(err) {
+			t.Errorf("expected source object %q to be deleted", key)
+		}
+	}
+}
+
+func TestLocalComposeMissingSource(t *testing.T) {
+	client := testLocalClient(t, time.Hour)
+
+	if _, err := client.Upload(context.Background(), "test-src1", bytes.NewReader([]byte("TEST"))); err != nil {
+		t.Fatalf("unexpected error uploading object: %s", err)
+	}
+
+	if _, err := client.Compose(context.Background(), "test-key", "test-src1", "test-src2"); err == nil {
+		t.Fatalf("expected error composing objects")
+	}
+
+	if _, err := os.Stat(filepath.Join(client.root, "test-key")); !os.IsNotExist(err) {
+		t.Errorf("expected destination object to not exist")
+	}
+	if _, err := os.Stat(filepath.Join(client.root, "test-src1")); err != nil {
+		t.Errorf("expected source object to be retained")
+	}
+}
+
+func TestLocalDelete(t *testing.T) {
+	client := testLocalClient(t, time.Hour)
+
+	if _, err := client.Upload(context.Background(), "test-key", bytes.NewReader([]byte("TEST"))); err != nil {
+		t.Fatalf("unexpected error uploading object: %s", err)
+	}
+
+	// Deleting an object twice is not an error
+	for i := 0; i < 2; i++ {
+		if err := client.Delete(context.Background(), "test-key"); err != nil {
+			t.Fatalf("unexpected error deleting object: %s", err)
+		}
+	}
+
+	if _, err := client.Get(context.Background(), "test-key"); err == nil {
+		t.Fatalf("expected error reading deleted object")
+	}
+}
+
+func TestLocalExpire(t *testing.T) {
+	client := testLocalClient(t, time.Hour)
+
+	for _, key := range []string{"test-old", "test-new"} {
+		if _, err := client.Upload(context.Background(), key, bytes.NewReader([]byte("TEST"))); err != nil {
+			t.Fatalf("unexpected error uploading object: %s", err)
+		}
+	}
+
+	abandoned := filepath.Join(client.root, localTempFilePrefix+"abandoned")
+	if err := os.WriteFile(abandoned, nil, os.ModePerm); err != nil {
+		t.Fatalf("unexpected error writing file: %s", err)
+	}
+
+	old := time.Now().Add(-2 * time.Hour)
+	for _, path := range []string{filepath.Join(client.root, "test-old"), abandoned} {
+		if err := os.Chtimes(path, old, old); err != nil {
+			t.Fatalf("unexpected error changing file times: %s", err)
+		}
+	}
+
+	if err := newLazyStore(client).(expirer).expire(context.Background()); err != nil {
+		t.Fatalf("unexpected error expiring objects: %s", err)
+	}
+
+	entries, err := os.ReadDir(client.root)
+	if err != nil {
+		t.Fatalf("unexpected error reading directory: %s", err)
+	}
+	if len(entries) != 1 || entries[0].Name() != "test-new" {
+		var names []string
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+		t.Errorf("unexpected files after expiry. want=%v have=%v", []string{"test-new"}, names)
+	}
+}
+
+func testLocalClient(t *testing.T, ttl time.Duration) *localStore {
+	client := newLocal(filepath.Join(t.TempDir(), "lsif-uploads"), ttl, newOperations(&observation.TestContext))
+	if err := client.Init(context.Background()); err != nil {
+		t.Fatalf("unexpected error initializing client: %s", err)
+	}
+
+	return client
+}
+
+func readLocalObject(t *testing.T, client *localStore, key string) string {
+	rc, err := client.Get(context.Background(), key)
+	if err != nil {
+		t.Fatalf("unexpected error getting object: %s", err)
+	}
+	defer rc.Close()
+
+	contents, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("unexpected error reading object: %s", err)
+	}
+
+	return string(contents)
+}
+
+type errorReader struct{}
+
+func (r *errorReader) Read(p []byte) (int, error) { return 0, io.ErrUnexpectedEOF }
@@ -12,6 +12,7 @@ type operations struct {
 	upload  *observation.Operation
 	compose *observation.Operation
 	delete  *observation.Operation
+	expire  *observation.Operation
 }
 
 func newOperations(observationContext *observation.Context) *operations {
@@ -35,5 +36,6 @@ func newOperations(observationContext *observation.Context) *operations {
 		upload:  op("Upload"),
 		compose: op("Compose"),
 		delete:  op("Delete"),
+		expire:  op("Expire"),
 	}
 }
@@ -9,7 +9,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 )
 
-// Store is an expiring key/value store backed by a managed blob store.
+// Store is an expiring key/value store backed by a managed blob store or by the local filesystem.
 type Store interface {
 	// Init ensures that the underlying target bucket exists and has the expected ACL
 	// and lifecycle configuration.
@@ -34,6 +34,7 @@ var storeConstructors = map[string]func(ctx context.Context, config *Config, ope
 	"s3":    newS3FromConfig,
 	"minio": newS3FromConfig,
 	"gcs":   newGCSFromConfig,
+	"local": newLocalFromConfig,
 }
 
 // CreateLazy initialize a new store from the given configuration that is initialized
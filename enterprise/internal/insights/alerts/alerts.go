@@ -0,0 +1,266 @@
+// Package alerts evaluates the threshold alerts attached to code insights series and notifies
+// their creators when an alert fires.
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api/internalapi"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/txemail/txtypes"
+)
+
+// Transition describes how the state of an alert changes given a new series value.
+type Transition int
+
+const (
+	// Unchanged means the alert keeps its current state.
+	Unchanged Transition = iota
+	// Triggered means the threshold was crossed and the alert must notify.
+	Triggered
+	// Recovered means the series value no longer crosses the threshold of a firing alert.
+	Recovered
+)
+
+// Evaluate returns the transition of the given alert for a new series value. An alert that has
+// already fired does not trigger again until the value recovers.
+func Evaluate(alert types.InsightSeriesAlert, value float64) Transition {
+	var breached bool
+	switch alert.Comparator {
+	case types.AlertAbove:
+		breached = value > alert.Threshold
+	case types.AlertBelow:
+		breached = value < alert.Threshold
+	}
+
+	firing := alert.TriggeredAt != nil
+	switch {
+	case breached && !firing:
+		return Triggered
+	case !breached && firing:
+		return Recovered
+	}
+	return Unchanged
+}
+
+// Evaluator evaluates the alerts of insight series as new data points are recorded.
+type Evaluator struct {
+	insightsStore store.Interface
+	alertStore    *store.AlertStore
+}
+
+func NewEvaluator(insightsStore store.Interface, alertStore *store.AlertStore) *Evaluator {
+	return &Evaluator{insightsStore: insightsStore, alertStore: alertStore}
+}
+
+// EvaluateSeries evaluates every alert of the given series against the value recorded at
+// recordTime. The state of a triggered alert is only persisted once its notifications were
+// delivered, so that a failed delivery is retried the next time the series is recorded.
+func (e *Evaluator) EvaluateSeries(ctx context.Context, series *types.InsightSeries, recordTime, now time.Time) error {
+	alerts, err := e.alertStore.GetAlerts(ctx, store.GetAlertsArgs{SeriesID: series.SeriesID})
+	if err != nil {
+		return errors.Wrap(err, "GetAlerts")
+	}
+
+	for _, alert := range alerts {
+		value, valueErr := e.seriesValue(ctx, alert, recordTime)
+		if valueErr != nil {
+			err = multierror.Append(err, errors.Wrapf(valueErr, "series value for alert %d", alert.ID))
+			continue
+		}
+
+		triggeredAt := alert.TriggeredAt
+		switch Evaluate(alert, value) {
+		case Triggered:
+			if notifyErr := notify(ctx, alert, series, value); notifyErr != nil {
+				err = multierror.Append(err, errors.Wrapf(notifyErr, "notify for alert %d", alert.ID))
+				continue
+			}
+			triggeredAt = &now
+		case Recovered:
+			triggeredAt = nil
+		}
+		if updateErr := e.alertStore.UpdateAlertState(ctx, alert.ID, triggeredAt, value); updateErr != nil {
+			err = multierror.Append(err, errors.Wrapf(updateErr, "UpdateAlertState for alert %d", alert.ID))
+		}
+	}
+	return err
+}
+
+// seriesValue returns the value of the series recorded at recordTime, as seen in the view the
+// alert was created from.
+func (e *Evaluator) seriesValue(ctx context.Context, alert types.InsightSeriesAlert, recordTime time.Time) (float64, error) {
+	// 🚨 SECURITY: The value is computed with the repository permissions of the creator of the
+	// alert, since they are the one being notified of it.
+	ctx = actor.WithActor(ctx, actor.FromUser(alert.CreatedByUserID))
+
+	points, err := e.insightsStore.SeriesPoints(ctx, seriesPointsOpts(alert, recordTime))
+	if err != nil {
+		return 0, err
+	}
+	var value float64
+	for _, point := range points {
+		value += point.Value
+	}
+	return value, nil
+}
+
+// seriesPointsOpts returns the options to query the points of the alert's series recorded at
+// recordTime, filtered like the view the alert was created from. The query covers a small window
+// around recordTime, since the database stores times with a lower precision. Points of all capture
+// groups are summed up.
+func seriesPointsOpts(alert types.InsightSeriesAlert, recordTime time.Time) store.SeriesPointsOpts {
+	seriesID := alert.SeriesID
+	from, to := recordTime.Add(-time.Millisecond), recordTime.Add(time.Millisecond)
+	opts := store.SeriesPointsOpts{SeriesID: &seriesID, From: &from, To: &to}
+	if alert.Filters.IncludeRepoRegex != nil {
+		opts.IncludeRepoRegex = *alert.Filters.IncludeRepoRegex
+	}
+	if alert.Filters.ExcludeRepoRegex != nil {
+		opts.ExcludeRepoRegex = *alert.Filters.ExcludeRepoRegex
+	}
+	return opts
+}
+
+// TemplateData is the data rendered by the alert email templates.
+type TemplateData struct {
+	Title       string
+	Query       string
+	Value       string
+	Comparison  string
+	Threshold   string
+	InsightsURL string
+}
+
+// WebhookPayload is the JSON body posted to the webhook URL of a triggered alert.
+type WebhookPayload struct {
+	AlertID    int       `json:"alertId"`
+	SeriesID   string    `json:"seriesId"`
+	Query      string    `json:"query"`
+	Comparator string    `json:"comparator"`
+	Threshold  float64   `json:"threshold"`
+	Value      float64   `json:"value"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+var MockNotify func(ctx context.Context, alert types.InsightSeriesAlert, series *types.InsightSeries, value float64) error
+
+func notify(ctx context.Context, alert types.InsightSeriesAlert, series *types.InsightSeries, value float64) error {
+	if MockNotify != nil {
+		return MockNotify(ctx, alert, series, value)
+	}
+
+	if alert.Email {
+		if err := sendEmail(ctx, alert, series, value); err != nil {
+			return err
+		}
+	}
+	if alert.WebhookURL != nil {
+		if err := postWebhook(ctx, *alert.WebhookURL, WebhookPayload{
+			AlertID:    alert.ID,
+			SeriesID:   series.SeriesID,
+			Query:      series.Query,
+			Comparator: string(alert.Comparator),
+			Threshold:  alert.Threshold,
+			Value:      value,
+			Timestamp:  time.Now().UTC(),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sendEmail(ctx context.Context, alert types.InsightSeriesAlert, series *types.InsightSeries, value float64) error {
+	comparison := "above"
+	if alert.Comparator == types.AlertBelow {
+		comparison = "below"
+	}
+	insightsURL, err := getInsightsURL(ctx)
+	if err != nil {
+		return err
+	}
+	data := &TemplateData{
+		Title:       fmt.Sprintf("Series value is %s %s", comparison, formatFloat(alert.Threshold)),
+		Query:       series.Query,
+		Value:       formatFloat(value),
+		Comparison:  comparison,
+		Threshold:   formatFloat(alert.Threshold),
+		InsightsURL: insightsURL,
+	}
+
+	email, err := internalapi.Client.UserEmailsGetEmail(ctx, alert.CreatedByUserID)
+	if err != nil {
+		return errors.Errorf("internalapi.Client.UserEmailsGetEmail for userID=%d: %w", alert.CreatedByUserID, err)
+	}
+	if email == nil {
+		return errors.Errorf("unable to send email to user ID %d with unknown email address", alert.CreatedByUserID)
+	}
+	if err := internalapi.Client.SendEmail(ctx, txtypes.Message{
+		To:       []string{*email},
+		Template: alertEmailTemplates,
+		Data:     data,
+	}); err != nil {
+		return errors.Errorf("internalapi.Client.SendEmail to email=%q userID=%d: %w", *email, alert.CreatedByUserID, err)
+	}
+	return nil
+}
+
+// webhookDoer posts alert webhooks. Webhook URLs are provided by users, so it refuses to connect
+// to internal addresses. If it can't be created, webhookDoerErr is returned for every webhook.
+var webhookDoer, webhookDoerErr = httpcli.ExternalClientFactory.Doer(httpcli.DenyPrivateNetworksOpt)
+
+func postWebhook(ctx context.Context, webhookURL string, payload WebhookPayload) error {
+	if webhookDoerErr != nil {
+		return errors.Wrap(webhookDoerErr, "creating webhook client")
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "creating webhook request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := webhookDoer.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "posting webhook")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("webhook returned unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func getInsightsURL(ctx context.Context) (string, error) {
+	externalURLStr, err := internalapi.Client.ExternalURL(ctx)
+	if err != nil {
+		return "", errors.Errorf("failed to get ExternalURL: %w", err)
+	}
+	externalURL, err := url.Parse(externalURLStr)
+	if err != nil {
+		return "", errors.Errorf("failed to get ExternalURL: %w", err)
+	}
+	return externalURL.ResolveReference(&url.URL{Path: "insights"}).String(), nil
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,81 @@
+package alerts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+)
+
+func TestEvaluate(t *testing.T) {
+	firedAt := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		comparator types.AlertComparator
+		fired      bool
+		value      float64
+		want       Transition
+	}{
+		{name: "above crossed", comparator: types.AlertAbove, value: 11, want: Triggered},
+		{name: "above at threshold", comparator: types.AlertAbove, value: 10, want: Unchanged},
+		{name: "above still firing", comparator: types.AlertAbove, fired: true, value: 12, want: Unchanged},
+		{name: "above recovered", comparator: types.AlertAbove, fired: true, value: 9, want: Recovered},
+		{name: "below crossed", comparator: types.AlertBelow, value: 9, want: Triggered},
+		{name: "below not crossed", comparator: types.AlertBelow, value: 11, want: Unchanged},
+		{name: "below still firing", comparator: types.AlertBelow, fired: true, value: 1, want: Unchanged},
+		{name: "below recovered", comparator: types.AlertBelow, fired: true, value: 10, want: Recovered},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			alert := types.InsightSeriesAlert{Comparator: tc.comparator, Threshold: 10}
+			if tc.fired {
+				alert.TriggeredAt = &firedAt
+			}
+			if have := Evaluate(alert, tc.value); have != tc.want {
+				t.Errorf("unexpected transition. want=%d have=%d", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestSeriesPointsOpts(t *testing.T) {
+	recordTime := time.Date(2021, 12, 1, 12, 0, 0, 0, time.UTC)
+	include, exclude := "^github.com/sourcegraph/", "-archived$"
+
+	t.Run("unfiltered", func(t *testing.T) {
+		opts := seriesPointsOpts(types.InsightSeriesAlert{SeriesID: "s1"}, recordTime)
+		if opts.SeriesID == nil || *opts.SeriesID != "s1" {
+			t.Errorf("unexpected series ID: %v", opts.SeriesID)
+		}
+		if !opts.From.Before(recordTime) || !opts.To.After(recordTime) {
+			t.Errorf("record time %s is not in [%s, %s]", recordTime, opts.From, opts.To)
+		}
+		if opts.IncludeRepoRegex != "" || opts.ExcludeRepoRegex != "" {
+			t.Errorf("unexpected filters: %q %q", opts.IncludeRepoRegex, opts.ExcludeRepoRegex)
+		}
+	})
+
+	t.Run("view filters", func(t *testing.T) {
+		opts := seriesPointsOpts(types.InsightSeriesAlert{
+			SeriesID: "s1",
+			Filters:  types.InsightViewFilters{IncludeRepoRegex: &include, ExcludeRepoRegex: &exclude},
+		}, recordTime)
+		if opts.IncludeRepoRegex != include || opts.ExcludeRepoRegex != exclude {
+			t.Errorf("unexpected filters: %q %q", opts.IncludeRepoRegex, opts.ExcludeRepoRegex)
+		}
+	})
+}
+
+func TestPostWebhookWithoutClient(t *testing.T) {
+	doer, doerErr := webhookDoer, webhookDoerErr
+	t.Cleanup(func() { webhookDoer, webhookDoerErr = doer, doerErr })
+	webhookDoer, webhookDoerErr = nil, errors.New("no client")
+
+	if err := postWebhook(context.Background(), "https://example.com/hook", WebhookPayload{}); err == nil {
+		t.Fatal("expected an error when the webhook client couldn't be created")
+	}
+}
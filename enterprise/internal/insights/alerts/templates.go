@@ -0,0 +1,48 @@
+package alerts
+
+import (
+	"github.com/sourcegraph/sourcegraph/internal/txemail"
+	"github.com/sourcegraph/sourcegraph/internal/txemail/txtypes"
+)
+
+var alertEmailTemplates = txemail.MustValidate(txtypes.Templates{
+	Subject: `[Code Insights alert] {{.Title}}`,
+	Text: `
+A code insights alert was triggered:
+
+{{.Title}}
+The series for the query "{{.Query}}" is now at {{.Value}}, {{.Comparison}} the threshold of {{.Threshold}}.
+
+View insights on Sourcegraph {{.InsightsURL}}
+
+__
+You are receiving this notification because you created an alert on a code insights series.
+You will not be notified again until the series value recovers.
+`,
+	HTML: `
+<!DOCTYPE html>
+<html>
+  <body>
+    <p style="font-size: 16px; line-height: 24px">
+      A code insights alert was triggered:
+    </p>
+    <p style="font-size: 20px; line-height: 30px; font-weight: 700">
+      {{.Title}}<br />
+      <span style="font-size: 16px; line-height: 24px; font-weight: 400"
+        >The series for the query <code>{{.Query}}</code> is now at {{.Value}}, {{.Comparison}} the threshold of {{.Threshold}}.</span
+      >
+    </p>
+    <p style="font-size: 16px; line-height: 24px">
+      <a href="{{.InsightsURL}}">View insights on Sourcegraph</a>
+    </p>
+    <br />
+    <br />
+    __
+    <p style="font-size: 14px; line-height: 24px">
+      You are receiving this notification because you created an alert on a code
+      insights series. You will not be notified again until the series value recovers.
+    </p>
+  </body>
+</html>
+`,
+})
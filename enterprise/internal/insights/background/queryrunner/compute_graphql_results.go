@@ -8,6 +8,7 @@ import (
 )
 
 type ComputeResult interface {
+	RepoID() string
 	RepoName() string
 	Revhash() string
 	FilePath() string
@@ -48,6 +49,30 @@ func GroupByCaptureMatch(results []ComputeResult) []GroupedResults {
 	return grouped
 }
 
+// RepoCaptureCounts contains the number of matches for each distinct capture group value
+// within a single repository.
+type RepoCaptureCounts struct {
+	RepoName string
+	Counts   map[string]int
+}
+
+// GroupByRepository groups the capture group match counts of the given results by the
+// GraphQL ID of the repository they were found in.
+func GroupByRepository(results []ComputeResult) map[string]*RepoCaptureCounts {
+	grouped := make(map[string]*RepoCaptureCounts)
+	for _, result := range results {
+		repo, ok := grouped[result.RepoID()]
+		if !ok {
+			repo = &RepoCaptureCounts{RepoName: result.RepoName(), Counts: make(map[string]int)}
+			grouped[result.RepoID()] = repo
+		}
+		for value, count := range result.Counts() {
+			repo.Counts[value] += count
+		}
+	}
+	return grouped
+}
+
 func decodeComputeResult(result json.RawMessage) (ComputeResult, error) {
 	typeName := struct {
 		TypeName string `json:"__typeName"`
@@ -73,6 +98,7 @@ func decodeComputeResult(result json.RawMessage) (ComputeResult, error) {
 type computeMatchContext struct {
 	Commit     string
 	Repository struct {
+		ID   string
 		Name string
 	}
 	Path    string
@@ -87,6 +113,10 @@ func (c computeMatchContext) Counts() map[string]int {
 	return distinct
 }
 
+func (c computeMatchContext) RepoID() string {
+	return c.Repository.ID
+}
+
 func (c computeMatchContext) RepoName() string {
 	return c.Repository.Name
 }
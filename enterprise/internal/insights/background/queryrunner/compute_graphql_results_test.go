@@ -0,0 +1,68 @@
+package queryrunner
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGroupByRepository(t *testing.T) {
+	var res *gqlComputeSearchResponse
+	if err := json.Unmarshal([]byte(realComputeSearch), &res); err != nil {
+		t.Fatal(err)
+	}
+	var results []ComputeResult
+	for _, raw := range res.Data.Compute {
+		decoded, err := decodeComputeResult(raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		results = append(results, decoded)
+	}
+
+	want := map[string]*RepoCaptureCounts{
+		"UmVwb3NpdG9yeTox": {RepoName: "github.com/sourcegraph/sourcegraph", Counts: map[string]int{"1.16": 2, "1.17": 1}},
+		"UmVwb3NpdG9yeToy": {RepoName: "github.com/sourcegraph/zoekt", Counts: map[string]int{"1.17": 1}},
+	}
+	if diff := cmp.Diff(want, GroupByRepository(results)); diff != "" {
+		t.Errorf("unexpected grouped results (-want +got):\n%s", diff)
+	}
+}
+
+const realComputeSearch = `
+{
+  "data": {
+    "compute": [
+      {
+        "__typename": "ComputeMatchContext",
+        "repository": {"id": "UmVwb3NpdG9yeTox", "name": "github.com/sourcegraph/sourcegraph"},
+        "commit": "a8e1a8e6a5c7b6e5c6d2a0ac5ea5b7c7a1c2e3f4",
+        "path": "go.mod",
+        "matches": [{"value": "go 1.17", "environment": [{"variable": "1", "value": "1.17"}]}]
+      },
+      {
+        "__typename": "ComputeMatchContext",
+        "repository": {"id": "UmVwb3NpdG9yeTox", "name": "github.com/sourcegraph/sourcegraph"},
+        "commit": "a8e1a8e6a5c7b6e5c6d2a0ac5ea5b7c7a1c2e3f4",
+        "path": "lib/go.mod",
+        "matches": [{"value": "go 1.16", "environment": [{"variable": "1", "value": "1.16"}]}]
+      },
+      {
+        "__typename": "ComputeMatchContext",
+        "repository": {"id": "UmVwb3NpdG9yeTox", "name": "github.com/sourcegraph/sourcegraph"},
+        "commit": "a8e1a8e6a5c7b6e5c6d2a0ac5ea5b7c7a1c2e3f4",
+        "path": "dev/sg/go.mod",
+        "matches": [{"value": "go 1.16", "environment": [{"variable": "1", "value": "1.16"}]}]
+      },
+      {
+        "__typename": "ComputeMatchContext",
+        "repository": {"id": "UmVwb3NpdG9yeToy", "name": "github.com/sourcegraph/zoekt"},
+        "commit": "0b1d5a3c2e4f6a8b0c1d2e3f4a5b6c7d8e9f0a1b",
+        "path": "go.mod",
+        "matches": [{"value": "go 1.17", "environment": [{"variable": "1", "value": "1.17"}]}]
+      }
+    ]
+  }
+}
+`
@@ -16,6 +16,7 @@ query Run($query: String!) {
 	__typename
     ... on ComputeMatchContext {
       repository {
+        id
         name
       }
       commit
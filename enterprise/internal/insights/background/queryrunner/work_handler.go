@@ -21,6 +21,7 @@ import (
 	"github.com/inconshreveable/log15"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/alerts"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
 )
@@ -33,6 +34,7 @@ type workHandler struct {
 	baseWorkerStore *basestore.Store
 	insightsStore   *store.Store
 	metadadataStore *store.InsightStore
+	alertEvaluator  *alerts.Evaluator
 	limiter         *rate.Limiter
 
 	mu          sync.RWMutex
@@ -92,6 +94,15 @@ func (r *workHandler) Handle(ctx context.Context, record workerutil.Record) (err
 		return err
 	}
 
+	recordTime := time.Now()
+	if job.RecordTime != nil {
+		recordTime = *job.RecordTime
+	}
+
+	if series.GeneratedFromCaptureGroups {
+		return r.handleCaptureGroups(ctx, job, series, recordTime)
+	}
+
 	// Actually perform the search query.
 	//
 	// 🚨 SECURITY: The request is performed without authentication, we get back results from every
@@ -105,11 +116,6 @@ func (r *workHandler) Handle(ctx context.Context, record workerutil.Record) (err
 		return err
 	}
 
-	recordTime := time.Now()
-	if job.RecordTime != nil {
-		recordTime = *job.RecordTime
-	}
-
 	if len(results.Errors) > 0 {
 		return errors.Errorf("GraphQL errors: %v", results.Errors)
 	}
@@ -172,6 +178,11 @@ func (r *workHandler) Handle(ctx context.Context, record workerutil.Record) (err
 		matchesPerRepo[decoded.repoID()] = matchesPerRepo[decoded.repoID()] + decoded.matchCount()
 	}
 
+	if evaluatesAlerts(job) {
+		// Registered before the transaction so that alerts are evaluated once the points are committed.
+		defer func() { r.evaluateAlerts(ctx, series, recordTime, err) }()
+	}
+
 	tx, err := r.insightsStore.Transact(ctx)
 	if err != nil {
 		return err
@@ -208,6 +219,73 @@ func (r *workHandler) Handle(ctx context.Context, record workerutil.Record) (err
 	return err
 }
 
+// handleCaptureGroups executes the job's query through compute and records one data point per
+// repository for every distinct value of the first capture group, so that each value forms its
+// own series.
+func (r *workHandler) handleCaptureGroups(ctx context.Context, job *Job, series *types.InsightSeries, recordTime time.Time) (err error) {
+	// 🚨 SECURITY: As with regular search queries, this request is performed without authentication.
+	// Points are recorded per repository so they can be filtered by repository permissions when read.
+	results, err := ComputeSearch(ctx, job.SearchQuery)
+	if err != nil {
+		return err
+	}
+
+	if evaluatesAlerts(job) {
+		defer func() { r.evaluateAlerts(ctx, series, recordTime, err) }()
+	}
+
+	tx, err := r.insightsStore.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	if job.PersistMode == string(store.SnapshotMode) {
+		if err := tx.DeleteSnapshots(ctx, series); err != nil {
+			return err
+		}
+	}
+
+	for graphQLRepoID, repo := range GroupByRepository(results) {
+		dbRepoID, idErr := graphqlbackend.UnmarshalRepositoryID(graphql.ID(graphQLRepoID))
+		if idErr != nil {
+			err = multierror.Append(err, errors.Wrap(idErr, "UnmarshalRepositoryID"))
+			continue
+		}
+		if len(repo.RepoName) == 0 {
+			err = multierror.Append(err, errors.Newf("MissingRepositoryName for repo_id: %d", dbRepoID))
+			continue
+		}
+
+		for capture, matchCount := range repo.Counts {
+			args := ToCaptureRecording(job, float64(matchCount), recordTime, repo.RepoName, dbRepoID, capture)
+			if recordErr := tx.RecordSeriesPoints(ctx, args); recordErr != nil {
+				err = multierror.Append(err, errors.Wrap(recordErr, "RecordSeriesPoints"))
+			}
+		}
+	}
+	return err
+}
+
+// evaluatesAlerts returns true if the alerts of the series must be evaluated once the job recorded
+// its points. Only jobs recording the current value of a series evaluate alerts: backfilled points
+// don't describe the current state of the series, and snapshots are low fidelity points that are
+// replaced by the next snapshot or recording.
+func evaluatesAlerts(job *Job) bool {
+	return job.RecordTime == nil && job.PersistMode == string(store.RecordMode)
+}
+
+// evaluateAlerts evaluates the alerts of the series against the points recorded by a job. Alerts
+// are skipped if recording failed.
+func (r *workHandler) evaluateAlerts(ctx context.Context, series *types.InsightSeries, recordTime time.Time, recordErr error) {
+	if recordErr != nil || r.alertEvaluator == nil {
+		return
+	}
+	if err := r.alertEvaluator.EvaluateSeries(ctx, series, recordTime, time.Now()); err != nil {
+		log15.Error("insights.queryrunner.workHandler: failed to evaluate alerts", "seriesID", series.SeriesID, "error", err)
+	}
+}
+
 func ToRecording(record *Job, value float64, recordTime time.Time, repoName string, repoID api.RepoID) []store.RecordSeriesPointArgs {
 	args := make([]store.RecordSeriesPointArgs, 0, len(record.DependentFrames)+1)
 	base := store.RecordSeriesPointArgs{
@@ -229,3 +307,13 @@ func ToRecording(record *Job, value float64, recordTime time.Time, repoName stri
 	}
 	return args
 }
+
+// ToCaptureRecording is like ToRecording, but tags every recorded point with the given capture
+// group value.
+func ToCaptureRecording(record *Job, value float64, recordTime time.Time, repoName string, repoID api.RepoID, capture string) []store.RecordSeriesPointArgs {
+	args := ToRecording(record, value, recordTime, repoName, repoID)
+	for i := range args {
+		args[i].Point.Capture = &capture
+	}
+	return args
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/keegancsmith/sqlf"
 	"github.com/lib/pq"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/alerts"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
@@ -83,6 +84,7 @@ func NewWorker(ctx context.Context, workerStore dbworkerstore.Store, insightsSto
 		insightsStore:   insightsStore,
 		limiter:         limiter,
 		metadadataStore: store.NewInsightStore(insightsStore.Handle().DB()),
+		alertEvaluator:  alerts.NewEvaluator(insightsStore, store.NewAlertStore(insightsStore.Handle().DB())),
 		seriesCache:     sharedCache,
 	}, options)
 }
@@ -0,0 +1,236 @@
+// Package export serves the recorded data points of code insights in formats suitable for
+// consumption by external tools (e.g. BI tooling).
+package export
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gorilla/mux"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/csvutil"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+)
+
+// insightViewKind is the kind of the relay ID of an insight view, as used by the GraphQL API.
+const insightViewKind = "insight_view"
+
+// InsightViewStore is the subset of the insight metadata store used by the export handler.
+type InsightViewStore interface {
+	GetAll(ctx context.Context, args store.InsightQueryArgs) ([]types.InsightViewSeries, error)
+}
+
+type handler struct {
+	insightStore    InsightViewStore
+	timeSeriesStore store.Interface
+	orgStore        database.OrgStore
+}
+
+// NewHandler returns an HTTP handler that exports every data point of an insight view. The insight
+// view is identified by the {id} route variable, which may be either the GraphQL ID of the view or
+// its unique ID. The format is chosen with the "format" query parameter and is either "csv" (the
+// default) or "json".
+func NewHandler(insightsDB, postgres dbutil.DB) http.Handler {
+	return newHandler(
+		store.NewInsightStore(insightsDB),
+		store.New(insightsDB, store.NewInsightPermissionStore(postgres)),
+		database.Orgs(postgres),
+	)
+}
+
+func newHandler(insightStore InsightViewStore, timeSeriesStore store.Interface, orgStore database.OrgStore) http.Handler {
+	return &handler{
+		insightStore:    insightStore,
+		timeSeriesStore: timeSeriesStore,
+		orgStore:        orgStore,
+	}
+}
+
+// Row is a single exported data point of an insight series for a single repository.
+type Row struct {
+	SeriesID       string    `json:"seriesId"`
+	SeriesLabel    string    `json:"seriesLabel"`
+	Capture        *string   `json:"capture,omitempty"`
+	RepositoryID   int32     `json:"repositoryId"`
+	RepositoryName string    `json:"repositoryName"`
+	Time           time.Time `json:"time"`
+	Value          float64   `json:"value"`
+}
+
+// Export is the JSON representation of an exported insight view.
+type Export struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Points      []Row  `json:"points"`
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = FormatCSV
+	}
+	if format != FormatCSV && format != FormatJSON {
+		http.Error(w, fmt.Sprintf("unsupported export format %q", format), http.StatusBadRequest)
+		return
+	}
+
+	export, err := h.export(r.Context(), uniqueID(mux.Vars(r)["id"]))
+	if err != nil {
+		if errors.Is(err, errInsightNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		log15.Error("insights.export", "error", err)
+		http.Error(w, "failed to export insight", http.StatusInternalServerError)
+		return
+	}
+
+	switch format {
+	case FormatJSON:
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(export)
+	case FormatCSV:
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", export.ID+".csv"))
+		err = writeCSV(w, export.Points)
+	}
+	if err != nil {
+		log15.Error("insights.export: failed to write response", "error", err)
+	}
+}
+
+var errInsightNotFound = errors.New("insight not found")
+
+// export collects every data point of the given insight view visible to the current user.
+func (h *handler) export(ctx context.Context, uniqueID string) (*Export, error) {
+	userIDs, orgIDs, err := h.userPermissions(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "userPermissions")
+	}
+
+	// 🚨 SECURITY: Only insight views granted to the current user (directly or through a dashboard)
+	// are returned by GetAll.
+	viewSeries, err := h.insightStore.GetAll(ctx, store.InsightQueryArgs{UniqueID: uniqueID, UserID: userIDs, OrgID: orgIDs})
+	if err != nil {
+		return nil, errors.Wrap(err, "GetAll")
+	}
+	if len(viewSeries) == 0 {
+		return nil, errInsightNotFound
+	}
+
+	export := &Export{
+		ID:          string(relay.MarshalID(insightViewKind, uniqueID)),
+		Title:       viewSeries[0].Title,
+		Description: viewSeries[0].Description,
+		Points:      []Row{},
+	}
+	for _, series := range viewSeries {
+		seriesID := series.SeriesID
+		opts := store.SeriesPointsOpts{SeriesID: &seriesID}
+		if series.DefaultFilterIncludeRepoRegex != nil {
+			opts.IncludeRepoRegex = *series.DefaultFilterIncludeRepoRegex
+		}
+		if series.DefaultFilterExcludeRepoRegex != nil {
+			opts.ExcludeRepoRegex = *series.DefaultFilterExcludeRepoRegex
+		}
+
+		// 🚨 SECURITY: RepoSeriesPoints excludes points of repositories the current user cannot see.
+		points, err := h.timeSeriesStore.RepoSeriesPoints(ctx, opts)
+		if err != nil {
+			return nil, errors.Wrapf(err, "RepoSeriesPoints for seriesID: %s", series.SeriesID)
+		}
+		for _, point := range points {
+			label := series.Label
+			if point.Capture != nil {
+				label = *point.Capture
+			}
+			export.Points = append(export.Points, Row{
+				SeriesID:       series.SeriesID,
+				SeriesLabel:    label,
+				Capture:        point.Capture,
+				RepositoryID:   int32(point.RepoID),
+				RepositoryName: point.RepoName,
+				Time:           point.Time,
+				Value:          point.Value,
+			})
+		}
+	}
+	return export, nil
+}
+
+// 🚨 SECURITY: Only add users / orgs if the user is non-anonymous. This restricts anonymous users
+// to insights with a global grant.
+func (h *handler) userPermissions(ctx context.Context) (userIDs []int, orgIDs []int, err error) {
+	userID := actor.FromContext(ctx).UID
+	if userID == 0 {
+		return nil, nil, nil
+	}
+	orgs, err := h.orgStore.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	orgIDs = make([]int, 0, len(orgs))
+	for _, org := range orgs {
+		orgIDs = append(orgIDs, int(org.ID))
+	}
+	return []int{int(userID)}, orgIDs, nil
+}
+
+var csvHeader = []string{"series_id", "series_label", "capture", "repository_id", "repository_name", "time", "value"}
+
+func writeCSV(w http.ResponseWriter, rows []Row) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		capture := ""
+		if row.Capture != nil {
+			capture = *row.Capture
+		}
+		if err := cw.Write([]string{
+			csvutil.Escape(row.SeriesID),
+			csvutil.Escape(row.SeriesLabel),
+			csvutil.Escape(capture),
+			strconv.Itoa(int(row.RepositoryID)),
+			csvutil.Escape(row.RepositoryName),
+			row.Time.UTC().Format(time.RFC3339),
+			strconv.FormatFloat(row.Value, 'f', -1, 64),
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// uniqueID returns the unique ID of the insight view referenced by the given identifier, which may
+// be either a GraphQL ID or a unique ID.
+func uniqueID(id string) string {
+	var uniqueID string
+	if relay.UnmarshalKind(graphql.ID(id)) == insightViewKind {
+		if err := relay.UnmarshalSpec(graphql.ID(id), &uniqueID); err == nil {
+			return uniqueID
+		}
+	}
+	return id
+}
@@ -0,0 +1,130 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/gorilla/mux"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbmock"
+)
+
+type fakeInsightViewStore []types.InsightViewSeries
+
+func (f fakeInsightViewStore) GetAll(ctx context.Context, args store.InsightQueryArgs) ([]types.InsightViewSeries, error) {
+	var matching []types.InsightViewSeries
+	for _, series := range f {
+		if series.UniqueID == args.UniqueID {
+			matching = append(matching, series)
+		}
+	}
+	return matching, nil
+}
+
+func TestHandler(t *testing.T) {
+	recordedAt := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	capture := "1.17"
+
+	views := fakeInsightViewStore{
+		{UniqueID: "view1", Title: "Go versions", SeriesID: "series1", Label: "go.mod"},
+		{UniqueID: "view1", Title: "Go versions", SeriesID: "series2", Label: "captured"},
+		{UniqueID: "view2", Title: "Formulas", SeriesID: "series3", Label: "=HYPERLINK(\"https://example.com\")"},
+	}
+	timeSeriesStore := store.NewMockInterface()
+	timeSeriesStore.RepoSeriesPointsFunc.SetDefaultHook(func(ctx context.Context, opts store.SeriesPointsOpts) ([]store.RepoSeriesPoint, error) {
+		switch *opts.SeriesID {
+		case "series1":
+			return []store.RepoSeriesPoint{
+				{SeriesID: "series1", Time: recordedAt, Value: 3, RepoID: 1, RepoName: "github.com/sourcegraph/sourcegraph"},
+				{SeriesID: "series1", Time: recordedAt, Value: 1.5, RepoID: 2, RepoName: "github.com/sourcegraph/zoekt"},
+			}, nil
+		case "series2":
+			return []store.RepoSeriesPoint{
+				{SeriesID: "series2", Time: recordedAt, Value: 2, RepoID: 1, RepoName: "github.com/sourcegraph/sourcegraph", Capture: &capture},
+			}, nil
+		case "series3":
+			return []store.RepoSeriesPoint{
+				{SeriesID: "series3", Time: recordedAt, Value: 1, RepoID: 3, RepoName: "@evil/repo"},
+			}, nil
+		}
+		return nil, nil
+	})
+
+	router := mux.NewRouter()
+	router.Path("/insights/export/{id}").Handler(newHandler(views, timeSeriesStore, dbmock.NewMockOrgStore()))
+
+	serve := func(t *testing.T, url string) *httptest.ResponseRecorder {
+		t.Helper()
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", url, nil))
+		return w
+	}
+
+	t.Run("csv", func(t *testing.T) {
+		w := serve(t, "/insights/export/view1")
+		if w.Code != http.StatusOK {
+			t.Fatalf("unexpected status code. want=%d have=%d", http.StatusOK, w.Code)
+		}
+
+		want := `series_id,series_label,capture,repository_id,repository_name,time,value
+series1,go.mod,,1,github.com/sourcegraph/sourcegraph,2021-12-01T00:00:00Z,3
+series1,go.mod,,2,github.com/sourcegraph/zoekt,2021-12-01T00:00:00Z,1.5
+series2,1.17,1.17,1,github.com/sourcegraph/sourcegraph,2021-12-01T00:00:00Z,2
+`
+		if diff := cmp.Diff(want, w.Body.String()); diff != "" {
+			t.Errorf("unexpected CSV (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("csv escapes formulas", func(t *testing.T) {
+		w := serve(t, "/insights/export/view2")
+		if w.Code != http.StatusOK {
+			t.Fatalf("unexpected status code. want=%d have=%d", http.StatusOK, w.Code)
+		}
+
+		want := `series_id,series_label,capture,repository_id,repository_name,time,value
+series3,"'=HYPERLINK(""https://example.com"")",,3,'@evil/repo,2021-12-01T00:00:00Z,1
+`
+		if diff := cmp.Diff(want, w.Body.String()); diff != "" {
+			t.Errorf("unexpected CSV (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("json by GraphQL ID", func(t *testing.T) {
+		// aW5zaWdodF92aWV3OiJ2aWV3MSI= is the GraphQL ID of view1.
+		w := serve(t, "/insights/export/aW5zaWdodF92aWV3OiJ2aWV3MSI=?format=json")
+		if w.Code != http.StatusOK {
+			t.Fatalf("unexpected status code. want=%d have=%d", http.StatusOK, w.Code)
+		}
+
+		var export Export
+		if err := json.Unmarshal(w.Body.Bytes(), &export); err != nil {
+			t.Fatal(err)
+		}
+		if export.Title != "Go versions" {
+			t.Errorf("unexpected title. want=%q have=%q", "Go versions", export.Title)
+		}
+		if len(export.Points) != 3 {
+			t.Errorf("unexpected number of points. want=%d have=%d", 3, len(export.Points))
+		}
+	})
+
+	t.Run("unknown insight", func(t *testing.T) {
+		if w := serve(t, "/insights/export/missing"); w.Code != http.StatusNotFound {
+			t.Errorf("unexpected status code. want=%d have=%d", http.StatusNotFound, w.Code)
+		}
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		if w := serve(t, "/insights/export/view1?format=xml"); w.Code != http.StatusBadRequest {
+			t.Errorf("unexpected status code. want=%d have=%d", http.StatusBadRequest, w.Code)
+		}
+	})
+}
@@ -76,6 +76,30 @@ func (r *disabledResolver) DeleteInsightView(ctx context.Context, args *graphqlb
 	return nil, errors.New(r.reason)
 }
 
+func (r *disabledResolver) CreateInsightSeriesAlert(ctx context.Context, args *graphqlbackend.CreateInsightSeriesAlertArgs) (graphqlbackend.InsightSeriesAlertResolver, error) {
+	return nil, errors.New(r.reason)
+}
+
+func (r *disabledResolver) DeleteInsightSeriesAlert(ctx context.Context, args *graphqlbackend.DeleteInsightSeriesAlertArgs) (*graphqlbackend.EmptyResponse, error) {
+	return nil, errors.New(r.reason)
+}
+
+func (r *disabledResolver) CreateInsightAnnotation(ctx context.Context, args *graphqlbackend.CreateInsightAnnotationArgs) (graphqlbackend.InsightAnnotationResolver, error) {
+	return nil, errors.New(r.reason)
+}
+
+func (r *disabledResolver) UpdateInsightAnnotation(ctx context.Context, args *graphqlbackend.UpdateInsightAnnotationArgs) (graphqlbackend.InsightAnnotationResolver, error) {
+	return nil, errors.New(r.reason)
+}
+
+func (r *disabledResolver) DeleteInsightAnnotation(ctx context.Context, args *graphqlbackend.DeleteInsightAnnotationArgs) (*graphqlbackend.EmptyResponse, error) {
+	return nil, errors.New(r.reason)
+}
+
+func (r *disabledResolver) UpdateInsightSeriesGoal(ctx context.Context, args *graphqlbackend.UpdateInsightSeriesGoalArgs) (graphqlbackend.InsightViewPayloadResolver, error) {
+	return nil, errors.New(r.reason)
+}
+
 func (r *disabledResolver) SearchInsightLivePreview(ctx context.Context, args graphqlbackend.SearchInsightLivePreviewArgs) ([]graphqlbackend.SearchInsightLivePreviewSeriesResolver, error) {
 	return nil, errors.New(r.reason)
 }
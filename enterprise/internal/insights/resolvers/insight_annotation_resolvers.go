@@ -0,0 +1,241 @@
+package resolvers
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+)
+
+var _ graphqlbackend.InsightAnnotationResolver = &insightAnnotationResolver{}
+var _ graphqlbackend.InsightSeriesGoalResolver = &insightSeriesGoalResolver{}
+
+const insightAnnotationKind = "InsightAnnotation"
+
+type insightAnnotationResolver struct {
+	annotation types.InsightViewAnnotation
+}
+
+func (r *insightAnnotationResolver) ID() graphql.ID {
+	return relay.MarshalID(insightAnnotationKind, r.annotation.ID)
+}
+
+func (r *insightAnnotationResolver) Time() graphqlbackend.DateTime {
+	return graphqlbackend.DateTime{Time: r.annotation.Time}
+}
+
+func (r *insightAnnotationResolver) Label() string { return r.annotation.Label }
+
+func toAnnotationResolvers(annotations []types.InsightViewAnnotation) []graphqlbackend.InsightAnnotationResolver {
+	resolvers := make([]graphqlbackend.InsightAnnotationResolver, 0, len(annotations))
+	for _, annotation := range annotations {
+		resolvers = append(resolvers, &insightAnnotationResolver{annotation: annotation})
+	}
+	return resolvers
+}
+
+func (i *insightViewResolver) Annotations(ctx context.Context) ([]graphqlbackend.InsightAnnotationResolver, error) {
+	annotations, err := i.insightStore.GetAnnotations(ctx, store.AnnotationQueryArgs{ViewID: i.view.ViewID})
+	if err != nil {
+		return nil, errors.Wrap(err, "GetAnnotations")
+	}
+	return toAnnotationResolvers(annotations), nil
+}
+
+func (r *insightSeriesResolver) Annotations(ctx context.Context) ([]graphqlbackend.InsightAnnotationResolver, error) {
+	// Series of insights defined in settings do not belong to an insight view.
+	if r.series.ViewID == 0 {
+		return []graphqlbackend.InsightAnnotationResolver{}, nil
+	}
+	annotations, err := r.metadataStore.GetAnnotations(ctx, store.AnnotationQueryArgs{ViewID: r.series.ViewID})
+	if err != nil {
+		return nil, errors.Wrap(err, "GetAnnotations")
+	}
+	return toAnnotationResolvers(annotations), nil
+}
+
+type insightSeriesGoalResolver struct {
+	value      float64
+	targetDate *time.Time
+	projection *goalProjection
+}
+
+func (r *insightSeriesGoalResolver) Value() float64 { return r.value }
+
+func (r *insightSeriesGoalResolver) TargetDate() *graphqlbackend.DateTime {
+	return graphqlbackend.DateTimeOrNil(r.targetDate)
+}
+
+func (r *insightSeriesGoalResolver) SlopePerDay() *float64 {
+	if r.projection == nil {
+		return nil
+	}
+	return &r.projection.slopePerDay
+}
+
+func (r *insightSeriesGoalResolver) ProjectedCompletionDate() *graphqlbackend.DateTime {
+	if r.projection == nil {
+		return nil
+	}
+	return graphqlbackend.DateTimeOrNil(r.projection.completion)
+}
+
+func (r *insightSeriesResolver) Goal(ctx context.Context) (graphqlbackend.InsightSeriesGoalResolver, error) {
+	if r.series.GoalValue == nil {
+		return nil, nil
+	}
+	points, err := r.insightsStore.SeriesPoints(ctx, r.seriesPointsOpts(nil, nil, nil, nil))
+	if err != nil {
+		return nil, err
+	}
+	return &insightSeriesGoalResolver{
+		value:      *r.series.GoalValue,
+		targetDate: r.series.GoalTargetAt,
+		projection: projectGoal(points, *r.series.GoalValue),
+	}, nil
+}
+
+func (r *Resolver) CreateInsightAnnotation(ctx context.Context, args *graphqlbackend.CreateInsightAnnotationArgs) (graphqlbackend.InsightAnnotationResolver, error) {
+	label := strings.TrimSpace(args.Input.Label)
+	if label == "" {
+		return nil, errors.New("annotation label must not be empty")
+	}
+
+	view, err := r.accessibleView(ctx, args.Input.InsightViewId)
+	if err != nil {
+		return nil, err
+	}
+
+	var createdBy *int32
+	if uid := actor.FromContext(ctx).UID; uid != 0 {
+		createdBy = &uid
+	}
+	annotation, err := r.insightStore.CreateAnnotation(ctx, types.InsightViewAnnotation{
+		ViewID:          view.ViewID,
+		ViewUniqueID:    view.UniqueID,
+		Time:            args.Input.Time.Time,
+		Label:           label,
+		CreatedByUserID: createdBy,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "CreateAnnotation")
+	}
+	return &insightAnnotationResolver{annotation: annotation}, nil
+}
+
+func (r *Resolver) UpdateInsightAnnotation(ctx context.Context, args *graphqlbackend.UpdateInsightAnnotationArgs) (graphqlbackend.InsightAnnotationResolver, error) {
+	var label *string
+	if args.Input.Label != nil {
+		trimmed := strings.TrimSpace(*args.Input.Label)
+		if trimmed == "" {
+			return nil, errors.New("annotation label must not be empty")
+		}
+		label = &trimmed
+	}
+
+	annotation, err := r.accessibleAnnotation(ctx, args.Input.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if args.Input.Time != nil {
+		annotation.Time = args.Input.Time.Time
+	}
+	if label != nil {
+		annotation.Label = *label
+	}
+	if err := r.insightStore.UpdateAnnotation(ctx, annotation); err != nil {
+		return nil, errors.Wrap(err, "UpdateAnnotation")
+	}
+	return &insightAnnotationResolver{annotation: annotation}, nil
+}
+
+func (r *Resolver) DeleteInsightAnnotation(ctx context.Context, args *graphqlbackend.DeleteInsightAnnotationArgs) (*graphqlbackend.EmptyResponse, error) {
+	annotation, err := r.accessibleAnnotation(ctx, args.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.insightStore.DeleteAnnotation(ctx, annotation.ID); err != nil {
+		return nil, errors.Wrap(err, "DeleteAnnotation")
+	}
+	return &graphqlbackend.EmptyResponse{}, nil
+}
+
+// accessibleAnnotation returns the annotation with the given GraphQL ID if the current user can see
+// the insight view it belongs to.
+func (r *Resolver) accessibleAnnotation(ctx context.Context, id graphql.ID) (types.InsightViewAnnotation, error) {
+	var annotationId int
+	if err := relay.UnmarshalSpec(id, &annotationId); err != nil {
+		return types.InsightViewAnnotation{}, errors.Wrap(err, "error unmarshalling the annotation id")
+	}
+
+	annotations, err := r.insightStore.GetAnnotations(ctx, store.AnnotationQueryArgs{ID: annotationId})
+	if err != nil {
+		return types.InsightViewAnnotation{}, errors.Wrap(err, "GetAnnotations")
+	}
+	if len(annotations) == 0 {
+		return types.InsightViewAnnotation{}, errors.New("annotation not found")
+	}
+	// 🚨 SECURITY: annotations can be changed by anyone who can see the insight view, mirroring the
+	// permissions to edit the view itself.
+	if err := r.permissionsValidator.validateUserAccessForView(ctx, annotations[0].ViewUniqueID); err != nil {
+		return types.InsightViewAnnotation{}, errors.New("annotation not found")
+	}
+	return annotations[0], nil
+}
+
+func (r *Resolver) UpdateInsightSeriesGoal(ctx context.Context, args *graphqlbackend.UpdateInsightSeriesGoalArgs) (graphqlbackend.InsightViewPayloadResolver, error) {
+	view, err := r.accessibleView(ctx, args.Input.InsightViewId)
+	if err != nil {
+		return nil, err
+	}
+
+	found := false
+	for _, series := range view.Series {
+		if series.SeriesID == args.Input.SeriesId {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, errors.Newf("insight view does not contain series %q", args.Input.SeriesId)
+	}
+
+	var targetDate *time.Time
+	if args.Input.TargetDate != nil {
+		targetDate = &args.Input.TargetDate.Time
+	}
+	if err := r.insightStore.SetViewSeriesGoal(ctx, args.Input.SeriesId, view.ViewID, args.Input.Value, targetDate); err != nil {
+		return nil, errors.Wrap(err, "SetViewSeriesGoal")
+	}
+	return &insightPayloadResolver{baseInsightResolver: r.baseInsightResolver, validator: r.permissionsValidator, viewId: view.UniqueID}, nil
+}
+
+// accessibleView returns the insight view with the given GraphQL ID if the current user can see it.
+func (r *Resolver) accessibleView(ctx context.Context, id graphql.ID) (*types.Insight, error) {
+	var insightViewId string
+	if err := relay.UnmarshalSpec(id, &insightViewId); err != nil {
+		return nil, errors.Wrap(err, "error unmarshalling the insight view id")
+	}
+	if err := r.permissionsValidator.validateUserAccessForView(ctx, insightViewId); err != nil {
+		return nil, err
+	}
+
+	views, err := r.insightStore.GetMapped(ctx, store.InsightQueryArgs{UniqueID: insightViewId, WithoutAuthorization: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "GetMapped")
+	}
+	if len(views) == 0 {
+		return nil, errors.New("No insight view found with this id")
+	}
+	return &views[0], nil
+}
@@ -0,0 +1,104 @@
+package resolvers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	insightsdbtesting "github.com/sourcegraph/sourcegraph/enterprise/internal/insights/dbtesting"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtesting"
+)
+
+func TestUpdateInsightAnnotationEmptyLabel(t *testing.T) {
+	// The label is validated before the annotation is looked up, so no stores are needed.
+	resolver := &Resolver{}
+
+	label := "   "
+	_, err := resolver.UpdateInsightAnnotation(context.Background(), &graphqlbackend.UpdateInsightAnnotationArgs{
+		Input: graphqlbackend.UpdateInsightAnnotationInput{
+			Id:    relay.MarshalID(insightAnnotationKind, 1),
+			Label: &label,
+		},
+	})
+	if err == nil {
+		t.Fatal("expected an error for an empty label")
+	}
+}
+
+func TestResolver_UpdateInsightAnnotation(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := actor.WithInternalActor(context.Background())
+	now := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	timescale, cleanup := insightsdbtesting.TimescaleDB(t)
+	defer cleanup()
+	postgres := dbtesting.GetDB(t)
+	resolver := newWithClock(timescale, postgres, clock)
+
+	view, err := resolver.insightStore.CreateView(ctx, types.InsightView{
+		Title:            "my view",
+		UniqueID:         "1234567",
+		PresentationType: types.Line,
+	}, []store.InsightViewGrant{store.GlobalGrant()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	annotation, err := resolver.insightStore.CreateAnnotation(ctx, types.InsightViewAnnotation{
+		ViewID:       view.ID,
+		ViewUniqueID: view.UniqueID,
+		Time:         now,
+		Label:        "migration started",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := relay.MarshalID(insightAnnotationKind, annotation.ID)
+
+	label := " migration finished "
+	updated, err := resolver.UpdateInsightAnnotation(ctx, &graphqlbackend.UpdateInsightAnnotationArgs{
+		Input: graphqlbackend.UpdateInsightAnnotationInput{Id: id, Label: &label},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have, want := updated.Label(), "migration finished"; have != want {
+		t.Errorf("unexpected label: have=%q want=%q", have, want)
+	}
+	if have, want := updated.Time().Time, now; !have.Equal(want) {
+		t.Errorf("unexpected time: have=%s want=%s", have, want)
+	}
+
+	later := now.Add(24 * time.Hour)
+	updated, err = resolver.UpdateInsightAnnotation(ctx, &graphqlbackend.UpdateInsightAnnotationArgs{
+		Input: graphqlbackend.UpdateInsightAnnotationInput{Id: id, Time: &graphqlbackend.DateTime{Time: later}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have, want := updated.Label(), "migration finished"; have != want {
+		t.Errorf("unexpected label: have=%q want=%q", have, want)
+	}
+
+	annotations, err := resolver.insightStore.GetAnnotations(ctx, store.AnnotationQueryArgs{ID: annotation.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(annotations) != 1 || annotations[0].Label != "migration finished" || !annotations[0].Time.Equal(later) {
+		t.Errorf("unexpected stored annotations: %+v", annotations)
+	}
+
+	if _, err := resolver.UpdateInsightAnnotation(ctx, &graphqlbackend.UpdateInsightAnnotationArgs{
+		Input: graphqlbackend.UpdateInsightAnnotationInput{Id: relay.MarshalID(insightAnnotationKind, annotation.ID+1), Label: &label},
+	}); err == nil {
+		t.Error("expected an error for an unknown annotation")
+	}
+}
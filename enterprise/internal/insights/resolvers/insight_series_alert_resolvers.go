@@ -0,0 +1,168 @@
+package resolvers
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+)
+
+var _ graphqlbackend.InsightSeriesAlertResolver = &insightSeriesAlertResolver{}
+
+const insightSeriesAlertKind = "InsightSeriesAlert"
+
+type insightSeriesAlertResolver struct {
+	alert types.InsightSeriesAlert
+}
+
+func (r *insightSeriesAlertResolver) ID() graphql.ID {
+	return relay.MarshalID(insightSeriesAlertKind, r.alert.ID)
+}
+
+func (r *insightSeriesAlertResolver) SeriesId() string { return r.alert.SeriesID }
+
+func (r *insightSeriesAlertResolver) Comparator() string { return string(r.alert.Comparator) }
+
+func (r *insightSeriesAlertResolver) Threshold() float64 { return r.alert.Threshold }
+
+func (r *insightSeriesAlertResolver) Email() bool { return r.alert.Email }
+
+func (r *insightSeriesAlertResolver) WebhookURL() *string { return r.alert.WebhookURL }
+
+func (r *insightSeriesAlertResolver) TriggeredAt() *graphqlbackend.DateTime {
+	return graphqlbackend.DateTimeOrNil(r.alert.TriggeredAt)
+}
+
+func (r *insightSeriesAlertResolver) LastValue() *float64 { return r.alert.LastValue }
+
+func (r *insightSeriesResolver) Alerts(ctx context.Context) ([]graphqlbackend.InsightSeriesAlertResolver, error) {
+	uid := actor.FromContext(ctx).UID
+	if uid == 0 || r.alertStore == nil {
+		return []graphqlbackend.InsightSeriesAlertResolver{}, nil
+	}
+
+	alerts, err := r.alertStore.GetAlerts(ctx, store.GetAlertsArgs{SeriesID: r.series.SeriesID, UserID: uid})
+	if err != nil {
+		return nil, errors.Wrap(err, "GetAlerts")
+	}
+	resolvers := make([]graphqlbackend.InsightSeriesAlertResolver, 0, len(alerts))
+	for _, alert := range alerts {
+		resolvers = append(resolvers, &insightSeriesAlertResolver{alert: alert})
+	}
+	return resolvers, nil
+}
+
+func (r *Resolver) CreateInsightSeriesAlert(ctx context.Context, args *graphqlbackend.CreateInsightSeriesAlertArgs) (graphqlbackend.InsightSeriesAlertResolver, error) {
+	uid := actor.FromContext(ctx).UID
+	if uid == 0 {
+		return nil, errors.New("must be signed in to create an alert")
+	}
+
+	comparator := types.AlertComparator(args.Input.Comparator)
+	if comparator != types.AlertAbove && comparator != types.AlertBelow {
+		return nil, errors.Newf("invalid comparator %q", args.Input.Comparator)
+	}
+	if args.Input.WebhookURL != nil {
+		if err := validateWebhookURL(*args.Input.WebhookURL); err != nil {
+			return nil, err
+		}
+	}
+	email := args.Input.Email
+	if !email && args.Input.WebhookURL == nil {
+		return nil, errors.New("an alert requires at least one of email or webhookURL")
+	}
+
+	// 🚨 SECURITY: users can only attach alerts to series of insights they can see.
+	if err := r.permissionsValidator.validateUserAccessForSeries(ctx, args.Input.SeriesId); err != nil {
+		return nil, err
+	}
+
+	var viewID *int
+	if args.Input.InsightViewId != nil {
+		view, err := r.accessibleView(ctx, *args.Input.InsightViewId)
+		if err != nil {
+			return nil, err
+		}
+		if !viewHasSeries(view, args.Input.SeriesId) {
+			return nil, errors.New("the insight view does not contain the series")
+		}
+		viewID = &view.ViewID
+	}
+
+	alert, err := r.alertStore.CreateAlert(ctx, types.InsightSeriesAlert{
+		SeriesID:        args.Input.SeriesId,
+		ViewID:          viewID,
+		Comparator:      comparator,
+		Threshold:       args.Input.Threshold,
+		CreatedByUserID: uid,
+		Email:           email,
+		WebhookURL:      args.Input.WebhookURL,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "CreateAlert")
+	}
+	return &insightSeriesAlertResolver{alert: alert}, nil
+}
+
+func (r *Resolver) DeleteInsightSeriesAlert(ctx context.Context, args *graphqlbackend.DeleteInsightSeriesAlertArgs) (*graphqlbackend.EmptyResponse, error) {
+	var id int
+	if err := relay.UnmarshalSpec(args.Id, &id); err != nil {
+		return nil, errors.Wrap(err, "error unmarshalling the alert id")
+	}
+
+	// 🚨 SECURITY: only the creator of an alert can delete it. We return a generic not found error to
+	// prevent leaking alert existence.
+	uid := actor.FromContext(ctx).UID
+	if uid == 0 {
+		return nil, errors.New("alert not found")
+	}
+	alerts, err := r.alertStore.GetAlerts(ctx, store.GetAlertsArgs{ID: id, UserID: uid})
+	if err != nil {
+		return nil, errors.Wrap(err, "GetAlerts")
+	}
+	if len(alerts) == 0 {
+		return nil, errors.New("alert not found")
+	}
+
+	if err := r.alertStore.DeleteAlert(ctx, id); err != nil {
+		return nil, errors.Wrap(err, "DeleteAlert")
+	}
+	return &graphqlbackend.EmptyResponse{}, nil
+}
+
+func validateWebhookURL(webhookURL string) error {
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return errors.Wrap(err, "invalid webhookURL")
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.Newf("invalid webhookURL %q: must be an absolute http or https URL", webhookURL)
+	}
+	// Hosts resolving to internal addresses are rejected when the webhook is posted, this only
+	// catches obvious mistakes early.
+	if host := u.Hostname(); host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return errors.Newf("invalid webhookURL %q: must not point to an internal address", webhookURL)
+	} else if ip := net.ParseIP(host); ip != nil && httpcli.IsPrivateNetworkIP(ip) {
+		return errors.Newf("invalid webhookURL %q: must not point to an internal address", webhookURL)
+	}
+	return nil
+}
+
+func viewHasSeries(view *types.Insight, seriesID string) bool {
+	for _, series := range view.Series {
+		if series.SeriesID == seriesID {
+			return true
+		}
+	}
+	return false
+}
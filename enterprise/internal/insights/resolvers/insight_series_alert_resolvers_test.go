@@ -0,0 +1,27 @@
+package resolvers
+
+import "testing"
+
+func TestValidateWebhookURL(t *testing.T) {
+	for webhookURL, valid := range map[string]bool{
+		"https://example.com/hook":         true,
+		"http://8.8.8.8/hook":              true,
+		"ftp://example.com/hook":           false,
+		"/hook":                            false,
+		"http://localhost:8080/hook":       false,
+		"http://app.localhost/hook":        false,
+		"http://127.0.0.1/hook":            false,
+		"http://[::1]/hook":                false,
+		"http://10.0.0.1/hook":             false,
+		"http://169.254.169.254/latest":    false,
+		"https://192.168.1.1:8443/webhook": false,
+	} {
+		err := validateWebhookURL(webhookURL)
+		if valid && err != nil {
+			t.Errorf("unexpected error for %q: %s", webhookURL, err)
+		}
+		if !valid && err == nil {
+			t.Errorf("expected error for %q", webhookURL)
+		}
+	}
+}
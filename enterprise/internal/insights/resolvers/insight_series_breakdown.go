@@ -0,0 +1,112 @@
+package resolvers
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
+)
+
+// dataPointBreakdown is a single data point of a series along with the repositories contributing to it.
+type dataPointBreakdown struct {
+	time         time.Time
+	value        float64
+	repositories []repositoryDataPoint
+}
+
+// repositoryDataPoint is the contribution of a single repository to a data point.
+type repositoryDataPoint struct {
+	repositoryName string
+	value          float64
+	delta          float64
+}
+
+// breakdownByRepository groups the given per-repository points by time, in ascending order. For each
+// point in time, at most first repositories are returned, ordered by descending value and then by
+// the magnitude of their change since the previous point in time.
+func breakdownByRepository(points []store.RepoSeriesPoint, first int) []dataPointBreakdown {
+	byTime := make(map[time.Time]map[string]float64)
+	for _, point := range points {
+		t := point.Time.UTC()
+		if _, ok := byTime[t]; !ok {
+			byTime[t] = make(map[string]float64)
+		}
+		byTime[t][point.RepoName] += point.Value
+	}
+
+	times := make([]time.Time, 0, len(byTime))
+	for t := range byTime {
+		times = append(times, t)
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+
+	breakdowns := make([]dataPointBreakdown, 0, len(times))
+	previous := map[string]float64{}
+	for _, t := range times {
+		current := byTime[t]
+
+		breakdown := dataPointBreakdown{time: t}
+		for repositoryName, value := range current {
+			breakdown.value += value
+			breakdown.repositories = append(breakdown.repositories, repositoryDataPoint{
+				repositoryName: repositoryName,
+				value:          value,
+				delta:          value - previous[repositoryName],
+			})
+		}
+		for repositoryName, value := range previous {
+			if _, ok := current[repositoryName]; !ok {
+				breakdown.repositories = append(breakdown.repositories, repositoryDataPoint{
+					repositoryName: repositoryName,
+					delta:          -value,
+				})
+			}
+		}
+
+		sort.Slice(breakdown.repositories, func(i, j int) bool {
+			a, b := breakdown.repositories[i], breakdown.repositories[j]
+			if a.value != b.value {
+				return a.value > b.value
+			}
+			if math.Abs(a.delta) != math.Abs(b.delta) {
+				return math.Abs(a.delta) > math.Abs(b.delta)
+			}
+			return a.repositoryName < b.repositoryName
+		})
+		if first >= 0 && len(breakdown.repositories) > first {
+			breakdown.repositories = breakdown.repositories[:first]
+		}
+
+		breakdowns = append(breakdowns, breakdown)
+		previous = current
+	}
+	return breakdowns
+}
+
+var _ graphqlbackend.InsightsDataPointBreakdownResolver = &insightsDataPointBreakdownResolver{}
+
+type insightsDataPointBreakdownResolver struct{ breakdown dataPointBreakdown }
+
+func (r *insightsDataPointBreakdownResolver) DateTime() graphqlbackend.DateTime {
+	return graphqlbackend.DateTime{Time: r.breakdown.time}
+}
+
+func (r *insightsDataPointBreakdownResolver) Value() float64 { return r.breakdown.value }
+
+func (r *insightsDataPointBreakdownResolver) Repositories() []graphqlbackend.InsightsRepositoryDataPointResolver {
+	resolvers := make([]graphqlbackend.InsightsRepositoryDataPointResolver, 0, len(r.breakdown.repositories))
+	for _, repository := range r.breakdown.repositories {
+		resolvers = append(resolvers, insightsRepositoryDataPointResolver{repository})
+	}
+	return resolvers
+}
+
+var _ graphqlbackend.InsightsRepositoryDataPointResolver = insightsRepositoryDataPointResolver{}
+
+type insightsRepositoryDataPointResolver struct{ p repositoryDataPoint }
+
+func (r insightsRepositoryDataPointResolver) RepositoryName() string { return r.p.repositoryName }
+func (r insightsRepositoryDataPointResolver) Value() float64         { return r.p.value }
+func (r insightsRepositoryDataPointResolver) Delta() float64         { return r.p.delta }
@@ -0,0 +1,60 @@
+package resolvers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
+)
+
+func TestBreakdownByRepository(t *testing.T) {
+	first := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	second := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	// Points are returned by the store in descending time order.
+	points := []store.RepoSeriesPoint{
+		{Time: second, RepoName: "a", Value: 2},
+		{Time: second, RepoName: "c", Value: 4},
+		{Time: first, RepoName: "a", Value: 5},
+		{Time: first, RepoName: "b", Value: 3},
+		{Time: first, RepoName: "c", Value: 1},
+	}
+
+	t.Run("all repositories", func(t *testing.T) {
+		want := []dataPointBreakdown{
+			{
+				time:  first,
+				value: 9,
+				repositories: []repositoryDataPoint{
+					{repositoryName: "a", value: 5, delta: 5},
+					{repositoryName: "b", value: 3, delta: 3},
+					{repositoryName: "c", value: 1, delta: 1},
+				},
+			},
+			{
+				time:  second,
+				value: 6,
+				repositories: []repositoryDataPoint{
+					{repositoryName: "c", value: 4, delta: 3},
+					{repositoryName: "a", value: 2, delta: -3},
+					{repositoryName: "b", value: 0, delta: -3},
+				},
+			},
+		}
+		if diff := cmp.Diff(want, breakdownByRepository(points, 10), cmp.AllowUnexported(dataPointBreakdown{}, repositoryDataPoint{})); diff != "" {
+			t.Errorf("unexpected breakdown (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("top repository", func(t *testing.T) {
+		want := []dataPointBreakdown{
+			{time: first, value: 9, repositories: []repositoryDataPoint{{repositoryName: "a", value: 5, delta: 5}}},
+			{time: second, value: 6, repositories: []repositoryDataPoint{{repositoryName: "c", value: 4, delta: 3}}},
+		}
+		if diff := cmp.Diff(want, breakdownByRepository(points, 1), cmp.AllowUnexported(dataPointBreakdown{}, repositoryDataPoint{})); diff != "" {
+			t.Errorf("unexpected breakdown (-want +got):\n%s", diff)
+		}
+	})
+}
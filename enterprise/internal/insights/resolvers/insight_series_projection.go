@@ -0,0 +1,63 @@
+package resolvers
+
+import (
+	"sort"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
+)
+
+// projectionWindow is the number of most recent data points the slope of a series is estimated from.
+const projectionWindow = 6
+
+// goalProjection is an estimate of when a series reaches its goal, extrapolated from its recent slope.
+type goalProjection struct {
+	// slopePerDay is the least squares slope of the most recent data points, in value per day.
+	slopePerDay float64
+	// completion is the projected time at which the goal is reached, or nil if the series is flat
+	// or trending away from the goal.
+	completion *time.Time
+}
+
+// projectGoal estimates when the series formed by the given points reaches the goal value. It
+// returns nil if there are not enough data points to estimate a slope.
+func projectGoal(points []store.SeriesPoint, goal float64) *goalProjection {
+	sorted := make([]store.SeriesPoint, len(points))
+	copy(sorted, points)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Time.Before(sorted[j].Time) })
+	if len(sorted) > projectionWindow {
+		sorted = sorted[len(sorted)-projectionWindow:]
+	}
+	if len(sorted) < 2 {
+		return nil
+	}
+
+	first := sorted[0].Time
+	var sumX, sumY, sumXY, sumXX float64
+	for _, point := range sorted {
+		x := point.Time.Sub(first).Hours() / 24
+		sumX += x
+		sumY += point.Value
+		sumXY += x * point.Value
+		sumXX += x * x
+	}
+	n := float64(len(sorted))
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		// All points were recorded at the same time.
+		return nil
+	}
+	projection := &goalProjection{slopePerDay: (n*sumXY - sumX*sumY) / denominator}
+
+	latest := sorted[len(sorted)-1]
+	remaining := goal - latest.Value
+	switch {
+	case remaining == 0:
+		projection.completion = &latest.Time
+	case remaining*projection.slopePerDay > 0:
+		days := remaining / projection.slopePerDay
+		completion := latest.Time.Add(time.Duration(days * float64(24*time.Hour)))
+		projection.completion = &completion
+	}
+	return projection
+}
@@ -0,0 +1,82 @@
+package resolvers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
+)
+
+func TestProjectGoal(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2021, 12, d, 0, 0, 0, 0, time.UTC) }
+	ptr := func(t time.Time) *time.Time { return &t }
+
+	// Points are returned by the store in descending time order, decreasing by 2 per day.
+	decreasing := []store.SeriesPoint{
+		{Time: day(4), Value: 14},
+		{Time: day(3), Value: 16},
+		{Time: day(2), Value: 18},
+		{Time: day(1), Value: 20},
+	}
+
+	tests := []struct {
+		name      string
+		points    []store.SeriesPoint
+		goal      float64
+		wantNil   bool
+		wantSlope float64
+		want      *time.Time
+	}{
+		{name: "trending towards goal", points: decreasing, goal: 0, wantSlope: -2, want: ptr(day(11))},
+		{name: "trending away from goal", points: decreasing, goal: 30, wantSlope: -2},
+		{name: "goal reached", points: decreasing, goal: 14, wantSlope: -2, want: ptr(day(4))},
+		{
+			name: "flat",
+			points: []store.SeriesPoint{
+				{Time: day(2), Value: 5},
+				{Time: day(1), Value: 5},
+			},
+			goal: 0,
+		},
+		{
+			name: "only recent points are considered",
+			points: []store.SeriesPoint{
+				{Time: day(8), Value: 6},
+				{Time: day(7), Value: 7},
+				{Time: day(6), Value: 8},
+				{Time: day(5), Value: 9},
+				{Time: day(4), Value: 10},
+				{Time: day(3), Value: 11},
+				{Time: day(2), Value: 100},
+				{Time: day(1), Value: 1000},
+			},
+			goal:      0,
+			wantSlope: -1,
+			want:      ptr(day(14)),
+		},
+		{name: "single point", points: decreasing[:1], goal: 0, wantNil: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			projection := projectGoal(tc.points, tc.goal)
+			if tc.wantNil {
+				if projection != nil {
+					t.Fatalf("expected no projection, got %+v", projection)
+				}
+				return
+			}
+			if projection == nil {
+				t.Fatal("expected a projection")
+			}
+			if projection.slopePerDay != tc.wantSlope {
+				t.Errorf("unexpected slope. want=%v have=%v", tc.wantSlope, projection.slopePerDay)
+			}
+			switch {
+			case tc.want == nil && projection.completion != nil:
+				t.Errorf("expected no completion, got %s", projection.completion)
+			case tc.want != nil && (projection.completion == nil || !projection.completion.Equal(*tc.want)):
+				t.Errorf("unexpected completion. want=%s have=%v", tc.want, projection.completion)
+			}
+		})
+	}
+}
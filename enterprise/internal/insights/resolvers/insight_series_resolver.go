@@ -2,6 +2,8 @@ package resolvers
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"time"
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
@@ -19,56 +21,90 @@ type insightSeriesResolver struct {
 	workerBaseStore *basestore.Store
 	series          types.InsightViewSeries
 	metadataStore   store.InsightMetadataStore
+	alertStore      *store.AlertStore
 
 	filters types.InsightViewFilters
+
+	// capture is the capture group value this resolver represents, if the series is generated
+	// from capture groups.
+	capture *string
 }
 
-func (r *insightSeriesResolver) SeriesId() string { return r.series.SeriesID }
+func (r *insightSeriesResolver) SeriesId() string {
+	if r.capture != nil {
+		return fmt.Sprintf("%s-%s", r.series.SeriesID, *r.capture)
+	}
+	return r.series.SeriesID
+}
 
-func (r *insightSeriesResolver) Label() string { return r.series.Label }
+func (r *insightSeriesResolver) Label() string {
+	if r.capture != nil {
+		return *r.capture
+	}
+	return r.series.Label
+}
 
 func (r *insightSeriesResolver) Points(ctx context.Context, args *graphqlbackend.InsightsPointsArgs) ([]graphqlbackend.InsightsDataPointResolver, error) {
+	opts := r.seriesPointsOpts(args.From, args.To, args.IncludeRepoRegex, args.ExcludeRepoRegex)
+	points, err := r.insightsStore.SeriesPoints(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]graphqlbackend.InsightsDataPointResolver, 0, len(points))
+	for _, point := range points {
+		resolvers = append(resolvers, insightsDataPointResolver{point})
+	}
+	return resolvers, nil
+}
+
+func (r *insightSeriesResolver) RepositoryBreakdown(ctx context.Context, args *graphqlbackend.InsightsRepositoryBreakdownArgs) ([]graphqlbackend.InsightsDataPointBreakdownResolver, error) {
+	opts := r.seriesPointsOpts(args.From, args.To, args.IncludeRepoRegex, args.ExcludeRepoRegex)
+	points, err := r.insightsStore.RepoSeriesPoints(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	breakdowns := breakdownByRepository(points, int(args.First))
+	resolvers := make([]graphqlbackend.InsightsDataPointBreakdownResolver, 0, len(breakdowns))
+	for _, breakdown := range breakdowns {
+		resolvers = append(resolvers, &insightsDataPointBreakdownResolver{breakdown})
+	}
+	return resolvers, nil
+}
+
+// seriesPointsOpts returns the options to query the data points of the series represented by this
+// resolver with the given arguments.
+func (r *insightSeriesResolver) seriesPointsOpts(from, to *graphqlbackend.DateTime, includeRepoRegex, excludeRepoRegex *string) store.SeriesPointsOpts {
 	var opts store.SeriesPointsOpts
 
 	// Query data points only for the series we are representing.
 	seriesID := r.series.SeriesID
 	opts.SeriesID = &seriesID
+	opts.Capture = r.capture
 
-	if args.From == nil {
+	if from == nil {
 		// Default to last 12mo of data
-		args.From = &graphqlbackend.DateTime{Time: time.Now().AddDate(-1, 0, 0)}
-	}
-	if args.From != nil {
-		opts.From = &args.From.Time
+		from = &graphqlbackend.DateTime{Time: time.Now().AddDate(-1, 0, 0)}
 	}
-	if args.To != nil {
-		opts.To = &args.To.Time
+	opts.From = &from.Time
+	if to != nil {
+		opts.To = &to.Time
 	}
 
 	// to preserve backwards compatibility, we are going to keep the arguments on this resolver for now. Ideally
 	// we would deprecate these in favor of passing arguments from a higher level resolver (insight view) to match
 	// the model of how we want default filters to work at the insight view level. That said, we will only inherit
 	// higher resolver filters if provided filter arguments are nil.
-	if args.IncludeRepoRegex != nil {
-		opts.IncludeRepoRegex = *args.IncludeRepoRegex
+	if includeRepoRegex != nil {
+		opts.IncludeRepoRegex = *includeRepoRegex
 	} else if r.filters.IncludeRepoRegex != nil {
 		opts.IncludeRepoRegex = *r.filters.IncludeRepoRegex
 	}
-	if args.ExcludeRepoRegex != nil {
-		opts.ExcludeRepoRegex = *args.ExcludeRepoRegex
+	if excludeRepoRegex != nil {
+		opts.ExcludeRepoRegex = *excludeRepoRegex
 	} else if r.filters.ExcludeRepoRegex != nil {
 		opts.ExcludeRepoRegex = *r.filters.ExcludeRepoRegex
 	}
-
-	points, err := r.insightsStore.SeriesPoints(ctx, opts)
-	if err != nil {
-		return nil, err
-	}
-	resolvers := make([]graphqlbackend.InsightsDataPointResolver, 0, len(points))
-	for _, point := range points {
-		resolvers = append(resolvers, insightsDataPointResolver{point})
-	}
-	return resolvers, nil
+	return opts
 }
 
 func (r *insightSeriesResolver) Status(ctx context.Context) (graphqlbackend.InsightStatusResolver, error) {
@@ -110,6 +146,44 @@ func (r *insightSeriesResolver) DirtyMetadata(ctx context.Context) ([]graphqlbac
 	return resolvers, nil
 }
 
+// expandCaptureGroupSeries returns one series resolver for each distinct capture group value
+// recorded for the series of the given resolver.
+func expandCaptureGroupSeries(ctx context.Context, r insightSeriesResolver) ([]graphqlbackend.InsightSeriesResolver, error) {
+	seriesID := r.series.SeriesID
+	opts := store.SeriesPointsOpts{SeriesID: &seriesID}
+	if r.filters.IncludeRepoRegex != nil {
+		opts.IncludeRepoRegex = *r.filters.IncludeRepoRegex
+	}
+	if r.filters.ExcludeRepoRegex != nil {
+		opts.ExcludeRepoRegex = *r.filters.ExcludeRepoRegex
+	}
+
+	points, err := r.insightsStore.SeriesPoints(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	captures := make(map[string]struct{})
+	for _, point := range points {
+		if point.Capture != nil {
+			captures[*point.Capture] = struct{}{}
+		}
+	}
+	values := make([]string, 0, len(captures))
+	for capture := range captures {
+		values = append(values, capture)
+	}
+	sort.Strings(values)
+
+	resolvers := make([]graphqlbackend.InsightSeriesResolver, 0, len(values))
+	for i := range values {
+		expanded := r
+		expanded.capture = &values[i]
+		resolvers = append(resolvers, &expanded)
+	}
+	return resolvers, nil
+}
+
 var _ graphqlbackend.InsightsDataPointResolver = insightsDataPointResolver{}
 
 type insightsDataPointResolver struct{ p store.SeriesPoint }
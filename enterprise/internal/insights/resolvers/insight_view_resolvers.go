@@ -87,13 +87,23 @@ func (i *insightViewResolver) DataSeries(ctx context.Context) ([]graphqlbackend.
 	}
 
 	for j := range i.view.Series {
-		resolvers = append(resolvers, &insightSeriesResolver{
+		seriesResolver := insightSeriesResolver{
 			insightsStore:   i.timeSeriesStore,
 			workerBaseStore: i.workerBaseStore,
 			series:          i.view.Series[j],
 			metadataStore:   i.insightStore,
+			alertStore:      i.alertStore,
 			filters:         *filters,
-		})
+		}
+		if i.view.Series[j].GeneratedFromCaptureGroups {
+			expanded, err := expandCaptureGroupSeries(ctx, seriesResolver)
+			if err != nil {
+				return nil, errors.Wrapf(err, "expandCaptureGroupSeries for seriesID: %s", i.view.Series[j].SeriesID)
+			}
+			resolvers = append(resolvers, expanded...)
+			continue
+		}
+		resolvers = append(resolvers, &seriesResolver)
 	}
 
 	return resolvers, nil
@@ -142,6 +152,10 @@ func (s *searchInsightDataSeriesDefinitionResolver) TimeScope(ctx context.Contex
 	return &insightTimeScopeUnionResolver{resolver: intervalResolver}, nil
 }
 
+func (s *searchInsightDataSeriesDefinitionResolver) GeneratedFromCaptureGroups() (bool, error) {
+	return s.series.GeneratedFromCaptureGroups, nil
+}
+
 type insightIntervalTimeScopeResolver struct {
 	unit  string
 	value int32
@@ -488,6 +502,13 @@ func emptyIfNil(in *string) string {
 	return *in
 }
 
+func falseIfNil(in *bool) bool {
+	if in == nil {
+		return false
+	}
+	return *in
+}
+
 // A dummy type to represent the GraphQL union InsightTimeScope
 type insightTimeScopeUnionResolver struct {
 	resolver interface{}
@@ -659,9 +680,10 @@ func createAndAttachSeries(ctx context.Context, tx *store.InsightStore, view typ
 	// Don't try to match on frontend series
 	if len(series.RepositoryScope.Repositories) == 0 {
 		matchingSeries, foundSeries, err = tx.FindMatchingSeries(ctx, store.MatchSeriesArgs{
-			Query:             series.Query,
-			StepIntervalUnit:  series.TimeScope.StepInterval.Unit,
-			StepIntervalValue: int(series.TimeScope.StepInterval.Value)})
+			Query:                      series.Query,
+			StepIntervalUnit:           series.TimeScope.StepInterval.Unit,
+			StepIntervalValue:          int(series.TimeScope.StepInterval.Value),
+			GeneratedFromCaptureGroups: falseIfNil(series.GeneratedFromCaptureGroups)})
 		if err != nil {
 			return errors.Wrap(err, "FindMatchingSeries")
 		}
@@ -669,12 +691,13 @@ func createAndAttachSeries(ctx context.Context, tx *store.InsightStore, view typ
 
 	if !foundSeries {
 		seriesToAdd, err = tx.CreateSeries(ctx, types.InsightSeries{
-			SeriesID:            ksuid.New().String(),
-			Query:               series.Query,
-			CreatedAt:           time.Now(),
-			Repositories:        series.RepositoryScope.Repositories,
-			SampleIntervalUnit:  series.TimeScope.StepInterval.Unit,
-			SampleIntervalValue: int(series.TimeScope.StepInterval.Value),
+			SeriesID:                   ksuid.New().String(),
+			Query:                      series.Query,
+			CreatedAt:                  time.Now(),
+			Repositories:               series.RepositoryScope.Repositories,
+			SampleIntervalUnit:         series.TimeScope.StepInterval.Unit,
+			SampleIntervalValue:        int(series.TimeScope.StepInterval.Value),
+			GeneratedFromCaptureGroups: falseIfNil(series.GeneratedFromCaptureGroups),
 		})
 		if err != nil {
 			return errors.Wrap(err, "CreateSeries")
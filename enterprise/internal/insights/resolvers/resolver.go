@@ -25,6 +25,7 @@ type baseInsightResolver struct {
 	insightStore    *store.InsightStore
 	timeSeriesStore *store.Store
 	dashboardStore  *store.DBDashboardStore
+	alertStore      *store.AlertStore
 	workerBaseStore *basestore.Store
 
 	// including the DB references for any one off stores that may need to be created.
@@ -42,6 +43,7 @@ func WithBase(insightsDB dbutil.DB, primaryDB dbutil.DB, clock func() time.Time)
 		insightStore:    insightStore,
 		timeSeriesStore: timeSeriesStore,
 		dashboardStore:  dashboardStore,
+		alertStore:      store.NewAlertStore(insightsDB),
 		workerBaseStore: workerBaseStore,
 		insightsDB:      insightsDB,
 		postgresDB:      primaryDB,
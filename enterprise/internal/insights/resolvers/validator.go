@@ -93,6 +93,24 @@ func (v *InsightPermissionsValidator) validateUserAccessForView(ctx context.Cont
 	return nil
 }
 
+func (v *InsightPermissionsValidator) validateUserAccessForSeries(ctx context.Context, seriesId string) error {
+	err := v.loadUserContext(ctx)
+	if err != nil {
+		return err
+	}
+	results, err := v.insightStore.GetAll(ctx, store.InsightQueryArgs{SeriesID: seriesId, UserID: v.userIds, OrgID: v.orgIds})
+	if err != nil {
+		return errors.Wrap(err, "GetAll")
+	}
+	// 🚨 SECURITY: a series is only visible to the user through an insight view they can see. We will return a generic
+	// not found error to prevent leaking series existence.
+	if len(results) == 0 {
+		return errors.New("insight series not found")
+	}
+
+	return nil
+}
+
 // WithBaseStore sets the base store for any insight related stores. Used to propagate a transaction into this validator
 // for permission checks against code insights tables.
 func (v *InsightPermissionsValidator) WithBaseStore(base basestore.ShareableStore) *InsightPermissionsValidator {
@@ -0,0 +1,153 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+// AlertStore persists threshold alert rules of insight series and their state.
+type AlertStore struct {
+	*basestore.Store
+	Now func() time.Time
+}
+
+// NewAlertStore returns a new AlertStore backed by the given Timescale db.
+func NewAlertStore(db dbutil.DB) *AlertStore {
+	return &AlertStore{Store: basestore.NewWithDB(db, sql.TxOptions{}), Now: time.Now}
+}
+
+// Handle returns the underlying transactable database handle.
+// Needed to implement the ShareableStore interface.
+func (s *AlertStore) Handle() *basestore.TransactableHandle { return s.Store.Handle() }
+
+// With creates a new AlertStore with the given basestore. Shareable store as the underlying basestore.Store.
+// Needed to implement the basestore.Store interface
+func (s *AlertStore) With(other basestore.ShareableStore) *AlertStore {
+	return &AlertStore{Store: s.Store.With(other), Now: s.Now}
+}
+
+func (s *AlertStore) Transact(ctx context.Context) (*AlertStore, error) {
+	txBase, err := s.Store.Transact(ctx)
+	return &AlertStore{Store: txBase, Now: s.Now}, err
+}
+
+type GetAlertsArgs struct {
+	ID       int
+	SeriesID string
+	UserID   int32
+}
+
+// GetAlerts returns the alerts matching the given arguments.
+func (s *AlertStore) GetAlerts(ctx context.Context, args GetAlertsArgs) ([]types.InsightSeriesAlert, error) {
+	preds := []*sqlf.Query{sqlf.Sprintf("TRUE")}
+	if args.ID > 0 {
+		preds = append(preds, sqlf.Sprintf("a.id = %s", args.ID))
+	}
+	if args.SeriesID != "" {
+		preds = append(preds, sqlf.Sprintf("i.series_id = %s", args.SeriesID))
+	}
+	if args.UserID > 0 {
+		preds = append(preds, sqlf.Sprintf("a.created_by_user_id = %s", args.UserID))
+	}
+	return scanAlerts(s.Query(ctx, sqlf.Sprintf(getAlertsSql, sqlf.Join(preds, "\n AND"))))
+}
+
+// CreateAlert attaches a new alert to the series referenced by alert.SeriesID.
+func (s *AlertStore) CreateAlert(ctx context.Context, alert types.InsightSeriesAlert) (types.InsightSeriesAlert, error) {
+	if alert.CreatedAt.IsZero() {
+		alert.CreatedAt = s.Now()
+	}
+	row := s.QueryRow(ctx, sqlf.Sprintf(insertAlertSql,
+		alert.Comparator,
+		alert.Threshold,
+		alert.CreatedByUserID,
+		alert.Email,
+		alert.WebhookURL,
+		alert.CreatedAt,
+		alert.ViewID,
+		alert.SeriesID,
+	))
+	if err := row.Scan(&alert.ID); err != nil {
+		return types.InsightSeriesAlert{}, err
+	}
+	return alert, nil
+}
+
+// DeleteAlert deletes the alert with the given ID.
+func (s *AlertStore) DeleteAlert(ctx context.Context, id int) error {
+	return s.Exec(ctx, sqlf.Sprintf(deleteAlertSql, id))
+}
+
+// UpdateAlertState records the value the alert was last evaluated with and whether it is
+// currently firing. A nil triggeredAt marks the alert as recovered.
+func (s *AlertStore) UpdateAlertState(ctx context.Context, id int, triggeredAt *time.Time, lastValue float64) error {
+	return s.Exec(ctx, sqlf.Sprintf(updateAlertStateSql, triggeredAt, lastValue, id))
+}
+
+func scanAlerts(rows *sql.Rows, queryErr error) (_ []types.InsightSeriesAlert, err error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	results := make([]types.InsightSeriesAlert, 0)
+	for rows.Next() {
+		var temp types.InsightSeriesAlert
+		if err := rows.Scan(
+			&temp.ID,
+			&temp.SeriesID,
+			&temp.ViewID,
+			&temp.Filters.IncludeRepoRegex,
+			&temp.Filters.ExcludeRepoRegex,
+			&temp.Comparator,
+			&temp.Threshold,
+			&temp.CreatedByUserID,
+			&temp.Email,
+			&temp.WebhookURL,
+			&temp.TriggeredAt,
+			&temp.LastValue,
+			&temp.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		results = append(results, temp)
+	}
+	return results, nil
+}
+
+const getAlertsSql = `
+-- source: enterprise/internal/insights/store/alert_store.go:GetAlerts
+SELECT a.id, i.series_id, a.insight_view_id, iv.default_filter_include_repo_regex, iv.default_filter_exclude_repo_regex,
+	a.comparator, a.threshold, a.created_by_user_id, a.email, a.webhook_url, a.triggered_at, a.last_value, a.created_at
+FROM insight_series_alert a
+JOIN insight_series i ON i.id = a.insight_series_id
+LEFT JOIN insight_view iv ON iv.id = a.insight_view_id
+WHERE %S
+ORDER BY a.id;
+`
+
+const insertAlertSql = `
+-- source: enterprise/internal/insights/store/alert_store.go:CreateAlert
+INSERT INTO insight_series_alert (insight_series_id, comparator, threshold, created_by_user_id, email, webhook_url, created_at, insight_view_id)
+SELECT id, %s, %s, %s, %s, %s, %s, %s
+FROM insight_series
+WHERE series_id = %s
+RETURNING id;
+`
+
+const deleteAlertSql = `
+-- source: enterprise/internal/insights/store/alert_store.go:DeleteAlert
+DELETE FROM insight_series_alert WHERE id = %s;
+`
+
+const updateAlertStateSql = `
+-- source: enterprise/internal/insights/store/alert_store.go:UpdateAlertState
+UPDATE insight_series_alert SET triggered_at = %s, last_value = %s WHERE id = %s;
+`
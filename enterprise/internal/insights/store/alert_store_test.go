@@ -0,0 +1,103 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	insightsdbtesting "github.com/sourcegraph/sourcegraph/enterprise/internal/insights/dbtesting"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+)
+
+func TestAlertStore(t *testing.T) {
+	timescale, cleanup := insightsdbtesting.TimescaleDB(t)
+	defer cleanup()
+	now := time.Now().Truncate(time.Microsecond).Round(0).UTC()
+	ctx := context.Background()
+
+	_, err := timescale.Exec(`INSERT INTO insight_series (series_id, query, created_at, oldest_historical_at, last_recorded_at,
+                            next_recording_after, last_snapshot_at, next_snapshot_after)
+                            VALUES ('series-id-1', 'query-1', $1, $1, $1, $1, $1, $1);`, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := NewAlertStore(timescale)
+	store.Now = func() time.Time { return now }
+
+	webhook := "https://example.com/hook"
+	created, err := store.CreateAlert(ctx, types.InsightSeriesAlert{
+		SeriesID:        "series-id-1",
+		Comparator:      types.AlertAbove,
+		Threshold:       10,
+		CreatedByUserID: 1,
+		Email:           true,
+		WebhookURL:      &webhook,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	alerts, err := store.GetAlerts(ctx, GetAlertsArgs{SeriesID: "series-id-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]types.InsightSeriesAlert{created}, alerts); diff != "" {
+		t.Errorf("unexpected alerts (-want +got):\n%s", diff)
+	}
+
+	include := "^github.com/sourcegraph/"
+	_, err = timescale.Exec(`INSERT INTO insight_view (id, title, description, unique_id, default_filter_include_repo_regex)
+                            VALUES (1, 'title', 'description', 'view-1', $1);`, include)
+	if err != nil {
+		t.Fatal(err)
+	}
+	viewID := 1
+	viewAlert, err := store.CreateAlert(ctx, types.InsightSeriesAlert{
+		SeriesID:        "series-id-1",
+		ViewID:          &viewID,
+		Comparator:      types.AlertBelow,
+		Threshold:       5,
+		CreatedByUserID: 1,
+		Email:           true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	alerts, err = store.GetAlerts(ctx, GetAlertsArgs{ID: viewAlert.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	viewAlert.Filters.IncludeRepoRegex = &include
+	if diff := cmp.Diff([]types.InsightSeriesAlert{viewAlert}, alerts); diff != "" {
+		t.Errorf("unexpected alerts (-want +got):\n%s", diff)
+	}
+	if err := store.DeleteAlert(ctx, viewAlert.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	value := 12.0
+	if err := store.UpdateAlertState(ctx, created.ID, &now, value); err != nil {
+		t.Fatal(err)
+	}
+	alerts, err = store.GetAlerts(ctx, GetAlertsArgs{ID: created.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 1 || alerts[0].TriggeredAt == nil || *alerts[0].LastValue != value {
+		t.Errorf("unexpected alert state: %+v", alerts)
+	}
+
+	if err := store.DeleteAlert(ctx, created.ID); err != nil {
+		t.Fatal(err)
+	}
+	alerts, err = store.GetAlerts(ctx, GetAlertsArgs{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 0 {
+		t.Errorf("expected no alerts, got %d", len(alerts))
+	}
+}
@@ -50,6 +50,7 @@ func (s *InsightStore) Transact(ctx context.Context) (*InsightStore, error) {
 type InsightQueryArgs struct {
 	UniqueIDs   []string
 	UniqueID    string
+	SeriesID    string
 	UserID      []int
 	OrgID       []int
 	DashboardID int
@@ -80,6 +81,9 @@ func (s *InsightStore) Get(ctx context.Context, args InsightQueryArgs) ([]types.
 	if args.DashboardID > 0 {
 		viewConditions = append(viewConditions, sqlf.Sprintf("id in (select insight_view_id from dashboard_insight_view where dashboard_id = %s)", args.DashboardID))
 	}
+	if len(args.SeriesID) > 0 {
+		viewConditions = append(viewConditions, sqlf.Sprintf("id in (select ivs.insight_view_id from insight_view_series ivs join insight_series s on ivs.insight_series_id = s.id where s.series_id = %s)", args.SeriesID))
+	}
 	preds = append(preds, sqlf.Sprintf("i.deleted_at IS NULL"))
 	if !args.WithoutAuthorization {
 		viewConditions = append(viewConditions, sqlf.Sprintf("id in (%s)", visibleViewsQuery(args.UserID, args.OrgID)))
@@ -112,6 +116,9 @@ func (s *InsightStore) GetAll(ctx context.Context, args InsightQueryArgs) ([]typ
 	if args.DashboardID > 0 {
 		viewConditions = append(viewConditions, sqlf.Sprintf("id in (select insight_view_id from dashboard_insight_view where dashboard_id = %s)", args.DashboardID))
 	}
+	if len(args.SeriesID) > 0 {
+		viewConditions = append(viewConditions, sqlf.Sprintf("id in (select ivs.insight_view_id from insight_view_series ivs join insight_series s on ivs.insight_series_id = s.id where s.series_id = %s)", args.SeriesID))
+	}
 	preds = append(preds, sqlf.Sprintf("i.deleted_at IS NULL"))
 
 	cursor := insightViewPageCursor{
@@ -340,6 +347,7 @@ func scanDataSeries(rows *sql.Rows, queryErr error) (_ []types.InsightSeries, er
 			&temp.Enabled,
 			&temp.SampleIntervalUnit,
 			&temp.SampleIntervalValue,
+			&temp.GeneratedFromCaptureGroups,
 		); err != nil {
 			return []types.InsightSeries{}, err
 		}
@@ -380,6 +388,9 @@ func scanInsightViewSeries(rows *sql.Rows, queryErr error) (_ []types.InsightVie
 			&temp.DefaultFilterExcludeRepoRegex,
 			&temp.OtherThreshold,
 			&temp.PresentationType,
+			&temp.GeneratedFromCaptureGroups,
+			&temp.GoalValue,
+			&temp.GoalTargetAt,
 		); err != nil {
 			return []types.InsightViewSeries{}, err
 		}
@@ -518,6 +529,84 @@ func (s *InsightStore) UpdateViewSeries(ctx context.Context, seriesId string, vi
 	return s.Exec(ctx, sqlf.Sprintf(updateInsightViewSeries, metadata.Label, metadata.Stroke, seriesId, viewId))
 }
 
+// SetViewSeriesGoal sets the goal of a series in the given view. A nil value removes the goal.
+func (s *InsightStore) SetViewSeriesGoal(ctx context.Context, seriesId string, viewId int, value *float64, targetAt *time.Time) error {
+	if value == nil {
+		targetAt = nil
+	}
+	return s.Exec(ctx, sqlf.Sprintf(setInsightViewSeriesGoalSql, value, targetAt, seriesId, viewId))
+}
+
+type AnnotationQueryArgs struct {
+	ID     int
+	ViewID int
+}
+
+// GetAnnotations returns the annotations matching the given arguments, ordered by time.
+func (s *InsightStore) GetAnnotations(ctx context.Context, args AnnotationQueryArgs) ([]types.InsightViewAnnotation, error) {
+	preds := []*sqlf.Query{sqlf.Sprintf("TRUE")}
+	if args.ID > 0 {
+		preds = append(preds, sqlf.Sprintf("a.id = %s", args.ID))
+	}
+	if args.ViewID > 0 {
+		preds = append(preds, sqlf.Sprintf("a.insight_view_id = %s", args.ViewID))
+	}
+	return scanAnnotations(s.Query(ctx, sqlf.Sprintf(getAnnotationsSql, sqlf.Join(preds, "\n AND"))))
+}
+
+// CreateAnnotation adds an annotation to the view with the ID annotation.ViewID.
+func (s *InsightStore) CreateAnnotation(ctx context.Context, annotation types.InsightViewAnnotation) (types.InsightViewAnnotation, error) {
+	if annotation.CreatedAt.IsZero() {
+		annotation.CreatedAt = s.Now()
+	}
+	row := s.QueryRow(ctx, sqlf.Sprintf(insertAnnotationSql,
+		annotation.ViewID,
+		annotation.Time,
+		annotation.Label,
+		annotation.CreatedByUserID,
+		annotation.CreatedAt,
+	))
+	if err := row.Scan(&annotation.ID); err != nil {
+		return types.InsightViewAnnotation{}, errors.Wrap(err, "failed to insert annotation")
+	}
+	return annotation, nil
+}
+
+// UpdateAnnotation updates the time and label of the annotation with the ID annotation.ID.
+func (s *InsightStore) UpdateAnnotation(ctx context.Context, annotation types.InsightViewAnnotation) error {
+	return s.Exec(ctx, sqlf.Sprintf(updateAnnotationSql, annotation.Time, annotation.Label, annotation.ID))
+}
+
+// DeleteAnnotation deletes the annotation with the given ID.
+func (s *InsightStore) DeleteAnnotation(ctx context.Context, id int) error {
+	return s.Exec(ctx, sqlf.Sprintf(deleteAnnotationSql, id))
+}
+
+func scanAnnotations(rows *sql.Rows, queryErr error) (_ []types.InsightViewAnnotation, err error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	results := make([]types.InsightViewAnnotation, 0)
+	for rows.Next() {
+		var temp types.InsightViewAnnotation
+		if err := rows.Scan(
+			&temp.ID,
+			&temp.ViewID,
+			&temp.ViewUniqueID,
+			&temp.Time,
+			&temp.Label,
+			&temp.CreatedByUserID,
+			&temp.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		results = append(results, temp)
+	}
+	return results, nil
+}
+
 func (s *InsightStore) AddViewGrants(ctx context.Context, view types.InsightView, grants []InsightViewGrant) error {
 	if view.ID == 0 {
 		return errors.New("unable to grant view permissions invalid insight view id")
@@ -590,6 +679,7 @@ func (s *InsightStore) CreateSeries(ctx context.Context, series types.InsightSer
 		pq.Array(series.Repositories),
 		series.SampleIntervalUnit,
 		series.SampleIntervalValue,
+		series.GeneratedFromCaptureGroups,
 	))
 	var id int
 	err := row.Scan(&id)
@@ -613,6 +703,7 @@ type InsightMetadataStore interface {
 	GetMapped(ctx context.Context, args InsightQueryArgs) ([]types.Insight, error)
 	GetDirtyQueries(ctx context.Context, series *types.InsightSeries) ([]*types.DirtyQuery, error)
 	GetDirtyQueriesAggregated(ctx context.Context, seriesID string) ([]*types.DirtyQueryAggregate, error)
+	GetAnnotations(ctx context.Context, args AnnotationQueryArgs) ([]types.InsightViewAnnotation, error)
 }
 
 // StampRecording will update the recording metadata for this series and return the InsightSeries struct with updated values.
@@ -660,15 +751,16 @@ func (s *InsightStore) SetSeriesEnabled(ctx context.Context, seriesId string, en
 }
 
 type MatchSeriesArgs struct {
-	Query             string
-	StepIntervalUnit  string
-	StepIntervalValue int
+	Query                      string
+	StepIntervalUnit           string
+	StepIntervalValue          int
+	GeneratedFromCaptureGroups bool
 }
 
 func (s *InsightStore) FindMatchingSeries(ctx context.Context, args MatchSeriesArgs) (_ types.InsightSeries, found bool, _ error) {
 	where := sqlf.Sprintf(
-		"(repositories = '{}' OR repositories is NULL) AND query = %s AND sample_interval_unit = %s AND sample_interval_value = %s",
-		args.Query, args.StepIntervalUnit, args.StepIntervalValue,
+		"(repositories = '{}' OR repositories is NULL) AND query = %s AND sample_interval_unit = %s AND sample_interval_value = %s AND generated_from_capture_groups = %s",
+		args.Query, args.StepIntervalUnit, args.StepIntervalValue, args.GeneratedFromCaptureGroups,
 	)
 
 	q := sqlf.Sprintf(getInsightDataSeriesSql, where)
@@ -745,6 +837,40 @@ FROM insight_series s
 WHERE s.series_id = %s AND vs.insight_series_id = s.id AND vs.insight_view_id = %s
 `
 
+const setInsightViewSeriesGoalSql = `
+-- source: enterprise/internal/insights/store/insight_store.go:SetViewSeriesGoal
+UPDATE insight_view_series vs
+SET goal_value = %s, goal_target_at = %s
+FROM insight_series s
+WHERE s.series_id = %s AND vs.insight_series_id = s.id AND vs.insight_view_id = %s
+`
+
+const getAnnotationsSql = `
+-- source: enterprise/internal/insights/store/insight_store.go:GetAnnotations
+SELECT a.id, a.insight_view_id, iv.unique_id, a.annotation_time, a.label, a.created_by_user_id, a.created_at
+FROM insight_view_annotation a
+JOIN insight_view iv ON iv.id = a.insight_view_id
+WHERE %s
+ORDER BY a.annotation_time, a.id
+`
+
+const insertAnnotationSql = `
+-- source: enterprise/internal/insights/store/insight_store.go:CreateAnnotation
+INSERT INTO insight_view_annotation (insight_view_id, annotation_time, label, created_by_user_id, created_at)
+VALUES (%s, %s, %s, %s, %s)
+RETURNING id;
+`
+
+const updateAnnotationSql = `
+-- source: enterprise/internal/insights/store/insight_store.go:UpdateAnnotation
+UPDATE insight_view_annotation SET annotation_time = %s, label = %s WHERE id = %s;
+`
+
+const deleteAnnotationSql = `
+-- source: enterprise/internal/insights/store/insight_store.go:DeleteAnnotation
+DELETE FROM insight_view_annotation WHERE id = %s;
+`
+
 const createInsightViewSql = `
 -- source: enterprise/internal/insights/store/insight_store.go:CreateView
 INSERT INTO insight_view (title, description, unique_id, default_filter_include_repo_regex, default_filter_exclude_repo_regex,
@@ -763,8 +889,8 @@ const createInsightSeriesSql = `
 -- source: enterprise/internal/insights/store/insight_store.go:CreateSeries
 INSERT INTO insight_series (series_id, query, created_at, oldest_historical_at, last_recorded_at,
                             next_recording_after, last_snapshot_at, next_snapshot_after, repositories,
-							sample_interval_unit, sample_interval_value)
-VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
+							sample_interval_unit, sample_interval_value, generated_from_capture_groups)
+VALUES (%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)
 RETURNING id;`
 
 const getInsightByViewSql = `
@@ -773,7 +899,7 @@ SELECT iv.id, iv.unique_id, iv.title, iv.description, ivs.label, ivs.stroke,
 i.series_id, i.query, i.created_at, i.oldest_historical_at, i.last_recorded_at,
 i.next_recording_after, i.backfill_queued_at, i.last_snapshot_at, i.next_snapshot_after, i.repositories,
 i.sample_interval_unit, i.sample_interval_value, iv.default_filter_include_repo_regex, iv.default_filter_exclude_repo_regex,
-iv.other_threshold, iv.presentation_type
+iv.other_threshold, iv.presentation_type, i.generated_from_capture_groups, ivs.goal_value, ivs.goal_target_at
 FROM (%s) iv
          JOIN insight_view_series ivs ON iv.id = ivs.insight_view_id
          JOIN insight_series i ON ivs.insight_series_id = i.id
@@ -785,7 +911,7 @@ const getInsightDataSeriesSql = `
 -- source: enterprise/internal/insights/store/insight_store.go:GetDataSeries
 select id, series_id, query, created_at, oldest_historical_at, last_recorded_at, next_recording_after,
 last_snapshot_at, next_snapshot_after, (CASE WHEN deleted_at IS NULL THEN TRUE ELSE FALSE END) AS enabled,
-sample_interval_unit, sample_interval_value from insight_series
+sample_interval_unit, sample_interval_value, generated_from_capture_groups from insight_series
 WHERE %s
 `
 
@@ -795,7 +921,7 @@ SELECT iv.id, iv.unique_id, iv.title, iv.description, ivs.label, ivs.stroke,
        i.series_id, i.query, i.created_at, i.oldest_historical_at, i.last_recorded_at,
        i.next_recording_after, i.backfill_queued_at, i.last_snapshot_at, i.next_snapshot_after, i.repositories,
        i.sample_interval_unit, i.sample_interval_value, iv.default_filter_include_repo_regex, iv.default_filter_exclude_repo_regex,
-	   iv.other_threshold, iv.presentation_type
+	   iv.other_threshold, iv.presentation_type, i.generated_from_capture_groups, ivs.goal_value, ivs.goal_target_at
 FROM (%s) iv
 JOIN insight_view_series ivs ON iv.id = ivs.insight_view_id
 JOIN insight_series i ON ivs.insight_series_id = i.id
@@ -570,6 +570,142 @@ func TestUpdateViewSeries(t *testing.T) {
 	})
 }
 
+func TestSetViewSeriesGoal(t *testing.T) {
+	timescale, cleanup := insightsdbtesting.TimescaleDB(t)
+	defer cleanup()
+	now := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	store := NewInsightStore(timescale)
+	store.Now = func() time.Time {
+		return now
+	}
+
+	view, err := store.CreateView(ctx, types.InsightView{
+		Title:            "my view",
+		UniqueID:         "1234567",
+		PresentationType: types.Line,
+	}, []InsightViewGrant{GlobalGrant()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	series, err := store.CreateSeries(ctx, types.InsightSeries{
+		SeriesID:           "unique-1",
+		Query:              "query-1",
+		OldestHistoricalAt: now,
+		LastRecordedAt:     now,
+		NextRecordingAfter: now,
+		LastSnapshotAt:     now,
+		NextSnapshotAfter:  now,
+		Enabled:            true,
+		SampleIntervalUnit: string(types.Month),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.AttachSeriesToView(ctx, series, view, types.InsightViewSeriesMetadata{Label: "label", Stroke: "blue"}); err != nil {
+		t.Fatal(err)
+	}
+
+	goal := 0.0
+	target := time.Date(2022, 9, 30, 0, 0, 0, 0, time.UTC)
+	if err := store.SetViewSeriesGoal(ctx, series.SeriesID, view.ID, &goal, &target); err != nil {
+		t.Fatal(err)
+	}
+	got, err := store.Get(ctx, InsightQueryArgs{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got[0].GoalValue == nil || *got[0].GoalValue != goal || got[0].GoalTargetAt == nil || !got[0].GoalTargetAt.Equal(target) {
+		t.Errorf("unexpected goal: value=%v target=%v", got[0].GoalValue, got[0].GoalTargetAt)
+	}
+
+	if err := store.SetViewSeriesGoal(ctx, series.SeriesID, view.ID, nil, &target); err != nil {
+		t.Fatal(err)
+	}
+	got, err = store.Get(ctx, InsightQueryArgs{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got[0].GoalValue != nil || got[0].GoalTargetAt != nil {
+		t.Errorf("expected goal to be removed: value=%v target=%v", got[0].GoalValue, got[0].GoalTargetAt)
+	}
+}
+
+func TestAnnotations(t *testing.T) {
+	timescale, cleanup := insightsdbtesting.TimescaleDB(t)
+	defer cleanup()
+	now := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	store := NewInsightStore(timescale)
+	store.Now = func() time.Time {
+		return now
+	}
+
+	view, err := store.CreateView(ctx, types.InsightView{
+		Title:            "my view",
+		UniqueID:         "1234567",
+		PresentationType: types.Line,
+	}, []InsightViewGrant{GlobalGrant()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userID := int32(1)
+	later, err := store.CreateAnnotation(ctx, types.InsightViewAnnotation{
+		ViewID:          view.ID,
+		ViewUniqueID:    view.UniqueID,
+		Time:            now,
+		Label:           "migration finished",
+		CreatedByUserID: &userID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	earlier, err := store.CreateAnnotation(ctx, types.InsightViewAnnotation{
+		ViewID:       view.ID,
+		ViewUniqueID: view.UniqueID,
+		Time:         now.Add(-30 * 24 * time.Hour),
+		Label:        "migration started",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := store.GetAnnotations(ctx, AnnotationQueryArgs{ViewID: view.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]types.InsightViewAnnotation{earlier, later}, got); diff != "" {
+		t.Errorf("unexpected annotations (-want +got):\n%s", diff)
+	}
+
+	earlier.Time = now.Add(-60 * 24 * time.Hour)
+	earlier.Label = "migration planned"
+	if err := store.UpdateAnnotation(ctx, earlier); err != nil {
+		t.Fatal(err)
+	}
+	got, err = store.GetAnnotations(ctx, AnnotationQueryArgs{ID: earlier.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]types.InsightViewAnnotation{earlier}, got); diff != "" {
+		t.Errorf("unexpected annotations after update (-want +got):\n%s", diff)
+	}
+
+	if err := store.DeleteAnnotation(ctx, earlier.ID); err != nil {
+		t.Fatal(err)
+	}
+	got, err = store.GetAnnotations(ctx, AnnotationQueryArgs{ViewID: view.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].ID != later.ID {
+		t.Errorf("unexpected annotations after delete: %+v", got)
+	}
+}
+
 func TestDeleteView(t *testing.T) {
 	timescale, cleanup := insightsdbtesting.TimescaleDB(t)
 	defer cleanup()
@@ -14,6 +14,9 @@ import (
 // github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store)
 // used for unit testing.
 type MockInsightMetadataStore struct {
+	// GetAnnotationsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetAnnotations.
+	GetAnnotationsFunc *InsightMetadataStoreGetAnnotationsFunc
 	// GetDirtyQueriesFunc is an instance of a mock function object
 	// controlling the behavior of the method GetDirtyQueries.
 	GetDirtyQueriesFunc *InsightMetadataStoreGetDirtyQueriesFunc
@@ -31,6 +34,11 @@ type MockInsightMetadataStore struct {
 // results, unless overwritten.
 func NewMockInsightMetadataStore() *MockInsightMetadataStore {
 	return &MockInsightMetadataStore{
+		GetAnnotationsFunc: &InsightMetadataStoreGetAnnotationsFunc{
+			defaultHook: func(context.Context, AnnotationQueryArgs) ([]types.InsightViewAnnotation, error) {
+				return nil, nil
+			},
+		},
 		GetDirtyQueriesFunc: &InsightMetadataStoreGetDirtyQueriesFunc{
 			defaultHook: func(context.Context, *types.InsightSeries) ([]*types.DirtyQuery, error) {
 				return nil, nil
@@ -54,6 +62,11 @@ func NewMockInsightMetadataStore() *MockInsightMetadataStore {
 // overwritten.
 func NewStrictMockInsightMetadataStore() *MockInsightMetadataStore {
 	return &MockInsightMetadataStore{
+		GetAnnotationsFunc: &InsightMetadataStoreGetAnnotationsFunc{
+			defaultHook: func(context.Context, AnnotationQueryArgs) ([]types.InsightViewAnnotation, error) {
+				panic("unexpected invocation of MockInsightMetadataStore.GetAnnotations")
+			},
+		},
 		GetDirtyQueriesFunc: &InsightMetadataStoreGetDirtyQueriesFunc{
 			defaultHook: func(context.Context, *types.InsightSeries) ([]*types.DirtyQuery, error) {
 				panic("unexpected invocation of MockInsightMetadataStore.GetDirtyQueries")
@@ -77,6 +90,9 @@ func NewStrictMockInsightMetadataStore() *MockInsightMetadataStore {
 // implementation, unless overwritten.
 func NewMockInsightMetadataStoreFrom(i InsightMetadataStore) *MockInsightMetadataStore {
 	return &MockInsightMetadataStore{
+		GetAnnotationsFunc: &InsightMetadataStoreGetAnnotationsFunc{
+			defaultHook: i.GetAnnotations,
+		},
 		GetDirtyQueriesFunc: &InsightMetadataStoreGetDirtyQueriesFunc{
 			defaultHook: i.GetDirtyQueries,
 		},
@@ -89,6 +105,118 @@ func NewMockInsightMetadataStoreFrom(i InsightMetadataStore) *MockInsightMetadat
 	}
 }
 
+// InsightMetadataStoreGetAnnotationsFunc describes the behavior when the
+// GetAnnotations method of the parent MockInsightMetadataStore instance is
+// invoked.
+type InsightMetadataStoreGetAnnotationsFunc struct {
+	defaultHook func(context.Context, AnnotationQueryArgs) ([]types.InsightViewAnnotation, error)
+	hooks       []func(context.Context, AnnotationQueryArgs) ([]types.InsightViewAnnotation, error)
+	history     []InsightMetadataStoreGetAnnotationsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetAnnotations delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockInsightMetadataStore) GetAnnotations(v0 context.Context, v1 AnnotationQueryArgs) ([]types.InsightViewAnnotation, error) {
+	r0, r1 := m.GetAnnotationsFunc.nextHook()(v0, v1)
+	m.GetAnnotationsFunc.appendCall(InsightMetadataStoreGetAnnotationsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetAnnotations
+// method of the parent MockInsightMetadataStore instance is invoked and the
+// hook queue is empty.
+func (f *InsightMetadataStoreGetAnnotationsFunc) SetDefaultHook(hook func(context.Context, AnnotationQueryArgs) ([]types.InsightViewAnnotation, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetAnnotations method of the parent MockInsightMetadataStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *InsightMetadataStoreGetAnnotationsFunc) PushHook(hook func(context.Context, AnnotationQueryArgs) ([]types.InsightViewAnnotation, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *InsightMetadataStoreGetAnnotationsFunc) SetDefaultReturn(r0 []types.InsightViewAnnotation, r1 error) {
+	f.SetDefaultHook(func(context.Context, AnnotationQueryArgs) ([]types.InsightViewAnnotation, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *InsightMetadataStoreGetAnnotationsFunc) PushReturn(r0 []types.InsightViewAnnotation, r1 error) {
+	f.PushHook(func(context.Context, AnnotationQueryArgs) ([]types.InsightViewAnnotation, error) {
+		return r0, r1
+	})
+}
+
+func (f *InsightMetadataStoreGetAnnotationsFunc) nextHook() func(context.Context, AnnotationQueryArgs) ([]types.InsightViewAnnotation, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *InsightMetadataStoreGetAnnotationsFunc) appendCall(r0 InsightMetadataStoreGetAnnotationsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of InsightMetadataStoreGetAnnotationsFuncCall
+// objects describing the invocations of this function.
+func (f *InsightMetadataStoreGetAnnotationsFunc) History() []InsightMetadataStoreGetAnnotationsFuncCall {
+	f.mutex.Lock()
+	history := make([]InsightMetadataStoreGetAnnotationsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// InsightMetadataStoreGetAnnotationsFuncCall is an object that describes an
+// invocation of method GetAnnotations on an instance of
+// MockInsightMetadataStore.
+type InsightMetadataStoreGetAnnotationsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 AnnotationQueryArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []types.InsightViewAnnotation
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c InsightMetadataStoreGetAnnotationsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c InsightMetadataStoreGetAnnotationsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // InsightMetadataStoreGetDirtyQueriesFunc describes the behavior when the
 // GetDirtyQueries method of the parent MockInsightMetadataStore instance is
 // invoked.
@@ -21,6 +21,9 @@ type MockInterface struct {
 	// RecordSeriesPointsFunc is an instance of a mock function object
 	// controlling the behavior of the method RecordSeriesPoints.
 	RecordSeriesPointsFunc *InterfaceRecordSeriesPointsFunc
+	// RepoSeriesPointsFunc is an instance of a mock function object
+	// controlling the behavior of the method RepoSeriesPoints.
+	RepoSeriesPointsFunc *InterfaceRepoSeriesPointsFunc
 	// SeriesPointsFunc is an instance of a mock function object controlling
 	// the behavior of the method SeriesPoints.
 	SeriesPointsFunc *InterfaceSeriesPointsFunc
@@ -45,6 +48,11 @@ func NewMockInterface() *MockInterface {
 				return nil
 			},
 		},
+		RepoSeriesPointsFunc: &InterfaceRepoSeriesPointsFunc{
+			defaultHook: func(context.Context, SeriesPointsOpts) ([]RepoSeriesPoint, error) {
+				return nil, nil
+			},
+		},
 		SeriesPointsFunc: &InterfaceSeriesPointsFunc{
 			defaultHook: func(context.Context, SeriesPointsOpts) ([]SeriesPoint, error) {
 				return nil, nil
@@ -72,6 +80,11 @@ func NewStrictMockInterface() *MockInterface {
 				panic("unexpected invocation of MockInterface.RecordSeriesPoints")
 			},
 		},
+		RepoSeriesPointsFunc: &InterfaceRepoSeriesPointsFunc{
+			defaultHook: func(context.Context, SeriesPointsOpts) ([]RepoSeriesPoint, error) {
+				panic("unexpected invocation of MockInterface.RepoSeriesPoints")
+			},
+		},
 		SeriesPointsFunc: &InterfaceSeriesPointsFunc{
 			defaultHook: func(context.Context, SeriesPointsOpts) ([]SeriesPoint, error) {
 				panic("unexpected invocation of MockInterface.SeriesPoints")
@@ -93,6 +106,9 @@ func NewMockInterfaceFrom(i Interface) *MockInterface {
 		RecordSeriesPointsFunc: &InterfaceRecordSeriesPointsFunc{
 			defaultHook: i.RecordSeriesPoints,
 		},
+		RepoSeriesPointsFunc: &InterfaceRepoSeriesPointsFunc{
+			defaultHook: i.RepoSeriesPoints,
+		},
 		SeriesPointsFunc: &InterfaceSeriesPointsFunc{
 			defaultHook: i.SeriesPoints,
 		},
@@ -420,6 +436,115 @@ func (c InterfaceRecordSeriesPointsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// InterfaceRepoSeriesPointsFunc describes the behavior when the
+// RepoSeriesPoints method of the parent MockInterface instance is invoked.
+type InterfaceRepoSeriesPointsFunc struct {
+	defaultHook func(context.Context, SeriesPointsOpts) ([]RepoSeriesPoint, error)
+	hooks       []func(context.Context, SeriesPointsOpts) ([]RepoSeriesPoint, error)
+	history     []InterfaceRepoSeriesPointsFuncCall
+	mutex       sync.Mutex
+}
+
+// RepoSeriesPoints delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockInterface) RepoSeriesPoints(v0 context.Context, v1 SeriesPointsOpts) ([]RepoSeriesPoint, error) {
+	r0, r1 := m.RepoSeriesPointsFunc.nextHook()(v0, v1)
+	m.RepoSeriesPointsFunc.appendCall(InterfaceRepoSeriesPointsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RepoSeriesPoints
+// method of the parent MockInterface instance is invoked and the hook queue
+// is empty.
+func (f *InterfaceRepoSeriesPointsFunc) SetDefaultHook(hook func(context.Context, SeriesPointsOpts) ([]RepoSeriesPoint, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RepoSeriesPoints method of the parent MockInterface instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *InterfaceRepoSeriesPointsFunc) PushHook(hook func(context.Context, SeriesPointsOpts) ([]RepoSeriesPoint, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultDefaultHook with a function that returns
+// the given values.
+func (f *InterfaceRepoSeriesPointsFunc) SetDefaultReturn(r0 []RepoSeriesPoint, r1 error) {
+	f.SetDefaultHook(func(context.Context, SeriesPointsOpts) ([]RepoSeriesPoint, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushDefaultHook with a function that returns the given
+// values.
+func (f *InterfaceRepoSeriesPointsFunc) PushReturn(r0 []RepoSeriesPoint, r1 error) {
+	f.PushHook(func(context.Context, SeriesPointsOpts) ([]RepoSeriesPoint, error) {
+		return r0, r1
+	})
+}
+
+func (f *InterfaceRepoSeriesPointsFunc) nextHook() func(context.Context, SeriesPointsOpts) ([]RepoSeriesPoint, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *InterfaceRepoSeriesPointsFunc) appendCall(r0 InterfaceRepoSeriesPointsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of InterfaceRepoSeriesPointsFuncCall objects
+// describing the invocations of this function.
+func (f *InterfaceRepoSeriesPointsFunc) History() []InterfaceRepoSeriesPointsFuncCall {
+	f.mutex.Lock()
+	history := make([]InterfaceRepoSeriesPointsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// InterfaceRepoSeriesPointsFuncCall is an object that describes an
+// invocation of method RepoSeriesPoints on an instance of MockInterface.
+type InterfaceRepoSeriesPointsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 SeriesPointsOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []RepoSeriesPoint
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c InterfaceRepoSeriesPointsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c InterfaceRepoSeriesPointsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // InterfaceSeriesPointsFunc describes the behavior when the SeriesPoints
 // method of the parent MockInterface instance is invoked.
 type InterfaceSeriesPointsFunc struct {
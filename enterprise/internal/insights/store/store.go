@@ -23,6 +23,7 @@ import (
 // for actual API usage.
 type Interface interface {
 	SeriesPoints(ctx context.Context, opts SeriesPointsOpts) ([]SeriesPoint, error)
+	RepoSeriesPoints(ctx context.Context, opts SeriesPointsOpts) ([]RepoSeriesPoint, error)
 	RecordSeriesPoint(ctx context.Context, v RecordSeriesPointArgs) error
 	RecordSeriesPoints(ctx context.Context, pts []RecordSeriesPointArgs) error
 	CountData(ctx context.Context, opts CountDataOpts) (int, error)
@@ -87,9 +88,16 @@ type SeriesPoint struct {
 	Time     time.Time
 	Value    float64
 	Metadata []byte
+
+	// Capture is the capture group value this point was recorded for. It is only set for series
+	// generated from capture groups, where each distinct value forms its own series.
+	Capture *string
 }
 
 func (s *SeriesPoint) String() string {
+	if s.Capture != nil {
+		return fmt.Sprintf("SeriesPoint{Time: %q, Capture: %q, Value: %v, Metadata: %s}", s.Time, *s.Capture, s.Value, s.Metadata)
+	}
 	return fmt.Sprintf("SeriesPoint{Time: %q, Value: %v, Metadata: %s}", s.Time, s.Value, s.Metadata)
 }
 
@@ -101,6 +109,10 @@ type SeriesPointsOpts struct {
 	// RepoID, if non-nil, indicates to filter results to only points recorded with this repo ID.
 	RepoID *api.RepoID
 
+	// Capture, if non-nil, indicates to filter results to only points recorded for this capture
+	// group value.
+	Capture *string
+
 	Excluded []api.RepoID
 	Included []api.RepoID
 
@@ -146,6 +158,7 @@ func (s *Store) SeriesPoints(ctx context.Context, opts SeriesPointsOpts) ([]Seri
 			&point.Time,
 			&point.Value,
 			&point.Metadata,
+			&point.Capture,
 		)
 		if err != nil {
 			return err
@@ -162,18 +175,18 @@ func (s *Store) SeriesPoints(ctx context.Context, opts SeriesPointsOpts) ([]Seri
 // and then SUM the result for each repository, giving us our final total number.
 const fullVectorSeriesAggregation = `
 -- source: enterprise/internal/insights/store/store.go:SeriesPoints
-SELECT sub.series_id, sub.interval_time, SUM(sub.value) as value, sub.metadata FROM (
-	SELECT sp.repo_name_id, sp.series_id, sp.time AS interval_time, MAX(value) as value, null as metadata
+SELECT sub.series_id, sub.interval_time, SUM(sub.value) as value, sub.metadata, sub.capture FROM (
+	SELECT sp.repo_name_id, sp.series_id, sp.time AS interval_time, MAX(value) as value, null as metadata, sp.capture
 	FROM (  select * from series_points
 			union
 			select * from series_points_snapshots
 	) AS sp
 	JOIN repo_names rn ON sp.repo_name_id = rn.id
 	WHERE %s
-	GROUP BY sp.series_id, interval_time, sp.repo_name_id
+	GROUP BY sp.series_id, interval_time, sp.repo_name_id, sp.capture
 	ORDER BY sp.series_id, interval_time, sp.repo_name_id DESC
 ) sub
-GROUP BY sub.series_id, sub.interval_time, sub.metadata
+GROUP BY sub.series_id, sub.interval_time, sub.metadata, sub.capture
 ORDER BY sub.series_id, sub.interval_time DESC
 `
 
@@ -188,6 +201,20 @@ ORDER BY sub.series_id, sub.interval_time DESC
 // 3. Searches may not complete at the same exact time, so even in a perfect world if the interval
 //    should be 12h it may be off by a minute or so.
 func seriesPointsQuery(opts SeriesPointsOpts) *sqlf.Query {
+	return sqlf.Sprintf(
+		fullVectorSeriesAggregation+limitClause(opts),
+		sqlf.Join(seriesPointsPredicates(opts), "\n AND "),
+	)
+}
+
+func limitClause(opts SeriesPointsOpts) string {
+	if opts.Limit > 0 {
+		return fmt.Sprintf("LIMIT %d", opts.Limit)
+	}
+	return ""
+}
+
+func seriesPointsPredicates(opts SeriesPointsOpts) []*sqlf.Query {
 	preds := []*sqlf.Query{}
 
 	if opts.SeriesID != nil {
@@ -196,16 +223,15 @@ func seriesPointsQuery(opts SeriesPointsOpts) *sqlf.Query {
 	if opts.RepoID != nil {
 		preds = append(preds, sqlf.Sprintf("repo_id = %d", int32(*opts.RepoID)))
 	}
+	if opts.Capture != nil {
+		preds = append(preds, sqlf.Sprintf("capture = %s", *opts.Capture))
+	}
 	if opts.From != nil {
 		preds = append(preds, sqlf.Sprintf("time >= %s", *opts.From))
 	}
 	if opts.To != nil {
 		preds = append(preds, sqlf.Sprintf("time <= %s", *opts.To))
 	}
-	limitClause := ""
-	if opts.Limit > 0 {
-		limitClause = fmt.Sprintf("LIMIT %d", opts.Limit)
-	}
 	if len(opts.Included) > 0 {
 		s := fmt.Sprintf("repo_id = any(%v)", values(opts.Included))
 		preds = append(preds, sqlf.Sprintf(s))
@@ -224,13 +250,71 @@ func seriesPointsQuery(opts SeriesPointsOpts) *sqlf.Query {
 	if len(preds) == 0 {
 		preds = append(preds, sqlf.Sprintf("TRUE"))
 	}
-	return sqlf.Sprintf(
-		fullVectorSeriesAggregation+limitClause,
-		sqlf.Join(preds, "\n AND "),
+	return preds
+}
+
+// RepoSeriesPoint describes a single insights' series data point recorded for a single repository.
+type RepoSeriesPoint struct {
+	SeriesID string
+	Time     time.Time
+	Value    float64
+	RepoID   api.RepoID
+	RepoName string
+	Capture  *string
+}
+
+// RepoSeriesPoints queries data points over time for a specific insights' series, keeping the points
+// recorded for each repository separate instead of aggregating them into a single value.
+func (s *Store) RepoSeriesPoints(ctx context.Context, opts SeriesPointsOpts) ([]RepoSeriesPoint, error) {
+	points := make([]RepoSeriesPoint, 0, opts.Limit)
+
+	// 🚨 SECURITY: Exposing individual repositories requires the same repo permission enforcement as
+	// SeriesPoints. See the comment there for details on this approach. 🚨
+	denylist, err := s.permStore.GetUnauthorizedRepoIDs(ctx)
+	if err != nil {
+		return []RepoSeriesPoint{}, err
+	}
+	opts.Excluded = append(opts.Excluded, denylist...)
+
+	q := sqlf.Sprintf(
+		repoSeriesPointsFmtstr+limitClause(opts),
+		sqlf.Join(seriesPointsPredicates(opts), "\n AND "),
 	)
+	err = s.query(ctx, q, func(sc scanner) error {
+		var point RepoSeriesPoint
+		err := sc.Scan(
+			&point.SeriesID,
+			&point.Time,
+			&point.Value,
+			&point.RepoID,
+			&point.RepoName,
+			&point.Capture,
+		)
+		if err != nil {
+			return err
+		}
+		points = append(points, point)
+		return nil
+	})
+	return points, err
 }
 
-//values constructs a SQL values statement out of an array of repository ids
+// As with fullVectorSeriesAggregation, we select the per-repository maximum to eliminate duplicate
+// points that might have been recorded in a given interval for a given repository.
+const repoSeriesPointsFmtstr = `
+-- source: enterprise/internal/insights/store/store.go:RepoSeriesPoints
+SELECT sp.series_id, sp.time, MAX(sp.value) AS value, sp.repo_id, rn.name, sp.capture
+FROM (  select * from series_points
+		union
+		select * from series_points_snapshots
+) AS sp
+JOIN repo_names rn ON sp.repo_name_id = rn.id
+WHERE %s
+GROUP BY sp.series_id, sp.time, sp.repo_id, rn.name, sp.capture
+ORDER BY sp.series_id, sp.time DESC, rn.name
+`
+
+// values constructs a SQL values statement out of an array of repository ids
 func values(ids []api.RepoID) string {
 	if len(ids) == 0 {
 		return ""
@@ -330,7 +414,8 @@ type RecordSeriesPointArgs struct {
 	// but is not a DB table primary key ID.
 	SeriesID string
 
-	// Point is the actual data point recorded and at what time.
+	// Point is the actual data point recorded and at what time. For series generated from
+	// capture groups, Point.Capture holds the capture group value the point belongs to.
 	Point SeriesPoint
 
 	// Repository name and DB ID to associate with this data point, if any.
@@ -415,6 +500,7 @@ func (s *Store) RecordSeriesPoint(ctx context.Context, v RecordSeriesPointArgs)
 		v.RepoID,           // repo_id
 		repoNameID,         // repo_name_id
 		repoNameID,         // original_repo_name_id
+		v.Point.Capture,    // capture
 	)
 	// Insert the actual data point.
 	return txStore.Exec(ctx, q)
@@ -472,8 +558,9 @@ INSERT INTO %s (
 	metadata_id,
 	repo_id,
 	repo_name_id,
-	original_repo_name_id)
-VALUES (%s, %s, %s, %s, %s, %s, %s);
+	original_repo_name_id,
+	capture)
+VALUES (%s, %s, %s, %s, %s, %s, %s, %s);
 `
 
 func (s *Store) query(ctx context.Context, q *sqlf.Query, sc scanFunc) error {
@@ -28,6 +28,9 @@ type InsightViewSeries struct {
 	DefaultFilterExcludeRepoRegex *string
 	OtherThreshold                *float64
 	PresentationType              PresentationType
+	GeneratedFromCaptureGroups    bool
+	GoalValue                     *float64
+	GoalTargetAt                  *time.Time
 }
 
 type Insight struct {
@@ -63,23 +66,35 @@ type InsightView struct {
 	PresentationType PresentationType
 }
 
+// InsightViewAnnotation marks an event on the timeline of an insight view.
+type InsightViewAnnotation struct {
+	ID              int
+	ViewID          int
+	ViewUniqueID    string
+	Time            time.Time
+	Label           string
+	CreatedByUserID *int32
+	CreatedAt       time.Time
+}
+
 // InsightSeries is a single data series for a Code Insight. This contains some metadata about the data series, as well
 // as its unique series ID.
 type InsightSeries struct {
-	ID                  int
-	SeriesID            string
-	Query               string
-	CreatedAt           time.Time
-	OldestHistoricalAt  time.Time
-	LastRecordedAt      time.Time
-	NextRecordingAfter  time.Time
-	LastSnapshotAt      time.Time
-	NextSnapshotAfter   time.Time
-	BackfillQueuedAt    time.Time
-	Enabled             bool
-	Repositories        []string
-	SampleIntervalUnit  string
-	SampleIntervalValue int
+	ID                         int
+	SeriesID                   string
+	Query                      string
+	CreatedAt                  time.Time
+	OldestHistoricalAt         time.Time
+	LastRecordedAt             time.Time
+	NextRecordingAfter         time.Time
+	LastSnapshotAt             time.Time
+	NextSnapshotAfter          time.Time
+	BackfillQueuedAt           time.Time
+	Enabled                    bool
+	Repositories               []string
+	SampleIntervalUnit         string
+	SampleIntervalValue        int
+	GeneratedFromCaptureGroups bool
 }
 
 type IntervalUnit string
@@ -133,3 +148,28 @@ const (
 	Line PresentationType = "LINE"
 	Pie  PresentationType = "PIE"
 )
+
+// AlertComparator is the direction in which an insight series value has to cross the threshold of
+// an alert for it to fire.
+type AlertComparator string
+
+const (
+	AlertAbove AlertComparator = "ABOVE"
+	AlertBelow AlertComparator = "BELOW"
+)
+
+// InsightSeriesAlert is a threshold alert rule attached to an insight series.
+type InsightSeriesAlert struct {
+	ID              int
+	SeriesID        string
+	ViewID          *int               // the view the alert was created from, if any
+	Filters         InsightViewFilters // the default filters of the view, applied to the series values
+	Comparator      AlertComparator
+	Threshold       float64
+	CreatedByUserID int32
+	Email           bool
+	WebhookURL      *string
+	TriggeredAt     *time.Time // set while the alert is firing, until the series value recovers
+	LastValue       *float64
+	CreatedAt       time.Time
+}
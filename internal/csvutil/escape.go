@@ -0,0 +1,14 @@
+// Package csvutil contains helpers for writing CSV files.
+package csvutil
+
+import "strings"
+
+// Escape prefixes values that spreadsheet applications would otherwise
+// interpret as a formula with a single quote, so that user-controlled values
+// in exported CSV files can't be used for formula injection.
+func Escape(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
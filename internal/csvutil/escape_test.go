@@ -0,0 +1,21 @@
+package csvutil
+
+import "testing"
+
+func TestEscape(t *testing.T) {
+	for in, want := range map[string]string{
+		"":                         "",
+		"github.com/sourcegraph/a": "github.com/sourcegraph/a",
+		"Fix a=b":                  "Fix a=b",
+		"=HYPERLINK(\"x\")":        "'=HYPERLINK(\"x\")",
+		"+1":                       "'+1",
+		"-1":                       "'-1",
+		"@SUM(A1)":                 "'@SUM(A1)",
+		"\tcmd":                    "'\tcmd",
+		"\rcmd":                    "'\rcmd",
+	} {
+		if have := Escape(in); have != want {
+			t.Errorf("Escape(%q): want=%q have=%q", in, want, have)
+		}
+	}
+}
@@ -0,0 +1,371 @@
+package awscodecommit
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/codecommit"
+	codecommittypes "github.com/aws/aws-sdk-go-v2/service/codecommit/types"
+	"github.com/cockroachdb/errors"
+)
+
+// PullRequestStatus is the status of an AWS CodeCommit pull request.
+type PullRequestStatus string
+
+const (
+	PullRequestStatusOpen   PullRequestStatus = "OPEN"
+	PullRequestStatusClosed PullRequestStatus = "CLOSED"
+)
+
+// PullRequest is an AWS CodeCommit pull request.
+type PullRequest struct {
+	ID               string            // the system-generated ID of the pull request
+	RevisionID       string            // the ID of the current revision, which changes with every push to the source branch
+	Title            string            // the title of the pull request
+	Description      string            // the description of the pull request
+	Status           PullRequestStatus // the status of the pull request
+	AuthorARN        string            // the ARN of the user who created the pull request
+	CreationDate     time.Time         // the date the pull request was created
+	LastActivityDate time.Time         // the date of the last activity on the pull request
+	URL              string            // the URL of the pull request in the AWS console
+
+	// Target is the repository and branches of the pull request. CodeCommit
+	// supports pull requests with multiple targets, but those are never
+	// created by us, so we only keep the first one.
+	Target PullRequestTarget
+
+	ApprovalRules []ApprovalRule // the approval rules applied to the pull request
+	Approvals     []Approval     // the approval states of the current revision
+	Evaluation    *Evaluation    // the evaluation of the approval rules for the current revision
+}
+
+// AuthorName returns the name of the IAM identity that created the pull
+// request, which is the last segment of its ARN, such as "alice" for
+// "arn:aws:iam::123456789012:user/alice".
+func (pr *PullRequest) AuthorName() string {
+	if i := strings.LastIndex(pr.AuthorARN, "/"); i >= 0 {
+		return pr.AuthorARN[i+1:]
+	}
+	return pr.AuthorARN
+}
+
+// PullRequestTarget is the repository and branches of a pull request.
+type PullRequestTarget struct {
+	RepositoryName       string
+	SourceReference      string // the fully qualified source branch, such as refs/heads/my-branch
+	DestinationReference string // the fully qualified destination branch
+	SourceCommit         string // the tip of the source branch
+	DestinationCommit    string // the tip of the destination branch
+	MergeBase            string
+	IsMerged             bool
+	MergeCommitID        string
+	MergedBy             string // the ARN of the user who merged the pull request
+}
+
+// ApprovalRule is an approval rule applied to a pull request.
+type ApprovalRule struct {
+	ID      string
+	Name    string
+	Content string // the JSON rule definition
+}
+
+// ApprovalState is the state of an approval of a pull request.
+type ApprovalState string
+
+const (
+	ApprovalStateApprove ApprovalState = "APPROVE"
+	ApprovalStateRevoke  ApprovalState = "REVOKE"
+)
+
+// Approval is the approval state of a pull request revision for a user.
+type Approval struct {
+	UserARN string
+	State   ApprovalState
+}
+
+// Evaluation is the evaluation of the approval rules of a pull request.
+type Evaluation struct {
+	// Approved is true if all approval rules are satisfied.
+	Approved bool
+	// Overridden is true if the approval rules were overridden and no longer
+	// apply to the pull request.
+	Overridden        bool
+	RulesSatisfied    []string
+	RulesNotSatisfied []string
+}
+
+// CreatePullRequestInput is the input to create a pull request.
+type CreatePullRequestInput struct {
+	RepositoryName       string
+	Title                string
+	Description          string
+	SourceReference      string // the fully qualified branch to be merged
+	DestinationReference string // the fully qualified branch the pull request is merged into
+}
+
+// ErrNotMergeable is returned by MergePullRequest when the pull request
+// cannot be merged, for example because of merge conflicts or unsatisfied
+// approval rules.
+var ErrNotMergeable = errors.New("pull request cannot be merged")
+
+// CreatePullRequest opens a pull request.
+func (c *Client) CreatePullRequest(ctx context.Context, input CreatePullRequestInput) (*PullRequest, error) {
+	svc := codecommit.NewFromConfig(c.aws)
+	result, err := svc.CreatePullRequest(ctx, &codecommit.CreatePullRequestInput{
+		Title:       &input.Title,
+		Description: &input.Description,
+		Targets: []codecommittypes.Target{{
+			RepositoryName:       &input.RepositoryName,
+			SourceReference:      &input.SourceReference,
+			DestinationReference: &input.DestinationReference,
+		}},
+	})
+	if err != nil {
+		return nil, &wrappedError{err: err}
+	}
+	return c.fromPullRequest(result.PullRequest), nil
+}
+
+// GetPullRequest gets a pull request by ID.
+func (c *Client) GetPullRequest(ctx context.Context, id string) (*PullRequest, error) {
+	svc := codecommit.NewFromConfig(c.aws)
+	result, err := svc.GetPullRequest(ctx, &codecommit.GetPullRequestInput{PullRequestId: &id})
+	if err != nil {
+		return nil, &wrappedError{err: err}
+	}
+	return c.fromPullRequest(result.PullRequest), nil
+}
+
+// FindOpenPullRequest returns the open pull request in the given repository
+// with the given source and destination references. If there is none, nil is
+// returned.
+//
+// Unlike other code hosts, CodeCommit allows multiple open pull requests for
+// the same branches, so callers have to check for existing pull requests
+// themselves.
+func (c *Client) FindOpenPullRequest(ctx context.Context, repositoryName, sourceReference, destinationReference string) (*PullRequest, error) {
+	svc := codecommit.NewFromConfig(c.aws)
+	input := codecommit.ListPullRequestsInput{
+		RepositoryName:    &repositoryName,
+		PullRequestStatus: codecommittypes.PullRequestStatusEnumOpen,
+	}
+	for {
+		result, err := svc.ListPullRequests(ctx, &input)
+		if err != nil {
+			return nil, &wrappedError{err: err}
+		}
+
+		for _, id := range result.PullRequestIds {
+			pr, err := c.GetPullRequest(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if pr.Target.SourceReference == sourceReference && pr.Target.DestinationReference == destinationReference {
+				return pr, nil
+			}
+		}
+
+		if result.NextToken == nil {
+			return nil, nil
+		}
+		input.NextToken = result.NextToken
+	}
+}
+
+// LoadPullRequestApprovals loads the approval states and the evaluation of the
+// approval rules of the current revision of the given pull request.
+func (c *Client) LoadPullRequestApprovals(ctx context.Context, pr *PullRequest) error {
+	svc := codecommit.NewFromConfig(c.aws)
+
+	states, err := svc.GetPullRequestApprovalStates(ctx, &codecommit.GetPullRequestApprovalStatesInput{
+		PullRequestId: &pr.ID,
+		RevisionId:    &pr.RevisionID,
+	})
+	if err != nil {
+		return &wrappedError{err: err}
+	}
+	pr.Approvals = make([]Approval, 0, len(states.Approvals))
+	for _, a := range states.Approvals {
+		pr.Approvals = append(pr.Approvals, Approval{
+			UserARN: stringValue(a.UserArn),
+			State:   ApprovalState(a.ApprovalState),
+		})
+	}
+
+	evaluation, err := svc.EvaluatePullRequestApprovalRules(ctx, &codecommit.EvaluatePullRequestApprovalRulesInput{
+		PullRequestId: &pr.ID,
+		RevisionId:    &pr.RevisionID,
+	})
+	if err != nil {
+		return &wrappedError{err: err}
+	}
+	if e := evaluation.Evaluation; e != nil {
+		pr.Evaluation = &Evaluation{
+			Approved:          e.Approved,
+			Overridden:        e.Overridden,
+			RulesSatisfied:    e.ApprovalRulesSatisfied,
+			RulesNotSatisfied: e.ApprovalRulesNotSatisfied,
+		}
+	}
+
+	return nil
+}
+
+// UpdatePullRequest updates the title and description of a pull request.
+func (c *Client) UpdatePullRequest(ctx context.Context, id, title, description string) (*PullRequest, error) {
+	svc := codecommit.NewFromConfig(c.aws)
+
+	if _, err := svc.UpdatePullRequestTitle(ctx, &codecommit.UpdatePullRequestTitleInput{
+		PullRequestId: &id,
+		Title:         &title,
+	}); err != nil {
+		return nil, &wrappedError{err: err}
+	}
+
+	result, err := svc.UpdatePullRequestDescription(ctx, &codecommit.UpdatePullRequestDescriptionInput{
+		PullRequestId: &id,
+		Description:   &description,
+	})
+	if err != nil {
+		return nil, &wrappedError{err: err}
+	}
+	return c.fromPullRequest(result.PullRequest), nil
+}
+
+// UpdatePullRequestStatus opens or closes a pull request.
+func (c *Client) UpdatePullRequestStatus(ctx context.Context, id string, status PullRequestStatus) (*PullRequest, error) {
+	svc := codecommit.NewFromConfig(c.aws)
+	result, err := svc.UpdatePullRequestStatus(ctx, &codecommit.UpdatePullRequestStatusInput{
+		PullRequestId:     &id,
+		PullRequestStatus: codecommittypes.PullRequestStatusEnum(status),
+	})
+	if err != nil {
+		return nil, &wrappedError{err: err}
+	}
+	return c.fromPullRequest(result.PullRequest), nil
+}
+
+// CreatePullRequestComment posts a general comment on the given pull request.
+func (c *Client) CreatePullRequestComment(ctx context.Context, pr *PullRequest, content string) error {
+	svc := codecommit.NewFromConfig(c.aws)
+	_, err := svc.PostCommentForPullRequest(ctx, &codecommit.PostCommentForPullRequestInput{
+		PullRequestId:  &pr.ID,
+		RepositoryName: &pr.Target.RepositoryName,
+		BeforeCommitId: &pr.Target.DestinationCommit,
+		AfterCommitId:  &pr.Target.SourceCommit,
+		Content:        &content,
+	})
+	if err != nil {
+		return &wrappedError{err: err}
+	}
+	return nil
+}
+
+// MergePullRequest merges the given pull request. If squash is true, the
+// changes are squashed into a single commit, otherwise a merge commit is
+// created.
+//
+// ErrNotMergeable is returned if the pull request cannot be merged.
+func (c *Client) MergePullRequest(ctx context.Context, pr *PullRequest, squash bool) (*PullRequest, error) {
+	svc := codecommit.NewFromConfig(c.aws)
+
+	var (
+		merged *codecommittypes.PullRequest
+		err    error
+	)
+	if squash {
+		var result *codecommit.MergePullRequestBySquashOutput
+		result, err = svc.MergePullRequestBySquash(ctx, &codecommit.MergePullRequestBySquashInput{
+			PullRequestId:  &pr.ID,
+			RepositoryName: &pr.Target.RepositoryName,
+			SourceCommitId: &pr.Target.SourceCommit,
+		})
+		if err == nil {
+			merged = result.PullRequest
+		}
+	} else {
+		var result *codecommit.MergePullRequestByThreeWayOutput
+		result, err = svc.MergePullRequestByThreeWay(ctx, &codecommit.MergePullRequestByThreeWayInput{
+			PullRequestId:  &pr.ID,
+			RepositoryName: &pr.Target.RepositoryName,
+			SourceCommitId: &pr.Target.SourceCommit,
+		})
+		if err == nil {
+			merged = result.PullRequest
+		}
+	}
+	if err != nil {
+		if isNotMergeable(err) {
+			return nil, errors.Wrap(ErrNotMergeable, err.Error())
+		}
+		return nil, &wrappedError{err: err}
+	}
+	return c.fromPullRequest(merged), nil
+}
+
+func isNotMergeable(err error) bool {
+	return errors.HasType(err, &codecommittypes.ManualMergeRequiredException{}) ||
+		errors.HasType(err, &codecommittypes.PullRequestApprovalRulesNotSatisfiedException{}) ||
+		errors.HasType(err, &codecommittypes.TipOfSourceReferenceIsDifferentException{}) ||
+		errors.HasType(err, &codecommittypes.PullRequestAlreadyClosedException{})
+}
+
+func (c *Client) fromPullRequest(p *codecommittypes.PullRequest) *PullRequest {
+	pr := PullRequest{
+		ID:          stringValue(p.PullRequestId),
+		RevisionID:  stringValue(p.RevisionId),
+		Title:       stringValue(p.Title),
+		Description: stringValue(p.Description),
+		Status:      PullRequestStatus(p.PullRequestStatus),
+		AuthorARN:   stringValue(p.AuthorArn),
+	}
+	if p.CreationDate != nil {
+		pr.CreationDate = *p.CreationDate
+	}
+	if p.LastActivityDate != nil {
+		pr.LastActivityDate = *p.LastActivityDate
+	}
+
+	if len(p.PullRequestTargets) > 0 {
+		t := p.PullRequestTargets[0]
+		pr.Target = PullRequestTarget{
+			RepositoryName:       stringValue(t.RepositoryName),
+			SourceReference:      stringValue(t.SourceReference),
+			DestinationReference: stringValue(t.DestinationReference),
+			SourceCommit:         stringValue(t.SourceCommit),
+			DestinationCommit:    stringValue(t.DestinationCommit),
+			MergeBase:            stringValue(t.MergeBase),
+		}
+		if m := t.MergeMetadata; m != nil {
+			pr.Target.IsMerged = m.IsMerged
+			pr.Target.MergeCommitID = stringValue(m.MergeCommitId)
+			pr.Target.MergedBy = stringValue(m.MergedBy)
+		}
+	}
+
+	for _, r := range p.ApprovalRules {
+		pr.ApprovalRules = append(pr.ApprovalRules, ApprovalRule{
+			ID:      stringValue(r.ApprovalRuleId),
+			Name:    stringValue(r.ApprovalRuleName),
+			Content: stringValue(r.ApprovalRuleContent),
+		})
+	}
+
+	region := url.PathEscape(c.aws.Region)
+	pr.URL = fmt.Sprintf(
+		"https://%s.console.aws.amazon.com/codesuite/codecommit/repositories/%s/pull-requests/%s/details?region=%s",
+		region, url.PathEscape(pr.Target.RepositoryName), url.PathEscape(pr.ID), region,
+	)
+
+	return &pr
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
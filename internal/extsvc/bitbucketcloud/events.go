@@ -0,0 +1,150 @@
+package bitbucketcloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const eventKeyHeader = "X-Event-Key"
+
+// WebhookEventKey returns the key of the webhook event sent in the given
+// request, such as "pullrequest:approved".
+func WebhookEventKey(r *http.Request) string {
+	return r.Header.Get(eventKeyHeader)
+}
+
+// ParseWebhookEvent parses the payload of a webhook event with the given
+// key.
+func ParseWebhookEvent(eventKey string, payload []byte) (e interface{}, err error) {
+	switch eventKey {
+	case "pullrequest:approved":
+		e = &PullRequestApprovedEvent{}
+	case "pullrequest:unapproved":
+		e = &PullRequestUnapprovedEvent{}
+	case "pullrequest:changes_request_created":
+		e = &PullRequestChangesRequestCreatedEvent{}
+	case "pullrequest:changes_request_removed":
+		e = &PullRequestChangesRequestRemovedEvent{}
+	case "pullrequest:comment_created":
+		e = &PullRequestCommentCreatedEvent{}
+	case "pullrequest:fulfilled":
+		e = &PullRequestFulfilledEvent{}
+	case "pullrequest:rejected":
+		e = &PullRequestRejectedEvent{}
+	case "pullrequest:updated":
+		e = &PullRequestUpdatedEvent{}
+	case "repo:commit_status_created", "repo:commit_status_updated":
+		e = &RepoCommitStatusEvent{}
+	default:
+		return nil, errors.Errorf("unknown webhook event key: %q", eventKey)
+	}
+	return e, json.Unmarshal(payload, e)
+}
+
+// RepoEvent holds the fields common to all webhook events.
+type RepoEvent struct {
+	Actor      Account `json:"actor"`
+	Repository Repo    `json:"repository"`
+}
+
+// PullRequestEvent holds the fields common to all pull request webhook
+// events.
+type PullRequestEvent struct {
+	RepoEvent
+	PullRequest PullRequest `json:"pullrequest"`
+}
+
+// Approval is the approval of a pull request by a user.
+type Approval struct {
+	Date time.Time `json:"date"`
+	User Account   `json:"user"`
+}
+
+type PullRequestApprovedEvent struct {
+	PullRequestEvent
+	Approval Approval `json:"approval"`
+}
+
+func (e *PullRequestApprovedEvent) Key() string {
+	return fmt.Sprintf("%d:approved:%s:%d", e.PullRequest.ID, e.Approval.User.UUID, e.Approval.Date.UnixNano())
+}
+
+type PullRequestUnapprovedEvent struct {
+	PullRequestEvent
+	Approval Approval `json:"approval"`
+}
+
+func (e *PullRequestUnapprovedEvent) Key() string {
+	return fmt.Sprintf("%d:unapproved:%s:%d", e.PullRequest.ID, e.Approval.User.UUID, e.Approval.Date.UnixNano())
+}
+
+// ChangesRequest is a request for changes to a pull request by a user.
+type ChangesRequest struct {
+	Date time.Time `json:"date"`
+	User Account   `json:"user"`
+}
+
+type PullRequestChangesRequestCreatedEvent struct {
+	PullRequestEvent
+	ChangesRequest ChangesRequest `json:"changes_request"`
+}
+
+func (e *PullRequestChangesRequestCreatedEvent) Key() string {
+	return fmt.Sprintf("%d:changes_request_created:%s:%d", e.PullRequest.ID, e.ChangesRequest.User.UUID, e.ChangesRequest.Date.UnixNano())
+}
+
+type PullRequestChangesRequestRemovedEvent struct {
+	PullRequestEvent
+	ChangesRequest ChangesRequest `json:"changes_request"`
+}
+
+func (e *PullRequestChangesRequestRemovedEvent) Key() string {
+	return fmt.Sprintf("%d:changes_request_removed:%s:%d", e.PullRequest.ID, e.ChangesRequest.User.UUID, e.ChangesRequest.Date.UnixNano())
+}
+
+type PullRequestCommentCreatedEvent struct {
+	PullRequestEvent
+	Comment Comment `json:"comment"`
+}
+
+func (e *PullRequestCommentCreatedEvent) Key() string {
+	return fmt.Sprintf("%d:comment:%d", e.PullRequest.ID, e.Comment.ID)
+}
+
+// PullRequestFulfilledEvent is sent when a pull request is merged.
+type PullRequestFulfilledEvent struct {
+	PullRequestEvent
+}
+
+func (e *PullRequestFulfilledEvent) Key() string {
+	return fmt.Sprintf("%d:fulfilled:%d", e.PullRequest.ID, e.PullRequest.UpdatedOn.UnixNano())
+}
+
+// PullRequestRejectedEvent is sent when a pull request is declined.
+type PullRequestRejectedEvent struct {
+	PullRequestEvent
+}
+
+func (e *PullRequestRejectedEvent) Key() string {
+	return fmt.Sprintf("%d:rejected:%d", e.PullRequest.ID, e.PullRequest.UpdatedOn.UnixNano())
+}
+
+type PullRequestUpdatedEvent struct {
+	PullRequestEvent
+}
+
+func (e *PullRequestUpdatedEvent) Key() string {
+	return fmt.Sprintf("%d:updated:%d", e.PullRequest.ID, e.PullRequest.UpdatedOn.UnixNano())
+}
+
+// RepoCommitStatusEvent is sent when a commit status is created or updated.
+// Unlike pull request events, it doesn't reference the pull requests the
+// commit belongs to.
+type RepoCommitStatusEvent struct {
+	RepoEvent
+	CommitStatus CommitStatus `json:"commit_status"`
+}
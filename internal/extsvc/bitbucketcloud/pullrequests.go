@@ -0,0 +1,397 @@
+package bitbucketcloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// PullRequestState is the state of a Bitbucket Cloud pull request.
+type PullRequestState string
+
+const (
+	PullRequestStateOpen       PullRequestState = "OPEN"
+	PullRequestStateMerged     PullRequestState = "MERGED"
+	PullRequestStateDeclined   PullRequestState = "DECLINED"
+	PullRequestStateSuperseded PullRequestState = "SUPERSEDED"
+)
+
+// PullRequest is a Bitbucket Cloud pull request, as returned by the
+// /2.0/repositories/{workspace}/{repo_slug}/pullrequests endpoints.
+type PullRequest struct {
+	ID                int64               `json:"id"`
+	Title             string              `json:"title"`
+	Summary           RenderedMarkup      `json:"summary"`
+	State             PullRequestState    `json:"state"`
+	Author            Account             `json:"author"`
+	Source            PullRequestEndpoint `json:"source"`
+	Destination       PullRequestEndpoint `json:"destination"`
+	MergeCommit       *Commit             `json:"merge_commit,omitempty"`
+	CommentCount      int64               `json:"comment_count"`
+	TaskCount         int64               `json:"task_count"`
+	CloseSourceBranch bool                `json:"close_source_branch"`
+	ClosedBy          *Account            `json:"closed_by,omitempty"`
+	Reason            string              `json:"reason"`
+	CreatedOn         time.Time           `json:"created_on"`
+	UpdatedOn         time.Time           `json:"updated_on"`
+	Reviewers         []Account           `json:"reviewers"`
+	Participants      []Participant       `json:"participants"`
+	Links             PullRequestLinks    `json:"links"`
+
+	// Statuses are the commit statuses of the source commit of the pull
+	// request. They are not part of the pull request API response and are
+	// populated by LoadPullRequestStatuses.
+	Statuses []*CommitStatus `json:"statuses,omitempty"`
+}
+
+type PullRequestEndpoint struct {
+	Branch     PullRequestBranch `json:"branch"`
+	Commit     *Commit           `json:"commit,omitempty"`
+	Repository Repo              `json:"repository"`
+}
+
+type PullRequestBranch struct {
+	Name string `json:"name"`
+}
+
+type PullRequestLinks struct {
+	Self Link `json:"self"`
+	HTML Link `json:"html"`
+}
+
+type Commit struct {
+	// Hash is abbreviated to 12 characters in pull request payloads, but
+	// complete in commit status payloads.
+	Hash string `json:"hash"`
+}
+
+type RenderedMarkup struct {
+	Raw    string `json:"raw"`
+	Markup string `json:"markup"`
+	HTML   string `json:"html"`
+}
+
+type Account struct {
+	UUID        string `json:"uuid"`
+	AccountID   string `json:"account_id"`
+	DisplayName string `json:"display_name"`
+	Nickname    string `json:"nickname"`
+	Type        string `json:"type"`
+}
+
+// ParticipantRole is the role of a participant in a pull request.
+type ParticipantRole string
+
+const (
+	ParticipantRoleParticipant ParticipantRole = "PARTICIPANT"
+	ParticipantRoleReviewer    ParticipantRole = "REVIEWER"
+)
+
+// ParticipantState is the review state of a participant in a pull request.
+// It is empty if the participant didn't review the pull request.
+type ParticipantState string
+
+const (
+	ParticipantStateApproved         ParticipantState = "approved"
+	ParticipantStateChangesRequested ParticipantState = "changes_requested"
+)
+
+type Participant struct {
+	User           Account          `json:"user"`
+	Role           ParticipantRole  `json:"role"`
+	Approved       bool             `json:"approved"`
+	State          ParticipantState `json:"state"`
+	ParticipatedOn *time.Time       `json:"participated_on"`
+}
+
+// CommitStatusState is the state of a commit status, such as a build.
+type CommitStatusState string
+
+const (
+	CommitStatusStateSuccessful CommitStatusState = "SUCCESSFUL"
+	CommitStatusStateFailed     CommitStatusState = "FAILED"
+	CommitStatusStateInProgress CommitStatusState = "INPROGRESS"
+	CommitStatusStateStopped    CommitStatusState = "STOPPED"
+)
+
+// CommitStatus is a status, such as the result of a build, reported for a
+// commit.
+type CommitStatus struct {
+	StatusKey   string            `json:"key"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	URL         string            `json:"url"`
+	State       CommitStatusState `json:"state"`
+	Commit      Commit            `json:"commit"`
+	CreatedOn   time.Time         `json:"created_on"`
+	UpdatedOn   time.Time         `json:"updated_on"`
+}
+
+func (s *CommitStatus) Key() string {
+	return fmt.Sprintf("%s:%s", s.Commit.Hash, s.StatusKey)
+}
+
+type Comment struct {
+	ID        int64          `json:"id"`
+	Content   RenderedMarkup `json:"content"`
+	User      Account        `json:"user"`
+	Deleted   bool           `json:"deleted"`
+	CreatedOn time.Time      `json:"created_on"`
+	UpdatedOn time.Time      `json:"updated_on"`
+}
+
+// PullRequestInput is the input to create or update a pull request.
+type PullRequestInput struct {
+	Title       string
+	Description string
+	// SourceBranch is the name of the branch to be merged, without a refs/heads/
+	// prefix.
+	SourceBranch string
+	// DestinationBranch is the name of the branch the pull request is merged
+	// into, without a refs/heads/ prefix. If empty, the main branch of the
+	// repository is used.
+	DestinationBranch string
+	// CloseSourceBranch deletes the source branch once the pull request is
+	// merged.
+	CloseSourceBranch bool
+}
+
+func (input PullRequestInput) MarshalJSON() ([]byte, error) {
+	type branch struct {
+		Name string `json:"name"`
+	}
+	type endpoint struct {
+		Branch branch `json:"branch"`
+	}
+	type pullRequest struct {
+		Title             string    `json:"title"`
+		Description       string    `json:"description"`
+		Source            endpoint  `json:"source"`
+		Destination       *endpoint `json:"destination,omitempty"`
+		CloseSourceBranch bool      `json:"close_source_branch"`
+	}
+
+	pr := pullRequest{
+		Title:             input.Title,
+		Description:       input.Description,
+		Source:            endpoint{Branch: branch{Name: input.SourceBranch}},
+		CloseSourceBranch: input.CloseSourceBranch,
+	}
+	if input.DestinationBranch != "" {
+		pr.Destination = &endpoint{Branch: branch{Name: input.DestinationBranch}}
+	}
+	return json.Marshal(pr)
+}
+
+// MergeStrategy is the strategy used to merge a pull request.
+type MergeStrategy string
+
+const (
+	MergeStrategyMergeCommit MergeStrategy = "merge_commit"
+	MergeStrategySquash      MergeStrategy = "squash"
+	MergeStrategyFastForward MergeStrategy = "fast_forward"
+)
+
+// MergePullRequestOpts are the options to merge a pull request.
+type MergePullRequestOpts struct {
+	Message           *string        `json:"message,omitempty"`
+	CloseSourceBranch *bool          `json:"close_source_branch,omitempty"`
+	MergeStrategy     *MergeStrategy `json:"merge_strategy,omitempty"`
+}
+
+// ErrNotMergeable is returned by MergePullRequest when the pull request
+// cannot be merged, for example because of merge conflicts or unmet merge
+// checks.
+var ErrNotMergeable = errors.New("pull request cannot be merged")
+
+// CreatePullRequest opens a pull request in the given repository.
+//
+// Bitbucket Cloud doesn't return an error if a pull request for the same
+// source and destination branch already exists: instead, the existing pull
+// request is updated and returned.
+func (c *Client) CreatePullRequest(ctx context.Context, repo *Repo, input PullRequestInput) (*PullRequest, error) {
+	var pr PullRequest
+	if err := c.send(ctx, http.MethodPost, pullRequestsPath(repo), input, &pr); err != nil {
+		return nil, errors.Wrap(err, "creating pull request")
+	}
+	return &pr, nil
+}
+
+// FindOpenPullRequest returns the open pull request from the given source
+// branch into the given destination branch, or nil if there is none. If the
+// destination branch is empty, pull requests into any branch are considered.
+func (c *Client) FindOpenPullRequest(ctx context.Context, repo *Repo, sourceBranch, destinationBranch string) (*PullRequest, error) {
+	q := fmt.Sprintf(`source.branch.name = %q AND state = "OPEN"`, sourceBranch)
+	if destinationBranch != "" {
+		q += fmt.Sprintf(` AND destination.branch.name = %q`, destinationBranch)
+	}
+	qry := url.Values{"q": []string{q}}
+
+	var prs []*PullRequest
+	if _, err := c.page(ctx, pullRequestsPath(repo), qry, &PageToken{Pagelen: 1}, &prs); err != nil {
+		return nil, errors.Wrap(err, "finding open pull request")
+	}
+	if len(prs) == 0 {
+		return nil, nil
+	}
+	return prs[0], nil
+}
+
+// GetPullRequest retrieves a single pull request.
+func (c *Client) GetPullRequest(ctx context.Context, repo *Repo, id int64) (*PullRequest, error) {
+	req, err := http.NewRequest(http.MethodGet, pullRequestPath(repo, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var pr PullRequest
+	if err := c.do(ctx, req, &pr); err != nil {
+		return nil, errors.Wrap(err, "getting pull request")
+	}
+	return &pr, nil
+}
+
+// LoadPullRequestStatuses loads the commit statuses of the source commit of
+// the given pull request into its Statuses field.
+func (c *Client) LoadPullRequestStatuses(ctx context.Context, repo *Repo, pr *PullRequest) error {
+	var (
+		statuses []*CommitStatus
+		token    = &PageToken{Pagelen: 100}
+	)
+	for {
+		var (
+			page []*CommitStatus
+			err  error
+		)
+		if token.HasMore() {
+			token, err = c.reqPage(ctx, token.Next, &page)
+		} else {
+			token, err = c.page(ctx, pullRequestPath(repo, pr.ID)+"/statuses", nil, token, &page)
+		}
+		if err != nil {
+			return errors.Wrap(err, "loading pull request statuses")
+		}
+		statuses = append(statuses, page...)
+
+		if !token.HasMore() {
+			break
+		}
+	}
+
+	pr.Statuses = statuses
+	return nil
+}
+
+// UpdatePullRequest updates the title, description and destination branch of
+// the given pull request.
+func (c *Client) UpdatePullRequest(ctx context.Context, repo *Repo, id int64, input PullRequestInput) (*PullRequest, error) {
+	var pr PullRequest
+	if err := c.send(ctx, http.MethodPut, pullRequestPath(repo, id), input, &pr); err != nil {
+		return nil, errors.Wrap(err, "updating pull request")
+	}
+	return &pr, nil
+}
+
+// DeclinePullRequest declines (closes without merging) the given pull request.
+func (c *Client) DeclinePullRequest(ctx context.Context, repo *Repo, id int64) (*PullRequest, error) {
+	var pr PullRequest
+	if err := c.send(ctx, http.MethodPost, pullRequestPath(repo, id)+"/decline", nil, &pr); err != nil {
+		return nil, errors.Wrap(err, "declining pull request")
+	}
+	return &pr, nil
+}
+
+// MergePullRequest merges the given pull request.
+func (c *Client) MergePullRequest(ctx context.Context, repo *Repo, id int64, opts MergePullRequestOpts) (*PullRequest, error) {
+	var pr PullRequest
+	if err := c.send(ctx, http.MethodPost, pullRequestPath(repo, id)+"/merge", opts, &pr); err != nil {
+		var e *httpError
+		if errors.As(err, &e) && (e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusConflict) {
+			return nil, errors.Wrap(ErrNotMergeable, string(e.Body))
+		}
+		return nil, errors.Wrap(err, "merging pull request")
+	}
+	return &pr, nil
+}
+
+// CreatePullRequestComment adds a comment to the given pull request.
+func (c *Client) CreatePullRequestComment(ctx context.Context, repo *Repo, id int64, text string) (*Comment, error) {
+	payload := struct {
+		Content struct {
+			Raw string `json:"raw"`
+		} `json:"content"`
+	}{}
+	payload.Content.Raw = text
+
+	var comment Comment
+	if err := c.send(ctx, http.MethodPost, pullRequestPath(repo, id)+"/comments", payload, &comment); err != nil {
+		return nil, errors.Wrap(err, "creating pull request comment")
+	}
+	return &comment, nil
+}
+
+// CurrentUser returns the account the client is authenticated as.
+func (c *Client) CurrentUser(ctx context.Context) (*Account, error) {
+	req, err := http.NewRequest(http.MethodGet, "/2.0/user", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var account Account
+	if err := c.do(ctx, req, &account); err != nil {
+		return nil, errors.Wrap(err, "getting current user")
+	}
+	return &account, nil
+}
+
+// send sends a request with the given payload encoded as JSON.
+func (c *Client) send(ctx context.Context, method, path string, payload, result interface{}) error {
+	var body bytes.Buffer
+	if payload != nil {
+		if err := json.NewEncoder(&body).Encode(payload); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequest(method, path, &body)
+	if err != nil {
+		return err
+	}
+	return c.do(ctx, req, result)
+}
+
+func pullRequestsPath(repo *Repo) string {
+	return fmt.Sprintf("/2.0/repositories/%s/pullrequests", repo.FullName)
+}
+
+func pullRequestPath(repo *Repo, id int64) string {
+	return fmt.Sprintf("%s/%d", pullRequestsPath(repo), id)
+}
+
+// IsNotFound reports whether err is a Bitbucket Cloud API not found error.
+func IsNotFound(err error) bool {
+	var e *httpError
+	return errors.As(err, &e) && e.NotFound()
+}
+
+// IsUnauthorized reports whether err is a Bitbucket Cloud API unauthorized
+// error.
+func IsUnauthorized(err error) bool {
+	var e *httpError
+	return errors.As(err, &e) && e.Unauthorized()
+}
+
+// WithCredentials returns a copy of the client that authenticates with the
+// given username and app password.
+func (c *Client) WithCredentials(username, appPassword string) *Client {
+	cc := *c
+	cc.Username = username
+	cc.AppPassword = appPassword
+	return &cc
+}
@@ -505,6 +505,20 @@ type UpdatePullRequestInput struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
 	ToRef       Ref    `json:"toRef"`
+
+	// Reviewers replaces the reviewers of the pull request. The reviewers
+	// are left untouched if it's empty.
+	Reviewers []UpdatePullRequestReviewer `json:"reviewers,omitempty"`
+}
+
+// UpdatePullRequestReviewer is a reviewer of a pull request in an
+// UpdatePullRequestInput.
+type UpdatePullRequestReviewer struct {
+	Name string
+}
+
+func (r UpdatePullRequestReviewer) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}{"user": map[string]string{"name": r.Name}})
 }
 
 func (c *Client) UpdatePullRequest(ctx context.Context, in *UpdatePullRequestInput) (*PullRequest, error) {
@@ -577,8 +591,22 @@ func (c *Client) CreatePullRequest(ctx context.Context, pr *PullRequest) error {
 		// return errors.Wrap(err, "fetching default reviewers")
 	}
 
-	reviewers := make([]reviewer, 0, len(defaultReviewers))
-	for _, r := range defaultReviewers {
+	// Reviewers set on the given PR are requested in addition to the default
+	// reviewers.
+	names := defaultReviewers
+	for _, r := range pr.Reviewers {
+		if r.User != nil {
+			names = append(names, r.User.Name)
+		}
+	}
+
+	reviewers := make([]reviewer, 0, len(names))
+	seen := make(map[string]struct{}, len(names))
+	for _, r := range names {
+		if _, ok := seen[r]; ok {
+			continue
+		}
+		seen[r] = struct{}{}
 		reviewers = append(reviewers, reviewer{User: struct {
 			Name string `json:"name"`
 		}{Name: r}})
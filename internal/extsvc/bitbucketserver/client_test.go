@@ -1289,6 +1289,37 @@ func TestClient_GetVersion(t *testing.T) {
 	}
 }
 
+func TestUpdatePullRequestInput_MarshalJSON(t *testing.T) {
+	for name, tc := range map[string]struct {
+		input UpdatePullRequestInput
+		want  string
+	}{
+		"without reviewers": {
+			input: UpdatePullRequestInput{PullRequestID: "1", Version: 2, Title: "t"},
+			want:  `{"version":2,"title":"t","description":"","toRef":{"id":"","repository":{"id":0,"slug":"","project":{"key":""}}}}`,
+		},
+		"with reviewers": {
+			input: UpdatePullRequestInput{
+				PullRequestID: "1",
+				Version:       2,
+				Title:         "t",
+				Reviewers:     []UpdatePullRequestReviewer{{Name: "alice"}, {Name: "bob"}},
+			},
+			want: `{"version":2,"title":"t","description":"","toRef":{"id":"","repository":{"id":0,"slug":"","project":{"key":""}}},"reviewers":[{"user":{"name":"alice"}},{"user":{"name":"bob"}}]}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			have, err := json.Marshal(tc.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, string(have)); diff != "" {
+				t.Errorf("mismatch (-want +have):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestMain(m *testing.M) {
 	flag.Parse()
 	if !testing.Verbose() {
@@ -877,6 +877,9 @@ query($owner: String!, $name: String!, $number: Int!) {
 
 // GetOpenPullRequestByRefs fetches the the pull request associated with the supplied
 // refs. GitHub only allows one open PR by ref at a time.
+// The head ref of a pull request from a fork must be namespaced with the owner
+// of the fork, as in "owner:branch", otherwise only pull requests whose head
+// is in the same repository are considered.
 // If nothing is found an error is returned.
 func (c *V4Client) GetOpenPullRequestByRefs(ctx context.Context, owner, name, baseRef, headRef string) (*PullRequest, error) {
 	version := c.determineGitHubVersion(ctx)
@@ -884,23 +887,29 @@ func (c *V4Client) GetOpenPullRequestByRefs(ctx context.Context, owner, name, ba
 	if err != nil {
 		return nil, err
 	}
+	headOwner, headRefName := splitHeadRef(abbreviateRef(headRef))
+
 	var q strings.Builder
 	q.WriteString(prFragment)
 	q.WriteString("query {\n")
 	q.WriteString(fmt.Sprintf("repository(owner: %q, name: %q) {\n",
 		owner, name))
-	q.WriteString(fmt.Sprintf("pullRequests(baseRefName: %q, headRefName: %q, first: 1, states: OPEN) { \n",
-		abbreviateRef(baseRef), abbreviateRef(headRef),
+	// Pull requests from different forks can share the head ref name, so we
+	// need to look at all of them to find the one from the right repository.
+	q.WriteString(fmt.Sprintf("pullRequests(baseRefName: %q, headRefName: %q, first: 100, states: OPEN) { \n",
+		abbreviateRef(baseRef), headRefName,
 	))
-	q.WriteString("nodes{ ... pr }\n}\n}\n}")
+	q.WriteString("nodes{ ... pr isCrossRepository headRepositoryOwner { login } }\n}\n}\n}")
 
 	var results struct {
 		Repository struct {
 			PullRequests struct {
 				Nodes []*struct {
 					PullRequest
-					Participants  struct{ Nodes []Actor }
-					TimelineItems TimelineItemConnection
+					Participants        struct{ Nodes []Actor }
+					TimelineItems       TimelineItemConnection
+					IsCrossRepository   bool
+					HeadRepositoryOwner *struct{ Login string }
 				}
 			}
 		}
@@ -910,11 +919,22 @@ func (c *V4Client) GetOpenPullRequestByRefs(ctx context.Context, owner, name, ba
 	if err != nil {
 		return nil, err
 	}
-	if len(results.Repository.PullRequests.Nodes) != 1 {
-		return nil, errors.Errorf("expected 1 pull request, got %d instead", len(results.Repository.PullRequests.Nodes))
+
+	var matching []int
+	for i, node := range results.Repository.PullRequests.Nodes {
+		var nodeHeadOwner string
+		if node.HeadRepositoryOwner != nil {
+			nodeHeadOwner = node.HeadRepositoryOwner.Login
+		}
+		if headRefMatches(headOwner, node.IsCrossRepository, nodeHeadOwner) {
+			matching = append(matching, i)
+		}
+	}
+	if len(matching) != 1 {
+		return nil, errors.Errorf("expected 1 pull request, got %d instead", len(matching))
 	}
 
-	node := results.Repository.PullRequests.Nodes[0]
+	node := results.Repository.PullRequests.Nodes[matching[0]]
 	pr := node.PullRequest
 	pr.Participants = node.Participants.Nodes
 	pr.TimelineItems = node.TimelineItems.Nodes
@@ -928,6 +948,26 @@ func (c *V4Client) GetOpenPullRequestByRefs(ctx context.Context, owner, name, ba
 	return &pr, nil
 }
 
+// splitHeadRef splits a head ref of the form "owner:branch", as used for pull
+// requests from forks, into the owner and the branch. The owner is empty if
+// the head ref isn't namespaced.
+func splitHeadRef(headRef string) (owner, branch string) {
+	if i := strings.Index(headRef, ":"); i >= 0 {
+		return headRef[:i], headRef[i+1:]
+	}
+	return "", headRef
+}
+
+// headRefMatches returns true if a pull request with the given head
+// repository belongs to the head ref with the given owner namespace. An empty
+// owner only matches pull requests from the same repository.
+func headRefMatches(owner string, isCrossRepository bool, headRepositoryOwner string) bool {
+	if owner == "" {
+		return !isCrossRepository
+	}
+	return strings.EqualFold(owner, headRepositoryOwner)
+}
+
 const createPullRequestCommentMutation = `
 mutation CreatePullRequestComment($input: AddCommentInput!) {
   addComment(input: $input) {
@@ -12,6 +12,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/rcache"
@@ -30,6 +32,29 @@ func TestSplitRepositoryNameWithOwner(t *testing.T) {
 	}
 }
 
+func TestHeadRefMatches(t *testing.T) {
+	for _, tc := range []struct {
+		headRef             string
+		isCrossRepository   bool
+		headRepositoryOwner string
+		want                bool
+	}{
+		{headRef: "my-branch", headRepositoryOwner: "sourcegraph", want: true},
+		{headRef: "my-branch", isCrossRepository: true, headRepositoryOwner: "someone", want: false},
+		{headRef: "someone:my-branch", isCrossRepository: true, headRepositoryOwner: "someone", want: true},
+		{headRef: "someone:my-branch", isCrossRepository: true, headRepositoryOwner: "SomeOne", want: true},
+		{headRef: "someone:my-branch", isCrossRepository: true, headRepositoryOwner: "someone-else", want: false},
+	} {
+		owner, branch := splitHeadRef(tc.headRef)
+		if branch != "my-branch" {
+			t.Errorf("%s: got branch %q, want %q", tc.headRef, branch, "my-branch")
+		}
+		if have := headRefMatches(owner, tc.isCrossRepository, tc.headRepositoryOwner); have != tc.want {
+			t.Errorf("%s from %s: got %v, want %v", tc.headRef, tc.headRepositoryOwner, have, tc.want)
+		}
+	}
+}
+
 type mockHTTPResponseBody struct {
 	count        int
 	responseBody string
@@ -153,6 +178,116 @@ func TestClient_GetRepository_nonexistent(t *testing.T) {
 	}
 }
 
+// TestClient_Fork tests the behavior of Fork.
+func TestClient_Fork(t *testing.T) {
+	testOrg := "org"
+
+	for name, tc := range map[string]struct {
+		org      *string
+		wantBody string
+	}{
+		"user namespace": {org: nil, wantBody: `{}`},
+		"organization":   {org: &testOrg, wantBody: `{"organization":"org"}`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c := newTestClient(t, httpcli.DoerFunc(func(req *http.Request) (*http.Response, error) {
+				if have, want := req.Method, "POST"; have != want {
+					t.Errorf("unexpected method: have %q want %q", have, want)
+				}
+				if have, want := req.URL.Path, "/repos/owner/repo/forks"; have != want {
+					t.Errorf("unexpected path: have %q want %q", have, want)
+				}
+				body, err := io.ReadAll(req.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if have := string(body); have != tc.wantBody {
+					t.Errorf("unexpected body: have %q want %q", have, tc.wantBody)
+				}
+
+				return &http.Response{
+					Request:    req,
+					StatusCode: http.StatusAccepted,
+					Body: io.NopCloser(strings.NewReader(`{
+  "node_id": "i",
+  "full_name": "user/repo",
+  "html_url": "https://github.example.com/user/repo",
+  "fork": true
+}`)),
+				}, nil
+			}))
+
+			fork, err := c.Fork(context.Background(), "owner", "repo", tc.org)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := &Repository{
+				ID:            "i",
+				NameWithOwner: "user/repo",
+				URL:           "https://github.example.com/user/repo",
+				IsFork:        true,
+			}
+			if diff := cmp.Diff(want, fork); diff != "" {
+				t.Errorf("unexpected fork (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestClient_RequestReviewersAndAddLabels tests the requests sent by
+// RequestReviewers and AddLabels.
+func TestClient_RequestReviewersAndAddLabels(t *testing.T) {
+	for name, tc := range map[string]struct {
+		call     func(c *V3Client) error
+		wantPath string
+		wantBody string
+	}{
+		"reviewers": {
+			call: func(c *V3Client) error {
+				return c.RequestReviewers(context.Background(), "owner", "repo", 42, []string{"alice", "org/team"})
+			},
+			wantPath: "/repos/owner/repo/pulls/42/requested_reviewers",
+			wantBody: `{"reviewers":["alice"],"team_reviewers":["team"]}`,
+		},
+		"labels": {
+			call: func(c *V3Client) error {
+				return c.AddLabels(context.Background(), "owner", "repo", 42, []string{"batch change"})
+			},
+			wantPath: "/repos/owner/repo/issues/42/labels",
+			wantBody: `{"labels":["batch change"]}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c := newTestClient(t, httpcli.DoerFunc(func(req *http.Request) (*http.Response, error) {
+				if have, want := req.Method, "POST"; have != want {
+					t.Errorf("unexpected method: have %q want %q", have, want)
+				}
+				if have := req.URL.Path; have != tc.wantPath {
+					t.Errorf("unexpected path: have %q want %q", have, tc.wantPath)
+				}
+				body, err := io.ReadAll(req.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if have := string(body); have != tc.wantBody {
+					t.Errorf("unexpected body: have %q want %q", have, tc.wantBody)
+				}
+
+				return &http.Response{
+					Request:    req,
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`[]`)),
+				}, nil
+			}))
+
+			if err := tc.call(c); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
 func TestClient_ListOrgRepositories(t *testing.T) {
 	mock := mockHTTPResponseBody{
 		responseBody: `[
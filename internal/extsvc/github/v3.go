@@ -1,6 +1,7 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -144,6 +145,24 @@ func (c *V3Client) get(ctx context.Context, requestURI string, result interface{
 		return nil, err
 	}
 
+	return c.request(ctx, req, result)
+}
+
+func (c *V3Client) post(ctx context.Context, requestURI string, payload, result interface{}) (http.Header, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling payload")
+	}
+
+	req, err := http.NewRequest("POST", requestURI, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.request(ctx, req, result)
+}
+
+func (c *V3Client) request(ctx context.Context, req *http.Request, result interface{}) (http.Header, error) {
 	// Include node_id (GraphQL ID) in response. See
 	// https://developer.github.com/changes/2017-12-19-graphql-node-id/.
 	//
@@ -161,8 +180,7 @@ func (c *V3Client) get(ctx context.Context, requestURI string, result interface{
 		req.Header.Add("Accept", "application/vnd.github.nebula-preview+json")
 	}
 
-	err = c.rateLimit.Wait(ctx)
-	if err != nil {
+	if err := c.rateLimit.Wait(ctx); err != nil {
 		// We don't want to return a misleading rate limit exceeded error if the error is coming
 		// from the context.
 		if ctx.Err() != nil {
@@ -444,6 +462,59 @@ func (c *V3Client) GetRepository(ctx context.Context, owner, name string) (*Repo
 	}, false)
 }
 
+// Fork forks the given repository. If org is given, then the repository will
+// be forked into that organisation, otherwise the repository is forked into
+// the authenticated user's account.
+//
+// If the repository has already been forked into the target namespace, GitHub
+// returns the existing fork instead of creating a new one.
+func (c *V3Client) Fork(ctx context.Context, owner, repo string, org *string) (*Repository, error) {
+	payload := struct {
+		Org *string `json:"organization,omitempty"`
+	}{Org: org}
+
+	var restRepo restRepository
+	if _, err := c.post(ctx, "/repos/"+owner+"/"+repo+"/forks", payload, &restRepo); err != nil {
+		return nil, err
+	}
+
+	return convertRestRepo(restRepo), nil
+}
+
+// RequestReviewers requests reviews of the pull request with the given
+// number from the given users. Reviewers in the form "org/team" are requested
+// as team reviewers. Users that already reviewed the pull request are
+// requested again.
+func (c *V3Client) RequestReviewers(ctx context.Context, owner, repo string, number int64, reviewers []string) error {
+	payload := struct {
+		Reviewers     []string `json:"reviewers,omitempty"`
+		TeamReviewers []string `json:"team_reviewers,omitempty"`
+	}{}
+	for _, r := range reviewers {
+		if i := strings.Index(r, "/"); i >= 0 {
+			payload.TeamReviewers = append(payload.TeamReviewers, r[i+1:])
+		} else {
+			payload.Reviewers = append(payload.Reviewers, r)
+		}
+	}
+
+	var result json.RawMessage
+	_, err := c.post(ctx, fmt.Sprintf("/repos/%s/%s/pulls/%d/requested_reviewers", owner, repo, number), payload, &result)
+	return err
+}
+
+// AddLabels adds the given labels to the issue or pull request with the given
+// number. Labels that don't exist yet are created.
+func (c *V3Client) AddLabels(ctx context.Context, owner, repo string, number int64, labels []string) error {
+	payload := struct {
+		Labels []string `json:"labels"`
+	}{Labels: labels}
+
+	var result json.RawMessage
+	_, err := c.post(ctx, fmt.Sprintf("/repos/%s/%s/issues/%d/labels", owner, repo, number), payload, &result)
+	return err
+}
+
 // GetOrganization gets an org from GitHub by its login.
 func (c *V3Client) GetOrganization(ctx context.Context, login string) (org *OrgDetails, err error) {
 	err = c.requestGet(ctx, "/orgs/"+login, &org)
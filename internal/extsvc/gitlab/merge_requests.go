@@ -42,6 +42,7 @@ type MergeRequest struct {
 	WebURL         string            `json:"web_url"`
 	WorkInProgress bool              `json:"work_in_progress"`
 	Author         User              `json:"author"`
+	Reviewers      []User            `json:"reviewers,omitempty"`
 
 	DiffRefs DiffRefs `json:"diff_refs"`
 
@@ -89,6 +90,13 @@ type CreateMergeRequestOpts struct {
 	TargetBranch string `json:"target_branch"`
 	Title        string `json:"title"`
 	Description  string `json:"description,omitempty"`
+	// TargetProjectID is the ID of the project the merge request should be
+	// opened against, if it differs from the project the source branch lives
+	// in. This is used to open merge requests from forks.
+	TargetProjectID int `json:"target_project_id,omitempty"`
+	// Labels is a comma-separated list of labels.
+	Labels      string  `json:"labels,omitempty"`
+	ReviewerIDs []int32 `json:"reviewer_ids,omitempty"`
 	// TODO: other fields at
 	// https://docs.gitlab.com/ee/api/merge_requests.html#create-mr as needed.
 }
@@ -199,6 +207,11 @@ type UpdateMergeRequestOpts struct {
 	Title        string                       `json:"title"`
 	Description  string                       `json:"description,omitempty"`
 	StateEvent   UpdateMergeRequestStateEvent `json:"state_event,omitempty"`
+	// AddLabels is a comma-separated list of labels to add to the labels the
+	// merge request already has.
+	AddLabels string `json:"add_labels,omitempty"`
+	// ReviewerIDs replaces the reviewers of the merge request, if it's set.
+	ReviewerIDs []int32 `json:"reviewer_ids,omitempty"`
 }
 
 type UpdateMergeRequestStateEvent string
@@ -14,6 +14,12 @@ var MockGetUser func(c *Client, ctx context.Context, id string) (*User, error)
 // MockGetProject, if non-nil, will be called instead of Client.GetProject
 var MockGetProject func(c *Client, ctx context.Context, op GetProjectOp) (*Project, error)
 
+// MockForkProject, if non-nil, will be called instead of Client.ForkProject
+var MockForkProject func(c *Client, ctx context.Context, project *Project, namespace *string) (*Project, error)
+
+// MockListOwnedForks, if non-nil, will be called instead of Client.ListOwnedForks
+var MockListOwnedForks func(c *Client, ctx context.Context, project *Project) ([]*Project, error)
+
 // MockListTree, if non-nil, will be called instead of Client.ListTree
 var MockListTree func(c *Client, ctx context.Context, op ListTreeOp) ([]*Tree, error)
 
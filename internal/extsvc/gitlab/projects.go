@@ -1,6 +1,7 @@
 package gitlab
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -8,7 +9,9 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/peterhellberg/link"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -181,6 +184,61 @@ func (c *Client) getProjectFromAPI(ctx context.Context, id int, pathWithNamespac
 	return proj, err
 }
 
+// ForkProject forks the given project. If namespace is given, then the project
+// is forked into that namespace, otherwise it is forked into the namespace of
+// the authenticated user.
+//
+// Unlike GitHub, GitLab returns an error if a fork with the same path already
+// exists in the target namespace, so callers should check for an existing
+// fork first.
+func (c *Client) ForkProject(ctx context.Context, project *Project, namespace *string) (*Project, error) {
+	if MockForkProject != nil {
+		return MockForkProject(c, ctx, project, namespace)
+	}
+
+	payload := struct {
+		NamespacePath *string `json:"namespace_path,omitempty"`
+	}{NamespacePath: namespace}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling payload")
+	}
+
+	time.Sleep(c.rateLimitMonitor.RecommendedWaitForBackgroundOp(1))
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("projects/%d/fork", project.ID), bytes.NewBuffer(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request to fork a project")
+	}
+
+	fork := &Project{}
+	if _, _, err := c.do(ctx, req, fork); err != nil {
+		return nil, errors.Wrap(err, "sending request to fork a project")
+	}
+
+	return fork, nil
+}
+
+// ListOwnedForks lists the forks of the given project that are owned by the
+// authenticated user. Only the first page of results is returned.
+func (c *Client) ListOwnedForks(ctx context.Context, project *Project) ([]*Project, error) {
+	if MockListOwnedForks != nil {
+		return MockListOwnedForks(c, ctx, project)
+	}
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("projects/%d/forks?owned=true&per_page=100", project.ID), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request to list forks")
+	}
+
+	var forks []*Project
+	if _, _, err := c.do(ctx, req, &forks); err != nil {
+		return nil, errors.Wrap(err, "sending request to list forks")
+	}
+
+	return forks, nil
+}
+
 // ListProjects lists GitLab projects.
 func (c *Client) ListProjects(ctx context.Context, urlStr string) (projs []*Project, nextPageURL *string, err error) {
 	if MockListProjects != nil {
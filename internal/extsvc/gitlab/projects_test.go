@@ -100,3 +100,53 @@ func TestClient_GetProject_nonexistent(t *testing.T) {
 		t.Error("proj != nil")
 	}
 }
+
+func TestClient_ForkProject(t *testing.T) {
+	ctx := context.Background()
+	project := &Project{ProjectCommon: ProjectCommon{ID: 1}}
+
+	t.Run("error", func(t *testing.T) {
+		c := newTestClient(t)
+		c.httpClient = &mockHTTPEmptyResponse{http.StatusConflict}
+
+		fork, err := c.ForkProject(ctx, project, nil)
+		if fork != nil {
+			t.Errorf("unexpected non-nil fork: %+v", fork)
+		}
+		if err == nil {
+			t.Error("unexpected nil error")
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		c := newTestClient(t)
+		c.httpClient = &mockHTTPResponseBody{
+			responseBody: `
+{
+	"id": 2,
+	"path_with_namespace": "user/r",
+	"forked_from_project": {
+		"id": 1,
+		"path_with_namespace": "n1/r"
+	}
+}
+`,
+		}
+
+		want := &Project{
+			ProjectCommon: ProjectCommon{ID: 2, PathWithNamespace: "user/r"},
+			ForkedFromProject: &ProjectCommon{
+				ID:                1,
+				PathWithNamespace: "n1/r",
+			},
+		}
+
+		fork, err := c.ForkProject(ctx, project, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(fork, want) {
+			t.Errorf("got fork %+v, want %+v", fork, want)
+		}
+	})
+}
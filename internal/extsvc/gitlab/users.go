@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/peterhellberg/link"
 )
@@ -68,3 +69,16 @@ func (c *Client) GetUser(ctx context.Context, id string) (*User, error) {
 	}
 	return &usr, nil
 }
+
+// GetUserByUsername returns the user with the given username. It returns a
+// nil user, without an error, if no such user exists.
+func (c *Client) GetUserByUsername(ctx context.Context, username string) (*User, error) {
+	users, _, err := c.ListUsers(ctx, "users?username="+url.QueryEscape(username))
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, nil
+	}
+	return users[0], nil
+}
@@ -316,6 +316,8 @@ func WebhookURL(kind string, externalServiceID int64, externalURL string) string
 		path = "github-webhooks"
 	case KindBitbucketServer:
 		path = "bitbucket-server-webhooks"
+	case KindBitbucketCloud:
+		path = "bitbucket-cloud-webhooks"
 	case KindGitLab:
 		path = "gitlab-webhooks"
 	default:
@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/PuerkitoBio/rehttp"
@@ -571,6 +572,50 @@ func NewTimeoutOpt(timeout time.Duration) Opt {
 	}
 }
 
+// DenyPrivateNetworksOpt is an Opt that prevents an http.Client from
+// connecting to loopback, private, link-local and unspecified addresses. The
+// address is checked when dialing, after DNS resolution, so that redirects and
+// DNS records pointing at internal hosts are rejected as well. Proxies are
+// disabled, since they would connect on our behalf.
+//
+// Use it for requests to URLs provided by users.
+func DenyPrivateNetworksOpt(cli *http.Client) error {
+	tr, err := getTransportForMutation(cli)
+	if err != nil {
+		return errors.Wrap(err, "httpcli.DenyPrivateNetworksOpt")
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || IsPrivateNetworkIP(ip) {
+				return errors.Errorf("connecting to %s is not allowed", host)
+			}
+			return nil
+		},
+	}
+	tr.DialContext = dialer.DialContext
+	tr.Proxy = nil
+
+	return nil
+}
+
+// IsPrivateNetworkIP returns true if ip is a loopback, private, link-local or
+// unspecified address.
+func IsPrivateNetworkIP(ip net.IP) bool {
+	return ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsUnspecified()
+}
+
 // getTransport returns the http.Transport for cli. If Transport is nil, it is
 // set to a copy of the DefaultTransport. If it is the DefaultTransport, it is
 // updated to a copy of the DefaultTransport.
@@ -281,6 +281,47 @@ func TestNewTimeoutOpt(t *testing.T) {
 	}
 }
 
+func TestDenyPrivateNetworksOpt(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	var cli http.Client
+	if err := DenyPrivateNetworksOpt(&cli); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	resp, err := cli.Get(srv.URL)
+	if err == nil {
+		resp.Body.Close()
+		t.Fatal("expected request to loopback address to fail")
+	}
+	if !strings.Contains(err.Error(), "is not allowed") {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestIsPrivateNetworkIP(t *testing.T) {
+	for addr, want := range map[string]bool{
+		"127.0.0.1":       true,
+		"::1":             true,
+		"10.1.2.3":        true,
+		"172.16.0.1":      true,
+		"192.168.1.1":     true,
+		"169.254.169.254": true,
+		"fe80::1":         true,
+		"fd00::1":         true,
+		"0.0.0.0":         true,
+		"8.8.8.8":         false,
+		"2001:4860::8888": false,
+	} {
+		if have := IsPrivateNetworkIP(net.ParseIP(addr)); have != want {
+			t.Errorf("IsPrivateNetworkIP(%s): have %v, want %v", addr, have, want)
+		}
+	}
+}
+
 func TestErrorResilience(t *testing.T) {
 	failures := int64(5)
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
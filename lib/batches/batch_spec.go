@@ -38,6 +38,8 @@ type BatchSpec struct {
 	TransformChanges  *TransformChanges        `json:"transformChanges,omitempty" yaml:"transformChanges,omitempty"`
 	ImportChangesets  []ImportChangeset        `json:"importChangesets,omitempty" yaml:"importChangesets"`
 	ChangesetTemplate *ChangesetTemplate       `json:"changesetTemplate,omitempty" yaml:"changesetTemplate"`
+	AutoMerge         *AutoMerge               `json:"autoMerge,omitempty" yaml:"autoMerge,omitempty"`
+	Rollout           []RolloutWave            `json:"rollout,omitempty" yaml:"rollout,omitempty"`
 }
 
 type ChangesetTemplate struct {
@@ -46,6 +48,54 @@ type ChangesetTemplate struct {
 	Branch    string                       `json:"branch,omitempty" yaml:"branch"`
 	Commit    ExpandedGitCommitDescription `json:"commit,omitempty" yaml:"commit"`
 	Published *overridable.BoolOrString    `json:"published" yaml:"published"`
+	Fork      bool                         `json:"fork,omitempty" yaml:"fork"`
+	Reviewers []string                     `json:"reviewers,omitempty" yaml:"reviewers"`
+	Labels    []string                     `json:"labels,omitempty" yaml:"labels"`
+}
+
+const (
+	AutoMergeReviewStateApproved = "APPROVED"
+	AutoMergeReviewStateAny      = "ANY"
+
+	AutoMergeCheckStatePassed = "PASSED"
+	AutoMergeCheckStateAny    = "ANY"
+
+	AutoMergeMethodMerge  = "merge"
+	AutoMergeMethodSquash = "squash"
+)
+
+type AutoMerge struct {
+	ReviewState string           `json:"reviewState,omitempty" yaml:"reviewState"`
+	CheckState  string           `json:"checkState,omitempty" yaml:"checkState"`
+	Method      string           `json:"method,omitempty" yaml:"method"`
+	Window      *AutoMergeWindow `json:"window,omitempty" yaml:"window,omitempty"`
+}
+
+// RequiredReviewState returns the review state a changeset needs to have
+// before it can be merged, falling back to the default if none is set.
+func (a *AutoMerge) RequiredReviewState() string {
+	if a.ReviewState == "" {
+		return AutoMergeReviewStateApproved
+	}
+	return a.ReviewState
+}
+
+// RequiredCheckState returns the check state a changeset needs to have before
+// it can be merged, falling back to the default if none is set.
+func (a *AutoMerge) RequiredCheckState() string {
+	if a.CheckState == "" {
+		return AutoMergeCheckStatePassed
+	}
+	return a.CheckState
+}
+
+// Squash returns true if changesets should be squash merged.
+func (a *AutoMerge) Squash() bool { return a.Method == AutoMergeMethodSquash }
+
+type AutoMergeWindow struct {
+	Start string   `json:"start,omitempty" yaml:"start"`
+	End   string   `json:"end,omitempty" yaml:"end"`
+	Days  []string `json:"days,omitempty" yaml:"days"`
 }
 
 type GitCommitAuthor struct {
@@ -182,6 +232,12 @@ func parseBatchSpec(schema string, data []byte, opts ParseBatchSpecOptions) (*Ba
 		}
 	}
 
+	for i, wave := range spec.Rollout {
+		if err := wave.validate(); err != nil {
+			errs = multierror.Append(errs, NewValidationError(errors.Wrapf(err, "rollout wave %d", i+1)))
+		}
+	}
+
 	if !opts.AllowFiles {
 		for i, step := range spec.Steps {
 			if len(step.Files) != 0 {
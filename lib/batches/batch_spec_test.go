@@ -198,6 +198,109 @@ changesetTemplate:
 		wantErr := `1 error occurred:
 	* step 1 in batch spec uses the 'files' attribute to create files in the step container, which is not supported in this Batch Changes version
 
+`
+		haveErr := err.Error()
+		if haveErr != wantErr {
+			t.Fatalf("wrong error. want=%q, have=%q", wantErr, haveErr)
+		}
+	})
+
+	t.Run("parsing autoMerge", func(t *testing.T) {
+		const spec = `
+name: hello-world
+description: Add Hello World to READMEs
+on:
+  - repositoriesMatchingQuery: file:README.md
+steps:
+  - run: echo Hello World | tee -a $(find -name README.md)
+    container: alpine:3
+changesetTemplate:
+  title: Hello World
+  body: My first batch change!
+  branch: hello-world
+  commit:
+    message: Append Hello World to all README.md files
+autoMerge:
+  checkState: ANY
+  method: squash
+  window:
+    days: [monday, tuesday]
+    start: "09:00"
+    end: "17:00"
+`
+
+		batchSpec, err := ParseBatchSpec([]byte(spec), ParseBatchSpecOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if have, want := batchSpec.AutoMerge.RequiredReviewState(), AutoMergeReviewStateApproved; have != want {
+			t.Errorf("wrong review state. want=%q, have=%q", want, have)
+		}
+		if have, want := batchSpec.AutoMerge.RequiredCheckState(), AutoMergeCheckStateAny; have != want {
+			t.Errorf("wrong check state. want=%q, have=%q", want, have)
+		}
+		if !batchSpec.AutoMerge.Squash() {
+			t.Error("expected squash merge method")
+		}
+		if batchSpec.AutoMerge.Window == nil || len(batchSpec.AutoMerge.Window.Days) != 2 {
+			t.Errorf("wrong window: %+v", batchSpec.AutoMerge.Window)
+		}
+	})
+
+	t.Run("invalid autoMerge method", func(t *testing.T) {
+		const spec = `
+name: hello-world
+description: Add Hello World to READMEs
+on:
+  - repositoriesMatchingQuery: file:README.md
+steps:
+  - run: echo Hello World | tee -a $(find -name README.md)
+    container: alpine:3
+changesetTemplate:
+  title: Hello World
+  body: My first batch change!
+  branch: hello-world
+  commit:
+    message: Append Hello World to all README.md files
+autoMerge:
+  method: rebase
+`
+
+		if _, err := ParseBatchSpec([]byte(spec), ParseBatchSpecOptions{}); err == nil {
+			t.Fatal("no error returned")
+		}
+	})
+
+	t.Run("invalid rollout", func(t *testing.T) {
+		const spec = `
+name: hello-world
+description: Add Hello World to READMEs
+on:
+  - repositoriesMatchingQuery: file:README.md
+steps:
+  - run: echo Hello World | tee -a $(find -name README.md)
+    container: alpine:3
+changesetTemplate:
+  title: Hello World
+  body: My first batch change!
+  branch: hello-world
+  commit:
+    message: Append Hello World to all README.md files
+rollout:
+  - match: repo:^core/
+  - percent: 10
+    after: soon
+`
+
+		_, err := ParseBatchSpec([]byte(spec), ParseBatchSpecOptions{})
+		if err == nil {
+			t.Fatal("no error returned")
+		}
+
+		wantErr := `1 error occurred:
+	* rollout wave 2: invalid after: time: invalid duration "soon"
+
 `
 		haveErr := err.Error()
 		if haveErr != wantErr {
@@ -81,6 +81,15 @@ type ChangesetSpec struct {
 	Commits []GitCommitDescription `json:"commits,omitempty"`
 
 	Published PublishedValue `json:"published,omitempty"`
+
+	// Fork is true if the head ref should be pushed to a fork of the base
+	// repository in the namespace of the user publishing the changeset.
+	Fork bool `json:"fork,omitempty"`
+
+	// Reviewers are the usernames of the users to request reviews from.
+	Reviewers []string `json:"reviewers,omitempty"`
+	// Labels are the labels to add to the changeset.
+	Labels []string `json:"labels,omitempty"`
 }
 
 // MarshalJSON overwrites the default behavior of the json lib while unmarshalling
@@ -103,6 +112,9 @@ func (c *ChangesetSpec) MarshalJSON() ([]byte, error) {
 		Body           string                 `json:"body,omitempty"`
 		Commits        []GitCommitDescription `json:"commits,omitempty"`
 		Published      *PublishedValue        `json:"published,omitempty"`
+		Fork           bool                   `json:"fork,omitempty"`
+		Reviewers      []string               `json:"reviewers,omitempty"`
+		Labels         []string               `json:"labels,omitempty"`
 	}{
 		BaseRepository: c.BaseRepository,
 		ExternalID:     c.ExternalID,
@@ -113,6 +125,9 @@ func (c *ChangesetSpec) MarshalJSON() ([]byte, error) {
 		Title:          c.Title,
 		Body:           c.Body,
 		Commits:        c.Commits,
+		Fork:           c.Fork,
+		Reviewers:      c.Reviewers,
+		Labels:         c.Labels,
 	}
 	if !c.Published.Nil() {
 		v.Published = &c.Published
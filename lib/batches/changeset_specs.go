@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"unicode"
 
 	"github.com/cockroachdb/errors"
 	"github.com/hashicorp/go-multierror"
@@ -35,6 +36,10 @@ type ChangesetSpecInput struct {
 	Template              *ChangesetTemplate              `json:"-"`
 	TransformChanges      *TransformChanges               `json:"-"`
 
+	// ReadFile is used to read files from the repository when rendering the
+	// changeset template.
+	ReadFile template.RepositoryFileReader `json:"-"`
+
 	Result execution.Result
 }
 
@@ -55,6 +60,7 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, features ChangesetSpecFeatur
 			Name:        input.Repository.Name,
 			FileMatches: input.Repository.FileMatches,
 		},
+		ReadFile: input.ReadFile,
 	}
 
 	var authorName string
@@ -93,6 +99,16 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, features ChangesetSpecFeatur
 		return nil, err
 	}
 
+	reviewers, err := renderChangesetTemplateList("reviewers", input.Template.Reviewers, tmplCtx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := renderChangesetTemplateList("labels", input.Template.Labels, tmplCtx, false)
+	if err != nil {
+		return nil, err
+	}
+
 	// TODO: As a next step, we should extend the ChangesetTemplateContext to also include
 	// TransformChanges.Group and then change validateGroups and groupFileDiffs to, for each group,
 	// render the branch name *before* grouping the diffs.
@@ -134,6 +150,9 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, features ChangesetSpecFeatur
 				},
 			},
 			Published: PublishedValue{Val: published},
+			Fork:      input.Template.Fork,
+			Reviewers: reviewers,
+			Labels:    labels,
 		}, nil
 	}
 
@@ -170,6 +189,45 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, features ChangesetSpecFeatur
 	return specs, nil
 }
 
+// renderChangesetTemplateList renders each of the given templates and returns
+// the non-blank results, without duplicates. If split is true, the results are
+// also split on whitespace and commas, so that a single template can produce
+// multiple values, and a leading "@" is removed from each value.
+func renderChangesetTemplateList(name string, tmpls []string, tmplCtx *template.ChangesetTemplateContext, split bool) ([]string, error) {
+	var values []string
+	seen := make(map[string]struct{})
+
+	for i, tmpl := range tmpls {
+		rendered, err := template.RenderChangesetTemplateField(fmt.Sprintf("%s[%d]", name, i), tmpl, tmplCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		candidates := []string{rendered}
+		if split {
+			candidates = strings.FieldsFunc(rendered, func(r rune) bool {
+				return r == ',' || unicode.IsSpace(r)
+			})
+		}
+
+		for _, v := range candidates {
+			if split {
+				v = strings.TrimPrefix(v, "@")
+			}
+			if v == "" {
+				continue
+			}
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+			values = append(values, v)
+		}
+	}
+
+	return values, nil
+}
+
 type RepoFetcher func(context.Context, []string) (map[string]string, error)
 
 func BuildImportChangesetSpecs(ctx context.Context, importChangesets []ImportChangeset, repoFetcher RepoFetcher) (specs []*ChangesetSpec, errs error) {
@@ -2,6 +2,7 @@ package batches
 
 import (
 	"encoding/json"
+	"io/fs"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -181,6 +182,30 @@ func TestCreateChangesetSpecs(t *testing.T) {
 			want:     nil,
 			wantErr:  errOptionalPublishedUnsupported.Error(),
 		},
+		{
+			name: "reviewers and labels",
+			input: inputWith(defaultInput, func(input *ChangesetSpecInput) {
+				// The deep copy loses the unexported fields of the published
+				// value, so we reuse the original one.
+				input.Template.Published = defaultInput.Template.Published
+				input.Template.Reviewers = []string{`${{ repo.owners "README.md" }}`, "@bob, carol", ""}
+				input.Template.Labels = []string{"batch change", `${{ batch_change.name }}`, "batch change"}
+				input.ReadFile = func(path string) ([]byte, error) {
+					if path == ".github/CODEOWNERS" {
+						return []byte("*.md @alice @bob"), nil
+					}
+					return nil, &fs.PathError{Op: "open", Path: path, Err: fs.ErrNotExist}
+				}
+			}),
+			features: featuresAllEnabled,
+			want: []*ChangesetSpec{
+				specWith(defaultChangesetSpec, func(s *ChangesetSpec) {
+					s.Reviewers = []string{"alice", "bob", "carol"}
+					s.Labels = []string{"batch change", "the name"}
+				}),
+			},
+			wantErr: "",
+		},
 	}
 
 	for _, tt := range tests {
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 
@@ -113,8 +114,7 @@ func marshalAndHash(key *ExecutionKey, envs []map[string]string) (string, error)
 		return "", err
 	}
 
-	hash := sha256.Sum256(raw)
-	return base64.RawURLEncoding.EncodeToString(hash[:16]), nil
+	return hashBytes(raw), nil
 }
 
 // StepsCacheKey implements the Keyer interface for a batch spec execution in a
@@ -148,27 +148,143 @@ func (key *StepsCacheKeyWithGlobalEnv) Key() (string, error) {
 }
 
 func marshalAndHashStepsCacheKey(key StepsCacheKey, globalEnv []string) (string, error) {
-	// Setup a copy of the Task that only includes the Steps up to and
-	// including key.StepIndex.
-	clone := &ExecutionKey{
+	// Only the Steps up to and including key.StepIndex influence the result,
+	// so only they make it into the key. Each of them is reduced to its
+	// StepKey, which means that editing a later step, or a step that doesn't
+	// depend on them, leaves the keys of the unchanged prefix intact.
+	steps := key.ExecutionKey.Steps[0 : key.StepIndex+1]
+
+	stepKeys := make([]string, len(steps))
+	for i, step := range steps {
+		k, err := StepKey(step, globalEnv)
+		if err != nil {
+			return "", errors.Wrapf(err, "building key for step %d", i)
+		}
+		stepKeys[i] = k
+	}
+
+	// The repository, its base revision and the workspace path are always part
+	// of the key: the cached results contain the diff produced by the steps,
+	// which must never be applied to another workspace. The batch change
+	// attributes are only available to steps through templates, so they are
+	// only included when a step references them.
+	refs, err := stepsTemplateReferences(steps, globalEnv)
+	if err != nil {
+		return "", err
+	}
+	var attributes *template.BatchChangeAttributes
+	if refs["."] || refs["batch_change"] || referencesPrefix(refs, "batch_change.") {
+		attributes = key.ExecutionKey.BatchChangeAttributes
+	}
+
+	raw, err := json.Marshal(struct {
+		Repository            batches.Repository
+		Path                  string
+		OnlyFetchWorkspace    bool
+		BatchChangeAttributes *template.BatchChangeAttributes
+		Steps                 []string
+	}{
 		Repository:            key.ExecutionKey.Repository,
 		Path:                  key.ExecutionKey.Path,
 		OnlyFetchWorkspace:    key.ExecutionKey.OnlyFetchWorkspace,
-		Steps:                 key.ExecutionKey.Steps[0 : key.StepIndex+1],
-		BatchChangeAttributes: key.ExecutionKey.BatchChangeAttributes,
+		BatchChangeAttributes: attributes,
+		Steps:                 stepKeys,
+	})
+	if err != nil {
+		return "", err
 	}
 
-	// Resolve environment only for the subset of Steps
-	envs, err := resolveStepsEnvironment(globalEnv, clone.Steps)
+	return fmt.Sprintf("%s-step-%d", hashBytes(raw), key.StepIndex), nil
+}
+
+// StepKey returns a hash identifying a single step independent of the
+// workspace it runs in. It is based on the step definition, its environment
+// resolved against globalEnv, and the content of the files it mounts.
+func StepKey(step batches.Step, globalEnv []string) (string, error) {
+	env, err := step.Env.Resolve(globalEnv)
 	if err != nil {
-		return "", err
+		return "", errors.Wrap(err, "resolving environment")
 	}
 
-	hash, err := marshalAndHash(clone, envs)
+	files := make(map[string]string, len(step.Files))
+	for path, content := range step.Files {
+		files[path] = hashBytes([]byte(content))
+	}
+
+	raw, err := json.Marshal(struct {
+		Run         string
+		Container   string
+		Environment map[string]string
+		Files       map[string]string
+		Outputs     batches.Outputs
+		If          interface{}
+	}{
+		Run:         step.Run,
+		Container:   step.Container,
+		Environment: env,
+		Files:       files,
+		Outputs:     step.Outputs,
+		If:          step.If,
+	})
 	if err != nil {
 		return "", err
 	}
-	return fmt.Sprintf("%s-step-%d", hash, key.StepIndex), err
+
+	return hashBytes(raw), nil
+}
+
+// referencesPrefix returns true if any of the given references starts with
+// the given prefix.
+func referencesPrefix(refs map[string]bool, prefix string) bool {
+	for ref := range refs {
+		if strings.HasPrefix(ref, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// stepsTemplateReferences returns the template variables referenced by the
+// templated fields of the given steps. See template.StepTemplateReferences.
+func stepsTemplateReferences(steps []batches.Step, globalEnv []string) (map[string]bool, error) {
+	refs := map[string]bool{}
+	add := func(tmpl string) {
+		stepRefs, err := template.StepTemplateReferences(tmpl)
+		if err != nil {
+			// We can't tell what a template that doesn't parse references,
+			// so we assume it references everything.
+			refs["."] = true
+			return
+		}
+		for ref := range stepRefs {
+			refs[ref] = true
+		}
+	}
+
+	for i, step := range steps {
+		add(step.Run)
+		add(step.IfCondition())
+
+		env, err := step.Env.Resolve(globalEnv)
+		if err != nil {
+			return nil, errors.Wrapf(err, "resolving environment for step %d", i)
+		}
+		for _, value := range env {
+			add(value)
+		}
+		for _, content := range step.Files {
+			add(content)
+		}
+		for _, output := range step.Outputs {
+			add(output.Value)
+		}
+	}
+	return refs, nil
+}
+
+func hashBytes(raw []byte) string {
+	hash := sha256.Sum256(raw)
+	return base64.RawURLEncoding.EncodeToString(hash[:16])
 }
 
 func KeyForWorkspace(batchChangeAttributes *template.BatchChangeAttributes, r batches.Repository, path string, onlyFetchWorkspace bool, steps []batches.Step) ExecutionKey {
@@ -185,6 +301,13 @@ func KeyForWorkspace(batchChangeAttributes *template.BatchChangeAttributes, r ba
 }
 
 func ChangesetSpecsFromCache(spec *batches.BatchSpec, r batches.Repository, result execution.Result) ([]*batches.ChangesetSpec, error) {
+	return ChangesetSpecsFromCacheWithReadFile(spec, r, result, nil)
+}
+
+// ChangesetSpecsFromCacheWithReadFile is like ChangesetSpecsFromCache, but
+// uses readFile to read files from the repository when rendering the changeset
+// template. If readFile is nil, templates reading files fail to render.
+func ChangesetSpecsFromCacheWithReadFile(spec *batches.BatchSpec, r batches.Repository, result execution.Result, readFile template.RepositoryFileReader) ([]*batches.ChangesetSpec, error) {
 	sort.Strings(r.FileMatches)
 
 	input := &batches.ChangesetSpecInput{
@@ -195,6 +318,7 @@ func ChangesetSpecsFromCache(spec *batches.BatchSpec, r batches.Repository, resu
 		},
 		Template:         spec.ChangesetTemplate,
 		TransformChanges: spec.TransformChanges,
+		ReadFile:         readFile,
 		Result:           result,
 	}
 
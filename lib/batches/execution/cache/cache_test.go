@@ -8,6 +8,7 @@ import (
 	"gopkg.in/yaml.v2"
 
 	"github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/batches/env"
 	"github.com/sourcegraph/sourcegraph/lib/batches/template"
 )
 
@@ -148,3 +149,137 @@ func TestExecutionKeyWithEnvResolution(t *testing.T) {
 		t.Errorf("unexpected change in key: initial=%q have=%q", initial, have)
 	}
 }
+
+func TestStepsCacheKey_UnchangedPrefix(t *testing.T) {
+	var steps []batches.Step
+	if err := yaml.Unmarshal([]byte(`
+- run: apk add --no-cache jq
+  container: alpine:3
+  files:
+    /tmp/script.sh: echo "hello"
+- run: echo ${{ batch_change.name }} >> README.md
+  container: alpine:3
+`), &steps); err != nil {
+		t.Fatal(err)
+	}
+
+	key := KeyForWorkspace(
+		&template.BatchChangeAttributes{Name: "first", Description: "first"},
+		batches.Repository{ID: "graphql-id", Name: "github.com/sourcegraph/src-cli", BaseRev: "c0mmit"},
+		"",
+		false,
+		steps,
+	)
+
+	stepKeys := func(key ExecutionKey) []string {
+		t.Helper()
+		keys := make([]string, len(key.Steps))
+		for i := range key.Steps {
+			k, err := StepsCacheKey{ExecutionKey: &key, StepIndex: i}.Key()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			keys[i] = k
+		}
+		return keys
+	}
+
+	initial := stepKeys(key)
+
+	// Changing the batch change attributes only invalidates the step that
+	// references them.
+	edited := key
+	edited.BatchChangeAttributes = &template.BatchChangeAttributes{Name: "second", Description: "first"}
+	have := stepKeys(edited)
+	if have[0] != initial[0] {
+		t.Errorf("unexpected change in key of step 0: initial=%q have=%q", initial[0], have[0])
+	}
+	if have[1] == initial[1] {
+		t.Errorf("unexpected lack of change in key of step 1: %q", have[1])
+	}
+
+	// Editing the last step leaves the key of the first one intact.
+	edited = key
+	edited.Steps = append([]batches.Step{}, steps...)
+	edited.Steps[1].Run = "echo changed >> README.md"
+	have = stepKeys(edited)
+	if have[0] != initial[0] {
+		t.Errorf("unexpected change in key of step 0: initial=%q have=%q", initial[0], have[0])
+	}
+	if have[1] == initial[1] {
+		t.Errorf("unexpected lack of change in key of step 1: %q", have[1])
+	}
+
+	// Changing the content of a mounted file invalidates the step and all
+	// following steps.
+	edited = key
+	edited.Steps = append([]batches.Step{}, steps...)
+	edited.Steps[0].Files = map[string]string{"/tmp/script.sh": `echo "bye"`}
+	have = stepKeys(edited)
+	for i := range have {
+		if have[i] == initial[i] {
+			t.Errorf("unexpected lack of change in key of step %d: %q", i, have[i])
+		}
+	}
+
+	// The results of steps contain the diff produced in the workspace, so
+	// every key changes in a different repository, at a different base
+	// revision or in a different workspace path, even if no step references
+	// them.
+	for name, edit := range map[string]func(key *ExecutionKey){
+		"repository name": func(key *ExecutionKey) { key.Repository.Name = "github.com/sourcegraph/sourcegraph" },
+		"repository ID":   func(key *ExecutionKey) { key.Repository.ID = "other-graphql-id" },
+		"base revision":   func(key *ExecutionKey) { key.Repository.BaseRev = "0therc0mmit" },
+		"workspace path":  func(key *ExecutionKey) { key.Path = "client/web" },
+	} {
+		edited = key
+		edit(&edited)
+		have = stepKeys(edited)
+		for i := range have {
+			if have[i] == initial[i] {
+				t.Errorf("unexpected lack of change in key of step %d after changing the %s: %q", i, name, have[i])
+			}
+		}
+	}
+}
+
+func TestStepKey(t *testing.T) {
+	step := batches.Step{
+		Run:       "echo $" + testExecutionCacheKeyEnv,
+		Container: "alpine:3",
+		Env:       env.Environment{},
+	}
+	if err := yaml.Unmarshal([]byte(`- `+testExecutionCacheKeyEnv), &step.Env); err != nil {
+		t.Fatal(err)
+	}
+
+	initial, err := StepKey(step, []string{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	have, err := StepKey(step, []string{testExecutionCacheKeyEnv + "_UNRELATED=foo"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if have != initial {
+		t.Errorf("unexpected change in key: initial=%q have=%q", initial, have)
+	}
+
+	have, err = StepKey(step, []string{testExecutionCacheKeyEnv + "=foo"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if have == initial {
+		t.Errorf("unexpected lack of change in key: %q", have)
+	}
+
+	step.Container = "alpine:4"
+	have, err = StepKey(step, []string{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if have == initial {
+		t.Errorf("unexpected lack of change in key: %q", have)
+	}
+}
@@ -0,0 +1,119 @@
+package batches
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// RolloutWave is a wave of a staged rollout. It contains either the
+// changesets in repositories matching Match, or Percent percent of all
+// changesets of the batch change.
+type RolloutWave struct {
+	Match   string `json:"match,omitempty" yaml:"match"`
+	Percent int    `json:"percent,omitempty" yaml:"percent"`
+	After   string `json:"after,omitempty" yaml:"after"`
+}
+
+// Delay returns how long to wait after the previous wave has been published
+// before the changesets in this wave are published.
+func (w RolloutWave) Delay() (time.Duration, error) {
+	if w.After == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(w.After)
+}
+
+func (w RolloutWave) matcher() (*regexp.Regexp, error) {
+	return regexp.Compile(strings.TrimPrefix(w.Match, "repo:"))
+}
+
+func (w RolloutWave) validate() error {
+	if (w.Match == "") == (w.Percent == 0) {
+		return errors.New("exactly one of match and percent must be set")
+	}
+	if w.Match != "" {
+		if _, err := w.matcher(); err != nil {
+			return errors.Wrap(err, "invalid match")
+		}
+	}
+	if d, err := w.Delay(); err != nil {
+		return errors.Wrap(err, "invalid after")
+	} else if d < 0 {
+		return errors.New("after must not be negative")
+	}
+	return nil
+}
+
+// AssignRolloutWaves returns the rollout wave of the changeset in each of the
+// given repositories. Waves are numbered starting at 1.
+//
+// existing holds the wave each changeset was assigned to before, or 0 for
+// changesets that haven't been assigned yet. It may be nil. Changesets keep
+// their previous wave, so re-applying a batch spec doesn't move changesets
+// between waves; previous waves past the end of the declared waves are
+// clamped to the final wave.
+//
+// Each new changeset is assigned to the first wave it belongs to: a wave
+// gets the changesets that match its pattern or, for percentage waves, the
+// first changesets in the given order until the wave holds its share of all
+// changesets. Changesets that don't belong to any declared wave are assigned
+// to an additional final wave. If no waves are declared, all changesets are
+// assigned wave 0.
+func AssignRolloutWaves(waves []RolloutWave, repos []string, existing []int32) ([]int32, error) {
+	assigned := make([]int32, len(repos))
+	if len(waves) == 0 {
+		return assigned, nil
+	}
+
+	final := int32(len(waves) + 1)
+	sizes := make(map[int32]int, len(waves)+1)
+	for j := range repos {
+		if j < len(existing) && existing[j] > 0 {
+			assigned[j] = existing[j]
+			if assigned[j] > final {
+				assigned[j] = final
+			}
+			sizes[assigned[j]]++
+		}
+	}
+
+	for i, wave := range waves {
+		n := int32(i + 1)
+
+		if wave.Match != "" {
+			re, err := wave.matcher()
+			if err != nil {
+				return nil, errors.Wrapf(err, "rollout wave %d", n)
+			}
+			for j, repo := range repos {
+				if assigned[j] == 0 && re.MatchString(repo) {
+					assigned[j] = n
+				}
+			}
+			continue
+		}
+
+		size := int(math.Ceil(float64(len(repos)*wave.Percent)/100)) - sizes[n]
+		for j := range repos {
+			if size <= 0 {
+				break
+			}
+			if assigned[j] == 0 {
+				assigned[j] = n
+				size--
+			}
+		}
+	}
+
+	for j := range assigned {
+		if assigned[j] == 0 {
+			assigned[j] = final
+		}
+	}
+
+	return assigned, nil
+}
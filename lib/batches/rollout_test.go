@@ -0,0 +1,97 @@
+package batches
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAssignRolloutWaves(t *testing.T) {
+	repos := []string{
+		"github.com/sourcegraph/core-api",
+		"github.com/sourcegraph/core-web",
+		"github.com/sourcegraph/docs",
+		"github.com/sourcegraph/infra",
+		"github.com/sourcegraph/tools",
+	}
+
+	tests := []struct {
+		name     string
+		waves    []RolloutWave
+		existing []int32
+		want     []int32
+	}{
+		{
+			name:  "no rollout",
+			waves: nil,
+			want:  []int32{0, 0, 0, 0, 0},
+		},
+		{
+			name: "match and percent",
+			waves: []RolloutWave{
+				{Match: "repo:/core-"},
+				{Percent: 40, After: "1h"},
+			},
+			want: []int32{1, 1, 2, 2, 3},
+		},
+		{
+			name: "percent rounds up",
+			waves: []RolloutWave{
+				{Percent: 10},
+				{Percent: 100},
+			},
+			want: []int32{1, 2, 2, 2, 2},
+		},
+		{
+			name: "earlier waves win",
+			waves: []RolloutWave{
+				{Match: "docs"},
+				{Match: "sourcegraph/"},
+			},
+			want: []int32{2, 2, 1, 2, 2},
+		},
+		{
+			name: "existing waves are kept",
+			waves: []RolloutWave{
+				{Match: "repo:/core-"},
+				{Percent: 40},
+			},
+			existing: []int32{3, 1, 0, 2, 0},
+			want:     []int32{3, 1, 2, 2, 3},
+		},
+		{
+			name: "existing waves count towards percentages",
+			waves: []RolloutWave{
+				{Percent: 40},
+			},
+			existing: []int32{0, 0, 0, 1, 1},
+			want:     []int32{2, 2, 2, 1, 1},
+		},
+		{
+			name: "existing waves are clamped to the final wave",
+			waves: []RolloutWave{
+				{Match: "docs"},
+			},
+			existing: []int32{5, 0, 0, 0, 1},
+			want:     []int32{2, 2, 1, 2, 1},
+		},
+		{
+			name:     "no rollout ignores existing waves",
+			waves:    nil,
+			existing: []int32{1, 2, 3, 0, 0},
+			want:     []int32{0, 0, 0, 0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			have, err := AssignRolloutWaves(tt.waves, repos, tt.existing)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, have); diff != "" {
+				t.Errorf("wrong waves (-want +have):\n%s", diff)
+			}
+		})
+	}
+}
@@ -153,7 +153,7 @@ const BatchSpecJSON = `{
                     },
                     {
                       "type": "object",
-                      "description": "An environment variable to set in the step environment: the value will be passed through from the environment src is running within.",
+                      "description": "An environment variable to set in the step environment: the key is used as the environment variable name and the value as the value.",
                       "additionalProperties": {
                         "type": "string"
                       },
@@ -250,6 +250,89 @@ const BatchSpecJSON = `{
         }
       }
     },
+    "autoMerge": {
+      "title": "AutoMerge",
+      "type": ["object", "null"],
+      "description": "A policy to automatically merge the changesets of this batch change once they meet the given requirements.",
+      "additionalProperties": false,
+      "properties": {
+        "reviewState": {
+          "type": "string",
+          "description": "The review state a changeset needs to have before it is merged. ANY merges changesets regardless of their review state.",
+          "enum": ["APPROVED", "ANY"],
+          "default": "APPROVED"
+        },
+        "checkState": {
+          "type": "string",
+          "description": "The state of the checks of a changeset before it is merged. ANY merges changesets regardless of their checks, which includes changesets in repositories without any checks.",
+          "enum": ["PASSED", "ANY"],
+          "default": "PASSED"
+        },
+        "method": {
+          "type": "string",
+          "description": "The method used to merge changesets.",
+          "enum": ["merge", "squash"],
+          "default": "merge"
+        },
+        "window": {
+          "title": "AutoMergeWindow",
+          "type": "object",
+          "description": "The time window in which changesets are merged. If omitted, changesets are merged as soon as they meet the requirements.",
+          "additionalProperties": false,
+          "properties": {
+            "start": {
+              "description": "Window start time in UTC. If omitted, no time window is applied to the day(s) that match this rule.",
+              "type": "string",
+              "pattern": "^[0-9]?[0-9]:[0-9]{2}$"
+            },
+            "end": {
+              "description": "Window end time in UTC. If omitted, no time window is applied to the day(s) that match this rule.",
+              "type": "string",
+              "pattern": "^[0-9]?[0-9]:[0-9]{2}$"
+            },
+            "days": {
+              "description": "Day(s) the window applies to. If omitted, this rule applies to all days of the week.",
+              "type": "array",
+              "items": {
+                "type": "string",
+                "pattern": "^([mM]on(day)?|[tT]ue(s|sday)?|[wW]ed(nesday)?|[tT]hu(r|rs|rsday)?|[fF]ri(day)?|[sS]at(urday)?|[sS]un(day)?)$"
+              }
+            }
+          },
+          "dependencies": {
+            "start": ["end"]
+          }
+        }
+      }
+    },
+    "rollout": {
+      "type": "array",
+      "description": "A staged rollout of the changesets of this batch change. Changesets are published wave by wave, and the next wave is only published once all changesets in the previous waves have been published and none of them has failing checks. Changesets that don't belong to any wave are published in a final wave.",
+      "items": {
+        "title": "RolloutWave",
+        "type": "object",
+        "additionalProperties": false,
+        "properties": {
+          "match": {
+            "type": "string",
+            "description": "A regular expression, optionally prefixed with \"repo:\", matching the names of the repositories whose changesets are published in this wave.",
+            "examples": ["repo:^github.com/my-org/core-"]
+          },
+          "percent": {
+            "type": "integer",
+            "description": "The percentage of all changesets of the batch change that are published in this wave, ordered by repository name.",
+            "minimum": 1,
+            "maximum": 100
+          },
+          "after": {
+            "type": "string",
+            "description": "How long to wait after the previous wave has been published before publishing this wave, as a Go duration.",
+            "examples": ["1h", "30m"]
+          }
+        },
+        "oneOf": [{ "required": ["match"] }, { "required": ["percent"] }]
+      }
+    },
     "changesetTemplate": {
       "type": "object",
       "description": "A template describing how to create (and update) changesets with the file changes produced by the command steps.",
@@ -299,6 +382,25 @@ const BatchSpecJSON = `{
             }
           }
         },
+        "reviewers": {
+          "type": "array",
+          "description": "The users to request reviews of the changeset from. Each entry is rendered as a template and split on whitespace and commas, so that a single entry can produce multiple reviewers, such as the owners of the changed files from ` + "`" + `${{ repo.owners steps.path }}` + "`" + `. Reviewers are requested on GitHub, GitLab and Bitbucket Server. Publishing a changeset with reviewers to other code hosts fails.",
+          "items": {
+            "type": "string"
+          }
+        },
+        "labels": {
+          "type": "array",
+          "description": "The labels to add to the changeset. Each entry is rendered as a template. Labels are added on GitHub and GitLab. Publishing a changeset with labels to other code hosts fails.",
+          "items": {
+            "type": "string"
+          }
+        },
+        "fork": {
+          "type": "boolean",
+          "description": "Whether to push the changes to a fork of the repository in the namespace of the user applying the batch change, instead of to the repository itself. This allows users without push access to the repository to publish changesets. Only supported on GitHub and GitLab.",
+          "default": false
+        },
         "published": {
           "description": "Whether to publish the changeset. An unpublished changeset can be previewed on Sourcegraph by any person who can view the batch change, but its commit, branch, and pull request aren't created on the code host. A published changeset results in a commit, branch, and pull request being created on the code host. If omitted, the publication state is controlled from the Batch Changes UI.",
           "oneOf": [
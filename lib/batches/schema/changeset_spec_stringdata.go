@@ -92,6 +92,20 @@ const ChangesetSpecJSON = `{
             }
           }
         },
+        "reviewers": {
+          "type": "array",
+          "description": "The usernames of the users to request reviews of the changeset from.",
+          "items": { "type": "string" }
+        },
+        "labels": {
+          "type": "array",
+          "description": "The labels to add to the changeset.",
+          "items": { "type": "string" }
+        },
+        "fork": {
+          "type": "boolean",
+          "description": "Whether to push the head ref to a fork of the base repository in the namespace of the user publishing the changeset, and open a cross-repository changeset from there."
+        },
         "published": {
           "oneOf": [{ "type": "boolean" }, { "type": "string", "pattern": "^draft$" }, { "type": "null" }],
           "description": "Whether to publish the changeset. An unpublished changeset can be previewed on Sourcegraph by any person who can view the batch change, but its commit, branch, and pull request aren't created on the code host. A published changeset results in a commit, branch, and pull request being created on the code host."
@@ -0,0 +1,98 @@
+package template
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gobwas/glob"
+)
+
+// codeownersPaths are the locations in which we look for a CODEOWNERS file,
+// in the order GitHub and GitLab look for them.
+var codeownersPaths = []string{
+	".github/CODEOWNERS",
+	"CODEOWNERS",
+	"docs/CODEOWNERS",
+	".gitlab/CODEOWNERS",
+}
+
+type codeownersRule struct {
+	pattern glob.Glob
+	owners  []string
+}
+
+// parseCodeowners parses the content of a CODEOWNERS file. GitLab section
+// headers are ignored, so rules in sections are treated like all other rules.
+func parseCodeowners(content []byte) ([]codeownersRule, error) {
+	var rules []codeownersRule
+
+	s := bufio.NewScanner(bytes.NewReader(content))
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "[") || strings.HasPrefix(line, "^[") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		pattern, err := compileCodeownersPattern(fields[0])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid CODEOWNERS pattern %q", fields[0])
+		}
+
+		owners := make([]string, 0, len(fields)-1)
+		for _, owner := range fields[1:] {
+			if strings.HasPrefix(owner, "#") {
+				break
+			}
+			owners = append(owners, strings.TrimPrefix(owner, "@"))
+		}
+
+		rules = append(rules, codeownersRule{pattern: pattern, owners: owners})
+	}
+
+	return rules, s.Err()
+}
+
+// compileCodeownersPattern compiles a gitignore-style CODEOWNERS pattern into
+// a glob that matches the paths it applies to, relative to the repository
+// root.
+func compileCodeownersPattern(pattern string) (glob.Glob, error) {
+	// Patterns with a slash at the beginning or in the middle are relative to
+	// the repository root, all others match at any depth.
+	anchored := strings.Contains(strings.TrimSuffix(pattern, "/"), "/")
+
+	p := strings.TrimPrefix(pattern, "/")
+	dirOnly := strings.HasSuffix(p, "/")
+	p = strings.TrimSuffix(p, "/")
+
+	bases := []string{p}
+	if !anchored {
+		bases = append(bases, "**/"+p)
+	}
+
+	var alternatives []string
+	for _, base := range bases {
+		// A pattern matching a directory also matches everything in it.
+		if !dirOnly {
+			alternatives = append(alternatives, base)
+		}
+		alternatives = append(alternatives, base+"/**")
+	}
+
+	return glob.Compile("{"+strings.Join(alternatives, ",")+"}", '/')
+}
+
+// codeownersFor returns the owners of the given path according to the rules.
+// As in git, the last matching rule wins.
+func codeownersFor(rules []codeownersRule, path string) []string {
+	path = strings.TrimPrefix(path, "/")
+
+	for i := len(rules) - 1; i >= 0; i-- {
+		if rules[i].pattern.Match(path) {
+			return rules[i].owners
+		}
+	}
+	return nil
+}
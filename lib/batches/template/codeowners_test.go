@@ -0,0 +1,43 @@
+package template
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCodeownersFor(t *testing.T) {
+	rules, err := parseCodeowners([]byte(`# Fallback owners.
+*          @everyone
+
+[Docs]
+*.md       @docs-team
+/docs/     @writer docs@example.com
+
+build/     @release # Release engineering
+/cmd/*.go  @alice
+internal/db/migrations @dba
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for path, want := range map[string][]string{
+		"main.go":                         {"everyone"},
+		"README.md":                       {"docs-team"},
+		"pkg/README.md":                   {"docs-team"},
+		"docs/index.md":                   {"writer", "docs@example.com"},
+		"pkg/docs/index.md":               {"docs-team"},
+		"build/Dockerfile":                {"release"},
+		"tools/build/run.sh":              {"release"},
+		"cmd/main.go":                     {"alice"},
+		"cmd/server/main.go":              {"everyone"},
+		"/cmd/main.go":                    {"alice"},
+		"internal/db/migrations/1_up.sql": {"dba"},
+		"other/internal/db/migrations/x":  {"everyone"},
+	} {
+		if diff := cmp.Diff(want, codeownersFor(rules, path)); diff != "" {
+			t.Errorf("wrong owners for %q (-want +got):\n%s", path, diff)
+		}
+	}
+}
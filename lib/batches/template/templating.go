@@ -3,9 +3,11 @@ package template
 import (
 	"bytes"
 	"io"
+	"io/fs"
 	"sort"
 	"strings"
 	"text/template"
+	"text/template/parse"
 
 	"github.com/cockroachdb/errors"
 	"github.com/gobwas/glob"
@@ -65,6 +67,113 @@ func RenderStepTemplate(name, tmpl string, out io.Writer, stepCtx *StepContext)
 	return t.Execute(out, stepCtx)
 }
 
+// StepTemplateReferences parses tmpl as a step template and returns the
+// template variables it references. A variable is returned by its name when
+// it's used as a whole, and as "name.field" when one of its fields is
+// accessed, e.g. "steps.path". Fields of the StepContext accessed through the dot, such as
+// ".Repository.Name", are returned under the name of the matching variable.
+// If the template uses the dot itself, "." is returned, since it can
+// reference all of the StepContext.
+func StepTemplateReferences(tmpl string) (map[string]bool, error) {
+	t, err := template.New("references").Delims(startDelim, endDelim).Funcs(builtins).Funcs((&StepContext{}).ToFuncMap()).Parse(tmpl)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing step template")
+	}
+
+	refs := map[string]bool{}
+	for _, tt := range t.Templates() {
+		if tt.Tree != nil {
+			collectReferences(tt.Tree.Root, refs)
+		}
+	}
+	return refs, nil
+}
+
+func collectReferences(node parse.Node, refs map[string]bool) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, c := range n.Nodes {
+			collectReferences(c, refs)
+		}
+	case *parse.ActionNode:
+		collectReferences(n.Pipe, refs)
+	case *parse.IfNode:
+		collectBranchReferences(&n.BranchNode, refs)
+	case *parse.RangeNode:
+		collectBranchReferences(&n.BranchNode, refs)
+	case *parse.WithNode:
+		collectBranchReferences(&n.BranchNode, refs)
+	case *parse.TemplateNode:
+		collectReferences(n.Pipe, refs)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, c := range n.Cmds {
+			collectReferences(c, refs)
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			collectReferences(arg, refs)
+		}
+	case *parse.ChainNode:
+		if ident, ok := n.Node.(*parse.IdentifierNode); ok && len(n.Field) > 0 {
+			if stepVariables[ident.Ident] {
+				refs[ident.Ident+"."+n.Field[0]] = true
+			}
+			return
+		}
+		collectReferences(n.Node, refs)
+	case *parse.IdentifierNode:
+		// Identifiers are also used for builtin functions, which don't
+		// reference anything.
+		if stepVariables[n.Ident] {
+			refs[n.Ident] = true
+		}
+	case *parse.FieldNode:
+		name, ok := stepContextFields[n.Ident[0]]
+		if !ok {
+			refs["."] = true
+			return
+		}
+		if len(n.Ident) == 1 {
+			refs[name] = true
+			return
+		}
+		refs[name+"."+strings.ToLower(n.Ident[1])] = true
+	case *parse.DotNode, *parse.VariableNode:
+		refs["."] = true
+	}
+}
+
+// stepContextFields maps the fields of StepContext to the names of the
+// template variables giving access to them.
+var stepContextFields = map[string]string{
+	"BatchChange":  "batch_change",
+	"Outputs":      "outputs",
+	"Step":         "step",
+	"Steps":        "steps",
+	"PreviousStep": "previous_step",
+	"Repository":   "repository",
+}
+
+var stepVariables = func() map[string]bool {
+	vars := map[string]bool{}
+	for name := range (&StepContext{}).ToFuncMap() {
+		vars[name] = true
+	}
+	return vars
+}()
+
+func collectBranchReferences(n *parse.BranchNode, refs map[string]bool) {
+	collectReferences(n.Pipe, refs)
+	collectReferences(n.List, refs)
+	collectReferences(n.ElseList, refs)
+}
+
 func RenderStepMap(m map[string]string, stepCtx *StepContext) (map[string]string, error) {
 	rendered := make(map[string]string, len(m))
 
@@ -206,8 +315,60 @@ type ChangesetTemplateContext struct {
 
 	// Repository is the repository in which the steps were executed.
 	Repository Repository
+
+	// ReadFile reads files from the repository at the revision the changeset
+	// is based on. If it is nil, the repo.file and repo.owners functions
+	// return an error.
+	ReadFile RepositoryFileReader
+}
+
+// RepositoryFileReader returns the content of the file at the given path,
+// relative to the repository root. If the file doesn't exist, the returned
+// error wraps fs.ErrNotExist.
+type RepositoryFileReader func(path string) ([]byte, error)
+
+// readFile reads the file at the given path from the repository. A file that
+// doesn't exist is returned as nil content, without an error.
+func (tmplCtx *ChangesetTemplateContext) readFile(path string) ([]byte, error) {
+	if tmplCtx.ReadFile == nil {
+		return nil, errors.New("reading files from the repository is not supported")
+	}
+
+	content, err := tmplCtx.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "reading %q", path)
+	}
+	return content, nil
+}
+
+// owners returns the owners of the given path according to the first
+// CODEOWNERS file found in the repository.
+func (tmplCtx *ChangesetTemplateContext) owners(path string) (ownerList, error) {
+	for _, p := range codeownersPaths {
+		content, err := tmplCtx.readFile(p)
+		if err != nil {
+			return nil, err
+		}
+		if content == nil {
+			continue
+		}
+
+		rules, err := parseCodeowners(content)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing %s", p)
+		}
+		return ownerList(codeownersFor(rules, path)), nil
+	}
+	return nil, nil
 }
 
+type ownerList []string
+
+func (o ownerList) String() string { return strings.Join(o, " ") }
+
 // ToFuncMap returns a template.FuncMap to access fields on the StepContext in a
 // text/template.
 func (tmplCtx *ChangesetTemplateContext) ToFuncMap() template.FuncMap {
@@ -240,9 +401,79 @@ func (tmplCtx *ChangesetTemplateContext) ToFuncMap() template.FuncMap {
 				"path":           tmplCtx.Steps.Path,
 			}
 		},
+		// repo only exists so that templates calling repo.file and
+		// repo.owners parse, see rewriteRepoFuncs.
+		"repo": func() map[string]interface{} {
+			return map[string]interface{}{}
+		},
+		repoFuncs["file"]: func(path string) (string, error) {
+			content, err := tmplCtx.readFile(path)
+			return string(content), err
+		},
+		repoFuncs["owners"]: tmplCtx.owners,
 	}
 }
 
+// repoFuncs maps the functions that changeset templates call as fields of
+// repo, such as `repo.file "VERSION"`, to the names of the template functions
+// implementing them. text/template can't pass arguments to a function that's
+// accessed as a field, so rewriteRepoFuncs replaces these calls with direct
+// calls of the implementing functions after parsing.
+var repoFuncs = map[string]string{
+	"file":   "_repo_file",
+	"owners": "_repo_owners",
+}
+
+// rewriteRepoFuncs replaces the calls of repo functions in the given parse
+// tree with calls of the template functions implementing them. See repoFuncs.
+func rewriteRepoFuncs(node parse.Node) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, c := range n.Nodes {
+			rewriteRepoFuncs(c)
+		}
+	case *parse.ActionNode:
+		rewriteRepoFuncs(n.Pipe)
+	case *parse.IfNode:
+		rewriteBranchRepoFuncs(&n.BranchNode)
+	case *parse.RangeNode:
+		rewriteBranchRepoFuncs(&n.BranchNode)
+	case *parse.WithNode:
+		rewriteBranchRepoFuncs(&n.BranchNode)
+	case *parse.TemplateNode:
+		rewriteRepoFuncs(n.Pipe)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, c := range n.Cmds {
+			rewriteRepoFuncs(c)
+		}
+	case *parse.CommandNode:
+		if chain, ok := n.Args[0].(*parse.ChainNode); ok && len(chain.Field) == 1 {
+			if ident, ok := chain.Node.(*parse.IdentifierNode); ok && ident.Ident == "repo" {
+				if name, ok := repoFuncs[chain.Field[0]]; ok {
+					n.Args[0] = parse.NewIdentifier(name).SetPos(chain.Pos)
+				}
+			}
+		}
+		for _, arg := range n.Args {
+			rewriteRepoFuncs(arg)
+		}
+	case *parse.ChainNode:
+		rewriteRepoFuncs(n.Node)
+	}
+}
+
+func rewriteBranchRepoFuncs(n *parse.BranchNode) {
+	rewriteRepoFuncs(n.Pipe)
+	rewriteRepoFuncs(n.List)
+	rewriteRepoFuncs(n.ElseList)
+}
+
 func RenderChangesetTemplateField(name, tmpl string, tmplCtx *ChangesetTemplateContext) (string, error) {
 	var out bytes.Buffer
 
@@ -250,6 +481,11 @@ func RenderChangesetTemplateField(name, tmpl string, tmplCtx *ChangesetTemplateC
 	if err != nil {
 		return "", err
 	}
+	for _, tt := range t.Templates() {
+		if tt.Tree != nil {
+			rewriteRepoFuncs(tt.Tree.Root)
+		}
+	}
 
 	if err := t.Execute(&out, tmplCtx); err != nil {
 		return "", err
@@ -2,6 +2,7 @@ package template
 
 import (
 	"bytes"
+	"io/fs"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -231,6 +232,42 @@ ${{ steps.path }}
 	}
 }
 
+func TestStepTemplateReferences(t *testing.T) {
+	tests := []struct {
+		tmpl    string
+		want    map[string]bool
+		wantErr bool
+	}{
+		{tmpl: `apk add --no-cache jq`, want: map[string]bool{}},
+		{tmpl: `echo ${{ repository.name }}`, want: map[string]bool{"repository.name": true}},
+		{
+			tmpl: `${{ if eq steps.path "" }}${{ join repository.search_result_paths " " }}${{ end }}`,
+			want: map[string]bool{"steps.path": true, "repository.search_result_paths": true},
+		},
+		{tmpl: `${{ batch_change.name }} ${{ outputs }}`, want: map[string]bool{"batch_change.name": true, "outputs": true}},
+		{tmpl: `${{ .Repository.Name }} ${{ .Steps }}`, want: map[string]bool{"repository.name": true, "steps": true}},
+		{tmpl: `${{ range $p := previous_step.modified_files }}${{ $p }}${{ end }}`, want: map[string]bool{"previous_step.modified_files": true, ".": true}},
+		{tmpl: `${{ . }}`, want: map[string]bool{".": true}},
+		{tmpl: `${{ repository.name `, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		have, err := StepTemplateReferences(tc.tmpl)
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("%q: no error returned", tc.tmpl)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(tc.want, have); diff != "" {
+			t.Errorf("%q: wrong references (-want +have):\n%s", tc.tmpl, diff)
+		}
+	}
+}
+
 func TestRenderStepMap(t *testing.T) {
 	stepCtx := &StepContext{
 		PreviousStep: execution.StepResult{
@@ -346,6 +383,32 @@ ${{ steps.renamed_files }}
 []
 []`,
 		},
+		{
+			name: "repository files",
+			tmplCtx: &ChangesetTemplateContext{
+				ReadFile: func(path string) ([]byte, error) {
+					switch path {
+					case "VERSION":
+						return []byte("1.2.3"), nil
+					case ".github/CODEOWNERS":
+						return []byte("* @everyone\n/cmd/ @alice @org/team\n"), nil
+					default:
+						return nil, &fs.PathError{Op: "open", Path: path, Err: fs.ErrNotExist}
+					}
+				},
+			},
+			tmpl: `${{ repo.file "VERSION" }}
+${{ repo.file "missing.txt" }}
+${{ repo.owners "cmd/main.go" }}
+${{ join (repo.owners "README.md") "," }}
+${{ if eq (repo.file "VERSION") "1.2.3" }}${{ repo.owners "cmd/" | len }}${{ end }}
+`,
+			want: `1.2.3
+
+alice org/team
+everyone
+2`,
+		},
 	}
 
 	for _, tc := range tests {
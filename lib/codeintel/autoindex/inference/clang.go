@@ -0,0 +1,90 @@
+package inference
+
+import (
+	"path/filepath"
+	"regexp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func ClangPatterns() []*regexp.Regexp {
+	return []*regexp.Regexp{
+		pathPattern(rawPattern("compile_commands.json")),
+		pathPattern(rawPattern("CMakeLists.txt")),
+	}
+}
+
+const lsifClangImage = "sourcegraph/lsif-clang:latest"
+
+// cmakeBuildDir is the directory, relative to the root of a CMake project,
+// into which the compilation database is generated.
+const cmakeBuildDir = "build"
+
+func InferClangIndexJobs(gitclient GitClient, paths []string) (indexes []config.IndexJob) {
+	// A compilation database checked into the repository describes exactly how
+	// each file is compiled, so we prefer it over generating our own.
+	for _, path := range paths {
+		if !isCompilationDatabasePath(path) {
+			continue
+		}
+
+		indexes = append(indexes, config.IndexJob{
+			Steps:       nil,
+			Root:        "",
+			Indexer:     lsifClangImage,
+			IndexerArgs: []string{"lsif-clang", path},
+			Outfile:     "dump.lsif",
+		})
+	}
+	if len(indexes) > 0 {
+		return indexes
+	}
+
+	for _, path := range paths {
+		if !isCMakeProjectPath(path, paths) {
+			continue
+		}
+
+		root := dirWithoutDot(path)
+
+		dockerSteps := []config.DockerStep{
+			{
+				Root:     root,
+				Image:    lsifClangImage,
+				Commands: []string{"cmake -B " + cmakeBuildDir + " -DCMAKE_EXPORT_COMPILE_COMMANDS=ON"},
+			},
+		}
+
+		indexes = append(indexes, config.IndexJob{
+			Steps:       dockerSteps,
+			Root:        root,
+			Indexer:     lsifClangImage,
+			IndexerArgs: []string{"lsif-clang", filepath.Join(cmakeBuildDir, "compile_commands.json")},
+			Outfile:     "dump.lsif",
+		})
+	}
+
+	return indexes
+}
+
+var clangSegmentBlockList = append([]string{"third_party", "vendor"}, segmentBlockList...)
+
+func isCompilationDatabasePath(path string) bool {
+	return filepath.Base(path) == "compile_commands.json" && containsNoSegments(path, clangSegmentBlockList...)
+}
+
+// isCMakeProjectPath returns true if the given path is a CMakeLists.txt file of
+// a top-level CMake project. CMakeLists.txt files in directories whose parent
+// directory also contains one are usually included via add_subdirectory, and
+// are built as part of the parent project.
+func isCMakeProjectPath(path string, paths []string) bool {
+	if filepath.Base(path) != "CMakeLists.txt" || !containsNoSegments(path, clangSegmentBlockList...) {
+		return false
+	}
+
+	dir := dirWithoutDot(path)
+	if dir == "" {
+		return true
+	}
+	return !contains(paths, filepath.Join(dirWithoutDot(dir), "CMakeLists.txt"))
+}
@@ -0,0 +1,84 @@
+package inference
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func TestClangPatterns(t *testing.T) {
+	testLangPatterns(t, ClangPatterns(), []PathTestCase{
+		{"compile_commands.json", true},
+		{"build/compile_commands.json", true},
+		{"CMakeLists.txt", true},
+		{"subdir/CMakeLists.txt", true},
+		{"CMakeLists.txt.in", false},
+		{"main.cpp", false},
+	})
+}
+
+func TestInferClangIndexJobsCompilationDatabase(t *testing.T) {
+	paths := []string{
+		"CMakeLists.txt",
+		"build/compile_commands.json",
+		"third_party/zlib/compile_commands.json",
+	}
+
+	expectedIndexJobs := []config.IndexJob{
+		{
+			Steps:       nil,
+			Root:        "",
+			Indexer:     lsifClangImage,
+			IndexerArgs: []string{"lsif-clang", "build/compile_commands.json"},
+			Outfile:     "dump.lsif",
+		},
+	}
+	if diff := cmp.Diff(expectedIndexJobs, InferClangIndexJobs(NewMockGitClient(), paths)); diff != "" {
+		t.Errorf("unexpected index jobs (-want +got):\n%s", diff)
+	}
+}
+
+func TestInferClangIndexJobsCMake(t *testing.T) {
+	paths := []string{
+		"CMakeLists.txt",
+		"src/CMakeLists.txt",
+		"src/lib/CMakeLists.txt",
+		"tools/cli/CMakeLists.txt",
+		"third_party/zlib/CMakeLists.txt",
+		"tests/CMakeLists.txt",
+	}
+
+	expectedIndexJobs := []config.IndexJob{
+		{
+			Steps: []config.DockerStep{
+				{
+					Root:     "",
+					Image:    lsifClangImage,
+					Commands: []string{"cmake -B build -DCMAKE_EXPORT_COMPILE_COMMANDS=ON"},
+				},
+			},
+			Root:        "",
+			Indexer:     lsifClangImage,
+			IndexerArgs: []string{"lsif-clang", "build/compile_commands.json"},
+			Outfile:     "dump.lsif",
+		},
+		{
+			Steps: []config.DockerStep{
+				{
+					Root:     "tools/cli",
+					Image:    lsifClangImage,
+					Commands: []string{"cmake -B build -DCMAKE_EXPORT_COMPILE_COMMANDS=ON"},
+				},
+			},
+			Root:        "tools/cli",
+			Indexer:     lsifClangImage,
+			IndexerArgs: []string{"lsif-clang", "build/compile_commands.json"},
+			Outfile:     "dump.lsif",
+		},
+	}
+	if diff := cmp.Diff(expectedIndexJobs, InferClangIndexJobs(NewMockGitClient(), paths)); diff != "" {
+		t.Errorf("unexpected index jobs (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,76 @@
+package inference
+
+import (
+	"path/filepath"
+	"regexp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func PythonPatterns() []*regexp.Regexp {
+	return []*regexp.Regexp{
+		pathPattern(rawPattern("setup.py")),
+		pathPattern(rawPattern("pyproject.toml")),
+		pathPattern(rawPattern("requirements.txt")),
+	}
+}
+
+const lsifPyImage = "sourcegraph/lsif-py:latest"
+
+func InferPythonIndexJobs(gitclient GitClient, paths []string) (indexes []config.IndexJob) {
+	for _, root := range pythonProjectRoots(paths) {
+		// Dependencies are installed so that lsif-py can resolve symbols
+		// imported from third-party packages. A requirements.txt file is
+		// preferred as it usually pins the exact versions used to develop
+		// the project.
+		command := "pip install ."
+		if contains(paths, filepath.Join(root, "requirements.txt")) {
+			command = "pip install -r requirements.txt"
+		}
+
+		dockerSteps := []config.DockerStep{
+			{
+				Root:     root,
+				Image:    lsifPyImage,
+				Commands: []string{command},
+			},
+		}
+
+		indexes = append(indexes, config.IndexJob{
+			Steps:       dockerSteps,
+			Root:        root,
+			Indexer:     lsifPyImage,
+			IndexerArgs: []string{"lsif-py", ".", "--file", "dump.lsif"},
+			Outfile:     "dump.lsif",
+		})
+	}
+
+	return indexes
+}
+
+// pythonProjectRoots returns the directories containing a setup.py,
+// pyproject.toml or requirements.txt file, in the order of their first
+// occurrence in paths.
+func pythonProjectRoots(paths []string) (roots []string) {
+	for _, path := range paths {
+		if !isPythonProjectPath(path) {
+			continue
+		}
+
+		if root := dirWithoutDot(path); !contains(roots, root) {
+			roots = append(roots, root)
+		}
+	}
+
+	return roots
+}
+
+var pythonSegmentBlockList = append([]string{"venv", ".venv", "site-packages"}, segmentBlockList...)
+
+func isPythonProjectPath(path string) bool {
+	switch filepath.Base(path) {
+	case "setup.py", "pyproject.toml", "requirements.txt":
+		return containsNoSegments(path, pythonSegmentBlockList...)
+	}
+	return false
+}
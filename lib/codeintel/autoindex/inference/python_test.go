@@ -0,0 +1,79 @@
+package inference
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func TestPythonPatterns(t *testing.T) {
+	testLangPatterns(t, PythonPatterns(), []PathTestCase{
+		{"setup.py", true},
+		{"pyproject.toml", true},
+		{"requirements.txt", true},
+		{"subdir/setup.py", true},
+		{"subdir/requirements.txt", true},
+		{"dev-requirements.txt", false},
+		{"setup.py/subdir", false},
+		{"main.py", false},
+	})
+}
+
+func TestInferPythonIndexJobs(t *testing.T) {
+	paths := []string{
+		"setup.py",
+		"requirements.txt",
+		"a/pyproject.toml",
+		"b/setup.py",
+		"b/requirements.txt",
+		"venv/lib/python3.9/site-packages/six/setup.py",
+		"tests/fixtures/requirements.txt",
+	}
+
+	expectedIndexJobs := []config.IndexJob{
+		{
+			Steps: []config.DockerStep{
+				{
+					Root:     "",
+					Image:    lsifPyImage,
+					Commands: []string{"pip install -r requirements.txt"},
+				},
+			},
+			Root:        "",
+			Indexer:     lsifPyImage,
+			IndexerArgs: []string{"lsif-py", ".", "--file", "dump.lsif"},
+			Outfile:     "dump.lsif",
+		},
+		{
+			Steps: []config.DockerStep{
+				{
+					Root:     "a",
+					Image:    lsifPyImage,
+					Commands: []string{"pip install ."},
+				},
+			},
+			Root:        "a",
+			Indexer:     lsifPyImage,
+			IndexerArgs: []string{"lsif-py", ".", "--file", "dump.lsif"},
+			Outfile:     "dump.lsif",
+		},
+		{
+			Steps: []config.DockerStep{
+				{
+					Root:     "b",
+					Image:    lsifPyImage,
+					Commands: []string{"pip install -r requirements.txt"},
+				},
+			},
+			Root:        "b",
+			Indexer:     lsifPyImage,
+			IndexerArgs: []string{"lsif-py", ".", "--file", "dump.lsif"},
+			Outfile:     "dump.lsif",
+		},
+	}
+	if diff := cmp.Diff(expectedIndexJobs, InferPythonIndexJobs(NewMockGitClient(), paths)); diff != "" {
+		t.Errorf("unexpected index jobs (-want +got):\n%s", diff)
+	}
+}
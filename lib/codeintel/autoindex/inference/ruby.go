@@ -0,0 +1,60 @@
+package inference
+
+import (
+	"path/filepath"
+	"regexp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func RubyPatterns() []*regexp.Regexp {
+	return []*regexp.Regexp{
+		pathPattern(rawPattern("Gemfile")),
+		extensionPattern(rawPattern("gemspec")),
+	}
+}
+
+const lsifRubyImage = "sourcegraph/lsif-ruby:latest"
+
+func InferRubyIndexJobs(gitclient GitClient, paths []string) (indexes []config.IndexJob) {
+	var roots []string
+	for _, path := range paths {
+		if !isRubyProjectPath(path) {
+			continue
+		}
+
+		if root := dirWithoutDot(path); !contains(roots, root) {
+			roots = append(roots, root)
+		}
+	}
+
+	for _, root := range roots {
+		// Gems are only installed for projects with a Gemfile. A gemspec on
+		// its own describes a gem, but doesn't tell bundler where to find
+		// its dependencies.
+		var dockerSteps []config.DockerStep
+		if contains(paths, filepath.Join(root, "Gemfile")) {
+			dockerSteps = append(dockerSteps, config.DockerStep{
+				Root:     root,
+				Image:    lsifRubyImage,
+				Commands: []string{"bundle install"},
+			})
+		}
+
+		indexes = append(indexes, config.IndexJob{
+			Steps:       dockerSteps,
+			Root:        root,
+			Indexer:     lsifRubyImage,
+			IndexerArgs: []string{"lsif-ruby", "index"},
+			Outfile:     "dump.lsif",
+		})
+	}
+
+	return indexes
+}
+
+var rubySegmentBlockList = append([]string{"vendor"}, segmentBlockList...)
+
+func isRubyProjectPath(path string) bool {
+	return (filepath.Base(path) == "Gemfile" || filepath.Ext(path) == ".gemspec") && containsNoSegments(path, rubySegmentBlockList...)
+}
@@ -0,0 +1,57 @@
+package inference
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func TestRubyPatterns(t *testing.T) {
+	testLangPatterns(t, RubyPatterns(), []PathTestCase{
+		{"Gemfile", true},
+		{"subdir/Gemfile", true},
+		{"foo.gemspec", true},
+		{"subdir/foo.gemspec", true},
+		{"Gemfile.lock", false},
+		{"lib/foo.rb", false},
+	})
+}
+
+func TestInferRubyIndexJobs(t *testing.T) {
+	paths := []string{
+		"Gemfile",
+		"Gemfile.lock",
+		"rails.gemspec",
+		"engines/admin/admin.gemspec",
+		"vendor/bundle/ruby/gems/rake/rake.gemspec",
+		"test/fixtures/Gemfile",
+	}
+
+	expectedIndexJobs := []config.IndexJob{
+		{
+			Steps: []config.DockerStep{
+				{
+					Root:     "",
+					Image:    lsifRubyImage,
+					Commands: []string{"bundle install"},
+				},
+			},
+			Root:        "",
+			Indexer:     lsifRubyImage,
+			IndexerArgs: []string{"lsif-ruby", "index"},
+			Outfile:     "dump.lsif",
+		},
+		{
+			Steps:       nil,
+			Root:        "engines/admin",
+			Indexer:     lsifRubyImage,
+			IndexerArgs: []string{"lsif-ruby", "index"},
+			Outfile:     "dump.lsif",
+		},
+	}
+	if diff := cmp.Diff(expectedIndexJobs, InferRubyIndexJobs(NewMockGitClient(), paths)); diff != "" {
+		t.Errorf("unexpected index jobs (-want +got):\n%s", diff)
+	}
+}
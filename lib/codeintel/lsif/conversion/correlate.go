@@ -185,6 +185,7 @@ var vertexHandlers = map[string]func(state *wrappedState, element Element) error
 	"resultSet":            correlateResultSet,
 	"definitionResult":     correlateDefinitionResult,
 	"referenceResult":      correlateReferenceResult,
+	"typeDefinitionResult": correlateTypeDefinitionResult,
 	"implementationResult": correlateImplementationResult,
 	"hoverResult":          correlateHoverResult,
 	"moniker":              correlateMoniker,
@@ -218,6 +219,7 @@ var edgeHandlers = map[string]func(state *wrappedState, id int, edge Edge) error
 	"item":                        correlateItemEdge,
 	"textDocument/definition":     correlateTextDocumentDefinitionEdge,
 	"textDocument/references":     correlateTextDocumentReferencesEdge,
+	"textDocument/typeDefinition": correlateTextDocumentTypeDefinitionEdge,
 	"textDocument/implementation": correlateTextDocumentImplementationEdge,
 	"textDocument/hover":          correlateTextDocumentHoverEdge,
 	"moniker":                     correlateMonikerEdge,
@@ -319,6 +321,11 @@ func correlateReferenceResult(state *wrappedState, element Element) error {
 	return nil
 }
 
+func correlateTypeDefinitionResult(state *wrappedState, element Element) error {
+	state.TypeDefinitionData[element.ID] = datastructures.NewDefaultIDSetMap()
+	return nil
+}
+
 func correlateImplementationResult(state *wrappedState, element Element) error {
 	state.ImplementationData[element.ID] = datastructures.NewDefaultIDSetMap()
 	return nil
@@ -430,6 +437,19 @@ func correlateItemEdge(state *wrappedState, id int, edge Edge) error {
 		return nil
 	}
 
+	if documentMap, ok := state.TypeDefinitionData[edge.OutV]; ok {
+		for _, inV := range edge.InVs {
+			if _, ok := state.RangeData[inV]; !ok {
+				return malformedDump(id, inV, "range")
+			}
+
+			// Link type definition data to defining range
+			documentMap.SetAdd(edge.Document, inV)
+		}
+
+		return nil
+	}
+
 	if documentMap, ok := state.ImplementationData[edge.OutV]; ok {
 		for _, inV := range edge.InVs {
 			if _, ok := state.RangeData[inV]; !ok {
@@ -481,6 +501,21 @@ func correlateTextDocumentReferencesEdge(state *wrappedState, id int, edge Edge)
 	return nil
 }
 
+func correlateTextDocumentTypeDefinitionEdge(state *wrappedState, id int, edge Edge) error {
+	if _, ok := state.TypeDefinitionData[edge.InV]; !ok {
+		return malformedDump(id, edge.InV, "typeDefinitionResult")
+	}
+
+	if source, ok := state.RangeData[edge.OutV]; ok {
+		state.RangeData[edge.OutV] = source.SetTypeDefinitionResultID(edge.InV)
+	} else if source, ok := state.ResultSetData[edge.OutV]; ok {
+		state.ResultSetData[edge.OutV] = source.SetTypeDefinitionResultID(edge.InV)
+	} else {
+		return malformedDump(id, edge.OutV, "range", "resultSet")
+	}
+	return nil
+}
+
 func correlateTextDocumentImplementationEdge(state *wrappedState, id int, edge Edge) error {
 	if _, ok := state.ImplementationData[edge.InV]; !ok {
 		return malformedDump(id, edge.InV, "implementationResult")
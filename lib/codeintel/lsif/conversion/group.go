@@ -10,6 +10,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/bloomfilter"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/conversion/datastructures"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/precise"
 )
 
@@ -24,7 +25,7 @@ const resultsPerResultChunk = 512
 
 // groupBundleData converts a raw (but canonicalized) correlation State into a GroupedBundleData.
 func groupBundleData(ctx context.Context, state *State) (*precise.GroupedBundleDataChans, error) {
-	numResults := len(state.DefinitionData) + len(state.ReferenceData) + len(state.ImplementationData)
+	numResults := len(state.DefinitionData) + len(state.ReferenceData) + len(state.TypeDefinitionData) + len(state.ImplementationData)
 	numResultChunks := int(math.Max(1, math.Floor(float64(numResults)/resultsPerResultChunk)))
 
 	meta := precise.MetaData{NumResultChunks: numResultChunks}
@@ -122,10 +123,12 @@ func serializeDocument(state *State, documentID int) precise.DocumentData {
 			EndCharacter:           rangeData.End.Character,
 			DefinitionResultID:     toID(rangeData.DefinitionResultID),
 			ReferenceResultID:      toID(rangeData.ReferenceResultID),
+			TypeDefinitionResultID: toID(rangeData.TypeDefinitionResultID),
 			ImplementationResultID: toID(rangeData.ImplementationResultID),
 			HoverResultID:          toID(rangeData.HoverResultID),
 			DocumentationResultID:  toID(rangeData.DocumentationResultID),
 			MonikerIDs:             monikerIDs,
+			Symbol:                 symbolData(rangeData.Tag),
 		}
 
 		if rangeData.HoverResultID != 0 {
@@ -166,6 +169,10 @@ func serializeResultChunks(ctx context.Context, state *State, numResultChunks in
 		index := precise.HashKey(toID(id), numResultChunks)
 		chunkAssignments[index] = append(chunkAssignments[index], entry{id: id, ranges: ranges})
 	}
+	for id, ranges := range state.TypeDefinitionData {
+		index := precise.HashKey(toID(id), numResultChunks)
+		chunkAssignments[index] = append(chunkAssignments[index], entry{id: id, ranges: ranges})
+	}
 	for id, ranges := range state.ImplementationData {
 		index := precise.HashKey(toID(id), numResultChunks)
 		chunkAssignments[index] = append(chunkAssignments[index], entry{id: id, ranges: ranges})
@@ -461,3 +468,20 @@ func gatherPackageReferences(state *State, packageDefinitions []precise.Package)
 
 	return packageReferences, nil
 }
+
+// symbolData returns the symbol data described by the given range tag. Only definition tags
+// with a full range describe the extent of a symbol, so other tags are ignored.
+func symbolData(tag *protocol.RangeTag) *precise.SymbolData {
+	if tag == nil || tag.Type != "definition" || tag.FullRange == nil {
+		return nil
+	}
+
+	return &precise.SymbolData{
+		Text:               tag.Text,
+		Kind:               tag.Kind,
+		FullStartLine:      tag.FullRange.Start.Line,
+		FullStartCharacter: tag.FullRange.Start.Character,
+		FullEndLine:        tag.FullRange.End.Line,
+		FullEndCharacter:   tag.FullRange.End.Character,
+	}
+}
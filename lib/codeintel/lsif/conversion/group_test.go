@@ -39,6 +39,15 @@ func TestGroupBundleData(t *testing.T) {
 						Start: protocol.Pos{Line: 2, Character: 3},
 						End:   protocol.Pos{Line: 4, Character: 5},
 					},
+					Tag: &protocol.RangeTag{
+						Type: "definition",
+						Text: "foo",
+						Kind: protocol.Function,
+						FullRange: &protocol.RangeData{
+							Start: protocol.Pos{Line: 1, Character: 0},
+							End:   protocol.Pos{Line: 9, Character: 1},
+						},
+					},
 				},
 				DefinitionResultID: 3001,
 				ReferenceResultID:  0,
@@ -367,6 +376,14 @@ func TestGroupBundleData(t *testing.T) {
 					ReferenceResultID:  "",
 					HoverResultID:      "",
 					MonikerIDs:         []precise.ID{"4003", "4004", "4007"},
+					Symbol: &precise.SymbolData{
+						Text:               "foo",
+						Kind:               protocol.Function,
+						FullStartLine:      1,
+						FullStartCharacter: 0,
+						FullEndLine:        9,
+						FullEndCharacter:   1,
+					},
 				},
 				"2003": {
 					StartLine:          3,
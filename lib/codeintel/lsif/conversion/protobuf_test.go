@@ -0,0 +1,377 @@
+package conversion
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/conversion/datastructures"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol/reader"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol/scip"
+)
+
+func TestCorrelateProtobufRoundTrip(t *testing.T) {
+	testCases := []struct {
+		filename    string
+		root        string
+		projectRoot string
+	}{
+		{filename: "../testdata/dump1.lsif", root: "root", projectRoot: "file:///test/"},
+		{filename: "../testdata/dump2.lsif", root: "root/", projectRoot: "file:///test/"},
+		{filename: "../testdata/dump3.lsif", root: "", projectRoot: "file:///__w/sourcegraph/sourcegraph/shared/"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.filename, func(t *testing.T) {
+			input, err := os.ReadFile(testCase.filename)
+			if err != nil {
+				t.Fatalf("unexpected error reading test file: %s", err)
+			}
+
+			jsonState, err := correlateFromReader(context.Background(), bytes.NewReader(input), testCase.root)
+			if err != nil {
+				t.Fatalf("unexpected error correlating input: %s", err)
+			}
+			canonicalize(jsonState)
+
+			encoded := marshalProtobufIndex(t, protobufIndexFromState(t, jsonState, testCase.projectRoot))
+			protobufState, err := correlateFromReader(context.Background(), bytes.NewReader(encoded), testCase.root)
+			if err != nil {
+				t.Fatalf("unexpected error correlating protobuf input: %s", err)
+			}
+			canonicalize(protobufState)
+
+			if protobufState.LSIFVersion != reader.ProtobufIndexVersion {
+				t.Errorf("unexpected version. want=%q have=%q", reader.ProtobufIndexVersion, protobufState.LSIFVersion)
+			}
+			if protobufState.ProjectRoot != jsonState.ProjectRoot {
+				t.Errorf("unexpected project root. want=%q have=%q", jsonState.ProjectRoot, protobufState.ProjectRoot)
+			}
+
+			// Only the ranges, hover text, and diagnostics of the test data are representable
+			// as occurrences of document-local symbols
+			for _, summarize := range []func(state *State) interface{}{
+				func(state *State) interface{} { return summarizeDocuments(state) },
+				func(state *State) interface{} { return summarizeHovers(state) },
+				func(state *State) interface{} { return summarizeDiagnostics(state) },
+			} {
+				if diff := cmp.Diff(summarize(jsonState), summarize(protobufState)); diff != "" {
+					t.Errorf("unexpected state (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCorrelateProtobuf(t *testing.T) {
+	const (
+		iface  = "scip-go gomod github.com/test/pkg v1.0.0 `pkg`/Animal#Sound()."
+		impl   = "scip-go gomod github.com/test/pkg v1.0.0 `pkg`/Dog#Sound()."
+		typ    = "scip-go gomod github.com/test/pkg v1.0.0 `pkg`/Dog#"
+		value  = "scip-go gomod github.com/test/pkg v1.0.0 `pkg`/rex."
+		remote = "scip-go gomod github.com/test/dep v2.0.0 `dep`/Bark()."
+	)
+
+	index := &scip.Index{
+		Metadata: &scip.Metadata{ToolInfo: &scip.ToolInfo{Name: "scip-go"}, ProjectRoot: "file:///test/"},
+		Documents: []*scip.Document{
+			{
+				RelativePath: "root/animal.go",
+				Occurrences: []*scip.Occurrence{
+					{Range: []int32{1, 1, 6}, Symbol: iface, SymbolRoles: int32(scip.SymbolRole_Definition)},
+					{Range: []int32{2, 1, 4}, Symbol: "local 0", SymbolRoles: int32(scip.SymbolRole_Definition)},
+					{Range: []int32{3, 1, 4}, Symbol: "local 0", OverrideDocumentation: []string{"shadowed"}},
+				},
+				Symbols: []*scip.SymbolInformation{
+					{Symbol: iface, Documentation: []string{"Sound is a sound."}},
+					{Symbol: "local 0", Documentation: []string{"a local"}},
+				},
+			},
+			{
+				RelativePath: "root/dog.go",
+				Occurrences: []*scip.Occurrence{
+					{Range: []int32{1, 5, 8}, Symbol: typ, SymbolRoles: int32(scip.SymbolRole_Definition)},
+					{Range: []int32{2, 1, 2, 6}, Symbol: impl, SymbolRoles: int32(scip.SymbolRole_Definition)},
+					{Range: []int32{3, 1, 6}, Symbol: iface},
+					{Range: []int32{4, 1, 5}, Symbol: remote},
+					{Range: []int32{5, 4, 7}, Symbol: value, SymbolRoles: int32(scip.SymbolRole_Definition)},
+					{Range: []int32{5, 4, 7}, Diagnostics: []*scip.Diagnostic{{Severity: scip.Severity_Warning, Code: "W1", Message: "unused", Source: "vet"}}},
+					{Range: []int32{0, 0, 1}, Symbol: "local 0", SymbolRoles: int32(scip.SymbolRole_Definition)},
+				},
+				Symbols: []*scip.SymbolInformation{
+					{Symbol: impl, Relationships: []*scip.Relationship{{Symbol: iface, IsImplementation: true, IsReference: true}}},
+					{Symbol: value, Relationships: []*scip.Relationship{{Symbol: typ, IsTypeDefinition: true}}},
+				},
+			},
+		},
+		ExternalSymbols: []*scip.SymbolInformation{
+			{Symbol: remote, Documentation: []string{"Bark barks."}},
+		},
+	}
+
+	state, err := correlateFromReader(context.Background(), bytes.NewReader(marshalProtobufIndex(t, index)), "root")
+	if err != nil {
+		t.Fatalf("unexpected error correlating input: %s", err)
+	}
+	canonicalize(state)
+
+	monikers := func(kind, symbol string) []string {
+		parsed, err := scip.ParseSymbol(symbol)
+		if err != nil {
+			t.Fatalf("unexpected error parsing symbol: %s", err)
+		}
+		return []string{fmt.Sprintf("%s:scip-go:%s@%s:%s", kind, parsed.Descriptors, parsed.Package.Name, parsed.Package.Version)}
+	}
+
+	expectedSummaries := map[string]rangeSummary{
+		"animal.go:1:1-1:6": {
+			Hover:           "Sound is a sound.",
+			Definitions:     []string{"animal.go:1:1"},
+			References:      []string{"animal.go:1:1", "dog.go:2:1", "dog.go:3:1"},
+			Implementations: []string{"dog.go:2:1"},
+			Monikers:        monikers("export", iface),
+		},
+		"animal.go:2:1-2:4": {
+			Hover:       "a local",
+			Definitions: []string{"animal.go:2:1"},
+			References:  []string{"animal.go:2:1", "animal.go:3:1"},
+		},
+		"animal.go:3:1-3:4": {
+			Hover:       "shadowed",
+			Definitions: []string{"animal.go:2:1"},
+			References:  []string{"animal.go:2:1", "animal.go:3:1"},
+		},
+		"dog.go:0:0-0:1": {
+			Definitions: []string{"dog.go:0:0"},
+			References:  []string{"dog.go:0:0"},
+		},
+		"dog.go:1:5-1:8": {
+			Definitions: []string{"dog.go:1:5"},
+			References:  []string{"dog.go:1:5"},
+			Monikers:    monikers("export", typ),
+		},
+		"dog.go:2:1-2:6": {
+			Definitions: []string{"dog.go:2:1"},
+			References:  []string{"dog.go:2:1"},
+			Monikers:    monikers("export", impl),
+		},
+		"dog.go:3:1-3:6": {
+			Hover:           "Sound is a sound.",
+			Definitions:     []string{"animal.go:1:1"},
+			References:      []string{"animal.go:1:1", "dog.go:2:1", "dog.go:3:1"},
+			Implementations: []string{"dog.go:2:1"},
+			Monikers:        monikers("export", iface),
+		},
+		"dog.go:4:1-4:5": {
+			Hover:      "Bark barks.",
+			References: []string{"dog.go:4:1"},
+			Monikers:   monikers("import", remote),
+		},
+		"dog.go:5:4-5:7": {
+			Definitions:     []string{"dog.go:5:4"},
+			References:      []string{"dog.go:5:4"},
+			TypeDefinitions: []string{"dog.go:1:5"},
+			Monikers:        monikers("export", value),
+		},
+	}
+	if diff := cmp.Diff(expectedSummaries, summarizeRanges(state)); diff != "" {
+		t.Errorf("unexpected ranges (-want +got):\n%s", diff)
+	}
+
+	expectedDiagnostics := map[string][]Diagnostic{
+		"dog.go": {{Severity: 2, Code: "W1", Message: "unused", Source: "vet", StartLine: 5, StartCharacter: 4, EndLine: 5, EndCharacter: 7}},
+	}
+	if diff := cmp.Diff(expectedDiagnostics, summarizeDiagnostics(state)); diff != "" {
+		t.Errorf("unexpected diagnostics (-want +got):\n%s", diff)
+	}
+}
+
+func TestCorrelateProtobufMissingMetadata(t *testing.T) {
+	index := &scip.Index{Documents: []*scip.Document{{RelativePath: "foo.go"}}}
+
+	if _, err := correlateFromReader(context.Background(), bytes.NewReader(marshalProtobufIndex(t, index)), ""); err == nil {
+		t.Fatalf("expected an error correlating an index without metadata")
+	}
+}
+
+func marshalProtobufIndex(t *testing.T, index *scip.Index) []byte {
+	t.Helper()
+
+	encoded, err := proto.Marshal(index)
+	if err != nil {
+		t.Fatalf("unexpected error marshalling index: %s", err)
+	}
+
+	return encoded
+}
+
+// protobufIndexFromState converts the given canonicalized correlation state into a protobuf-encoded
+// index where each range is the only occurrence of its own document-local symbol.
+func protobufIndexFromState(t *testing.T, state *State, projectRoot string) *scip.Index {
+	index := &scip.Index{
+		Metadata: &scip.Metadata{
+			ToolInfo:    &scip.ToolInfo{Name: "test"},
+			ProjectRoot: projectRoot,
+		},
+	}
+
+	for _, documentID := range sortedKeys(state.DocumentData) {
+		relativePath, err := filepath.Rel(filepath.Clean(projectRoot), filepath.Join(state.ProjectRoot, state.DocumentData[documentID]))
+		if err != nil {
+			t.Fatalf("unexpected error relativizing path: %s", err)
+		}
+		document := &scip.Document{RelativePath: relativePath}
+
+		for _, rangeID := range sortedIDs(state.Contains.Get(documentID)) {
+			r := state.RangeData[rangeID]
+			symbol := fmt.Sprintf("local %d", rangeID)
+
+			document.Occurrences = append(document.Occurrences, &scip.Occurrence{
+				Range:  []int32{int32(r.Start.Line), int32(r.Start.Character), int32(r.End.Line), int32(r.End.Character)},
+				Symbol: symbol,
+			})
+
+			if r.HoverResultID != 0 {
+				document.Symbols = append(document.Symbols, &scip.SymbolInformation{
+					Symbol:        symbol,
+					Documentation: []string{state.HoverData[r.HoverResultID]},
+				})
+			}
+		}
+
+		for _, diagnosticResultID := range sortedIDs(state.Diagnostics.Get(documentID)) {
+			for _, diagnostic := range state.DiagnosticResults[diagnosticResultID] {
+				document.Occurrences = append(document.Occurrences, &scip.Occurrence{
+					Range: []int32{int32(diagnostic.StartLine), int32(diagnostic.StartCharacter), int32(diagnostic.EndLine), int32(diagnostic.EndCharacter)},
+					Diagnostics: []*scip.Diagnostic{{
+						Severity: scip.Severity(diagnostic.Severity),
+						Code:     diagnostic.Code,
+						Message:  diagnostic.Message,
+						Source:   diagnostic.Source,
+					}},
+				})
+			}
+		}
+
+		index.Documents = append(index.Documents, document)
+	}
+
+	return index
+}
+
+type rangeSummary struct {
+	Hover           string
+	Definitions     []string
+	References      []string
+	Implementations []string
+	TypeDefinitions []string
+	Monikers        []string
+}
+
+// summarizeRanges describes each range of the given canonicalized correlation state by its location
+// and the locations and text of its results, independently of element identifiers.
+func summarizeRanges(state *State) map[string]rangeSummary {
+	locations := func(data map[int]*datastructures.DefaultIDSetMap, resultID int) []string {
+		if resultID == 0 {
+			return nil
+		}
+
+		var locations []string
+		data[resultID].Each(func(documentID int, rangeIDs *datastructures.IDSet) {
+			rangeIDs.Each(func(rangeID int) {
+				locations = append(locations, locationKey(state.DocumentData[documentID], state.RangeData[rangeID]))
+			})
+		})
+		sort.Strings(locations)
+		return locations
+	}
+
+	summaries := map[string]rangeSummary{}
+	for documentID, path := range state.DocumentData {
+		state.Contains.SetEach(documentID, func(rangeID int) {
+			r := state.RangeData[rangeID]
+
+			var monikers []string
+			state.Monikers.SetEach(rangeID, func(monikerID int) {
+				moniker := state.MonikerData[monikerID]
+				packageInformation := state.PackageInformationData[moniker.PackageInformationID]
+				monikers = append(monikers, fmt.Sprintf("%s:%s:%s@%s:%s", moniker.Kind, moniker.Scheme, moniker.Identifier, packageInformation.Name, packageInformation.Version))
+			})
+			sort.Strings(monikers)
+
+			summaries[rangeKey(path, r)] = rangeSummary{
+				Hover:           state.HoverData[r.HoverResultID],
+				Definitions:     locations(state.DefinitionData, r.DefinitionResultID),
+				References:      locations(state.ReferenceData, r.ReferenceResultID),
+				Implementations: locations(state.ImplementationData, r.ImplementationResultID),
+				TypeDefinitions: locations(state.TypeDefinitionData, r.TypeDefinitionResultID),
+				Monikers:        monikers,
+			}
+		})
+	}
+
+	return summaries
+}
+
+// summarizeDocuments returns the paths of the documents of the given correlation state.
+func summarizeDocuments(state *State) []string {
+	paths := make([]string, 0, len(state.DocumentData))
+	for _, path := range state.DocumentData {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// summarizeHovers returns the hover text of each range of the given canonicalized correlation state.
+func summarizeHovers(state *State) map[string]string {
+	hovers := map[string]string{}
+	for key, summary := range summarizeRanges(state) {
+		hovers[key] = summary.Hover
+	}
+	return hovers
+}
+
+// summarizeDiagnostics returns the diagnostics of each document of the given correlation state.
+func summarizeDiagnostics(state *State) map[string][]Diagnostic {
+	diagnostics := map[string][]Diagnostic{}
+	for documentID, path := range state.DocumentData {
+		state.Diagnostics.SetEach(documentID, func(diagnosticResultID int) {
+			diagnostics[path] = append(diagnostics[path], state.DiagnosticResults[diagnosticResultID]...)
+		})
+	}
+	return diagnostics
+}
+
+func rangeKey(path string, r Range) string {
+	return fmt.Sprintf("%s:%d:%d-%d:%d", path, r.Start.Line, r.Start.Character, r.End.Line, r.End.Character)
+}
+
+func locationKey(path string, r Range) string {
+	return fmt.Sprintf("%s:%d:%d", path, r.Start.Line, r.Start.Character)
+}
+
+func sortedKeys(m map[int]string) []int {
+	keys := make([]int, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+	return keys
+}
+
+func sortedIDs(set *datastructures.IDSet) []int {
+	var ids []int
+	if set != nil {
+		set.Each(func(id int) { ids = append(ids, id) })
+	}
+	sort.Ints(ids)
+	return ids
+}
@@ -12,15 +12,21 @@ type Pair struct {
 	Err     error
 }
 
-// Read reads the given content as line-separated JSON objects and returns a channel of Pair values
-// for each non-empty line.
+// Read reads the given content as line-separated JSON objects or as a protobuf-encoded index,
+// depending on the detected format, and returns a channel of Pair values for each LSIF element.
 func Read(ctx context.Context, r io.Reader) <-chan Pair {
 	elements := make(chan Pair)
 
 	go func() {
 		defer close(elements)
 
-		for pair := range reader.Read(ctx, r) {
+		format, r, err := reader.DetectFormat(r)
+		if err != nil {
+			elements <- Pair{Err: err}
+			return
+		}
+
+		for pair := range reader.ReadFormat(ctx, r, format) {
 			element := Element{
 				ID:      pair.Element.ID,
 				Type:    pair.Element.Type,
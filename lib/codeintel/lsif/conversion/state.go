@@ -14,6 +14,7 @@ type State struct {
 	ResultSetData          map[int]ResultSet                       // maps resultSet ID -> ResultSet (which has *Result IDs)
 	DefinitionData         map[int]*datastructures.DefaultIDSetMap // maps definitionResult ID -> document ID -> range ID
 	ReferenceData          map[int]*datastructures.DefaultIDSetMap // maps referenceResult ID -> document ID -> range ID
+	TypeDefinitionData     map[int]*datastructures.DefaultIDSetMap // maps typeDefinitionResult ID -> document ID -> range ID
 	ImplementationData     map[int]*datastructures.DefaultIDSetMap // maps implementationResult ID -> document ID -> range ID
 	HoverData              map[int]string                          // maps hoverResult ID -> hover string
 	MonikerData            map[int]Moniker                         // maps moniker ID -> Moniker (which has kind, scheme, identifier, and packageInformation ID)
@@ -46,6 +47,7 @@ func newState() *State {
 		ResultSetData:          map[int]ResultSet{},
 		DefinitionData:         map[int]*datastructures.DefaultIDSetMap{},
 		ReferenceData:          map[int]*datastructures.DefaultIDSetMap{},
+		TypeDefinitionData:     map[int]*datastructures.DefaultIDSetMap{},
 		ImplementationData:     map[int]*datastructures.DefaultIDSetMap{},
 		HoverData:              map[int]string{},
 		MonikerData:            map[int]Moniker{},
@@ -12,6 +12,7 @@ type Range struct {
 	reader.Range
 	DefinitionResultID     int
 	ReferenceResultID      int
+	TypeDefinitionResultID int
 	ImplementationResultID int
 	HoverResultID          int
 	DocumentationResultID  int
@@ -36,6 +37,7 @@ func (r Range) SetDefinitionResultID(id int) Range {
 		Range:                  r.Range,
 		DefinitionResultID:     id,
 		ReferenceResultID:      r.ReferenceResultID,
+		TypeDefinitionResultID: r.TypeDefinitionResultID,
 		ImplementationResultID: r.ImplementationResultID,
 		HoverResultID:          r.HoverResultID,
 		DocumentationResultID:  r.DocumentationResultID,
@@ -50,6 +52,22 @@ func (r Range) SetReferenceResultID(id int) Range {
 		Range:                  r.Range,
 		DefinitionResultID:     r.DefinitionResultID,
 		ReferenceResultID:      id,
+		TypeDefinitionResultID: r.TypeDefinitionResultID,
+		ImplementationResultID: r.ImplementationResultID,
+		HoverResultID:          r.HoverResultID,
+		DocumentationResultID:  r.DocumentationResultID,
+	}
+}
+
+// Convenience function for setting the field within a map.
+//
+// See Note [Assignment to fields of structs in maps]
+func (r Range) SetTypeDefinitionResultID(id int) Range {
+	return Range{
+		Range:                  r.Range,
+		DefinitionResultID:     r.DefinitionResultID,
+		ReferenceResultID:      r.ReferenceResultID,
+		TypeDefinitionResultID: id,
 		ImplementationResultID: r.ImplementationResultID,
 		HoverResultID:          r.HoverResultID,
 		DocumentationResultID:  r.DocumentationResultID,
@@ -64,6 +82,7 @@ func (r Range) SetImplementationResultID(id int) Range {
 		Range:                  r.Range,
 		DefinitionResultID:     r.DefinitionResultID,
 		ReferenceResultID:      r.ReferenceResultID,
+		TypeDefinitionResultID: r.TypeDefinitionResultID,
 		ImplementationResultID: id,
 		HoverResultID:          r.HoverResultID,
 		DocumentationResultID:  r.DocumentationResultID,
@@ -78,6 +97,7 @@ func (r Range) SetHoverResultID(id int) Range {
 		Range:                  r.Range,
 		DefinitionResultID:     r.DefinitionResultID,
 		ReferenceResultID:      r.ReferenceResultID,
+		TypeDefinitionResultID: r.TypeDefinitionResultID,
 		ImplementationResultID: r.ImplementationResultID,
 		HoverResultID:          id,
 		DocumentationResultID:  r.DocumentationResultID,
@@ -92,6 +112,7 @@ func (r Range) SetDocumentationResultID(id int) Range {
 		Range:                  r.Range,
 		DefinitionResultID:     r.DefinitionResultID,
 		ReferenceResultID:      r.ReferenceResultID,
+		TypeDefinitionResultID: r.TypeDefinitionResultID,
 		ImplementationResultID: r.ImplementationResultID,
 		HoverResultID:          r.HoverResultID,
 		DocumentationResultID:  id,
@@ -102,6 +123,7 @@ type ResultSet struct {
 	reader.ResultSet
 	DefinitionResultID     int
 	ReferenceResultID      int
+	TypeDefinitionResultID int
 	ImplementationResultID int
 	HoverResultID          int
 	DocumentationResultID  int
@@ -115,6 +137,7 @@ func (rs ResultSet) SetDefinitionResultID(id int) ResultSet {
 		ResultSet:              rs.ResultSet,
 		DefinitionResultID:     id,
 		ReferenceResultID:      rs.ReferenceResultID,
+		TypeDefinitionResultID: rs.TypeDefinitionResultID,
 		ImplementationResultID: rs.ImplementationResultID,
 		HoverResultID:          rs.HoverResultID,
 		DocumentationResultID:  rs.DocumentationResultID,
@@ -129,6 +152,22 @@ func (rs ResultSet) SetReferenceResultID(id int) ResultSet {
 		ResultSet:              rs.ResultSet,
 		DefinitionResultID:     rs.DefinitionResultID,
 		ReferenceResultID:      id,
+		TypeDefinitionResultID: rs.TypeDefinitionResultID,
+		ImplementationResultID: rs.ImplementationResultID,
+		HoverResultID:          rs.HoverResultID,
+		DocumentationResultID:  rs.DocumentationResultID,
+	}
+}
+
+// Convenience function for setting the field within a map.
+//
+// See Note [Assignment to fields of structs in maps]
+func (rs ResultSet) SetTypeDefinitionResultID(id int) ResultSet {
+	return ResultSet{
+		ResultSet:              rs.ResultSet,
+		DefinitionResultID:     rs.DefinitionResultID,
+		ReferenceResultID:      rs.ReferenceResultID,
+		TypeDefinitionResultID: id,
 		ImplementationResultID: rs.ImplementationResultID,
 		HoverResultID:          rs.HoverResultID,
 		DocumentationResultID:  rs.DocumentationResultID,
@@ -143,6 +182,7 @@ func (rs ResultSet) SetImplementationResultID(id int) ResultSet {
 		ResultSet:              rs.ResultSet,
 		DefinitionResultID:     rs.DefinitionResultID,
 		ReferenceResultID:      rs.ReferenceResultID,
+		TypeDefinitionResultID: rs.TypeDefinitionResultID,
 		ImplementationResultID: id,
 		HoverResultID:          rs.HoverResultID,
 		DocumentationResultID:  rs.DocumentationResultID,
@@ -157,6 +197,7 @@ func (rs ResultSet) SetHoverResultID(id int) ResultSet {
 		ResultSet:              rs.ResultSet,
 		DefinitionResultID:     rs.DefinitionResultID,
 		ReferenceResultID:      rs.ReferenceResultID,
+		TypeDefinitionResultID: rs.TypeDefinitionResultID,
 		ImplementationResultID: rs.ImplementationResultID,
 		HoverResultID:          id,
 		DocumentationResultID:  rs.DocumentationResultID,
@@ -171,6 +212,7 @@ func (rs ResultSet) SetDocumentationResultID(id int) ResultSet {
 		ResultSet:              rs.ResultSet,
 		DefinitionResultID:     rs.DefinitionResultID,
 		ReferenceResultID:      rs.ReferenceResultID,
+		TypeDefinitionResultID: rs.TypeDefinitionResultID,
 		ImplementationResultID: rs.ImplementationResultID,
 		HoverResultID:          rs.HoverResultID,
 		DocumentationResultID:  id,
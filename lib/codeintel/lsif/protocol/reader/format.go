@@ -0,0 +1,85 @@
+package reader
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+)
+
+// Format is the encoding of a raw index.
+type Format int
+
+const (
+	// FormatJSON is line-delimited LSIF JSON.
+	FormatJSON Format = iota
+
+	// FormatProtobuf is a protobuf-encoded index (see the scip package).
+	FormatProtobuf
+)
+
+func (f Format) String() string {
+	if f == FormatProtobuf {
+		return "protobuf"
+	}
+	return "json"
+}
+
+// formatPeekSize is the number of bytes inspected to detect the format of an index.
+const formatPeekSize = 512
+
+// DetectFormat determines the format of the index in the given reader and returns a reader that
+// produces the entire index, including the bytes inspected to detect the format.
+func DetectFormat(r io.Reader) (Format, io.Reader, error) {
+	br := bufio.NewReaderSize(r, formatPeekSize)
+	prefix, err := br.Peek(formatPeekSize)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return FormatJSON, br, err
+	}
+
+	return detectFormat(prefix), br, nil
+}
+
+// detectFormat returns the format of an index beginning with the given bytes. A protobuf-encoded
+// index begins with the tag of one of its length-delimited top-level fields. Content that does not
+// look like a protobuf-encoded index is assumed to be LSIF JSON.
+func detectFormat(prefix []byte) Format {
+	if looksLikeJSONObject(prefix) {
+		return FormatJSON
+	}
+
+	if len(prefix) > 0 {
+		switch prefix[0] {
+		case 0x0a, 0x12, 0x1a:
+			// Tags of fields 1 (metadata), 2 (documents), and 3 (external symbols)
+			return FormatProtobuf
+		}
+	}
+
+	return FormatJSON
+}
+
+// looksLikeJSONObject returns true if the given bytes begin with an open brace followed by a key or
+// a close brace, ignoring whitespace. The metadata tag of a protobuf-encoded index is a newline and
+// may be followed by a length that is an open brace, but never then by a quote or a close brace.
+func looksLikeJSONObject(prefix []byte) bool {
+	prefix = bytes.TrimLeft(prefix, jsonWhitespace)
+	if len(prefix) == 0 || prefix[0] != '{' {
+		return false
+	}
+
+	prefix = bytes.TrimLeft(prefix[1:], jsonWhitespace)
+	return len(prefix) == 0 || prefix[0] == '"' || prefix[0] == '}'
+}
+
+const jsonWhitespace = " \t\r\n"
+
+// ReadFormat reads the given content in the given format and returns a channel of Pair values for
+// each LSIF element.
+func ReadFormat(ctx context.Context, r io.Reader, format Format) <-chan Pair {
+	if format == FormatProtobuf {
+		return ReadProtobuf(ctx, r)
+	}
+
+	return Read(ctx, r)
+}
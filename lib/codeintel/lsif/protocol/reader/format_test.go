@@ -0,0 +1,55 @@
+package reader
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol/scip"
+)
+
+func TestDetectFormat(t *testing.T) {
+	protobufIndex, err := proto.Marshal(&scip.Index{
+		Metadata: &scip.Metadata{ToolInfo: &scip.ToolInfo{Name: "test"}, ProjectRoot: "file:///test/"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error marshalling index: %s", err)
+	}
+
+	testCases := []struct {
+		name     string
+		content  []byte
+		expected Format
+	}{
+		{name: "json", content: []byte(`{"id":1,"type":"vertex","label":"metaData"}` + "\n"), expected: FormatJSON},
+		{name: "json with leading whitespace", content: []byte("\n  {\n\"id\": 1}\n"), expected: FormatJSON},
+		{name: "empty json object", content: []byte("{}\n"), expected: FormatJSON},
+		{name: "protobuf", content: protobufIndex, expected: FormatProtobuf},
+		{name: "protobuf with brace length", content: append([]byte{0x0a, '{', 0x12, 0x04}, bytes.Repeat([]byte("a"), 128)...), expected: FormatProtobuf},
+		{name: "protobuf documents", content: []byte{0x12, 0x00}, expected: FormatProtobuf},
+		{name: "empty", content: nil, expected: FormatJSON},
+		{name: "garbage", content: []byte("garbage"), expected: FormatJSON},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			format, r, err := DetectFormat(bytes.NewReader(testCase.content))
+			if err != nil {
+				t.Fatalf("unexpected error detecting format: %s", err)
+			}
+			if format != testCase.expected {
+				t.Errorf("unexpected format. want=%s have=%s", testCase.expected, format)
+			}
+
+			content, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatalf("unexpected error reading content: %s", err)
+			}
+			if !bytes.Equal(content, testCase.content) {
+				t.Errorf("unexpected content. want=%q have=%q", testCase.content, content)
+			}
+		})
+	}
+}
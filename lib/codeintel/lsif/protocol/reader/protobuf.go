@@ -0,0 +1,489 @@
+package reader
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol/scip"
+)
+
+// ProtobufIndexVersion is the version reported in the metaData vertex of a converted protobuf-encoded index.
+const ProtobufIndexVersion = "scip"
+
+// ReadProtobuf reads the given content as a protobuf-encoded index (see the scip package) and returns
+// a channel of Pair values for each LSIF vertex and edge equivalent to the index. Documents are read
+// and converted one at a time. Monikers and the results of symbol relationships are emitted after the
+// last document, as they depend on where each symbol is defined within the index.
+func ReadProtobuf(ctx context.Context, r io.Reader) <-chan Pair {
+	pairCh := make(chan Pair, ChannelBufferSize)
+
+	go func() {
+		defer close(pairCh)
+
+		converter := newProtobufConverter(func(element Element) error {
+			select {
+			case pairCh <- Pair{Element: element}:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+
+		err := scip.ReadIndex(r, scip.IndexVisitor{
+			VisitMetadata:       converter.convertMetadata,
+			VisitDocument:       converter.convertDocument,
+			VisitExternalSymbol: converter.convertExternalSymbol,
+		})
+		if err == nil {
+			err = converter.finalize()
+		}
+		if err != nil && ctx.Err() == nil {
+			pairCh <- Pair{Err: err}
+		}
+	}()
+
+	return pairCh
+}
+
+type protobufConverter struct {
+	emit             func(element Element) error
+	id               int
+	projectRoot      string
+	firstDocumentID  int
+	symbols          map[string]*protobufSymbol
+	globalSymbols    []*protobufSymbol
+	localSymbols     map[string]*protobufSymbol
+	relationships    []protobufRelationship
+	packageIDs       map[scip.Package]int
+	documentItems    map[int][]int
+	documentItemKeys []int
+}
+
+// protobufSymbol tracks the LSIF vertices emitted for a symbol of a protobuf-encoded index.
+type protobufSymbol struct {
+	symbol                 string
+	resultSetID            int
+	definitionResultID     int
+	referenceResultID      int
+	implementationResultID int
+	typeDefinitionResultID int
+	hasHover               bool
+	definitions            []protobufLocation
+}
+
+type protobufLocation struct {
+	documentID int
+	rangeID    int
+}
+
+type protobufRelationship struct {
+	source       *protobufSymbol
+	target       *protobufSymbol
+	relationship *scip.Relationship
+}
+
+func newProtobufConverter(emit func(element Element) error) *protobufConverter {
+	return &protobufConverter{
+		emit:       emit,
+		symbols:    map[string]*protobufSymbol{},
+		packageIDs: map[scip.Package]int{},
+	}
+}
+
+func (c *protobufConverter) convertMetadata(metadata *scip.Metadata) error {
+	if metadata.ProjectRoot == "" {
+		return errors.New("metadata has no project root")
+	}
+	c.projectRoot = metadata.ProjectRoot
+
+	_, err := c.emitVertex("metaData", MetaData{
+		Version:     ProtobufIndexVersion,
+		ProjectRoot: metadata.ProjectRoot,
+	})
+	return err
+}
+
+func (c *protobufConverter) convertDocument(document *scip.Document) error {
+	if c.projectRoot == "" {
+		return scip.ErrMissingMetadata
+	}
+
+	documentID, err := c.emitVertex("document", strings.TrimSuffix(c.projectRoot, "/")+"/"+document.RelativePath)
+	if err != nil {
+		return err
+	}
+	if c.firstDocumentID == 0 {
+		c.firstDocumentID = documentID
+	}
+
+	// Local symbols are scoped to the document
+	c.localSymbols = map[string]*protobufSymbol{}
+	c.documentItems = map[int][]int{}
+	c.documentItemKeys = nil
+
+	for _, symbol := range document.Symbols {
+		if err := c.convertSymbolInformation(symbol); err != nil {
+			return err
+		}
+	}
+
+	var rangeIDs []int
+	var diagnostics []Diagnostic
+	for _, occurrence := range document.Occurrences {
+		rangeData, err := protobufRange(occurrence.Range)
+		if err != nil {
+			return errors.Wrapf(err, "document %q", document.RelativePath)
+		}
+
+		for _, diagnostic := range occurrence.Diagnostics {
+			diagnostics = append(diagnostics, Diagnostic{
+				Severity:       int(diagnostic.Severity),
+				Code:           diagnostic.Code,
+				Message:        diagnostic.Message,
+				Source:         diagnostic.Source,
+				StartLine:      rangeData.Start.Line,
+				StartCharacter: rangeData.Start.Character,
+				EndLine:        rangeData.End.Line,
+				EndCharacter:   rangeData.End.Character,
+			})
+		}
+
+		if occurrence.Symbol == "" {
+			// Occurrences without a symbol only carry diagnostics
+			continue
+		}
+
+		rangeID, err := c.convertOccurrence(documentID, rangeData, occurrence)
+		if err != nil {
+			return err
+		}
+		rangeIDs = append(rangeIDs, rangeID)
+	}
+
+	for _, resultID := range c.documentItemKeys {
+		if err := c.emitEdge("item", Edge{OutV: resultID, InVs: c.documentItems[resultID], Document: documentID}); err != nil {
+			return err
+		}
+	}
+
+	if len(rangeIDs) > 0 {
+		if err := c.emitEdge("contains", Edge{OutV: documentID, InVs: rangeIDs}); err != nil {
+			return err
+		}
+	}
+
+	if len(diagnostics) > 0 {
+		diagnosticResultID, err := c.emitVertex("diagnosticResult", diagnostics)
+		if err != nil {
+			return err
+		}
+		if err := c.emitEdge("textDocument/diagnostic", Edge{OutV: documentID, InV: diagnosticResultID}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// convertOccurrence emits a range for the given occurrence and links it to the results of its symbol.
+// The item edges are buffered and emitted once per result at the end of the document.
+func (c *protobufConverter) convertOccurrence(documentID int, rangeData protocol.RangeData, occurrence *scip.Occurrence) (int, error) {
+	rangeID, err := c.emitVertex("range", Range{RangeData: rangeData})
+	if err != nil {
+		return 0, err
+	}
+
+	symbol, err := c.lookupSymbol(occurrence.Symbol)
+	if err != nil {
+		return 0, err
+	}
+	if err := c.emitEdge("next", Edge{OutV: rangeID, InV: symbol.resultSetID}); err != nil {
+		return 0, err
+	}
+
+	if occurrence.IsDefinition() {
+		if symbol.definitionResultID == 0 {
+			if symbol.definitionResultID, err = c.emitResult("definitionResult", "textDocument/definition", symbol.resultSetID); err != nil {
+				return 0, err
+			}
+		}
+
+		c.addDocumentItem(symbol.definitionResultID, rangeID)
+		symbol.definitions = append(symbol.definitions, protobufLocation{documentID: documentID, rangeID: rangeID})
+	}
+
+	// Definitions are also references, as in LSIF
+	c.addDocumentItem(symbol.referenceResultID, rangeID)
+
+	if len(occurrence.OverrideDocumentation) > 0 {
+		if err := c.emitHover(rangeID, occurrence.OverrideDocumentation); err != nil {
+			return 0, err
+		}
+	}
+
+	return rangeID, nil
+}
+
+func (c *protobufConverter) convertExternalSymbol(symbol *scip.SymbolInformation) error {
+	if c.projectRoot == "" {
+		return scip.ErrMissingMetadata
+	}
+
+	// External symbols are global, so there is no document to scope local symbols to
+	c.localSymbols = nil
+	return c.convertSymbolInformation(symbol)
+}
+
+// convertSymbolInformation attaches the hover text of the given symbol to its result set and records
+// its relationships, which are converted once all definitions in the index are known.
+func (c *protobufConverter) convertSymbolInformation(symbolInformation *scip.SymbolInformation) error {
+	symbol, err := c.lookupSymbol(symbolInformation.Symbol)
+	if err != nil {
+		return err
+	}
+
+	if len(symbolInformation.Documentation) > 0 && !symbol.hasHover {
+		symbol.hasHover = true
+
+		if err := c.emitHover(symbol.resultSetID, symbolInformation.Documentation); err != nil {
+			return err
+		}
+	}
+
+	for _, relationship := range symbolInformation.Relationships {
+		target, err := c.lookupSymbol(relationship.Symbol)
+		if err != nil {
+			return err
+		}
+
+		c.relationships = append(c.relationships, protobufRelationship{
+			source:       symbol,
+			target:       target,
+			relationship: relationship,
+		})
+	}
+
+	return nil
+}
+
+// finalize emits the results of symbol relationships and the monikers of global symbols.
+func (c *protobufConverter) finalize() error {
+	for _, relationship := range c.relationships {
+		if err := c.convertRelationship(relationship); err != nil {
+			return err
+		}
+	}
+
+	for _, symbol := range c.globalSymbols {
+		if err := c.emitMoniker(symbol); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *protobufConverter) convertRelationship(relationship protobufRelationship) (err error) {
+	source, target := relationship.source, relationship.target
+
+	if relationship.relationship.IsImplementation && len(source.definitions) > 0 {
+		// The implementations of the target include the source
+		if target.implementationResultID == 0 {
+			if target.implementationResultID, err = c.emitResult("implementationResult", "textDocument/implementation", target.resultSetID); err != nil {
+				return err
+			}
+		}
+
+		if err := c.emitLocationItems(target.implementationResultID, source.definitions); err != nil {
+			return err
+		}
+	}
+
+	if relationship.relationship.IsTypeDefinition && len(target.definitions) > 0 {
+		// The type definitions of the source include the target
+		if source.typeDefinitionResultID == 0 {
+			if source.typeDefinitionResultID, err = c.emitResult("typeDefinitionResult", "textDocument/typeDefinition", source.resultSetID); err != nil {
+				return err
+			}
+		}
+
+		if err := c.emitLocationItems(source.typeDefinitionResultID, target.definitions); err != nil {
+			return err
+		}
+	}
+
+	if relationship.relationship.IsReference && c.firstDocumentID != 0 {
+		// The references of the target include the references of the source. Item edges linking
+		// reference results require a document, but the correlator does not use it.
+		if err := c.emitEdge("item", Edge{OutV: target.referenceResultID, InVs: []int{source.referenceResultID}, Document: c.firstDocumentID}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// emitMoniker emits a moniker for the given global symbol. Symbols defined within the index are
+// exported and all other symbols are imported. Malformed symbols do not receive a moniker.
+func (c *protobufConverter) emitMoniker(symbol *protobufSymbol) error {
+	parsed, err := scip.ParseSymbol(symbol.symbol)
+	if err != nil {
+		return nil
+	}
+
+	kind := "import"
+	if len(symbol.definitions) > 0 {
+		kind = "export"
+	}
+
+	monikerID, err := c.emitVertex("moniker", Moniker{
+		Kind:       kind,
+		Scheme:     parsed.Scheme,
+		Identifier: parsed.Descriptors,
+	})
+	if err != nil {
+		return err
+	}
+	if err := c.emitEdge("moniker", Edge{OutV: symbol.resultSetID, InV: monikerID}); err != nil {
+		return err
+	}
+
+	if parsed.Package.Name == "" {
+		return nil
+	}
+
+	packageID, ok := c.packageIDs[parsed.Package]
+	if !ok {
+		if packageID, err = c.emitVertex("packageInformation", PackageInformation{
+			Name:    parsed.Package.Name,
+			Version: parsed.Package.Version,
+		}); err != nil {
+			return err
+		}
+
+		c.packageIDs[parsed.Package] = packageID
+	}
+
+	return c.emitEdge("packageInformation", Edge{OutV: monikerID, InV: packageID})
+}
+
+// lookupSymbol returns the converted symbol with the given name, emitting a result set and reference
+// result for symbols that have not been seen yet. Local symbols are resolved within the current document.
+func (c *protobufConverter) lookupSymbol(name string) (*protobufSymbol, error) {
+	symbols := c.symbols
+	if scip.IsLocalSymbol(name) {
+		if c.localSymbols == nil {
+			return nil, errors.Errorf("local symbol %q used outside of a document", name)
+		}
+
+		symbols = c.localSymbols
+	}
+
+	if symbol, ok := symbols[name]; ok {
+		return symbol, nil
+	}
+
+	resultSetID, err := c.emitVertex("resultSet", nil)
+	if err != nil {
+		return nil, err
+	}
+	referenceResultID, err := c.emitResult("referenceResult", "textDocument/references", resultSetID)
+	if err != nil {
+		return nil, err
+	}
+
+	symbol := &protobufSymbol{
+		symbol:            name,
+		resultSetID:       resultSetID,
+		referenceResultID: referenceResultID,
+	}
+	symbols[name] = symbol
+
+	if !scip.IsLocalSymbol(name) {
+		c.globalSymbols = append(c.globalSymbols, symbol)
+	}
+
+	return symbol, nil
+}
+
+// emitResult emits a result vertex with the given label and links the given range or result set to it.
+func (c *protobufConverter) emitResult(label, edgeLabel string, outV int) (int, error) {
+	resultID, err := c.emitVertex(label, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	return resultID, c.emitEdge(edgeLabel, Edge{OutV: outV, InV: resultID})
+}
+
+// emitHover emits a hover result with the given markdown parts and links the given range or result set to it.
+func (c *protobufConverter) emitHover(outV int, documentation []string) error {
+	hoverResultID, err := c.emitVertex("hoverResult", strings.Join(documentation, HoverPartSeparator))
+	if err != nil {
+		return err
+	}
+
+	return c.emitEdge("textDocument/hover", Edge{OutV: outV, InV: hoverResultID})
+}
+
+// emitLocationItems emits item edges from the given result to the given ranges, one per document.
+func (c *protobufConverter) emitLocationItems(resultID int, locations []protobufLocation) error {
+	var documentIDs []int
+	rangeIDsByDocument := map[int][]int{}
+	for _, location := range locations {
+		if _, ok := rangeIDsByDocument[location.documentID]; !ok {
+			documentIDs = append(documentIDs, location.documentID)
+		}
+		rangeIDsByDocument[location.documentID] = append(rangeIDsByDocument[location.documentID], location.rangeID)
+	}
+
+	for _, documentID := range documentIDs {
+		if err := c.emitEdge("item", Edge{OutV: resultID, InVs: rangeIDsByDocument[documentID], Document: documentID}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addDocumentItem queues an item edge from the given result to the given range in the current document.
+func (c *protobufConverter) addDocumentItem(resultID, rangeID int) {
+	if _, ok := c.documentItems[resultID]; !ok {
+		c.documentItemKeys = append(c.documentItemKeys, resultID)
+	}
+	c.documentItems[resultID] = append(c.documentItems[resultID], rangeID)
+}
+
+func (c *protobufConverter) emitVertex(label string, payload interface{}) (int, error) {
+	c.id++
+	return c.id, c.emit(Element{ID: c.id, Type: "vertex", Label: label, Payload: payload})
+}
+
+func (c *protobufConverter) emitEdge(label string, edge Edge) error {
+	c.id++
+	return c.emit(Element{ID: c.id, Type: "edge", Label: label, Payload: edge})
+}
+
+// protobufRange converts an occurrence range of the form [startLine, startCharacter, endLine, endCharacter]
+// or [startLine, startCharacter, endCharacter] into an LSIF range.
+func protobufRange(r []int32) (protocol.RangeData, error) {
+	switch len(r) {
+	case 3:
+		return protocol.RangeData{
+			Start: protocol.Pos{Line: int(r[0]), Character: int(r[1])},
+			End:   protocol.Pos{Line: int(r[0]), Character: int(r[2])},
+		}, nil
+
+	case 4:
+		return protocol.RangeData{
+			Start: protocol.Pos{Line: int(r[0]), Character: int(r[1])},
+			End:   protocol.Pos{Line: int(r[2]), Character: int(r[3])},
+		}, nil
+	}
+
+	return protocol.RangeData{}, errors.Errorf("illegal occurrence range %v", r)
+}
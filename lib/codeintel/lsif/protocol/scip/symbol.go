@@ -0,0 +1,89 @@
+package scip
+
+import (
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Symbol is a parsed global symbol.
+type Symbol struct {
+	Scheme      string
+	Package     Package
+	Descriptors string
+}
+
+// Package identifies the package that defines a global symbol.
+type Package struct {
+	Manager string
+	Name    string
+	Version string
+}
+
+// IsLocalSymbol returns true if the given symbol is local to the document in which it occurs.
+func IsLocalSymbol(symbol string) bool {
+	return strings.HasPrefix(symbol, "local ")
+}
+
+// ParseSymbol parses a global symbol of the form `<scheme> <manager> <package-name> <version> <descriptors>`.
+// Spaces within the first four fields are escaped by doubling them, and empty package fields are written
+// as a single `.`. The descriptors are returned unparsed.
+func ParseSymbol(symbol string) (Symbol, error) {
+	if IsLocalSymbol(symbol) {
+		return Symbol{}, errors.Errorf("symbol %q is local", symbol)
+	}
+
+	var fields [4]string
+	rest := symbol
+	for i := range fields {
+		field, remainder, ok := cutSymbolField(rest)
+		if !ok || field == "" {
+			return Symbol{}, errors.Errorf("malformed symbol %q", symbol)
+		}
+
+		fields[i], rest = field, remainder
+	}
+	if rest == "" {
+		return Symbol{}, errors.Errorf("malformed symbol %q: no descriptors", symbol)
+	}
+
+	unplaceholder := func(field string) string {
+		if field == "." {
+			return ""
+		}
+		return field
+	}
+
+	return Symbol{
+		Scheme: fields[0],
+		Package: Package{
+			Manager: unplaceholder(fields[1]),
+			Name:    unplaceholder(fields[2]),
+			Version: unplaceholder(fields[3]),
+		},
+		Descriptors: rest,
+	}, nil
+}
+
+// cutSymbolField returns the unescaped text before the first unescaped space of the given
+// symbol text and the text following that space.
+func cutSymbolField(s string) (field, rest string, ok bool) {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != ' ' {
+			sb.WriteByte(s[i])
+			continue
+		}
+
+		if i+1 < len(s) && s[i+1] == ' ' {
+			// Escaped space
+			sb.WriteByte(' ')
+			i++
+			continue
+		}
+
+		return sb.String(), s[i+1:], true
+	}
+
+	return "", "", false
+}
@@ -0,0 +1,50 @@
+package scip
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseSymbol(t *testing.T) {
+	testCases := []struct {
+		symbol   string
+		expected Symbol
+	}{
+		{
+			symbol: "scip-go gomod github.com/test/foo v1.0.0 `foo`/Foo().",
+			expected: Symbol{
+				Scheme:      "scip-go",
+				Package:     Package{Manager: "gomod", Name: "github.com/test/foo", Version: "v1.0.0"},
+				Descriptors: "`foo`/Foo().",
+			},
+		},
+		{
+			symbol: "scheme  A . pkg  A v0.1.0 ident A",
+			expected: Symbol{
+				Scheme:      "scheme A",
+				Package:     Package{Manager: "", Name: "pkg A", Version: "v0.1.0"},
+				Descriptors: "ident A",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		symbol, err := ParseSymbol(testCase.symbol)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %s", testCase.symbol, err)
+		}
+
+		if diff := cmp.Diff(testCase.expected, symbol); diff != "" {
+			t.Errorf("unexpected symbol for %q (-want +got):\n%s", testCase.symbol, diff)
+		}
+	}
+}
+
+func TestParseSymbolMalformed(t *testing.T) {
+	for _, symbol := range []string{"local 4", "scip-go gomod", "scip-go gomod github.com/test/foo v1.0.0", "scip-go gomod github.com/test/foo v1.0.0 "} {
+		if _, err := ParseSymbol(symbol); err == nil {
+			t.Errorf("expected error parsing %q", symbol)
+		}
+	}
+}
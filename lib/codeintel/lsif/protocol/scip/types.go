@@ -0,0 +1,12 @@
+// Package scip reads protobuf-encoded code intelligence indexes. The format is a subset of SCIP
+// (see index.proto): an index is a list of documents containing occurrences of symbols, rather
+// than the graph of vertices and edges used by LSIF. The message types are generated from
+// index.proto by protoc-gen-go.
+package scip
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative index.proto
+
+// IsDefinition returns true if the occurrence defines its symbol.
+func (x *Occurrence) IsDefinition() bool {
+	return x.GetSymbolRoles()&int32(SymbolRole_Definition) != 0
+}
@@ -0,0 +1,161 @@
+package scip
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// MaxMessageSize is the maximum encoded size of a single top-level field of an index (e.g. one
+// document). Larger fields are rejected instead of being buffered into memory.
+const MaxMessageSize = 1 << 30
+
+// ErrMissingMetadata occurs when the first field of an index is not its metadata.
+var ErrMissingMetadata = errors.New("index does not begin with metadata")
+
+// ErrMessageTooLarge occurs when a top-level field of an index exceeds the maximum size.
+var ErrMessageTooLarge = errors.New("message exceeds maximum size")
+
+// IndexVisitor receives the top-level fields of an index. Fields whose visitor function is nil
+// are skipped without being decoded.
+type IndexVisitor struct {
+	VisitMetadata       func(metadata *Metadata) error
+	VisitDocument       func(document *Document) error
+	VisitExternalSymbol func(symbol *SymbolInformation) error
+}
+
+// ReadIndex reads a protobuf-encoded index from the given reader and calls the matching visitor
+// function for each top-level field in the order they are encoded. Each document is decoded and
+// visited on its own, so the index as a whole is never held in memory. An error returned from a
+// visitor function stops the read and is returned as-is.
+func ReadIndex(r io.Reader, visitor IndexVisitor) error {
+	br := bufio.NewReader(r)
+
+	for {
+		num, payload, err := readField(br, MaxMessageSize)
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		switch num {
+		case 1:
+			if visitor.VisitMetadata != nil {
+				metadata := &Metadata{}
+				if err := proto.Unmarshal(payload, metadata); err != nil {
+					return errors.Wrap(err, "malformed metadata")
+				}
+				if err := visitor.VisitMetadata(metadata); err != nil {
+					return err
+				}
+			}
+
+		case 2:
+			if visitor.VisitDocument != nil {
+				document := &Document{}
+				if err := proto.Unmarshal(payload, document); err != nil {
+					return errors.Wrap(err, "malformed document")
+				}
+				if err := visitor.VisitDocument(document); err != nil {
+					return err
+				}
+			}
+
+		case 3:
+			if visitor.VisitExternalSymbol != nil {
+				symbol := &SymbolInformation{}
+				if err := proto.Unmarshal(payload, symbol); err != nil {
+					return errors.Wrap(err, "malformed external symbol")
+				}
+				if err := visitor.VisitExternalSymbol(symbol); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// ReadMetadata decodes the metadata at the start of the given protobuf-encoded index. Only the
+// first field of the index is read. If the encoded metadata is larger than maxSize bytes, the
+// error ErrMessageTooLarge is returned.
+func ReadMetadata(r io.Reader, maxSize int) (*Metadata, error) {
+	num, payload, err := readField(bufio.NewReader(r), maxSize)
+	if err != nil {
+		if err == io.EOF {
+			return nil, ErrMissingMetadata
+		}
+		return nil, err
+	}
+	if num != 1 {
+		return nil, ErrMissingMetadata
+	}
+
+	metadata := &Metadata{}
+	if err := proto.Unmarshal(payload, metadata); err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+// readField reads the next top-level field of an index from the given reader and returns its field
+// number and, for length-delimited fields, its payload. Fields of other wire types are consumed and
+// returned with an empty payload. This method returns io.EOF only if the reader is exhausted before
+// the start of a field.
+func readField(r *bufio.Reader, maxSize int) (protowire.Number, []byte, error) {
+	tag, err := binary.ReadUvarint(r)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	num, typ := protowire.DecodeTag(tag)
+	if num < protowire.MinValidNumber {
+		return 0, nil, errors.Errorf("illegal field number %d", num)
+	}
+
+	switch typ {
+	case protowire.VarintType:
+		_, err = binary.ReadUvarint(r)
+		return num, nil, noEOF(err)
+
+	case protowire.Fixed32Type:
+		_, err = r.Discard(4)
+		return num, nil, noEOF(err)
+
+	case protowire.Fixed64Type:
+		_, err = r.Discard(8)
+		return num, nil, noEOF(err)
+
+	case protowire.BytesType:
+		size, err := binary.ReadUvarint(r)
+		if err != nil {
+			return 0, nil, noEOF(err)
+		}
+		if size > uint64(maxSize) {
+			return 0, nil, errors.Wrapf(ErrMessageTooLarge, "field %d is %d bytes", num, size)
+		}
+
+		payload := make([]byte, size)
+		if _, err := io.ReadFull(r, payload); err != nil {
+			return 0, nil, noEOF(err)
+		}
+
+		return num, payload, nil
+	}
+
+	return 0, nil, errors.Errorf("unsupported wire type %d for field %d", typ, num)
+}
+
+// noEOF converts an EOF in the middle of a field into an unexpected EOF.
+func noEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,112 @@
+package scip
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+var testIndex = &Index{
+	Metadata: &Metadata{
+		ToolInfo:             &ToolInfo{Name: "scip-test", Version: "0.1.0", Arguments: []string{"--flag", "./..."}},
+		ProjectRoot:          "file:///test/",
+		TextDocumentEncoding: TextEncoding_UTF8,
+	},
+	Documents: []*Document{
+		{
+			RelativePath: "root/foo.go",
+			Occurrences: []*Occurrence{
+				{Range: []int32{1, 2, 5}, Symbol: "scip-go gomod github.com/test/foo v1.0.0 `foo`/Foo().", SymbolRoles: int32(SymbolRole_Definition)},
+				{Range: []int32{3, 4, 5, 6}, Symbol: "local 0", OverrideDocumentation: []string{"local variable"}},
+				{Range: []int32{7, 0, 1}, Diagnostics: []*Diagnostic{{Severity: Severity_Error, Code: "E1", Message: "oops", Source: "vet"}}},
+			},
+			Symbols: []*SymbolInformation{
+				{
+					Symbol:        "scip-go gomod github.com/test/foo v1.0.0 `foo`/Foo().",
+					Documentation: []string{"```go\nfunc Foo()\n```", "Foo does things."},
+					Relationships: []*Relationship{{Symbol: "scip-go gomod github.com/test/foo v1.0.0 `foo`/Fooer#Foo().", IsImplementation: true, IsReference: true}},
+				},
+			},
+		},
+		{RelativePath: "root/bar.go"},
+	},
+	ExternalSymbols: []*SymbolInformation{
+		{Symbol: "scip-go gomod github.com/test/dep v2.0.0 `dep`/Bar().", Documentation: []string{"Bar"}},
+	},
+}
+
+func marshalIndex(t *testing.T, index *Index) []byte {
+	t.Helper()
+
+	encoded, err := proto.Marshal(index)
+	if err != nil {
+		t.Fatalf("unexpected error marshalling index: %s", err)
+	}
+
+	return encoded
+}
+
+func TestReadIndex(t *testing.T) {
+	// Unknown fields at every level must be skipped
+	encoded := marshalIndex(t, testIndex)
+	encoded = protowire.AppendTag(encoded, 15, protowire.VarintType)
+	encoded = protowire.AppendVarint(encoded, 42)
+	encoded = protowire.AppendTag(encoded, 16, protowire.BytesType)
+	encoded = protowire.AppendBytes(encoded, []byte("unknown"))
+
+	index := &Index{}
+	if err := ReadIndex(bytes.NewReader(encoded), IndexVisitor{
+		VisitMetadata: func(metadata *Metadata) error {
+			index.Metadata = metadata
+			return nil
+		},
+		VisitDocument: func(document *Document) error {
+			index.Documents = append(index.Documents, document)
+			return nil
+		},
+		VisitExternalSymbol: func(symbol *SymbolInformation) error {
+			index.ExternalSymbols = append(index.ExternalSymbols, symbol)
+			return nil
+		},
+	}); err != nil {
+		t.Fatalf("unexpected error reading index: %s", err)
+	}
+
+	if diff := cmp.Diff(testIndex, index, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected index (-want +got):\n%s", diff)
+	}
+}
+
+func TestReadIndexTruncated(t *testing.T) {
+	encoded := marshalIndex(t, testIndex)
+
+	err := ReadIndex(bytes.NewReader(encoded[:len(encoded)-3]), IndexVisitor{
+		VisitDocument: func(document *Document) error { return nil },
+	})
+	if err != io.ErrUnexpectedEOF {
+		t.Errorf("unexpected error. want=%q have=%q", io.ErrUnexpectedEOF, err)
+	}
+}
+
+func TestReadMetadata(t *testing.T) {
+	metadata, err := ReadMetadata(bytes.NewReader(marshalIndex(t, testIndex)), 1024)
+	if err != nil {
+		t.Fatalf("unexpected error reading metadata: %s", err)
+	}
+	if diff := cmp.Diff(testIndex.Metadata, metadata, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected metadata (-want +got):\n%s", diff)
+	}
+
+	if _, err := ReadMetadata(bytes.NewReader(marshalIndex(t, &Index{Documents: testIndex.Documents})), 1024); err != ErrMissingMetadata {
+		t.Errorf("unexpected error. want=%q have=%q", ErrMissingMetadata, err)
+	}
+	if _, err := ReadMetadata(bytes.NewReader(marshalIndex(t, testIndex)), 4); !errors.Is(err, ErrMessageTooLarge) {
+		t.Errorf("unexpected error. want=%q have=%q", ErrMessageTooLarge, err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 // ValidationError represents an error related to a set of LSIF input lines.
 type ValidationError struct {
 	Message       string
+	Kind          string
 	RelevantLines []LineContext
 }
 
@@ -31,7 +31,14 @@ func NewValidationContext() *ValidationContext {
 
 // AddError creates a new validaton error and saves it in the validation context.
 func (ctx *ValidationContext) AddError(message string, args ...interface{}) *reader.ValidationError {
+	return ctx.AddErrorOfKind(KindOther, message, args...)
+}
+
+// AddErrorOfKind creates a new validation error with the given kind and saves it in
+// the validation context.
+func (ctx *ValidationContext) AddErrorOfKind(kind, message string, args ...interface{}) *reader.ValidationError {
 	err := reader.NewValidationError(message, args...)
+	err.Kind = kind
 
 	ctx.ErrorsLock.Lock()
 	ctx.Errors = append(ctx.Errors, err)
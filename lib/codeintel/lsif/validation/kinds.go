@@ -0,0 +1,23 @@
+package validation
+
+// The following kinds classify the validation errors added to a validation context so that
+// consumers can group or filter problems in an index without parsing error messages.
+const (
+	// KindMissingContainsEdge indicates a range that is not attached to any document via a
+	// contains edge.
+	KindMissingContainsEdge = "MISSING_CONTAINS_EDGE"
+
+	// KindDanglingRange indicates an item edge that refers to a range which does not belong
+	// to the document named by the edge.
+	KindDanglingRange = "DANGLING_RANGE"
+
+	// KindUnresolvedMoniker indicates an import or export moniker that is not attached to any
+	// package information vertex.
+	KindUnresolvedMoniker = "UNRESOLVED_MONIKER"
+
+	// KindUnreachableVertex indicates a vertex that cannot be reached from any range.
+	KindUnreachableVertex = "UNREACHABLE_VERTEX"
+
+	// KindOther indicates any other malformed element or relationship.
+	KindOther = "OTHER"
+)
@@ -8,7 +8,14 @@ import (
 )
 
 type Validator struct {
-	Context                    *ValidationContext
+	Context *ValidationContext
+
+	// UploadChecks switches to the relationship checks used when recording findings for
+	// uploads: item edges referring to vertices without an owner are only reported once by
+	// the range ownership check, and import and export monikers must be attached to package
+	// information.
+	UploadChecks bool
+
 	raisedMissingMetadataError bool
 }
 
@@ -18,7 +25,12 @@ func (v *Validator) Validate(indexFile io.Reader) error {
 	}
 
 	if len(v.Context.Errors) == 0 {
-		for _, rv := range relationshipValidators {
+		validators := relationshipValidators
+		if v.UploadChecks {
+			validators = uploadRelationshipValidators
+		}
+
+		for _, rv := range validators {
 			rv(v.Context)
 		}
 	}
@@ -37,3 +37,14 @@ var relationshipValidators = []RelationshipValidator{
 	ensureItemContains,
 	ensureUnambiguousResultSets,
 }
+
+// uploadRelationshipValidators is the set of validators that operate across the entire LSIF
+// graph when the validator runs with UploadChecks enabled.
+var uploadRelationshipValidators = []RelationshipValidator{
+	ensureReachability,
+	ensureRangeOwnership,
+	ensureDisjointRanges,
+	ensureOwnedItemContains,
+	ensureUnambiguousResultSets,
+	ensureMonikerPackageInformation,
+}
@@ -23,7 +23,7 @@ func ensureReachability(ctx *ValidationContext) bool {
 		}
 
 		if _, ok := visited[lineContext.Element.ID]; !ok {
-			ctx.AddError("vertex %d unreachable from any range", lineContext.Element.ID).AddContext(lineContext)
+			ctx.AddErrorOfKind(KindUnreachableVertex, "vertex %d unreachable from any range", lineContext.Element.ID).AddContext(lineContext)
 			return false
 		}
 
@@ -86,7 +86,7 @@ func ensureRangeOwnership(ctx *ValidationContext) bool {
 	return ctx.Stasher.Vertices(func(lineContext reader.LineContext) bool {
 		if lineContext.Element.Label == "range" {
 			if _, ok := ownershipMap[lineContext.Element.ID]; !ok {
-				ctx.AddError("range %d not owned by any document", lineContext.Element.ID).AddContext(lineContext)
+				ctx.AddErrorOfKind(KindMissingContainsEdge, "range %d not owned by any document", lineContext.Element.ID).AddContext(lineContext)
 				return false
 			}
 		}
@@ -157,6 +157,17 @@ func ensureDisjoint(ctx *ValidationContext, documentID int, ranges []reader.Line
 // ensureItemContains ensures that the inVs of every item edge refer to range that belong
 // to the document specified by the item edge's document property.
 func ensureItemContains(ctx *ValidationContext) bool {
+	return ensureItemContainsWith(ctx, false)
+}
+
+// ensureOwnedItemContains is like ensureItemContains, but skips inVs without an owner. These
+// are either unowned ranges, which are reported by ensureRangeOwnership, or linked reference
+// results.
+func ensureOwnedItemContains(ctx *ValidationContext) bool {
+	return ensureItemContainsWith(ctx, true)
+}
+
+func ensureItemContainsWith(ctx *ValidationContext, skipUnowned bool) bool {
 	ownershipMap := ctx.OwnershipMap()
 	if ownershipMap == nil {
 		return false
@@ -165,8 +176,13 @@ func ensureItemContains(ctx *ValidationContext) bool {
 	return ctx.Stasher.Edges(func(lineContext reader.LineContext, edge protocolReader.Edge) bool {
 		if lineContext.Element.Label == "item" {
 			return forEachInV(edge, func(inV int) bool {
-				if ownershipMap[inV].DocumentID != edge.Document {
-					ctx.AddError("vertex %d should be owned by document %d", inV, edge.Document).AddContext(lineContext, ownershipMap[inV].LineContext)
+				owner, ok := ownershipMap[inV]
+				if !ok && skipUnowned {
+					return true
+				}
+
+				if owner.DocumentID != edge.Document {
+					ctx.AddErrorOfKind(KindDanglingRange, "vertex %d should be owned by document %d", inV, edge.Document).AddContext(lineContext, owner.LineContext)
 					return false
 				}
 
@@ -226,3 +242,38 @@ func ensureUnambiguousResultSets(ctx *ValidationContext) bool {
 
 	return valid
 }
+
+// ensureMonikerPackageInformation ensures that every import and export moniker is attached to
+// a package information vertex. Without package information, a moniker cannot be resolved to
+// the index of another repository.
+func ensureMonikerPackageInformation(ctx *ValidationContext) bool {
+	packageInformation := map[int]struct{}{}
+	_ = ctx.Stasher.Edges(func(lineContext reader.LineContext, edge protocolReader.Edge) bool {
+		if lineContext.Element.Label == "packageInformation" {
+			packageInformation[edge.OutV] = struct{}{}
+		}
+
+		return true
+	})
+
+	valid := true
+	_ = ctx.Stasher.Vertices(func(lineContext reader.LineContext) bool {
+		if lineContext.Element.Label != "moniker" {
+			return true
+		}
+
+		moniker, ok := lineContext.Element.Payload.(protocolReader.Moniker)
+		if !ok || (moniker.Kind != "import" && moniker.Kind != "export") {
+			return true
+		}
+
+		if _, ok := packageInformation[lineContext.Element.ID]; !ok {
+			ctx.AddErrorOfKind(KindUnresolvedMoniker, "%s moniker %d has no package information", moniker.Kind, lineContext.Element.ID).AddContext(lineContext)
+			valid = false
+		}
+
+		return true
+	})
+
+	return valid
+}
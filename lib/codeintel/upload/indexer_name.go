@@ -6,6 +6,8 @@ import (
 	"io"
 
 	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol/scip"
 )
 
 // MaxBufferSize is the maximum size of the metaData line in the dump. This should be large enough
@@ -54,3 +56,23 @@ func ReadIndexerName(r io.Reader) (string, error) {
 
 	return meta.ToolInfo.Name, nil
 }
+
+// ReadProtobufIndexerName returns the name of the tool that generated the given protobuf-encoded
+// index contents. This function reads only the metadata message, which must be the first field of
+// all valid indexes.
+func ReadProtobufIndexerName(r io.Reader) (string, error) {
+	metadata, err := scip.ReadMetadata(r, MaxBufferSize)
+	if err != nil {
+		if errors.Is(err, scip.ErrMessageTooLarge) {
+			return "", ErrMetadataExceedsBuffer
+		}
+		return "", ErrInvalidMetaDataVertex
+	}
+
+	name := metadata.GetToolInfo().GetName()
+	if name == "" {
+		return "", ErrInvalidMetaDataVertex
+	}
+
+	return name, nil
+}
@@ -5,6 +5,10 @@ import (
 	"io"
 	"strings"
 	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol/scip"
 )
 
 const testMetaDataVertex = `{"label": "metaData", "toolInfo": {"name": "test"}}`
@@ -28,6 +32,42 @@ func TestReadIndexerNameMalformed(t *testing.T) {
 	}
 }
 
+func TestReadProtobufIndexerName(t *testing.T) {
+	index := &scip.Index{
+		Metadata:  &scip.Metadata{ToolInfo: &scip.ToolInfo{Name: "test"}, ProjectRoot: "file:///test/"},
+		Documents: []*scip.Document{{RelativePath: "foo.go"}},
+	}
+
+	name, err := ReadProtobufIndexerName(bytes.NewReader(marshalProtobufIndex(t, index)))
+	if err != nil {
+		t.Fatalf("unexpected error reading indexer name: %s", err)
+	}
+	if name != "test" {
+		t.Errorf("unexpected indexer name. want=%s have=%s", "test", name)
+	}
+}
+
+func TestReadProtobufIndexerNameMalformed(t *testing.T) {
+	for _, index := range []*scip.Index{
+		{Documents: []*scip.Document{{RelativePath: "foo.go"}}},
+		{Metadata: &scip.Metadata{ProjectRoot: "file:///test/"}},
+	} {
+		if _, err := ReadProtobufIndexerName(bytes.NewReader(marshalProtobufIndex(t, index))); err != ErrInvalidMetaDataVertex {
+			t.Fatalf("unexpected error reading indexer name. want=%q have=%q", ErrInvalidMetaDataVertex, err)
+		}
+	}
+}
+
+func TestReadProtobufIndexerNameExceedsBuffer(t *testing.T) {
+	index := &scip.Index{
+		Metadata: &scip.Metadata{ToolInfo: &scip.ToolInfo{Name: "test", Arguments: []string{strings.Repeat("a", MaxBufferSize)}}},
+	}
+
+	if _, err := ReadProtobufIndexerName(bytes.NewReader(marshalProtobufIndex(t, index))); err != ErrMetadataExceedsBuffer {
+		t.Fatalf("unexpected error reading indexer name. want=%q have=%q", ErrMetadataExceedsBuffer, err)
+	}
+}
+
 func generateTestIndex(metaDataVertex string) io.Reader {
 	lines := []string{metaDataVertex}
 	for i := 0; i < 20000; i++ {
@@ -36,3 +76,14 @@ func generateTestIndex(metaDataVertex string) io.Reader {
 
 	return bytes.NewReader([]byte(strings.Join(lines, "\n") + "\n"))
 }
+
+func marshalProtobufIndex(t *testing.T, index *scip.Index) []byte {
+	t.Helper()
+
+	encoded, err := proto.Marshal(index)
+	if err != nil {
+		t.Fatalf("unexpected error marshalling index: %s", err)
+	}
+
+	return encoded
+}
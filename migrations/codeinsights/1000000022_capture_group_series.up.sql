@@ -0,0 +1,18 @@
+BEGIN;
+
+ALTER TABLE insight_series
+    ADD COLUMN IF NOT EXISTS generated_from_capture_groups BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON COLUMN insight_series.generated_from_capture_groups IS 'Whether this series dynamically generates one series per value of the first capture group in its query.';
+
+ALTER TABLE series_points
+    ADD COLUMN IF NOT EXISTS capture TEXT;
+
+COMMENT ON COLUMN series_points.capture IS 'The capture group value this point was recorded for, if the series is generated from capture groups.';
+
+ALTER TABLE series_points_snapshots
+    ADD COLUMN IF NOT EXISTS capture TEXT;
+
+COMMENT ON COLUMN series_points_snapshots.capture IS 'The capture group value this point was recorded for, if the series is generated from capture groups.';
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS insight_series_alert;
+DROP TYPE IF EXISTS insight_series_alert_comparator;
+
+COMMIT;
@@ -0,0 +1,32 @@
+BEGIN;
+
+CREATE TYPE insight_series_alert_comparator AS ENUM ('ABOVE', 'BELOW');
+
+CREATE TABLE IF NOT EXISTS insight_series_alert
+(
+    id                 SERIAL                          NOT NULL CONSTRAINT insight_series_alert_pk PRIMARY KEY,
+    insight_series_id  INT                             NOT NULL CONSTRAINT insight_series_alert_insight_series_id_fk REFERENCES insight_series (id) ON DELETE CASCADE,
+    insight_view_id    INT                             CONSTRAINT insight_series_alert_insight_view_id_fk REFERENCES insight_view (id) ON DELETE CASCADE,
+    comparator         insight_series_alert_comparator NOT NULL,
+    threshold          DOUBLE PRECISION                NOT NULL,
+    created_by_user_id INT                             NOT NULL,
+    email              BOOLEAN                         NOT NULL DEFAULT FALSE,
+    webhook_url        TEXT,
+    triggered_at       TIMESTAMP,
+    last_value         DOUBLE PRECISION,
+    created_at         TIMESTAMP DEFAULT NOW()         NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS insight_series_alert_insight_series_id_idx ON insight_series_alert (insight_series_id);
+
+COMMENT ON TABLE insight_series_alert IS 'Threshold alert rules attached to an insight series, evaluated every time new data points are recorded for the series.';
+COMMENT ON COLUMN insight_series_alert.insight_view_id IS 'Insight view the alert was created from. The default repository filters of the view apply to the series values the alert is evaluated against. The unfiltered series is used if NULL.';
+COMMENT ON COLUMN insight_series_alert.comparator IS 'Whether the alert fires when the series value goes above or below the threshold.';
+COMMENT ON COLUMN insight_series_alert.threshold IS 'Value of the series at which the alert fires.';
+COMMENT ON COLUMN insight_series_alert.created_by_user_id IS 'User that created the alert. Email notifications are sent to the primary email address of this user.';
+COMMENT ON COLUMN insight_series_alert.email IS 'Whether an email is sent to the creator of the alert when it fires.';
+COMMENT ON COLUMN insight_series_alert.webhook_url IS 'URL a JSON payload is posted to when the alert fires, if set.';
+COMMENT ON COLUMN insight_series_alert.triggered_at IS 'Time the alert last fired. The alert does not fire again until the series value recovers, which resets this column to NULL.';
+COMMENT ON COLUMN insight_series_alert.last_value IS 'Series value at the time the alert was last evaluated.';
+
+COMMIT;
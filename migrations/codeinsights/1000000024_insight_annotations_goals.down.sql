@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE insight_view_series
+    DROP COLUMN IF EXISTS goal_target_at,
+    DROP COLUMN IF EXISTS goal_value;
+
+DROP TABLE IF EXISTS insight_view_annotation;
+
+COMMIT;
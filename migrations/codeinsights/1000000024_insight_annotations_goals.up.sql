@@ -0,0 +1,27 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS insight_view_annotation
+(
+    id                 SERIAL                  NOT NULL CONSTRAINT insight_view_annotation_pk PRIMARY KEY,
+    insight_view_id    INT                     NOT NULL CONSTRAINT insight_view_annotation_insight_view_id_fk REFERENCES insight_view (id) ON DELETE CASCADE,
+    annotation_time    TIMESTAMP               NOT NULL,
+    label              TEXT                    NOT NULL,
+    created_by_user_id INT,
+    created_at         TIMESTAMP DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS insight_view_annotation_insight_view_id_idx ON insight_view_annotation (insight_view_id);
+
+COMMENT ON TABLE insight_view_annotation IS 'Events marked on the timeline of an insight view, such as the start of a migration.';
+COMMENT ON COLUMN insight_view_annotation.annotation_time IS 'Point in time the annotation marks.';
+COMMENT ON COLUMN insight_view_annotation.label IS 'Short description of the event.';
+COMMENT ON COLUMN insight_view_annotation.created_by_user_id IS 'User that created the annotation, if available.';
+
+ALTER TABLE insight_view_series
+    ADD COLUMN IF NOT EXISTS goal_value DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS goal_target_at TIMESTAMP;
+
+COMMENT ON COLUMN insight_view_series.goal_value IS 'Target value of the series in this view, rendered as a goal line.';
+COMMENT ON COLUMN insight_view_series.goal_target_at IS 'Date by which the goal value should be reached, if any.';
+
+COMMIT;
@@ -0,0 +1,19 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS lsif_upload_validation_findings (
+    id serial PRIMARY KEY,
+    upload_id integer NOT NULL REFERENCES lsif_uploads(id) ON DELETE CASCADE,
+    kind text NOT NULL,
+    message text NOT NULL,
+    line_numbers integer[] NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX IF NOT EXISTS lsif_upload_validation_findings_upload_id ON lsif_upload_validation_findings(upload_id);
+
+COMMENT ON TABLE lsif_upload_validation_findings IS 'Stores problems detected while validating the raw LSIF data of an upload.';
+COMMENT ON COLUMN lsif_upload_validation_findings.upload_id IS 'The identifier of the upload whose data was validated.';
+COMMENT ON COLUMN lsif_upload_validation_findings.kind IS 'The category of the problem (e.g. MISSING_CONTAINS_EDGE, DANGLING_RANGE, or UNRESOLVED_MONIKER).';
+COMMENT ON COLUMN lsif_upload_validation_findings.message IS 'A human-readable description of the problem.';
+COMMENT ON COLUMN lsif_upload_validation_findings.line_numbers IS 'The (one-based) lines of the index file relevant to the problem.';
+
+COMMIT;
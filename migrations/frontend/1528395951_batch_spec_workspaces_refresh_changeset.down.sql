@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE
+    batch_spec_workspaces
+DROP COLUMN IF EXISTS
+    refresh_changeset_id;
+
+COMMIT;
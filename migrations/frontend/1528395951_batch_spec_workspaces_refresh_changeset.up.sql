@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE
+    batch_spec_workspaces
+ADD COLUMN IF NOT EXISTS
+    refresh_changeset_id bigint REFERENCES changesets(id) ON DELETE SET NULL DEFERRABLE;
+
+COMMIT;
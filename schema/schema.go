@@ -131,6 +131,27 @@ func (v *AuthProviders) UnmarshalJSON(data []byte) error {
 	return fmt.Errorf("tagged union type must have a %q property whose value is one of %s", "type", []string{"builtin", "saml", "openidconnect", "http-header", "github", "gitlab"})
 }
 
+// AutoMerge description: A policy to automatically merge the changesets of this batch change once they meet the given requirements.
+type AutoMerge struct {
+	// CheckState description: The state of the checks of a changeset before it is merged. ANY merges changesets regardless of their checks, which includes changesets in repositories without any checks.
+	CheckState string `json:"checkState,omitempty"`
+	// Method description: The method used to merge changesets.
+	Method string `json:"method,omitempty"`
+	// ReviewState description: The review state a changeset needs to have before it is merged. ANY merges changesets regardless of their review state.
+	ReviewState string `json:"reviewState,omitempty"`
+	// Window description: The time window in which changesets are merged. If omitted, changesets are merged as soon as they meet the requirements.
+	Window *AutoMergeWindow `json:"window,omitempty"`
+}
+
+// AutoMergeWindow description: The time window in which changesets are merged. If omitted, changesets are merged as soon as they meet the requirements.
+type AutoMergeWindow struct {
+	// Days description: Day(s) the window applies to. If omitted, this rule applies to all days of the week.
+	Days []string `json:"days,omitempty"`
+	// End description: Window end time in UTC. If omitted, no time window is applied to the day(s) that match this rule.
+	End string `json:"end,omitempty"`
+	// Start description: Window start time in UTC. If omitted, no time window is applied to the day(s) that match this rule.
+	Start string `json:"start,omitempty"`
+}
 type BackendInsight struct {
 	// Description description: The description of this insight
 	Description string          `json:"description,omitempty"`
@@ -161,6 +182,8 @@ type BatchChangeRolloutWindow struct {
 
 // BatchSpec description: A batch specification, which describes the batch change and what kinds of changes to make (or what existing changesets to track).
 type BatchSpec struct {
+	// AutoMerge description: A policy to automatically merge the changesets of this batch change once they meet the given requirements.
+	AutoMerge *AutoMerge `json:"autoMerge,omitempty"`
 	// ChangesetTemplate description: A template describing how to create (and update) changesets with the file changes produced by the command steps.
 	ChangesetTemplate *ChangesetTemplate `json:"changesetTemplate,omitempty"`
 	// Description description: The description of the batch change.
@@ -171,6 +194,8 @@ type BatchSpec struct {
 	Name string `json:"name"`
 	// On description: The set of repositories (and branches) to run the batch change on, specified as a list of search queries (that match repositories) and/or specific repositories.
 	On []interface{} `json:"on,omitempty"`
+	// Rollout description: A staged rollout of the changesets of this batch change. Changesets are published wave by wave, and the next wave is only published once all changesets in the previous waves have been published and none of them has failing checks. Changesets that don't belong to any wave are published in a final wave.
+	Rollout []*RolloutWave `json:"rollout,omitempty"`
 	// Steps description: The sequence of commands to run (for each repository branch matched in the `on` property) to produce the workspace changes that will be included in the batch change.
 	Steps []*Step `json:"steps,omitempty"`
 	// TransformChanges description: Optional transformations to apply to the changes produced in each repository.
@@ -211,6 +236,8 @@ type BitbucketCloudConnection struct {
 	Url string `json:"url"`
 	// Username description: The username to use when authenticating to the Bitbucket Cloud. Also set the corresponding "appPassword" field.
 	Username string `json:"username"`
+	// WebhookSecret description: A shared secret used to authenticate incoming webhooks from Bitbucket Cloud. Since Bitbucket Cloud does not sign webhook payloads, the webhook URL must include this value as the "secret" query parameter.
+	WebhookSecret string `json:"webhookSecret,omitempty"`
 }
 
 // BitbucketCloudRateLimit description: Rate limit applied when making background API requests to Bitbucket Cloud.
@@ -352,12 +379,18 @@ type BranchChangesetSpec struct {
 	Body string `json:"body"`
 	// Commits description: The Git commits with the proposed changes. These commits are pushed to the head ref.
 	Commits []*GitCommitDescription `json:"commits"`
+	// Fork description: Whether to push the head ref to a fork of the base repository in the namespace of the user publishing the changeset, and open a cross-repository changeset from there.
+	Fork bool `json:"fork,omitempty"`
 	// HeadRef description: The full name of the Git ref that holds the changes proposed by this changeset. This ref will be created or updated with the commits.
 	HeadRef string `json:"headRef"`
 	// HeadRepository description: The GraphQL ID of the repository that contains the branch with this changeset's changes. Fork repositories and cross-repository changesets are not yet supported. Therefore, headRepository must be equal to baseRepository.
 	HeadRepository string `json:"headRepository"`
+	// Labels description: The labels to add to the changeset.
+	Labels []string `json:"labels,omitempty"`
 	// Published description: Whether to publish the changeset. An unpublished changeset can be previewed on Sourcegraph by any person who can view the batch change, but its commit, branch, and pull request aren't created on the code host. A published changeset results in a commit, branch, and pull request being created on the code host.
 	Published interface{} `json:"published,omitempty"`
+	// Reviewers description: The usernames of the users to request reviews of the changeset from.
+	Reviewers []string `json:"reviewers,omitempty"`
 	// Title description: The title of the changeset on the code host.
 	Title string `json:"title"`
 }
@@ -399,8 +432,14 @@ type ChangesetTemplate struct {
 	Branch string `json:"branch"`
 	// Commit description: The Git commit to create with the changes.
 	Commit ExpandedGitCommitDescription `json:"commit"`
+	// Fork description: Whether to push the changes to a fork of the repository in the namespace of the user applying the batch change, instead of to the repository itself. This allows users without push access to the repository to publish changesets. Only supported on GitHub and GitLab.
+	Fork bool `json:"fork,omitempty"`
+	// Labels description: The labels to add to the changeset. Each entry is rendered as a template. Labels are added on GitHub and GitLab. Publishing a changeset with labels to other code hosts fails.
+	Labels []string `json:"labels,omitempty"`
 	// Published description: Whether to publish the changeset. An unpublished changeset can be previewed on Sourcegraph by any person who can view the batch change, but its commit, branch, and pull request aren't created on the code host. A published changeset results in a commit, branch, and pull request being created on the code host. If omitted, the publication state is controlled from the Batch Changes UI.
 	Published interface{} `json:"published,omitempty"`
+	// Reviewers description: The users to request reviews of the changeset from. Each entry is rendered as a template and split on whitespace and commas, so that a single entry can produce multiple reviewers, such as the owners of the changed files from `${{ repo.owners steps.path }}`. Reviewers are requested on GitHub, GitLab and Bitbucket Server. Publishing a changeset with reviewers to other code hosts fails.
+	Reviewers []string `json:"reviewers,omitempty"`
 	// Title description: The title of the changeset.
 	Title string `json:"title"`
 }
@@ -1320,6 +1359,14 @@ type Responders struct {
 	Type     string `json:"type,omitempty"`
 	Username string `json:"username,omitempty"`
 }
+type RolloutWave struct {
+	// After description: How long to wait after the previous wave has been published before publishing this wave, as a Go duration.
+	After string `json:"after,omitempty"`
+	// Match description: A regular expression, optionally prefixed with "repo:", matching the names of the repositories whose changesets are published in this wave.
+	Match string `json:"match,omitempty"`
+	// Percent description: The percentage of all changesets of the batch change that are published in this wave, ordered by repository name.
+	Percent int `json:"percent,omitempty"`
+}
 
 // SAMLAuthProvider description: Configures the SAML authentication provider for SSO.
 //